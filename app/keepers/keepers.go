@@ -363,6 +363,7 @@ func (a *AppKeepers) InitKeepers(
 		appCodec,
 		a.keys[sequencermoduletypes.StoreKey],
 		a.BankKeeper,
+		a.DistrKeeper,
 		a.RollappKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -428,6 +429,9 @@ func (a *AppKeepers) InitKeepers(
 		a.AccountKeeper,
 		a.BankKeeper,
 		nil,
+		a.RollappKeeper,
+		a.SequencerKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	a.DymNSKeeper = dymnskeeper.NewKeeper(
@@ -460,6 +464,7 @@ func (a *AppKeepers) InitKeepers(
 		a.IBCKeeper.ChannelKeeper,
 		a.IBCKeeper.ChannelKeeper,
 		&a.EIBCKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	a.EIBCKeeper.SetDelayedAckKeeper(a.DelayedAckKeeper)
@@ -501,6 +506,8 @@ func (a *AppKeepers) InitKeepers(
 		a.IBCKeeper.ChannelKeeper,
 		govModuleAddress,
 	)
+
+	a.EIBCKeeper.SetPacketForwardKeeper(a.PacketForwardMiddlewareKeeper)
 }
 
 func (a *AppKeepers) InitTransferStack() {
@@ -585,6 +592,7 @@ func (a *AppKeepers) SetupHooks() {
 			a.TxFeesKeeper.Hooks(),
 			a.DelayedAckKeeper.GetEpochHooks(),
 			a.RollappKeeper.GetEpochHooks(),
+			a.EIBCKeeper.GetEpochHooks(),
 		),
 	)
 
@@ -593,6 +601,12 @@ func (a *AppKeepers) SetupHooks() {
 		a.DelayedAckKeeper.GetEIBCHooks(),
 	))
 
+	// must be set before the sequencer rollapp hooks take a copy of the sequencer keeper
+	a.SequencerKeeper.SetHooks(sequencermoduletypes.NewMultiSequencerHooks(
+		// insert sequencer hooks receivers here
+		a.EIBCKeeper.GetSequencerHooks(),
+	))
+
 	// dependencies injected in InitTransferStack()
 	a.delayedAckMiddleware = delayedackmodule.NewIBCMiddleware()
 	// register the rollapp hooks
@@ -604,6 +618,7 @@ func (a *AppKeepers) SetupHooks() {
 		a.DymNSKeeper.GetRollAppHooks(),
 		a.LightClientKeeper.RollappHooks(),
 		a.IROKeeper,
+		a.EIBCKeeper.GetRollappHooks(),
 	))
 }
 
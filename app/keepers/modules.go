@@ -249,6 +249,7 @@ var maccPerms = map[string][]string{
 	govtypes.ModuleName:                                {authtypes.Burner},
 	ibctransfertypes.ModuleName:                        {authtypes.Minter, authtypes.Burner},
 	sequencertypes.ModuleName:                          {authtypes.Minter, authtypes.Burner, authtypes.Staking},
+	sequencertypes.CompensationPoolName:                nil,
 	rollappmoduletypes.ModuleName:                      {authtypes.Burner},
 	sponsorshiptypes.ModuleName:                        nil,
 	streamermoduletypes.ModuleName:                     nil,
@@ -260,6 +261,7 @@ var maccPerms = map[string][]string{
 	txfeestypes.ModuleName:                             {authtypes.Burner},
 	dymnstypes.ModuleName:                              {authtypes.Minter, authtypes.Burner},
 	irotypes.ModuleName:                                {authtypes.Minter, authtypes.Burner},
+	eibcmoduletypes.ModuleName:                         nil,
 }
 
 var BeginBlockers = []string{
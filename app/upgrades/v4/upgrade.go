@@ -28,6 +28,8 @@ import (
 	"github.com/dymensionxyz/dymension/v3/app/upgrades"
 	delayedackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
 	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	eibckeeper "github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
+	eibctypes "github.com/dymensionxyz/dymension/v3/x/eibc/types"
 	incentiveskeeper "github.com/dymensionxyz/dymension/v3/x/incentives/keeper"
 	incentivestypes "github.com/dymensionxyz/dymension/v3/x/incentives/types"
 	lightclientkeeper "github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
@@ -64,6 +66,10 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 		migrateIncentivesParams(ctx, keepers.IncentivesKeeper)
+		migrateEIBCParams(ctx, keepers.EIBCKeeper)
+		if err := keepers.EIBCKeeper.ReindexPendingDemandOrders(ctx); err != nil {
+			return nil, err
+		}
 
 		if err := migrateRollappGauges(ctx, keepers.RollappKeeper, keepers.IncentivesKeeper); err != nil {
 			return nil, err
@@ -137,6 +143,9 @@ func migrateRollappParams(ctx sdk.Context, rollappkeeper *rollappkeeper.Keeper)
 	// overwrite params for rollapp module due to proto change
 	params := rollapptypes.DefaultParams()
 	params.DisputePeriodInBlocks = rollappkeeper.DisputePeriodInBlocks(ctx)
+	// rollapp owners must not be able to shorten the dispute period they had so far
+	params.MinDisputePeriodInBlocks = params.DisputePeriodInBlocks
+	params.MaxDisputePeriodInBlocks = max(params.MaxDisputePeriodInBlocks, params.DisputePeriodInBlocks)
 	rollappkeeper.SetParams(ctx, params)
 }
 
@@ -168,6 +177,11 @@ func migrateSequencers(ctx sdk.Context, sequencerkeeper sequencerkeeper.Keeper)
 	list := sequencerkeeper.GetAllSequencers(ctx)
 	for _, oldSequencer := range list {
 		newSequencer := ConvertOldSequencerToNew(oldSequencer)
+		// the height at which the sequencer joined the bonded set is not known before the upgrade,
+		// so the bonded sequencers are considered to join it at the upgrade
+		if newSequencer.Status == sequencertypes.Bonded {
+			newSequencer.BondHeight = ctx.BlockHeight()
+		}
 		sequencerkeeper.SetSequencer(ctx, newSequencer)
 
 		if oldSequencer.Proposer {
@@ -215,6 +229,15 @@ func migrateIncentivesParams(ctx sdk.Context, ik *incentiveskeeper.Keeper) {
 	ik.SetParams(ctx, params)
 }
 
+func migrateEIBCParams(ctx sdk.Context, ek eibckeeper.Keeper) {
+	// set the added params and keep the existing ones
+	params := eibctypes.DefaultParams()
+	params.EpochIdentifier = ek.EpochIdentifier(ctx)
+	params.TimeoutFee = ek.TimeoutFee(ctx)
+	params.ErrackFee = ek.ErrAckFee(ctx)
+	ek.SetParams(ctx, params)
+}
+
 func ConvertOldRollappToNew(oldRollapp rollapptypes.Rollapp) rollapptypes.Rollapp {
 	return rollapptypes.Rollapp{
 		RollappId:        oldRollapp.RollappId,
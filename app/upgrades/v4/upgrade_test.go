@@ -1,6 +1,7 @@
 package v4_test
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
@@ -13,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/dymensionxyz/dymension/v3/app"
@@ -165,11 +167,23 @@ func (s *UpgradeTestSuite) validateDelayedAckParamsMigration() error {
 
 func (s *UpgradeTestSuite) validateRollappParamsMigration() error {
 	rollappParams := s.App.RollappKeeper.GetParams(s.Ctx)
-	cond := rollappParams.DisputePeriodInBlocks == expectDisputePeriodInBlocks
+	cond := rollappParams.DisputePeriodInBlocks == expectDisputePeriodInBlocks &&
+		rollappParams.MinDisputePeriodInBlocks == expectDisputePeriodInBlocks
 
 	if !cond {
 		return fmt.Errorf("rollapp parameters not set correctly")
 	}
+
+	// the rollapp owner can't shorten the dispute period
+	rollappID := rollappIDFromIdx(0)
+	_, err := s.App.RollappKeeper.CheckAndUpdateRollappFields(s.Ctx, &rollapptypes.MsgUpdateRollappInformation{
+		Owner:                 sample.AccAddressFromSecret(rollappID),
+		RollappId:             rollappID,
+		DisputePeriodInBlocks: expectDisputePeriodInBlocks - 1,
+	})
+	if !errors.Is(err, gerrc.ErrOutOfRange) {
+		return fmt.Errorf("rollapp dispute period below the minimum: got error: %v", err)
+	}
 	return nil
 }
 
@@ -233,6 +247,7 @@ func (s *UpgradeTestSuite) validateSequencersMigration(numSeq int) error {
 	expectSequencers := make([]sequencertypes.Sequencer, len(testSeqs))
 	for i, sequencer := range testSeqs {
 		expectSequencers[i] = v4.ConvertOldSequencerToNew(sequencer)
+		expectSequencers[i].BondHeight = s.Ctx.BlockHeight()
 	}
 	sequencers := s.App.SequencerKeeper.GetAllSequencers(s.Ctx)
 	s.Require().Len(sequencers, len(expectSequencers))
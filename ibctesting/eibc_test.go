@@ -3,6 +3,7 @@ package ibctesting_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 	eibckeeper "github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	eibctypes "github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
@@ -131,11 +133,11 @@ func (s *eibcSuite) TestEIBCDemandOrderCreation() {
 			false,
 		},
 		{
-			"invalid demand order - PFM and EIBC are not supported together",
+			"valid demand order - PFM and EIBC",
 			"1000000000",
 			"150",
-			0,
-			true,
+			1,
+			false,
 			map[string]map[string]string{"forward": {
 				"receiver": s.hubChain().SenderAccount.GetAddress().String(),
 				"port":     "transfer",
@@ -143,6 +145,18 @@ func (s *eibcSuite) TestEIBCDemandOrderCreation() {
 			}},
 			false,
 		},
+		{
+			"invalid demand order - invalid PFM forward",
+			"1000000000",
+			"150",
+			0,
+			true,
+			map[string]map[string]string{"forward": {
+				"port":    "transfer",
+				"channel": "channel-0",
+			}},
+			false,
+		},
 	}
 	totalDemandOrdersCreated := 0
 	for _, tc := range cases {
@@ -376,6 +390,200 @@ func (s *eibcSuite) TestEIBCDemandOrderFulfillment() {
 	}
 }
 
+// TestEIBCDemandOrderFulfillmentWithForward tests the fulfillment of a demand order whose packet is forwarded
+// by the packet-forward-middleware from the rollapp, through the hub, to a third chain:
+// 1. Fund the fulfiller with the rollapp IBC denom.
+// 2. Send a packet from the rollapp with an eIBC memo which forwards it to the third chain.
+// 3. Fulfill the demand order and validate the price is forwarded to the receiver on the third chain.
+// 4. Finalize the rollapp state and validate the fulfiller gets the amount instead of it being forwarded again.
+func (s *eibcSuite) TestEIBCDemandOrderFulfillmentWithForward() {
+	cosmosPath := s.newTransferPath(s.hubChain(), s.cosmosChain())
+	s.coordinator.Setup(cosmosPath)
+
+	bankKeeper := s.hubApp().BankKeeper
+	IBCSenderAccount := s.rollappChain().SenderAccount.GetAddress().String()
+	recipient := apptesting.CreateRandomAccounts(1)[0]
+	fulfiller := s.hubChain().SenderAccounts[1].SenderAccount.GetAddress()
+	cosmosReceiver := s.cosmosChain().SenderAccount.GetAddress()
+
+	// Fund the fulfiller with the rollapp IBC denom
+	s.rollappChain().NextBlock()
+	s.updateRollappState(uint64(s.rollappCtx().BlockHeight()))
+	packet := s.transferRollappToHub(s.path, IBCSenderAccount, fulfiller.String(), "1000", "", false)
+	currentRollappBlockHeight := uint64(s.rollappCtx().BlockHeight())
+	_, err := s.finalizeRollappState(1, currentRollappBlockHeight)
+	s.Require().NoError(err)
+	s.finalizeRollappPacketsUntilHeight(currentRollappBlockHeight)
+	IBCDenom := s.getRollappToHubIBCDenomFromPacket(packet)
+
+	// Send a packet to be forwarded to the cosmos chain
+	s.rollappChain().NextBlock()
+	s.updateRollappState(uint64(s.rollappCtx().BlockHeight()))
+	memoObj := map[string]map[string]string{
+		"eibc": {
+			"fee": "100",
+		},
+		"forward": {
+			"receiver": cosmosReceiver.String(),
+			"port":     cosmosPath.EndpointA.ChannelConfig.PortID,
+			"channel":  cosmosPath.EndpointA.ChannelID,
+		},
+	}
+	memo, err := json.Marshal(memoObj)
+	s.Require().NoError(err)
+	packet = s.transferRollappToHub(s.path, IBCSenderAccount, recipient.String(), "1000", string(memo), false)
+
+	demandOrders, err := s.hubApp().EIBCKeeper.ListAllDemandOrders(s.hubCtx())
+	s.Require().NoError(err)
+	demandOrder := getLastDemandOrderByChannelAndSequence(demandOrders)
+	s.Require().Equal(recipient.String(), demandOrder.Recipient)
+	price := demandOrder.Price[0]
+	s.Require().Equal(sdk.NewCoin(IBCDenom, sdk.NewInt(900)), price)
+
+	// The order can't be fulfilled partially, as its packet is acknowledged once by the forward
+	_, err = s.msgServer().FulfillOrderPartial(s.hubCtx(), eibctypes.NewMsgFulfillOrderPartial(fulfiller.String(), demandOrder.Id, "100", "450"))
+	s.Require().ErrorIs(err, delayedacktypes.ErrMemoHashPFMandEIBC)
+
+	// Fulfill the demand order. The price is forwarded from the recipient right away.
+	preFulfillmentBalance := bankKeeper.GetBalance(s.hubCtx(), fulfiller, IBCDenom)
+	ctx := s.hubCtx()
+	_, err = s.msgServer().FulfillOrder(ctx, eibctypes.NewMsgFulfillOrder(fulfiller.String(), demandOrder.Id, "100"))
+	s.Require().NoError(err)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	s.Require().NoError(err)
+
+	s.Require().Equal(preFulfillmentBalance.Sub(price), bankKeeper.GetBalance(s.hubCtx(), fulfiller, IBCDenom))
+	s.Require().True(bankKeeper.GetBalance(s.hubCtx(), recipient, IBCDenom).IsZero())
+
+	// Relay the forwarded packet to the cosmos chain and its ack back to the hub
+	s.hubChain().NextBlock()
+	err = cosmosPath.RelayPacket(forwardPacket)
+	s.Require().NoError(err)
+
+	cosmosDenom := s.getIBCDenomForChannel(
+		cosmosPath.EndpointB.ChannelID,
+		types.GetPrefixedDenom(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sdk.DefaultBondDenom),
+	)
+	cosmosBalance := convertToApp(s.cosmosChain()).BankKeeper.GetBalance(s.cosmosCtx(), cosmosReceiver, cosmosDenom)
+	s.Require().Equal(price.Amount, cosmosBalance.Amount)
+
+	// Finalize the rollapp state. The fulfiller gets the amount and nothing is forwarded again.
+	currentRollappBlockHeight = uint64(s.rollappCtx().BlockHeight())
+	_, err = s.finalizeRollappState(2, currentRollappBlockHeight)
+	s.Require().NoError(err)
+	evts := s.finalizeRollappPacketsUntilHeight(currentRollappBlockHeight)
+
+	s.Require().Equal(preFulfillmentBalance.AddAmount(sdk.NewInt(100)), bankKeeper.GetBalance(s.hubCtx(), fulfiller, IBCDenom))
+	s.Require().True(bankKeeper.GetBalance(s.hubCtx(), recipient, IBCDenom).IsZero())
+	s.Require().Equal(cosmosBalance, convertToApp(s.cosmosChain()).BankKeeper.GetBalance(s.cosmosCtx(), cosmosReceiver, cosmosDenom))
+
+	// The ack of the original packet was written by the packet-forward-middleware, not on finalization
+	_, err = ibctesting.ParseAckFromEvents(evts)
+	s.Require().Error(err)
+
+	// The original packet is acknowledged on the rollapp with the ack of the forward
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	s.path.EndpointA.Chain.NextBlock()
+	_ = s.path.EndpointB.UpdateClient()
+	err = s.path.EndpointB.AcknowledgePacket(packet, ack)
+	s.Require().NoError(err)
+}
+
+// TestEIBCDemandOrderFulfillmentWithFailedForward tests the fulfillment of a demand order whose forward to the third
+// chain fails. The forward is nonrefundable, so the price lands with the recipient on the hub and the rollapp packet
+// is acknowledged with a success ack describing the failure.
+func (s *eibcSuite) TestEIBCDemandOrderFulfillmentWithFailedForward() {
+	cosmosPath := s.newTransferPath(s.hubChain(), s.cosmosChain())
+	s.coordinator.Setup(cosmosPath)
+
+	bankKeeper := s.hubApp().BankKeeper
+	IBCSenderAccount := s.rollappChain().SenderAccount.GetAddress().String()
+	recipient := apptesting.CreateRandomAccounts(1)[0]
+	fulfiller := s.hubChain().SenderAccounts[1].SenderAccount.GetAddress()
+
+	// Fund the fulfiller with the rollapp IBC denom
+	s.rollappChain().NextBlock()
+	s.updateRollappState(uint64(s.rollappCtx().BlockHeight()))
+	packet := s.transferRollappToHub(s.path, IBCSenderAccount, fulfiller.String(), "1000", "", false)
+	currentRollappBlockHeight := uint64(s.rollappCtx().BlockHeight())
+	_, err := s.finalizeRollappState(1, currentRollappBlockHeight)
+	s.Require().NoError(err)
+	s.finalizeRollappPacketsUntilHeight(currentRollappBlockHeight)
+	IBCDenom := s.getRollappToHubIBCDenomFromPacket(packet)
+
+	// Send a packet to be forwarded to a receiver the cosmos chain rejects
+	s.rollappChain().NextBlock()
+	s.updateRollappState(uint64(s.rollappCtx().BlockHeight()))
+	memoObj := map[string]map[string]string{
+		"eibc": {
+			"fee": "100",
+		},
+		"forward": {
+			"receiver": "invalid",
+			"port":     cosmosPath.EndpointA.ChannelConfig.PortID,
+			"channel":  cosmosPath.EndpointA.ChannelID,
+		},
+	}
+	memo, err := json.Marshal(memoObj)
+	s.Require().NoError(err)
+	packet = s.transferRollappToHub(s.path, IBCSenderAccount, recipient.String(), "1000", string(memo), false)
+
+	demandOrders, err := s.hubApp().EIBCKeeper.ListAllDemandOrders(s.hubCtx())
+	s.Require().NoError(err)
+	demandOrder := getLastDemandOrderByChannelAndSequence(demandOrders)
+	price := demandOrder.Price[0]
+
+	// Fulfill the demand order. The price is forwarded from the recipient right away.
+	preFulfillmentBalance := bankKeeper.GetBalance(s.hubCtx(), fulfiller, IBCDenom)
+	ctx := s.hubCtx()
+	_, err = s.msgServer().FulfillOrder(ctx, eibctypes.NewMsgFulfillOrder(fulfiller.String(), demandOrder.Id, "100"))
+	s.Require().NoError(err)
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	s.Require().NoError(err)
+	s.Require().True(bankKeeper.GetBalance(s.hubCtx(), recipient, IBCDenom).IsZero())
+
+	// The cosmos chain rejects the forwarded packet with an error ack
+	s.hubChain().NextBlock()
+	err = cosmosPath.EndpointB.UpdateClient()
+	s.Require().NoError(err)
+	res, err := cosmosPath.EndpointB.RecvPacketWithResult(forwardPacket)
+	s.Require().NoError(err)
+	forwardAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	var errAck channeltypes.Acknowledgement
+	err = channeltypes.SubModuleCdc.UnmarshalJSON(forwardAck, &errAck)
+	s.Require().NoError(err)
+	s.Require().False(errAck.Success())
+
+	// The funds can't be refunded on the rollapp, so they are moved to the recipient on the hub
+	err = cosmosPath.EndpointA.UpdateClient()
+	s.Require().NoError(err)
+	err = cosmosPath.EndpointA.AcknowledgePacket(forwardPacket, forwardAck)
+	s.Require().NoError(err)
+	s.Require().Equal(price, bankKeeper.GetBalance(s.hubCtx(), recipient, IBCDenom))
+
+	// The original packet is acknowledged with a success ack describing the failure
+	ack := channeltypes.NewResultAcknowledgement([]byte(
+		fmt.Sprintf("packet forward failed after point of no return: %s", errAck.GetError()),
+	))
+	commitment, found := s.hubApp().IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(s.hubCtx(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	s.Require().True(found)
+	s.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), commitment)
+
+	// Finalize the rollapp state. The fulfiller gets the amount and the recipient keeps the price.
+	currentRollappBlockHeight = uint64(s.rollappCtx().BlockHeight())
+	_, err = s.finalizeRollappState(2, currentRollappBlockHeight)
+	s.Require().NoError(err)
+	s.finalizeRollappPacketsUntilHeight(currentRollappBlockHeight)
+	s.Require().Equal(preFulfillmentBalance.AddAmount(sdk.NewInt(100)), bankKeeper.GetBalance(s.hubCtx(), fulfiller, IBCDenom))
+	s.Require().Equal(price, bankKeeper.GetBalance(s.hubCtx(), recipient, IBCDenom))
+
+	s.path.EndpointA.Chain.NextBlock()
+	_ = s.path.EndpointB.UpdateClient()
+	err = s.path.EndpointB.AcknowledgePacket(packet, ack.Acknowledgement())
+	s.Require().NoError(err)
+}
+
 func (s *eibcSuite) rollappHasPacketCommitment(packet channeltypes.Packet) bool {
 	// TODO: this should be used to check that a commitment does (or doesn't) exist, when it should
 	// TODO: this is important to check that things actually work as expected and dont just look ok on the outside
@@ -36,6 +36,7 @@ func SequencerKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		cdc,
 		storeKey,
 		nil,
+		nil,
 		&rollappkeeper.Keeper{},
 		sample.AccAddress(),
 	)
@@ -24,3 +24,12 @@ func NewPacketUID(packetType RollappPacket_Type, hubPort string, hubChannel stri
 func (p PacketUID) String() string {
 	return fmt.Sprintf("%s-%s-%s-%d", p.Type, p.RollappHubChannel, p.RollappHubPort, p.Sequence)
 }
+
+// PacketUID returns the unique identifier of the rollapp packet on the hub
+func (r RollappPacket) PacketUID() PacketUID {
+	port, channel := r.Packet.GetSourcePort(), r.Packet.GetSourceChannel()
+	if r.Type == RollappPacket_ON_RECV {
+		port, channel = r.Packet.GetDestPort(), r.Packet.GetDestChannel()
+	}
+	return NewPacketUID(r.Type, port, channel, r.Packet.GetSequence())
+}
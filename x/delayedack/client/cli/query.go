@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetPacketsByRollapp())
 	cmd.AddCommand(CmdGetPacketsByStatus())
 	cmd.AddCommand(CmdGetPacketsByType())
+	cmd.AddCommand(CmdGetArchivedPackets())
+	cmd.AddCommand(CmdGetPausedRollapps())
 
 	return cmd
 }
@@ -217,3 +219,78 @@ func CmdGetPacketsByType() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetArchivedPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived-packets [sender|receiver|uid] [value]",
+		Short: "Get the archived summaries of the deleted packets",
+		Long: `Get the archived summaries of the deleted packets by sender, receiver or packet UID
+		Example:
+		archived-packets sender dym1...
+		archived-packets receiver dym1...
+		archived-packets uid ON_RECV-channel-0-transfer-1`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &types.QueryArchivedPacketsRequest{}
+			switch strings.ToLower(args[0]) {
+			case "sender":
+				req.Sender = args[1]
+			case "receiver":
+				req.Receiver = args[1]
+			case "uid":
+				req.PacketUid = args[1]
+			default:
+				return fmt.Errorf("invalid lookup: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req.Pagination, err = client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ArchivedPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdGetPausedRollapps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused-rollapps",
+		Short: "Get the rollapps whose packets finalization is paused by governance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PausedRollapps(cmd.Context(), &types.QueryPausedRollappsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -12,6 +12,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, packet := range genState.RollappPackets {
 		k.SetRollappPacket(ctx, packet)
 	}
+	for _, rollappID := range genState.PausedRollapps {
+		k.SetRollappFinalizationPaused(ctx, rollappID)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -19,5 +22,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Params:         k.GetParams(ctx),
 		RollappPackets: k.GetAllRollappPackets(ctx),
+		PausedRollapps: k.GetPausedRollapps(ctx),
 	}
 }
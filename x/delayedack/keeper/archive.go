@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+// ArchiveRollappPacket keeps a compact summary of the rollapp packet, indexed by sender, receiver and epoch,
+// so that it can be looked up after the packet is deleted.
+func (k Keeper) ArchiveRollappPacket(ctx sdk.Context, rollappPacket commontypes.RollappPacket, epochNumber int64) {
+	archived := types.ArchivedPacket{
+		RollappId:   rollappPacket.RollappId,
+		PacketUid:   rollappPacket.PacketUID().String(),
+		Sequence:    rollappPacket.Packet.GetSequence(),
+		Type:        rollappPacket.Type,
+		Status:      rollappPacket.Status,
+		EpochNumber: epochNumber,
+	}
+
+	// the transfer target could have been replaced by an eIBC fulfiller, archive the original one
+	original, err := rollappPacket.RestoreOriginalTransferTarget()
+	if err == nil {
+		data, err := original.GetTransferPacketData()
+		if err == nil {
+			archived.Amount = data.Amount
+			archived.Denom = data.Denom
+			archived.Sender = data.Sender
+			archived.Receiver = data.Receiver
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ArchivedPacketKey(archived.PacketUid), k.cdc.MustMarshal(&archived))
+	store.Set(types.ArchivedPacketByEpochKey(epochNumber, archived.PacketUid), []byte{})
+	if archived.Sender != "" {
+		store.Set(types.ArchivedPacketByAddressKey(types.ArchivedPacketBySenderKeyPrefix, archived.Sender, archived.PacketUid), []byte{})
+	}
+	if archived.Receiver != "" {
+		store.Set(types.ArchivedPacketByAddressKey(types.ArchivedPacketByReceiverKeyPrefix, archived.Receiver, archived.PacketUid), []byte{})
+	}
+}
+
+// GetArchivedPacket returns the archived packet by its packet UID.
+func (k Keeper) GetArchivedPacket(ctx sdk.Context, packetUID string) (types.ArchivedPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.ArchivedPacketKey(packetUID))
+	if b == nil {
+		return types.ArchivedPacket{}, false
+	}
+
+	var archived types.ArchivedPacket
+	k.cdc.MustUnmarshal(b, &archived)
+	return archived, true
+}
+
+// PruneArchivedPackets deletes the archived packets of all the epochs up to and including the given epoch.
+func (k Keeper) PruneArchivedPackets(ctx sdk.Context, lastEpochNumber int64) {
+	if lastEpochNumber < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ArchivedPacketByEpochKeyPrefix, types.ArchivedPacketByEpochPrefix(lastEpochNumber+1))
+	defer iterator.Close() // nolint: errcheck
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		packetUID := string(key[len(types.ArchivedPacketByEpochPrefix(0)):])
+		archived, found := k.GetArchivedPacket(ctx, packetUID)
+		if !found {
+			continue
+		}
+		// the packet could have been archived again in a later epoch
+		if lastEpochNumber < archived.EpochNumber {
+			continue
+		}
+		store.Delete(types.ArchivedPacketKey(packetUID))
+		store.Delete(types.ArchivedPacketByAddressKey(types.ArchivedPacketBySenderKeyPrefix, archived.Sender, packetUID))
+		store.Delete(types.ArchivedPacketByAddressKey(types.ArchivedPacketByReceiverKeyPrefix, archived.Receiver, packetUID))
+	}
+}
+
+// archivedPacketsByAddressStore returns the store of the index of the archived packets by address.
+func (k Keeper) archivedPacketsByAddressStore(ctx sdk.Context, indexPrefix []byte, address string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedPacketByAddressPrefix(indexPrefix, address))
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	delayedackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+// TestArchivedPackets tests that the deleted packets are archived for the retention window
+// and can be queried by sender, receiver and packet UID
+func (suite *DelayedAckTestSuite) TestArchivedPackets() {
+	keeper, ctx := suite.App.DelayedAckKeeper, suite.Ctx
+	params := keeper.GetParams(ctx)
+	params.EpochIdentifier = "minute"
+	params.PacketRetentionEpochs = 2
+	keeper.SetParams(ctx, params)
+
+	sender, receiver, fulfiller := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	rollappPacket := commontypes.RollappPacket{
+		RollappId: "testRollappId",
+		Packet: &channeltypes.Packet{
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-1",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-0",
+			Data:               transfertypes.NewFungibleTokenPacketData("adym", "100", sender, fulfiller, "").GetBytes(),
+			Sequence:           1,
+		},
+		Status:                 commontypes.Status_FINALIZED,
+		ProofHeight:            1,
+		Type:                   commontypes.RollappPacket_ON_RECV,
+		OriginalTransferTarget: receiver,
+	}
+	keeper.SetRollappPacket(ctx, rollappPacket)
+
+	err := keeper.GetEpochHooks().AfterEpochEnd(ctx, "minute", 1)
+	suite.Require().NoError(err)
+	suite.Require().Empty(keeper.ListRollappPackets(ctx, types.ByStatus(commontypes.Status_FINALIZED)))
+
+	expected := types.ArchivedPacket{
+		RollappId:   "testRollappId",
+		PacketUid:   "ON_RECV-channel-0-transfer-1",
+		Sequence:    1,
+		Type:        commontypes.RollappPacket_ON_RECV,
+		Amount:      "100",
+		Denom:       "adym",
+		Sender:      sender,
+		Receiver:    receiver,
+		Status:      commontypes.Status_FINALIZED,
+		EpochNumber: 1,
+	}
+	querier := delayedackkeeper.NewQuerier(keeper)
+	for _, req := range []*types.QueryArchivedPacketsRequest{
+		{Sender: sender},
+		{Receiver: receiver},
+		{PacketUid: expected.PacketUid},
+	} {
+		res, err := querier.ArchivedPackets(ctx, req)
+		suite.Require().NoError(err)
+		suite.Require().Equal([]types.ArchivedPacket{expected}, res.Packets)
+	}
+
+	res, err := querier.ArchivedPackets(ctx, &types.QueryArchivedPacketsRequest{Receiver: fulfiller})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Packets)
+
+	_, err = querier.ArchivedPackets(ctx, &types.QueryArchivedPacketsRequest{Sender: sender, Receiver: receiver})
+	suite.Require().Error(err)
+
+	// the archive is kept during the retention window
+	err = keeper.GetEpochHooks().AfterEpochEnd(ctx, "minute", 2)
+	suite.Require().NoError(err)
+	_, found := keeper.GetArchivedPacket(ctx, expected.PacketUid)
+	suite.Require().True(found)
+
+	err = keeper.GetEpochHooks().AfterEpochEnd(ctx, "minute", 3)
+	suite.Require().NoError(err)
+	_, found = keeper.GetArchivedPacket(ctx, expected.PacketUid)
+	suite.Require().False(found)
+	res, err = querier.ArchivedPackets(ctx, &types.QueryArchivedPacketsRequest{Sender: sender})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Packets)
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
@@ -17,8 +18,13 @@ import (
 // FinalizeRollappPacketsUntilHeight finalizes the packets for the given rollapp until the given height inclusively.
 // Returns the number of finalized packets. stateEndHeight is inclusive.
 func (k Keeper) FinalizeRollappPacketsUntilHeight(ctx sdk.Context, ibc porttypes.IBCModule, rollappID string, stateEndHeight uint64) (int, error) {
+	err := k.checkFinalizationNotPaused(ctx, rollappID)
+	if err != nil {
+		return 0, err
+	}
+
 	// Verify the height is finalized
-	err := k.VerifyHeightFinalized(ctx, rollappID, stateEndHeight)
+	err = k.VerifyHeightFinalized(ctx, rollappID, stateEndHeight)
 	if err != nil {
 		return 0, fmt.Errorf("verify height is not finalized: rollapp '%s': %w", rollappID, err)
 	}
@@ -44,6 +50,10 @@ type FinalizeRollappPacketsBySenderResult struct {
 // FinalizeRollappPacketsByReceiver finalizes the rollapp packets from the specified sender until the latest finalized
 // height inclusively. Returns the number of finalized packets.
 func (k Keeper) FinalizeRollappPacketsByReceiver(ctx sdk.Context, ibc porttypes.IBCModule, rollappID string, receiver string) (FinalizeRollappPacketsBySenderResult, error) {
+	if err := k.checkFinalizationNotPaused(ctx, rollappID); err != nil {
+		return FinalizeRollappPacketsBySenderResult{}, err
+	}
+
 	// Get rollapp's latest finalized height. All packets until this height with the specified receiver will be finalized.
 	latestFinalizedHeight, err := k.GetRollappLatestFinalizedHeight(ctx, rollappID)
 	if err != nil {
@@ -76,6 +86,11 @@ func (k Keeper) FinalizeRollappPacketsByReceiver(ctx sdk.Context, ibc porttypes.
 
 // FinalizeRollappPacket finalizes a singe packet by its rollapp packet key.
 func (k Keeper) FinalizeRollappPacket(ctx sdk.Context, ibc porttypes.IBCModule, rollappID string, rollappPacketKey string) error {
+	err := k.checkFinalizationNotPaused(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+
 	// Get a rollapp packet
 	packet, err := k.GetRollappPacket(ctx, rollappPacketKey)
 	if err != nil {
@@ -117,7 +132,8 @@ func (k Keeper) finalizeRollappPacket(
 	switch rollappPacket.Type {
 	case commontypes.RollappPacket_ON_RECV:
 		// TODO: makes more sense to modify the packet when calling the handler, instead storing in db "wrong" packet
-		ack := ibc.OnRecvPacket(ctx, *rollappPacket.Packet, rollappPacket.Relayer)
+		packet, forwarded := recvPacket(rollappPacket)
+		ack := ibc.OnRecvPacket(ctx, packet, rollappPacket.Relayer)
 		/*
 				We only write the ack if writing it succeeds:
 				1. Transfer fails and writing ack fails - In this case, the funds will never be refunded on the RA.
@@ -129,7 +145,8 @@ func (k Keeper) finalizeRollappPacket(
 						non-eibc: sender will get the funds back
 			            eibc:     effective transfer from fulfiller to original target
 		*/
-		if ack != nil {
+		// The ack of a packet forwarded on fulfillment is written by the packet-forward-middleware once the forward completes
+		if ack != nil && !forwarded {
 			packetErr = osmoutils.ApplyFuncIfNoError(ctx, k.writeRecvAck(rollappPacket, ack))
 		}
 	case commontypes.RollappPacket_ON_ACK:
@@ -155,6 +172,28 @@ func (k Keeper) finalizeRollappPacket(
 	return nil
 }
 
+// recvPacket returns the packet to pass to the transfer stack when finalizing an ON_RECV rollapp packet.
+// If the eIBC order of the packet was fulfilled, the funds were already forwarded by the fulfillment, so the
+// packet-forward-middleware instructions are dropped and the funds go to the fulfiller. It also returns whether
+// the packet was forwarded on fulfillment.
+func recvPacket(rollappPacket commontypes.RollappPacket) (channeltypes.Packet, bool) {
+	packet := *rollappPacket.Packet
+	if rollappPacket.OriginalTransferTarget == "" {
+		return packet, false
+	}
+	data, err := rollappPacket.GetTransferPacketData()
+	if err != nil {
+		return packet, false
+	}
+	memo := types.RemoveForwardMetadata(data.Memo)
+	if memo == data.Memo {
+		return packet, false
+	}
+	data.Memo = memo
+	packet.Data = data.GetBytes()
+	return packet, true
+}
+
 func (k Keeper) writeRecvAck(rollappPacket commontypes.RollappPacket, ack exported.Acknowledgement) wrappedFunc {
 	return func(ctx sdk.Context) (err error) {
 		var chanCap *capabilitytypes.Capability
@@ -47,3 +47,16 @@ func (k Keeper) HandleFraud(ctx sdk.Context, rollappID string, ibc porttypes.IBC
 	}
 	return nil
 }
+
+// DeleteRevertedPackets deletes the packets reverted on fraud. Used once the rollapp is recovered:
+// the reverted packets were already refunded and will not be finalized.
+func (k Keeper) DeleteRevertedPackets(ctx sdk.Context, rollappID string) error {
+	rollappRevertedPackets := k.ListRollappPackets(ctx, types.ByRollappIDByStatus(rollappID, commontypes.Status_REVERTED))
+	for _, rollappPacket := range rollappRevertedPackets {
+		err := k.deleteRollappPacket(ctx, &rollappPacket)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 
@@ -52,3 +54,54 @@ func (q Querier) GetPackets(goCtx context.Context, req *types.QueryRollappPacket
 
 	return res, nil
 }
+
+// ArchivedPackets implements types.QueryServer.
+func (q Querier) ArchivedPackets(goCtx context.Context, req *types.QueryArchivedPacketsRequest) (*types.QueryArchivedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var index prefix.Store
+	switch {
+	case req.PacketUid != "" && req.Sender == "" && req.Receiver == "":
+		archived, found := q.GetArchivedPacket(ctx, req.PacketUid)
+		if !found {
+			return nil, status.Error(codes.NotFound, "archived packet not found")
+		}
+		return &types.QueryArchivedPacketsResponse{Packets: []types.ArchivedPacket{archived}}, nil
+	case req.Sender != "" && req.Receiver == "" && req.PacketUid == "":
+		index = q.archivedPacketsByAddressStore(ctx, types.ArchivedPacketBySenderKeyPrefix, req.Sender)
+	case req.Receiver != "" && req.Sender == "" && req.PacketUid == "":
+		index = q.archivedPacketsByAddressStore(ctx, types.ArchivedPacketByReceiverKeyPrefix, req.Receiver)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "exactly one of sender, receiver or packet uid must be set")
+	}
+
+	res := &types.QueryArchivedPacketsResponse{}
+	pageRes, err := query.Paginate(index, req.Pagination, func(key []byte, _ []byte) error {
+		archived, found := q.GetArchivedPacket(ctx, string(key))
+		if found {
+			res.Packets = append(res.Packets, archived)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Pagination = pageRes
+
+	return res, nil
+}
+
+// PausedRollapps implements types.QueryServer.
+func (q Querier) PausedRollapps(goCtx context.Context, req *types.QueryPausedRollappsRequest) (*types.QueryPausedRollappsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPausedRollappsResponse{RollappIds: q.GetPausedRollapps(ctx)}, nil
+}
@@ -43,6 +43,12 @@ func (k eibcHooks) AfterDemandOrderFulfilled(ctx sdk.Context, demandOrder *eibct
 	return nil
 }
 
+// AfterFulfillmentTransferred is called when the claim on the funds of a fulfilled order is transferred.
+// The underlying packet recipient should be updated to the new fulfiller.
+func (k eibcHooks) AfterFulfillmentTransferred(ctx sdk.Context, demandOrder *eibctypes.DemandOrder, newFulfillerAddress string) error {
+	return k.UpdateRollappPacketTransferAddress(ctx, demandOrder.TrackingPacketKey, newFulfillerAddress)
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                 epoch hooks                                */
 /* -------------------------------------------------------------------------- */
@@ -65,6 +71,7 @@ func (e epochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, ep
 
 // AfterEpochEnd is the epoch end hook.
 // We want to clean up the demand orders that are with underlying packet status which are finalized.
+// A summary of the deleted packets is archived for the retention window.
 func (e epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	params := e.GetParams(ctx)
 
@@ -83,6 +90,9 @@ func (e epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 
 		for _, packet := range toDeletePackets {
 			err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				if params.PacketRetentionEpochs != 0 {
+					e.ArchiveRollappPacket(ctx, packet, epochNumber)
+				}
 				return e.deleteRollappPacket(ctx, &packet)
 			})
 			if err != nil {
@@ -96,5 +106,8 @@ func (e epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 			break
 		}
 	}
+
+	// prune the archived packets older than the retention window
+	e.PruneArchivedPackets(ctx, epochNumber-int64(params.PacketRetentionEpochs))
 	return nil
 }
@@ -20,6 +20,7 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	hooks      types.MultiDelayedAckHooks
 	paramstore paramtypes.Subspace
+	authority  string // authority is the x/gov module account
 
 	rollappKeeper types.RollappKeeper
 	porttypes.ICS4Wrapper
@@ -35,6 +36,7 @@ func NewKeeper(
 	ics4Wrapper porttypes.ICS4Wrapper,
 	channelKeeper types.ChannelKeeper,
 	eibcKeeper types.EIBCKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -44,6 +46,7 @@ func NewKeeper(
 		cdc:           cdc,
 		storeKey:      storeKey,
 		paramstore:    ps,
+		authority:     authority,
 		rollappKeeper: rollappKeeper,
 		ICS4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
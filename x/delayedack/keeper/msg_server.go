@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
@@ -108,3 +110,43 @@ func (m MsgServer) FinalizeRollappPacketsByReceiver(goCtx context.Context, msg *
 
 	return &types.MsgFinalizeRollappPacketsByReceiverResponse{}, nil
 }
+
+func (m MsgServer) PauseRollappFinalization(goCtx context.Context, msg *types.MsgPauseRollappFinalization) (*types.MsgPauseRollappFinalizationResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authority != m.k.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "only the gov module can pause the finalization")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err = m.k.PauseRollappFinalization(ctx, msg.RollappId)
+	if err != nil {
+		return nil, fmt.Errorf("pause rollapp finalization: %w", err)
+	}
+
+	return &types.MsgPauseRollappFinalizationResponse{}, nil
+}
+
+func (m MsgServer) ResumeRollappFinalization(goCtx context.Context, msg *types.MsgResumeRollappFinalization) (*types.MsgResumeRollappFinalizationResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authority != m.k.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "only the gov module can resume the finalization")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err = m.k.ResumeRollappFinalization(ctx, msg.RollappId)
+	if err != nil {
+		return nil, fmt.Errorf("resume rollapp finalization: %w", err)
+	}
+
+	return &types.MsgResumeRollappFinalizationResponse{}, nil
+}
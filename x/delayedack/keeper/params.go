@@ -35,3 +35,8 @@ func (k Keeper) DeletePacketsEpochLimit(ctx sdk.Context) (res int64) {
 	k.paramstore.Get(ctx, types.KeyDeletePacketsEpochLimit, &res)
 	return
 }
+
+func (k Keeper) PacketRetentionEpochs(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyPacketRetentionEpochs, &res)
+	return
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+// PauseRollappFinalization suspends the finalization of the rollapp packets until it is resumed.
+// The packets can still be reverted on fraud.
+func (k Keeper) PauseRollappFinalization(ctx sdk.Context, rollappID string) error {
+	if _, found := k.rollappKeeper.GetRollapp(ctx, rollappID); !found {
+		return errorsmod.Wrapf(gerrc.ErrNotFound, "rollapp: %s", rollappID)
+	}
+
+	if k.IsRollappFinalizationPaused(ctx, rollappID) {
+		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "finalization is already paused: rollapp: %s", rollappID)
+	}
+
+	k.SetRollappFinalizationPaused(ctx, rollappID)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventRollappFinalizationPaused{
+		RollappId: rollappID,
+	})
+}
+
+// ResumeRollappFinalization resumes the finalization of the rollapp packets.
+func (k Keeper) ResumeRollappFinalization(ctx sdk.Context, rollappID string) error {
+	if !k.IsRollappFinalizationPaused(ctx, rollappID) {
+		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "finalization is not paused: rollapp: %s", rollappID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PausedRollappKey(rollappID))
+
+	return uevent.EmitTypedEvent(ctx, &types.EventRollappFinalizationResumed{
+		RollappId: rollappID,
+	})
+}
+
+// IsRollappFinalizationPaused returns true if the finalization of the rollapp packets is paused.
+func (k Keeper) IsRollappFinalizationPaused(ctx sdk.Context, rollappID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PausedRollappKey(rollappID))
+}
+
+// GetPausedRollapps returns the rollapps whose packets finalization is paused.
+func (k Keeper) GetPausedRollapps(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PausedRollappKeyPrefix)
+	defer iterator.Close() // nolint: errcheck
+
+	var rollappIDs []string
+	for ; iterator.Valid(); iterator.Next() {
+		rollappIDs = append(rollappIDs, string(iterator.Key()[len(types.PausedRollappKeyPrefix):]))
+	}
+	return rollappIDs
+}
+
+// SetRollappFinalizationPaused marks the finalization of the rollapp packets as paused.
+func (k Keeper) SetRollappFinalizationPaused(ctx sdk.Context, rollappID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PausedRollappKey(rollappID), []byte{})
+}
+
+// checkFinalizationNotPaused returns an error if the finalization of the rollapp packets is paused.
+func (k Keeper) checkFinalizationNotPaused(ctx sdk.Context, rollappID string) error {
+	if k.IsRollappFinalizationPaused(ctx, rollappID) {
+		return errorsmod.Wrapf(types.ErrFinalizationPaused, "rollapp: %s", rollappID)
+	}
+	return nil
+}
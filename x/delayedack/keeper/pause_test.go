@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	delayedackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *DelayedAckTestSuite) TestPauseRollappFinalization() {
+	rollapp := "rollapp_1234-1"
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1},
+		StartHeight:    1,
+		NumBlocks:      10,
+		Status:         commontypes.Status_FINALIZED,
+		Sequencer:      proposer,
+	})
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1})
+
+	packet := commontypes.RollappPacket{
+		RollappId:   rollapp,
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 8,
+		Packet:      getNewTestPacket(2),
+	}
+	s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, packet)
+
+	querier := delayedackkeeper.NewQuerier(s.App.DelayedAckKeeper)
+	pause := s.App.MsgServiceRouter().Handler(new(types.MsgPauseRollappFinalization))
+	resume := s.App.MsgServiceRouter().Handler(new(types.MsgResumeRollappFinalization))
+
+	// only the gov module can pause
+	_, err := pause(s.Ctx, &types.MsgPauseRollappFinalization{
+		Authority: apptesting.CreateRandomAccounts(1)[0].String(),
+		RollappId: rollapp,
+	})
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	_, err = pause(s.Ctx, &types.MsgPauseRollappFinalization{Authority: authority, RollappId: "unknown_1234-1"})
+	s.Require().ErrorIs(err, gerrc.ErrNotFound)
+
+	_, err = pause(s.Ctx, &types.MsgPauseRollappFinalization{Authority: authority, RollappId: rollapp})
+	s.Require().NoError(err)
+	_, err = pause(s.Ctx, &types.MsgPauseRollappFinalization{Authority: authority, RollappId: rollapp})
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+	res, err := querier.PausedRollapps(s.Ctx, &types.QueryPausedRollappsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{rollapp}, res.RollappIds)
+
+	// every finalization path is blocked
+	finalize := s.App.MsgServiceRouter().Handler(new(types.MsgFinalizePacket))
+	_, err = finalize(s.Ctx, &types.MsgFinalizePacket{
+		Sender:            apptesting.CreateRandomAccounts(1)[0].String(),
+		RollappId:         rollapp,
+		PacketProofHeight: packet.ProofHeight,
+		PacketType:        packet.Type,
+		PacketSrcChannel:  packet.Packet.SourceChannel,
+		PacketSequence:    packet.Packet.Sequence,
+	})
+	s.Require().ErrorIs(err, types.ErrFinalizationPaused)
+
+	finalizeUntilHeight := s.App.MsgServiceRouter().Handler(new(types.MsgFinalizePacketsUntilHeight))
+	_, err = finalizeUntilHeight(s.Ctx, &types.MsgFinalizePacketsUntilHeight{
+		Sender:    apptesting.CreateRandomAccounts(1)[0].String(),
+		RollappId: rollapp,
+		Height:    10,
+	})
+	s.Require().ErrorIs(err, types.ErrFinalizationPaused)
+
+	finalizeByReceiver := s.App.MsgServiceRouter().Handler(new(types.MsgFinalizeRollappPacketsByReceiver))
+	_, err = finalizeByReceiver(s.Ctx, &types.MsgFinalizeRollappPacketsByReceiver{
+		Sender:    apptesting.CreateRandomAccounts(1)[0].String(),
+		RollappId: rollapp,
+		Receiver:  apptesting.CreateRandomAccounts(1)[0].String(),
+	})
+	s.Require().ErrorIs(err, types.ErrFinalizationPaused)
+
+	// finalization works again once resumed
+	_, err = resume(s.Ctx, &types.MsgResumeRollappFinalization{Authority: authority, RollappId: rollapp})
+	s.Require().NoError(err)
+	_, err = resume(s.Ctx, &types.MsgResumeRollappFinalization{Authority: authority, RollappId: rollapp})
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+	res, err = querier.PausedRollapps(s.Ctx, &types.QueryPausedRollappsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.RollappIds)
+
+	_, err = finalizeUntilHeight(s.Ctx, &types.MsgFinalizePacketsUntilHeight{
+		Sender:    apptesting.CreateRandomAccounts(1)[0].String(),
+		RollappId: rollapp,
+		Height:    10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(s.App.DelayedAckKeeper.ListRollappPackets(s.Ctx, types.ByRollappIDByStatus(rollapp, commontypes.Status_FINALIZED)), 1)
+}
@@ -85,7 +85,10 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 	packet.Data = packetBytes
 	// Update rollapp packet with the new updated packet and save in the store
 	rollappPacket.Packet = packet
-	rollappPacket.OriginalTransferTarget = originalTransferTarget
+	// The packet may be updated again when the fulfillment is transferred, the original target must be kept
+	if rollappPacket.OriginalTransferTarget == "" {
+		rollappPacket.OriginalTransferTarget = originalTransferTarget
+	}
 	k.SetRollappPacket(ctx, *rollappPacket)
 	return nil
 }
@@ -8,6 +8,10 @@ import (
 
 var _ rollapptypes.RollappHooks = &IBCMiddleware{}
 
-func (w IBCMiddleware) FraudSubmitted(ctx sdk.Context, rollappID string, height uint64, seqAddr string) error {
+func (w IBCMiddleware) FraudSubmitted(ctx sdk.Context, rollappID string, height uint64, seqAddr, reporter string) error {
 	return w.HandleFraud(ctx, rollappID, w.IBCModule)
 }
+
+func (w IBCMiddleware) RollappRecovered(ctx sdk.Context, rollappID string) error {
+	return w.DeleteRevertedPackets(ctx, rollappID)
+}
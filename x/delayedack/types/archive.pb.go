@@ -0,0 +1,732 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/delayedack/archive.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/dymensionxyz/dymension/v3/x/common/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ArchivedPacket is the compact summary of a rollapp packet kept after the packet is
+// deleted from the store.
+type ArchivedPacket struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// packet_uid is the unique identifier of the packet on the hub
+	PacketUid string                   `protobuf:"bytes,2,opt,name=packet_uid,json=packetUid,proto3" json:"packet_uid,omitempty"`
+	Sequence  uint64                   `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type      types.RollappPacket_Type `protobuf:"varint,4,opt,name=type,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"type,omitempty"`
+	Amount    string                   `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom     string                   `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// sender and receiver are the original parties of the transfer,
+	// regardless of any eIBC fulfillment
+	Sender   string `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,8,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// status is the final status of the packet
+	Status types.Status `protobuf:"varint,9,opt,name=status,proto3,enum=dymensionxyz.dymension.common.Status" json:"status,omitempty"`
+	// epoch_number is the epoch in which the packet was archived
+	EpochNumber int64 `protobuf:"varint,10,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *ArchivedPacket) Reset()         { *m = ArchivedPacket{} }
+func (m *ArchivedPacket) String() string { return proto.CompactTextString(m) }
+func (*ArchivedPacket) ProtoMessage()    {}
+func (*ArchivedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ce3a13233da1cea, []int{0}
+}
+func (m *ArchivedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedPacket.Merge(m, src)
+}
+func (m *ArchivedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedPacket proto.InternalMessageInfo
+
+func (m *ArchivedPacket) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *ArchivedPacket) GetPacketUid() string {
+	if m != nil {
+		return m.PacketUid
+	}
+	return ""
+}
+
+func (m *ArchivedPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ArchivedPacket) GetType() types.RollappPacket_Type {
+	if m != nil {
+		return m.Type
+	}
+	return types.RollappPacket_ON_RECV
+}
+
+func (m *ArchivedPacket) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *ArchivedPacket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ArchivedPacket) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ArchivedPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *ArchivedPacket) GetStatus() types.Status {
+	if m != nil {
+		return m.Status
+	}
+	return types.Status_PENDING
+}
+
+func (m *ArchivedPacket) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ArchivedPacket)(nil), "dymensionxyz.dymension.delayedack.ArchivedPacket")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/delayedack/archive.proto", fileDescriptor_4ce3a13233da1cea)
+}
+
+var fileDescriptor_4ce3a13233da1cea = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3d, 0x4f, 0xf2, 0x40,
+	0x1c, 0xe7, 0x78, 0xe9, 0x03, 0xf7, 0x18, 0x86, 0x8b, 0x31, 0x17, 0x12, 0x9b, 0x62, 0x62, 0xd2,
+	0x38, 0xb4, 0x11, 0x06, 0x27, 0x07, 0x4d, 0x1c, 0x5c, 0x8c, 0x56, 0x5d, 0x5c, 0x48, 0xe9, 0xfd,
+	0x23, 0x0d, 0xf4, 0xae, 0x5e, 0xaf, 0x84, 0xfa, 0x29, 0xfc, 0x58, 0x8e, 0x8c, 0x8e, 0x06, 0x3e,
+	0x83, 0xbb, 0xe9, 0x1d, 0x02, 0x0b, 0x3a, 0xfe, 0x5e, 0xef, 0xd7, 0xeb, 0x61, 0x9f, 0x15, 0x09,
+	0xf0, 0x2c, 0x16, 0x7c, 0x56, 0xbc, 0x6e, 0x80, 0xcf, 0x60, 0x12, 0x16, 0xc0, 0xc2, 0x68, 0xec,
+	0x87, 0x32, 0x1a, 0xc5, 0x53, 0xf0, 0x52, 0x29, 0x94, 0x20, 0xdd, 0xed, 0x80, 0xb7, 0x06, 0xde,
+	0x26, 0xd0, 0x39, 0xd9, 0xd1, 0x19, 0x89, 0x24, 0x11, 0xdc, 0xcf, 0x54, 0xa8, 0xf2, 0xcc, 0xd4,
+	0x75, 0x7a, 0xbf, 0x7b, 0xa5, 0x98, 0x4c, 0xc2, 0x34, 0x1d, 0xa4, 0x61, 0x34, 0x06, 0x65, 0x32,
+	0x47, 0x5f, 0x55, 0xdc, 0xbe, 0x30, 0xa3, 0xd8, 0xad, 0x16, 0xc8, 0x21, 0xc6, 0x3f, 0xd6, 0x98,
+	0x51, 0xe4, 0x20, 0xb7, 0x15, 0xb4, 0x56, 0xcc, 0x35, 0x2b, 0x65, 0xd3, 0x30, 0xc8, 0x63, 0x46,
+	0xab, 0x46, 0x36, 0xcc, 0x63, 0xcc, 0x48, 0x07, 0x37, 0x33, 0x78, 0xc9, 0x81, 0x47, 0x40, 0x6b,
+	0x0e, 0x72, 0xeb, 0xc1, 0x1a, 0x93, 0x2b, 0x5c, 0x57, 0x45, 0x0a, 0xb4, 0xee, 0x20, 0xb7, 0xdd,
+	0x3b, 0xf5, 0x76, 0x7c, 0xbe, 0xd9, 0xeb, 0x05, 0xe6, 0x48, 0xb3, 0xca, 0x7b, 0x28, 0x52, 0x08,
+	0x74, 0x9c, 0x1c, 0x60, 0x2b, 0x4c, 0x44, 0xce, 0x15, 0x6d, 0xe8, 0xd3, 0x57, 0x88, 0xec, 0xe3,
+	0x06, 0x03, 0x2e, 0x12, 0x6a, 0x69, 0xda, 0x80, 0xd2, 0x9d, 0x01, 0x67, 0x20, 0xe9, 0x3f, 0xe3,
+	0x36, 0xa8, 0x1c, 0x2a, 0x21, 0x82, 0x78, 0x0a, 0x92, 0x36, 0xb5, 0xb2, 0xc6, 0xe4, 0x1c, 0x5b,
+	0xe6, 0x66, 0x69, 0x4b, 0x4f, 0x3d, 0xfe, 0x63, 0xea, 0xbd, 0x36, 0x07, 0xab, 0x10, 0xe9, 0xe2,
+	0x3d, 0x48, 0x45, 0x34, 0x1a, 0xf0, 0x3c, 0x19, 0x82, 0xa4, 0xd8, 0x41, 0x6e, 0x2d, 0xf8, 0xaf,
+	0xb9, 0x1b, 0x4d, 0x5d, 0xde, 0xbd, 0x2f, 0x6c, 0x34, 0x5f, 0xd8, 0xe8, 0x73, 0x61, 0xa3, 0xb7,
+	0xa5, 0x5d, 0x99, 0x2f, 0xed, 0xca, 0xc7, 0xd2, 0xae, 0x3c, 0x9d, 0x3d, 0xc7, 0x6a, 0x94, 0x0f,
+	0xcb, 0xea, 0x5d, 0x0f, 0x6a, 0xda, 0xf7, 0x67, 0xdb, 0xaf, 0xaa, 0xbc, 0x95, 0x6c, 0x68, 0xe9,
+	0x3f, 0xda, 0xff, 0x1e, 0x00, 0x9b, 0xcf, 0x41, 0x2f, 0x87, 0x02, 0x00, 0x00,
+}
+
+func (m *ArchivedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PacketUid) > 0 {
+		i -= len(m.PacketUid)
+		copy(dAtA[i:], m.PacketUid)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.PacketUid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchivedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.PacketUid)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovArchive(uint64(m.Sequence))
+	}
+	if m.Type != 0 {
+		n += 1 + sovArchive(uint64(m.Type))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovArchive(uint64(m.Status))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovArchive(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func sovArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozArchive(x uint64) (n int) {
+	return sovArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArchivedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipArchive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthArchive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArchive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArchive = fmt.Errorf("proto: unexpected end of group")
+)
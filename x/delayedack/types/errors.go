@@ -10,4 +10,6 @@ var (
 	ErrRollappPacketAlreadyExists = errorsmod.Register(ModuleName, 3, "rollapp packet already exists")
 	ErrUnknownRequest             = errorsmod.Register(ModuleName, 8, "unknown request")
 	ErrBadEIBCFee                 = errorsmod.Register(ModuleName, 10, "provided eibc fee is invalid")
+	ErrBadEIBCFeeEscalation       = errorsmod.Register(ModuleName, 11, "provided eibc fee escalation is invalid")
+	ErrFinalizationPaused         = errorsmod.Register(ModuleName, 12, "rollapp packets finalization is paused by governance")
 )
@@ -266,10 +266,102 @@ func (m *EventFinalizeRollappPacketsByReceiver) GetFinalizedNum() uint64 {
 	return 0
 }
 
+type EventRollappFinalizationPaused struct {
+	// RollappID is the ID of the rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *EventRollappFinalizationPaused) Reset()         { *m = EventRollappFinalizationPaused{} }
+func (m *EventRollappFinalizationPaused) String() string { return proto.CompactTextString(m) }
+func (*EventRollappFinalizationPaused) ProtoMessage()    {}
+func (*EventRollappFinalizationPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de2c6b6165d75670, []int{3}
+}
+func (m *EventRollappFinalizationPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRollappFinalizationPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRollappFinalizationPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRollappFinalizationPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRollappFinalizationPaused.Merge(m, src)
+}
+func (m *EventRollappFinalizationPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRollappFinalizationPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRollappFinalizationPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRollappFinalizationPaused proto.InternalMessageInfo
+
+func (m *EventRollappFinalizationPaused) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type EventRollappFinalizationResumed struct {
+	// RollappID is the ID of the rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *EventRollappFinalizationResumed) Reset()         { *m = EventRollappFinalizationResumed{} }
+func (m *EventRollappFinalizationResumed) String() string { return proto.CompactTextString(m) }
+func (*EventRollappFinalizationResumed) ProtoMessage()    {}
+func (*EventRollappFinalizationResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de2c6b6165d75670, []int{4}
+}
+func (m *EventRollappFinalizationResumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRollappFinalizationResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRollappFinalizationResumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRollappFinalizationResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRollappFinalizationResumed.Merge(m, src)
+}
+func (m *EventRollappFinalizationResumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRollappFinalizationResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRollappFinalizationResumed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRollappFinalizationResumed proto.InternalMessageInfo
+
+func (m *EventRollappFinalizationResumed) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventFinalizePacket)(nil), "dymensionxyz.dymension.delayedack.EventFinalizePacket")
 	proto.RegisterType((*EventFinalizePacketsUntilHeight)(nil), "dymensionxyz.dymension.delayedack.EventFinalizePacketsUntilHeight")
 	proto.RegisterType((*EventFinalizeRollappPacketsByReceiver)(nil), "dymensionxyz.dymension.delayedack.EventFinalizeRollappPacketsByReceiver")
+	proto.RegisterType((*EventRollappFinalizationPaused)(nil), "dymensionxyz.dymension.delayedack.EventRollappFinalizationPaused")
+	proto.RegisterType((*EventRollappFinalizationResumed)(nil), "dymensionxyz.dymension.delayedack.EventRollappFinalizationResumed")
 }
 
 func init() {
@@ -277,34 +369,36 @@ func init() {
 }
 
 var fileDescriptor_de2c6b6165d75670 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xbd, 0x8e, 0xd3, 0x40,
-	0x10, 0xce, 0x1e, 0x3e, 0x8b, 0x2c, 0x70, 0xc0, 0x9e, 0x74, 0xb2, 0x4e, 0xc2, 0x84, 0x20, 0x44,
-	0x0a, 0x64, 0x8b, 0xbb, 0x82, 0xfe, 0x10, 0x08, 0x1a, 0x74, 0x2c, 0xd0, 0xd0, 0x58, 0xbe, 0xf5,
-	0x5c, 0xbc, 0x8a, 0xbd, 0x6b, 0xd6, 0x76, 0x14, 0xe7, 0x29, 0x68, 0x78, 0x06, 0x1e, 0x81, 0x57,
-	0xa0, 0x4c, 0x49, 0x89, 0x92, 0x17, 0x41, 0xde, 0xdd, 0xfc, 0x09, 0x42, 0x91, 0xce, 0x33, 0xdf,
-	0xe7, 0x6f, 0xbe, 0xf9, 0x3c, 0xc6, 0x41, 0xd2, 0xe4, 0x20, 0x4a, 0x2e, 0xc5, 0xa4, 0x99, 0x86,
-	0xab, 0x22, 0x4c, 0x20, 0x8b, 0x1b, 0x48, 0x62, 0x36, 0x0a, 0x61, 0x0c, 0xa2, 0x2a, 0x83, 0x42,
-	0xc9, 0x4a, 0x92, 0x47, 0x9b, 0xfc, 0xf5, 0xcb, 0xc1, 0x9a, 0x7f, 0x7a, 0xb6, 0x43, 0x92, 0xc9,
-	0x3c, 0x97, 0x22, 0x54, 0x32, 0xcb, 0xe2, 0xa2, 0x88, 0x8a, 0x98, 0x8d, 0xa0, 0x32, 0xb2, 0xfd,
-	0xef, 0x07, 0xf8, 0xf8, 0x55, 0x3b, 0xe7, 0x35, 0x17, 0x71, 0xc6, 0xa7, 0x70, 0xa9, 0x51, 0x72,
-	0x82, 0xdd, 0x12, 0x44, 0x02, 0xca, 0x43, 0x3d, 0x34, 0xe8, 0x52, 0x5b, 0x91, 0x07, 0x18, 0x2f,
-	0x75, 0x78, 0xe2, 0x1d, 0x68, 0xac, 0x6b, 0x3b, 0x6f, 0x13, 0x12, 0xe0, 0x63, 0x23, 0x1f, 0x15,
-	0x4a, 0xca, 0xeb, 0x28, 0x05, 0x3e, 0x4c, 0x2b, 0xef, 0x46, 0x0f, 0x0d, 0x1c, 0x7a, 0xdf, 0x40,
-	0x97, 0x2d, 0xf2, 0x46, 0x03, 0x84, 0xe2, 0x5b, 0x96, 0x5f, 0x35, 0x05, 0x78, 0x4e, 0x0f, 0x0d,
-	0x8e, 0xce, 0x9e, 0x07, 0x3b, 0x76, 0x35, 0x8b, 0x04, 0xd4, 0x8c, 0x33, 0x4e, 0x83, 0x8f, 0x4d,
-	0x01, 0x14, 0x1b, 0x95, 0xf6, 0x99, 0x3c, 0xc3, 0xc4, 0x6a, 0x96, 0x8a, 0x45, 0x2c, 0x8d, 0x85,
-	0x80, 0xcc, 0x3b, 0xd4, 0x56, 0xef, 0x19, 0xe4, 0x83, 0x62, 0x2f, 0x4d, 0x9f, 0x3c, 0xc5, 0x77,
-	0x97, 0x6c, 0xf8, 0x52, 0x83, 0x60, 0xe0, 0xb9, 0xda, 0xed, 0x91, 0xa5, 0xda, 0x6e, 0xff, 0x1b,
-	0xc2, 0x0f, 0xff, 0x91, 0x54, 0xf9, 0x49, 0x54, 0x3c, 0xb3, 0xeb, 0xec, 0x99, 0xda, 0x09, 0x76,
-	0xb7, 0x82, 0xb2, 0x15, 0x79, 0x8c, 0xef, 0x5c, 0xdb, 0x61, 0x49, 0x24, 0xea, 0x5c, 0xe7, 0xe3,
-	0xd0, 0xdb, 0xab, 0xe6, 0xbb, 0x3a, 0xef, 0xff, 0x40, 0xf8, 0xc9, 0x96, 0xaf, 0xad, 0x78, 0xca,
-	0x8b, 0x86, 0x02, 0x03, 0x3e, 0x06, 0xb5, 0xaf, 0xbb, 0x53, 0x7c, 0x53, 0x59, 0x09, 0xed, 0xaf,
-	0x4b, 0x57, 0xf5, 0x86, 0x73, 0xe7, 0xff, 0xce, 0x0f, 0xff, 0x76, 0x7e, 0xf1, 0xfe, 0xe7, 0xdc,
-	0x47, 0xb3, 0xb9, 0x8f, 0x7e, 0xcf, 0x7d, 0xf4, 0x75, 0xe1, 0x77, 0x66, 0x0b, 0xbf, 0xf3, 0x6b,
-	0xe1, 0x77, 0x3e, 0xbf, 0x18, 0xf2, 0x2a, 0xad, 0xaf, 0xda, 0x0f, 0x1e, 0xee, 0x38, 0xea, 0xf1,
-	0x79, 0x38, 0xd9, 0xfc, 0x59, 0xda, 0xfb, 0x29, 0xaf, 0x5c, 0x7d, 0xd5, 0xe7, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0x60, 0x15, 0xe5, 0x5e, 0x03, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x25, 0x8d, 0x9a, 0x01, 0x0a, 0x6c, 0xa5, 0xca, 0xaa, 0x84, 0x1b, 0x82, 0x10,
+	0x39, 0x20, 0x5b, 0xb4, 0x07, 0x8e, 0xa0, 0x22, 0x10, 0x5c, 0x50, 0x58, 0xe0, 0xc2, 0xc5, 0x72,
+	0xed, 0x69, 0xb3, 0xaa, 0xbd, 0x6b, 0x76, 0xed, 0xa8, 0xee, 0x53, 0x70, 0xe1, 0x19, 0x78, 0x04,
+	0x5e, 0x81, 0x63, 0x8f, 0x1c, 0x51, 0xf2, 0x22, 0xc8, 0xbb, 0xdb, 0x36, 0xa9, 0x6a, 0x2a, 0xf5,
+	0xe6, 0x99, 0x7f, 0xfc, 0xcf, 0x37, 0xa3, 0x1d, 0x08, 0xd2, 0x3a, 0x47, 0xa1, 0xb9, 0x14, 0xc7,
+	0xf5, 0x49, 0x78, 0x1e, 0x84, 0x29, 0x66, 0x71, 0x8d, 0x69, 0x9c, 0x1c, 0x85, 0x38, 0x45, 0x51,
+	0xea, 0xa0, 0x50, 0xb2, 0x94, 0xf4, 0xd1, 0x62, 0xfd, 0xc5, 0xcf, 0xc1, 0x45, 0xfd, 0xd6, 0x4e,
+	0x8b, 0x65, 0x22, 0xf3, 0x5c, 0x8a, 0x50, 0xc9, 0x2c, 0x8b, 0x8b, 0x22, 0x2a, 0xe2, 0xe4, 0x08,
+	0x4b, 0x6b, 0x3b, 0xfc, 0xb9, 0x02, 0x1b, 0x6f, 0x9a, 0x3e, 0x6f, 0xb9, 0x88, 0x33, 0x7e, 0x82,
+	0x63, 0xa3, 0xd2, 0x4d, 0xe8, 0x69, 0x14, 0x29, 0x2a, 0x8f, 0x0c, 0xc8, 0xa8, 0xcf, 0x5c, 0x44,
+	0x1f, 0x02, 0x9c, 0xf9, 0xf0, 0xd4, 0x5b, 0x31, 0x5a, 0xdf, 0x65, 0xde, 0xa7, 0x34, 0x80, 0x0d,
+	0x6b, 0x1f, 0x15, 0x4a, 0xca, 0x83, 0x68, 0x82, 0xfc, 0x70, 0x52, 0x7a, 0xb7, 0x06, 0x64, 0xd4,
+	0x65, 0x0f, 0xac, 0x34, 0x6e, 0x94, 0x77, 0x46, 0xa0, 0x0c, 0x6e, 0xbb, 0xfa, 0xb2, 0x2e, 0xd0,
+	0xeb, 0x0e, 0xc8, 0x68, 0x7d, 0xe7, 0x79, 0xd0, 0x32, 0xab, 0x1d, 0x24, 0x60, 0xb6, 0x9d, 0x25,
+	0x0d, 0x3e, 0xd7, 0x05, 0x32, 0xb0, 0x2e, 0xcd, 0x37, 0x7d, 0x06, 0xd4, 0x79, 0x6a, 0x95, 0x44,
+	0xc9, 0x24, 0x16, 0x02, 0x33, 0x6f, 0xd5, 0xa0, 0xde, 0xb7, 0xca, 0x27, 0x95, 0xbc, 0xb6, 0x79,
+	0xfa, 0x14, 0xee, 0x9d, 0x55, 0xe3, 0xb7, 0x0a, 0x45, 0x82, 0x5e, 0xcf, 0xd0, 0xae, 0xbb, 0x52,
+	0x97, 0x1d, 0xfe, 0x20, 0xb0, 0x7d, 0xc5, 0xa6, 0xf4, 0x17, 0x51, 0xf2, 0xcc, 0x8d, 0x73, 0xc3,
+	0xad, 0x6d, 0x42, 0x6f, 0x69, 0x51, 0x2e, 0xa2, 0x8f, 0xe1, 0xee, 0x81, 0x6b, 0x96, 0x46, 0xa2,
+	0xca, 0xcd, 0x7e, 0xba, 0xec, 0xce, 0x79, 0xf2, 0x43, 0x95, 0x0f, 0x7f, 0x11, 0x78, 0xb2, 0xc4,
+	0xb5, 0xb4, 0x1e, 0xbd, 0x57, 0x33, 0x4c, 0x90, 0x4f, 0x51, 0xdd, 0x94, 0x6e, 0x0b, 0xd6, 0x94,
+	0xb3, 0x30, 0x7c, 0x7d, 0xb6, 0xa6, 0x16, 0x2c, 0x1d, 0x79, 0xf7, 0xff, 0xe4, 0xab, 0x57, 0x90,
+	0xbf, 0x04, 0xdf, 0x80, 0x3b, 0x60, 0xc7, 0x1f, 0x97, 0x5c, 0x8a, 0x71, 0x5c, 0x69, 0x4c, 0x2f,
+	0x91, 0x91, 0x4b, 0x64, 0xc3, 0x57, 0xb0, 0xdd, 0x66, 0xc0, 0x50, 0x57, 0xf9, 0xb5, 0x0e, 0x7b,
+	0x1f, 0x7f, 0xcf, 0x7c, 0x72, 0x3a, 0xf3, 0xc9, 0xdf, 0x99, 0x4f, 0xbe, 0xcf, 0xfd, 0xce, 0xe9,
+	0xdc, 0xef, 0xfc, 0x99, 0xfb, 0x9d, 0xaf, 0x2f, 0x0e, 0x79, 0x39, 0xa9, 0xf6, 0x9b, 0x37, 0x17,
+	0xb6, 0xdc, 0xd5, 0x74, 0x37, 0x3c, 0x5e, 0xbc, 0xd7, 0xe6, 0x09, 0xeb, 0xfd, 0x9e, 0x39, 0xac,
+	0xdd, 0x7f, 0x03, 0x00, 0xa5, 0x97, 0x00, 0xc6, 0xe1, 0x03, 0x00, 0x00,
 }
 
 func (m *EventFinalizePacket) Marshal() (dAtA []byte, err error) {
@@ -467,6 +561,66 @@ func (m *EventFinalizeRollappPacketsByReceiver) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRollappFinalizationPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRollappFinalizationPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRollappFinalizationPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRollappFinalizationResumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRollappFinalizationResumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRollappFinalizationResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -558,6 +712,32 @@ func (m *EventFinalizeRollappPacketsByReceiver) Size() (n int) {
 	return n
 }
 
+func (m *EventRollappFinalizationPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRollappFinalizationResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1103,6 +1283,170 @@ func (m *EventFinalizeRollappPacketsByReceiver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRollappFinalizationPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRollappFinalizationPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRollappFinalizationPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRollappFinalizationResumed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRollappFinalizationResumed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRollappFinalizationResumed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21,6 +21,7 @@ type RollappKeeper interface {
 	MustGetStateInfo(ctx sdk.Context, rollappId string, index uint64) rollapptypes.StateInfo
 	GetLatestStateInfo(ctx sdk.Context, rollappId string) (types.StateInfo, bool)
 	GetLatestFinalizedStateIndex(ctx sdk.Context, rollappId string) (val types.StateInfoIndex, found bool)
+	GetRollapp(ctx sdk.Context, rollappId string) (val types.Rollapp, found bool)
 	GetAllRollapps(ctx sdk.Context) (list []types.Rollapp)
 	GetValidTransfer(
 		ctx sdk.Context,
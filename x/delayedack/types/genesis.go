@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -20,5 +22,15 @@ func (gs GenesisState) Validate() error {
 		}
 		rollappPacketMap[string(rollappPacket.RollappPacketKey())] = struct{}{}
 	}
+	pausedRollappMap := make(map[string]struct{})
+	for _, rollappID := range gs.GetPausedRollapps() {
+		if rollappID == "" {
+			return fmt.Errorf("paused rollapp id cannot be empty")
+		}
+		if _, ok := pausedRollappMap[rollappID]; ok {
+			return fmt.Errorf("duplicate paused rollapp: %s", rollappID)
+		}
+		pausedRollappMap[rollappID] = struct{}{}
+	}
 	return gs.Params.Validate()
 }
@@ -30,6 +30,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// streams are all streams that should exist at genesis
 	RollappPackets []types.RollappPacket `protobuf:"bytes,2,rep,name=rollapp_packets,json=rollappPackets,proto3" json:"rollapp_packets"`
+	// paused_rollapps are the rollapps whose packets finalization is paused
+	PausedRollapps []string `protobuf:"bytes,3,rep,name=paused_rollapps,json=pausedRollapps,proto3" json:"paused_rollapps,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +81,13 @@ func (m *GenesisState) GetRollappPackets() []types.RollappPacket {
 	return nil
 }
 
+func (m *GenesisState) GetPausedRollapps() []string {
+	if m != nil {
+		return m.PausedRollapps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.delayedack.GenesisState")
 }
@@ -88,7 +97,7 @@ func init() {
 }
 
 var fileDescriptor_1d8c175b9e6478cc = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
+	// 285 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4f, 0xa9, 0xcc, 0x4d,
 	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0x42, 0x70, 0xf4, 0x53, 0x52, 0x73, 0x12, 0x2b,
 	0x53, 0x53, 0x12, 0x93, 0xb3, 0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a,
@@ -96,16 +105,17 @@ var fileDescriptor_1d8c175b9e6478cc = []byte{
 	0xf9, 0xe9, 0xf9, 0x60, 0xd5, 0xfa, 0x20, 0x16, 0x44, 0xa3, 0x94, 0x1e, 0x61, 0x9b, 0x0a, 0x12,
 	0x8b, 0x12, 0x73, 0xa1, 0x16, 0x49, 0x19, 0xe1, 0x50, 0x9f, 0x9c, 0x9f, 0x9b, 0x9b, 0x9f, 0xa7,
 	0x5f, 0x94, 0x9f, 0x93, 0x93, 0x58, 0x50, 0x10, 0x5f, 0x90, 0x98, 0x9c, 0x9d, 0x5a, 0x02, 0xd1,
-	0xa3, 0xb4, 0x85, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0xdc, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x77,
+	0xa3, 0x74, 0x97, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0xdc, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x77,
 	0x2e, 0x36, 0x88, 0xa1, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x9a, 0x7a, 0x04, 0x9d, 0xaf,
 	0x17, 0x00, 0xd6, 0xe0, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xbb, 0x50, 0x34, 0x17,
 	0x3f, 0xaa, 0x8d, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x3a, 0xb8, 0x4c, 0x84, 0xb8,
-	0x53, 0x2f, 0x08, 0xa2, 0x2b, 0x00, 0xac, 0x09, 0x6a, 0x28, 0x5f, 0x11, 0xb2, 0x60, 0xb1, 0x53,
-	0xe0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa7, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0x81, 0x0c, 0xc3, 0x15, 0x53, 0x65, 0xc6, 0xfa, 0x15, 0xc8, 0x81, 0x58, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x10, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x19,
-	0x8d, 0x31, 0xe0, 0x01, 0x00, 0x00,
+	0x53, 0x2f, 0x08, 0xa2, 0x2b, 0x00, 0xac, 0x09, 0x6a, 0x28, 0x5f, 0x11, 0xb2, 0x60, 0xb1, 0x90,
+	0x3a, 0x17, 0x7f, 0x41, 0x62, 0x69, 0x71, 0x6a, 0x4a, 0x3c, 0x54, 0xa2, 0x58, 0x82, 0x59, 0x81,
+	0x59, 0x83, 0x33, 0x88, 0x0f, 0x22, 0x0c, 0x35, 0xa3, 0xd8, 0x29, 0xf0, 0xc4, 0x23, 0x39, 0xc6,
+	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
+	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xcc, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0x40, 0xb6, 0xe2,
+	0x8a, 0xd2, 0x32, 0x63, 0xfd, 0x0a, 0xe4, 0xd0, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
+	0x87, 0x9c, 0x31, 0x60, 0x00, 0x40, 0xfc, 0xf1, 0x09, 0x09, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -128,6 +138,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PausedRollapps) > 0 {
+		for iNdEx := len(m.PausedRollapps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedRollapps[iNdEx])
+			copy(dAtA[i:], m.PausedRollapps[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedRollapps[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RollappPackets) > 0 {
 		for iNdEx := len(m.RollappPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -180,6 +199,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PausedRollapps) > 0 {
+		for _, s := range m.PausedRollapps {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -285,6 +310,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedRollapps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedRollapps = append(m.PausedRollapps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "delayedack"
@@ -17,3 +23,52 @@ const (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+var (
+	// ArchivedPacketKeyPrefix is the prefix for the archived rollapp packets, by packet UID
+	ArchivedPacketKeyPrefix = []byte{0x01}
+	// ArchivedPacketBySenderKeyPrefix is the prefix for the index of the archived packets by sender
+	ArchivedPacketBySenderKeyPrefix = []byte{0x02}
+	// ArchivedPacketByReceiverKeyPrefix is the prefix for the index of the archived packets by receiver
+	ArchivedPacketByReceiverKeyPrefix = []byte{0x03}
+	// ArchivedPacketByEpochKeyPrefix is the prefix for the index of the archived packets by epoch number
+	ArchivedPacketByEpochKeyPrefix = []byte{0x04}
+	// PausedRollappKeyPrefix is the prefix for the rollapps whose packets finalization is paused
+	PausedRollappKeyPrefix = []byte{0x05}
+
+	keySeparatorBytes = []byte("/")
+)
+
+// ArchivedPacketKey returns the key of the archived packet: "prefix/packetUID"
+func ArchivedPacketKey(packetUID string) []byte {
+	return append(slices.Clone(ArchivedPacketKeyPrefix), []byte(packetUID)...)
+}
+
+// ArchivedPacketByAddressPrefix returns the prefix of the index of the archived packets
+// by address (sender or receiver): "prefix/address/"
+func ArchivedPacketByAddressPrefix(prefix []byte, address string) []byte {
+	return append(append(slices.Clone(prefix), []byte(address)...), keySeparatorBytes...)
+}
+
+// ArchivedPacketByAddressKey returns the key of the index of the archived packets
+// by address (sender or receiver): "prefix/address/packetUID"
+func ArchivedPacketByAddressKey(prefix []byte, address, packetUID string) []byte {
+	return append(ArchivedPacketByAddressPrefix(prefix, address), []byte(packetUID)...)
+}
+
+// ArchivedPacketByEpochPrefix returns the prefix of the index of the archived packets
+// by epoch number: "prefix/epochNumber"
+func ArchivedPacketByEpochPrefix(epochNumber int64) []byte {
+	return append(slices.Clone(ArchivedPacketByEpochKeyPrefix), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// ArchivedPacketByEpochKey returns the key of the index of the archived packets
+// by epoch number: "prefix/epochNumber/packetUID"
+func ArchivedPacketByEpochKey(epochNumber int64, packetUID string) []byte {
+	return append(ArchivedPacketByEpochPrefix(epochNumber), []byte(packetUID)...)
+}
+
+// PausedRollappKey returns the key of the paused rollapp: "prefix/rollappID"
+func PausedRollappKey(rollappID string) []byte {
+	return append(slices.Clone(PausedRollappKeyPrefix), []byte(rollappID)...)
+}
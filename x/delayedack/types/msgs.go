@@ -84,3 +84,41 @@ func (m MsgFinalizeRollappPacketsByReceiver) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+func (m MsgPauseRollappFinalization) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "authority must be a valid bech32 address: %s", m.Authority),
+		)
+	}
+	if len(m.RollappId) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("rollappId must be non-empty")
+	}
+	return nil
+}
+
+func (m MsgPauseRollappFinalization) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgResumeRollappFinalization) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "authority must be a valid bech32 address: %s", m.Authority),
+		)
+	}
+	if len(m.RollappId) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("rollappId must be non-empty")
+	}
+	return nil
+}
+
+func (m MsgResumeRollappFinalization) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
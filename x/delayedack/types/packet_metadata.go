@@ -4,9 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 )
 
 type PacketMetadata struct {
@@ -15,6 +16,16 @@ type PacketMetadata struct {
 
 type EIBCMetadata struct {
 	Fee string `json:"fee"`
+	// FeeEscalation is an optional schedule which raises the fee while the order is not fulfilled.
+	// Fee is the fee the schedule starts from.
+	FeeEscalation *FeeEscalationMetadata `json:"fee_escalation,omitempty"`
+}
+
+// FeeEscalationMetadata raises the fee by Step every Interval hub blocks until it reaches MaxFee.
+type FeeEscalationMetadata struct {
+	MaxFee   string `json:"max_fee"`
+	Step     string `json:"step"`
+	Interval uint64 `json:"interval"`
 }
 
 func (p PacketMetadata) ValidateBasic() error {
@@ -22,13 +33,48 @@ func (p PacketMetadata) ValidateBasic() error {
 }
 
 func (e EIBCMetadata) ValidateBasic() error {
-	_, err := e.FeeInt()
+	fee, err := e.FeeInt()
 	if err != nil {
 		return fmt.Errorf("fee: %w", err)
 	}
+	if e.FeeEscalation != nil {
+		if err := e.FeeEscalation.ValidateBasic(fee); err != nil {
+			return fmt.Errorf("fee escalation: %w", err)
+		}
+	}
 	return nil
 }
 
+// ValidateBasic validates the schedule against the fee it starts from.
+func (e FeeEscalationMetadata) ValidateBasic(startFee math.Int) error {
+	maxFee, step, err := e.MaxFeeAndStepInt()
+	if err != nil {
+		return err
+	}
+	if maxFee.LT(startFee) {
+		return errorsmod.Wrap(ErrBadEIBCFeeEscalation, "max fee is lower than the fee")
+	}
+	if !step.IsPositive() {
+		return errorsmod.Wrap(ErrBadEIBCFeeEscalation, "step must be positive")
+	}
+	if e.Interval == 0 {
+		return errorsmod.Wrap(ErrBadEIBCFeeEscalation, "interval must be positive")
+	}
+	return nil
+}
+
+func (e FeeEscalationMetadata) MaxFeeAndStepInt() (math.Int, math.Int, error) {
+	maxFee, ok := sdk.NewIntFromString(e.MaxFee)
+	if !ok {
+		return math.Int{}, math.Int{}, errorsmod.Wrap(ErrBadEIBCFeeEscalation, "max fee")
+	}
+	step, ok := sdk.NewIntFromString(e.Step)
+	if !ok {
+		return math.Int{}, math.Int{}, errorsmod.Wrap(ErrBadEIBCFeeEscalation, "step")
+	}
+	return maxFee, step, nil
+}
+
 func (e EIBCMetadata) FeeInt() (math.Int, error) {
 	i, ok := sdk.NewIntFromString(e.Fee)
 	if !ok || i.IsNegative() {
@@ -45,7 +91,8 @@ const (
 var (
 	ErrMemoUnmarshal         = fmt.Errorf("unmarshal memo")
 	ErrEIBCMetadataUnmarshal = fmt.Errorf("unmarshal eibc metadata")
-	ErrMemoHashPFMandEIBC    = fmt.Errorf("EIBC packet with PFM is currently not supported")
+	ErrForwardMetadata       = fmt.Errorf("invalid forward metadata")
+	ErrMemoHashPFMandEIBC    = fmt.Errorf("EIBC packet with PFM can only be fulfilled in full")
 	ErrMemoEibcEmpty         = fmt.Errorf("memo eIBC field is missing")
 )
 
@@ -57,10 +104,6 @@ func ParsePacketMetadata(input string) (*PacketMetadata, error) {
 	if err != nil {
 		return nil, ErrMemoUnmarshal
 	}
-	if memo[memoObjectKeyPFM] != nil {
-		// Currently not supporting eibc with PFM: https://github.com/dymensionxyz/dymension/issues/599
-		return nil, ErrMemoHashPFMandEIBC
-	}
 	if memo[memoObjectKeyEIBC] == nil {
 		return nil, ErrMemoEibcEmpty
 	}
@@ -71,3 +114,41 @@ func ParsePacketMetadata(input string) (*PacketMetadata, error) {
 	}
 	return &metadata, nil
 }
+
+// ParseForwardMetadata returns the packet-forward-middleware instructions of the memo.
+// It returns nil if the memo does not ask for the packet to be forwarded.
+func ParseForwardMetadata(input string) (*pfmtypes.ForwardMetadata, error) {
+	memo := make(map[string]any)
+	if err := json.Unmarshal([]byte(input), &memo); err != nil || memo[memoObjectKeyPFM] == nil {
+		return nil, nil
+	}
+	var metadata pfmtypes.PacketMetadata
+	if err := json.Unmarshal([]byte(input), &metadata); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrForwardMetadata, err)
+	}
+	if err := metadata.Forward.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrForwardMetadata, err)
+	}
+	return metadata.Forward, nil
+}
+
+// RemoveForwardMetadata returns the memo without the packet-forward-middleware instructions.
+// The memo is returned as is if it does not ask for the packet to be forwarded.
+func RemoveForwardMetadata(input string) string {
+	memo := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(input), &memo); err != nil {
+		return input
+	}
+	if _, ok := memo[memoObjectKeyPFM]; !ok {
+		return input
+	}
+	delete(memo, memoObjectKeyPFM)
+	if len(memo) == 0 {
+		return ""
+	}
+	bz, err := json.Marshal(memo)
+	if err != nil {
+		return input
+	}
+	return string(bz)
+}
@@ -27,6 +27,18 @@ func Test_parsePacketMetadata(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid - with pfm",
+			args{
+				`{"eibc":{"fee":"100"},"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0"}}`,
+			},
+			&PacketMetadata{
+				EIBC: &EIBCMetadata{
+					Fee: "100",
+				},
+			},
+			false,
+		},
 		{
 			"invalid - misquoted fee",
 			args{
@@ -65,3 +77,39 @@ func Test_parsePacketMetadata(t *testing.T) {
 		})
 	}
 }
+
+func Test_removeForwardMetadata(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			"eibc and pfm",
+			`{"eibc":{"fee":"100"},"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0"}}`,
+			`{"eibc":{"fee":"100"}}`,
+		},
+		{
+			"pfm only",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-0"}}`,
+			``,
+		},
+		{
+			"no pfm",
+			`{"eibc":{"fee":"100"}}`,
+			`{"eibc":{"fee":"100"}}`,
+		},
+		{
+			"not json",
+			`bad`,
+			`bad`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RemoveForwardMetadata(tt.input); got != tt.want {
+				t.Errorf("RemoveForwardMetadata() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -19,11 +19,15 @@ var (
 
 	// KeyDeletePacketsEpochLimit is the key for the delete packets epoch limit
 	KeyDeletePacketsEpochLimit = []byte("DeletePacketsEpochLimit")
+
+	// KeyPacketRetentionEpochs is the key for the packet retention epochs
+	KeyPacketRetentionEpochs = []byte("PacketRetentionEpochs")
 )
 
 const (
 	defaultEpochIdentifier         = "hour"
 	defaultDeletePacketsEpochLimit = 1000_000
+	defaultPacketRetentionEpochs   = 24 * 30 // 30 days of hourly epochs
 )
 
 // ParamKeyTable the param key table for launch module
@@ -32,11 +36,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(epochIdentifier string, bridgingFee sdk.Dec, deletePacketsEpochLimit int) Params {
+func NewParams(epochIdentifier string, bridgingFee sdk.Dec, deletePacketsEpochLimit int, packetRetentionEpochs uint64) Params {
 	return Params{
 		EpochIdentifier:         epochIdentifier,
 		BridgingFee:             bridgingFee,
 		DeletePacketsEpochLimit: int32(deletePacketsEpochLimit),
+		PacketRetentionEpochs:   packetRetentionEpochs,
 	}
 }
 
@@ -46,6 +51,7 @@ func DefaultParams() Params {
 		defaultEpochIdentifier,
 		sdk.NewDecWithPrec(1, 3), // 0.1%
 		defaultDeletePacketsEpochLimit,
+		defaultPacketRetentionEpochs,
 	)
 }
 
@@ -55,6 +61,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, validateEpochIdentifier),
 		paramtypes.NewParamSetPair(KeyBridgeFee, &p.BridgingFee, validateBridgingFee),
 		paramtypes.NewParamSetPair(KeyDeletePacketsEpochLimit, &p.DeletePacketsEpochLimit, validateDeletePacketsEpochLimit),
+		paramtypes.NewParamSetPair(KeyPacketRetentionEpochs, &p.PacketRetentionEpochs, validatePacketRetentionEpochs),
 	}
 }
 
@@ -99,6 +106,14 @@ func validateDeletePacketsEpochLimit(i interface{}) error {
 	return nil
 }
 
+func validatePacketRetentionEpochs(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := validateBridgingFee(p.BridgingFee); err != nil {
@@ -36,6 +36,10 @@ type Params struct {
 	// even if it means potentially causing the store to temporarily grow by piling up packets
 	// that weren't deleted but rather "postponed", to subsequent epochs.
 	DeletePacketsEpochLimit int32 `protobuf:"varint,3,opt,name=delete_packets_epoch_limit,json=deletePacketsEpochLimit,proto3" json:"delete_packets_epoch_limit,omitempty" yaml:"delete_packets_epoch_limit"`
+	// `packet_retention_epochs` is the number of epochs during which a summary of the
+	// deleted finalized and reverted rollapp packets is kept in the archive.
+	// Zero disables the archive.
+	PacketRetentionEpochs uint64 `protobuf:"varint,4,opt,name=packet_retention_epochs,json=packetRetentionEpochs,proto3" json:"packet_retention_epochs,omitempty" yaml:"packet_retention_epochs"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -84,6 +88,13 @@ func (m *Params) GetDeletePacketsEpochLimit() int32 {
 	return 0
 }
 
+func (m *Params) GetPacketRetentionEpochs() uint64 {
+	if m != nil {
+		return m.PacketRetentionEpochs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.delayedack.Params")
 }
@@ -93,28 +104,30 @@ func init() {
 }
 
 var fileDescriptor_9516cc08de197609 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x52, 0x73, 0x12,
-	0x2b, 0x53, 0x53, 0x12, 0x93, 0xb3, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0x91, 0xd5, 0x23, 0x34, 0xeb, 0x21, 0xd4, 0x4b, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x55, 0xeb, 0x83, 0x58, 0x10, 0x8d, 0x4a, 0x4b, 0x98, 0xb8, 0xd8, 0x02, 0xc0,
-	0x26, 0x09, 0xb9, 0x71, 0x09, 0xa4, 0x16, 0xe4, 0x27, 0x67, 0xc4, 0x67, 0xa6, 0xa4, 0xe6, 0x95,
-	0x64, 0xa6, 0x65, 0xa6, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x7f, 0xba, 0x27,
-	0x2f, 0x5e, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0xae, 0x42, 0x29, 0x88, 0x1f, 0x2c, 0xe4, 0x09,
-	0x17, 0x11, 0xca, 0xe0, 0xe2, 0x49, 0x2a, 0xca, 0x4c, 0x49, 0xcf, 0xcc, 0x4b, 0x8f, 0x4f, 0x4b,
-	0x4d, 0x95, 0x60, 0x02, 0x9b, 0xe1, 0x7a, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0x6a, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5,
-	0x50, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x58, 0xcf, 0x25, 0x35, 0xf9,
-	0xd3, 0x3d, 0x79, 0x61, 0x88, 0x8d, 0xc8, 0x66, 0x29, 0x05, 0x71, 0xc3, 0xb8, 0x6e, 0xa9, 0xa9,
-	0x42, 0x49, 0x5c, 0x52, 0x29, 0xa9, 0x39, 0xa9, 0x25, 0xa9, 0xf1, 0x05, 0x89, 0xc9, 0xd9, 0xa9,
-	0x25, 0xc5, 0xf1, 0x10, 0xe7, 0xe5, 0x64, 0xe6, 0x66, 0x96, 0x48, 0x30, 0x2b, 0x30, 0x6a, 0xb0,
-	0x3a, 0xa9, 0x7e, 0xba, 0x27, 0xaf, 0x08, 0x31, 0x09, 0xb7, 0x5a, 0xa5, 0x20, 0x71, 0x88, 0x64,
-	0x00, 0x44, 0xce, 0x15, 0x24, 0xe5, 0x03, 0x92, 0xb1, 0x62, 0x99, 0xb1, 0x40, 0x9e, 0xc1, 0x29,
-	0xf0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xcc, 0x91, 0xfc, 0x83, 0x23,
-	0xd2, 0xca, 0x8c, 0xf5, 0x2b, 0x90, 0x63, 0x0e, 0xec, 0xc9, 0x24, 0x36, 0x70, 0x04, 0x18, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x0c, 0xec, 0x8e, 0xeb, 0x01, 0x00, 0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x3f, 0x4b, 0xc3, 0x40,
+	0x14, 0x4f, 0x6c, 0x2d, 0x18, 0x05, 0x25, 0x2a, 0x0d, 0x15, 0x92, 0x36, 0xa0, 0x74, 0x31, 0x19,
+	0x3a, 0x08, 0x1d, 0x83, 0x2d, 0x08, 0x0e, 0x35, 0x63, 0x97, 0x90, 0x3f, 0xaf, 0xe9, 0xd1, 0x24,
+	0x17, 0x72, 0xa7, 0x34, 0x4e, 0x7e, 0x04, 0x47, 0x47, 0x3f, 0x4e, 0xc7, 0x8e, 0xe2, 0x10, 0xa4,
+	0xfd, 0x06, 0xf9, 0x04, 0x92, 0x9c, 0x6d, 0x83, 0xd0, 0xe9, 0xee, 0xbd, 0xdf, 0x9f, 0x77, 0xf7,
+	0xde, 0x13, 0x34, 0x2f, 0x0d, 0x21, 0x22, 0x08, 0x47, 0xf3, 0xf4, 0x55, 0xdf, 0x06, 0xba, 0x07,
+	0x81, 0x9d, 0x82, 0x67, 0xbb, 0x33, 0x3d, 0xb6, 0x13, 0x3b, 0x24, 0x5a, 0x9c, 0x60, 0x8a, 0xc5,
+	0x4e, 0x95, 0xbf, 0x13, 0x6b, 0x3b, 0x7e, 0xeb, 0xc2, 0xc7, 0x3e, 0x2e, 0xd9, 0x7a, 0x71, 0x63,
+	0x42, 0xf5, 0xad, 0x26, 0x34, 0x46, 0xa5, 0x93, 0x38, 0x14, 0xce, 0x20, 0xc6, 0xee, 0xd4, 0x42,
+	0x1e, 0x44, 0x14, 0x4d, 0x10, 0x24, 0x12, 0xdf, 0xe6, 0xbb, 0x47, 0xc6, 0x55, 0x9e, 0x29, 0xcd,
+	0xd4, 0x0e, 0x83, 0xbe, 0xfa, 0x9f, 0xa1, 0x9a, 0xa7, 0x65, 0xea, 0x61, 0x9b, 0x11, 0xa7, 0xc2,
+	0x89, 0x93, 0x20, 0xcf, 0x47, 0x91, 0x6f, 0x4d, 0x00, 0xa4, 0x83, 0xd2, 0x63, 0xb0, 0xc8, 0x14,
+	0xee, 0x3b, 0x53, 0x6e, 0x7c, 0x44, 0xa7, 0xcf, 0x8e, 0xe6, 0xe2, 0x50, 0x77, 0x31, 0x09, 0x31,
+	0xf9, 0x3b, 0x6e, 0x89, 0x37, 0xd3, 0x69, 0x1a, 0x03, 0xd1, 0xee, 0xc1, 0xcd, 0x33, 0xe5, 0x9c,
+	0x55, 0xac, 0x7a, 0xa9, 0xe6, 0xf1, 0x26, 0x1c, 0x02, 0x88, 0x8e, 0xd0, 0xf2, 0x20, 0x00, 0x0a,
+	0x56, 0x6c, 0xbb, 0x33, 0xa0, 0xc4, 0x62, 0xcf, 0x0b, 0x50, 0x88, 0xa8, 0x54, 0x6b, 0xf3, 0xdd,
+	0x43, 0xe3, 0x3a, 0xcf, 0x94, 0x0e, 0x73, 0xda, 0xcf, 0x55, 0xcd, 0x26, 0x03, 0x47, 0x0c, 0x1b,
+	0x14, 0xd0, 0x63, 0x81, 0x88, 0x63, 0xa1, 0xc9, 0x04, 0x56, 0x02, 0xb4, 0xf8, 0x23, 0x8e, 0x98,
+	0x92, 0x48, 0xf5, 0x36, 0xdf, 0xad, 0x1b, 0x6a, 0x9e, 0x29, 0x32, 0x2b, 0xb0, 0x87, 0xa8, 0x9a,
+	0x97, 0x0c, 0x31, 0x37, 0x40, 0xe9, 0x4f, 0xfa, 0xf5, 0x8f, 0x4f, 0x85, 0x33, 0x9e, 0x16, 0x2b,
+	0x99, 0x5f, 0xae, 0x64, 0xfe, 0x67, 0x25, 0xf3, 0xef, 0x6b, 0x99, 0x5b, 0xae, 0x65, 0xee, 0x6b,
+	0x2d, 0x73, 0xe3, 0xbb, 0x4a, 0xaf, 0xf6, 0x2c, 0xc4, 0x4b, 0x4f, 0x9f, 0x57, 0xb7, 0xa2, 0x6c,
+	0xa0, 0xd3, 0x28, 0x87, 0xdb, 0xfb, 0x1d, 0x00, 0x84, 0x4e, 0x85, 0xa8, 0x47, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -137,6 +150,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PacketRetentionEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PacketRetentionEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.DeletePacketsEpochLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DeletePacketsEpochLimit))
 		i--
@@ -188,6 +206,9 @@ func (m *Params) Size() (n int) {
 	if m.DeletePacketsEpochLimit != 0 {
 		n += 1 + sovParams(uint64(m.DeletePacketsEpochLimit))
 	}
+	if m.PacketRetentionEpochs != 0 {
+		n += 1 + sovParams(uint64(m.PacketRetentionEpochs))
+	}
 	return n
 }
 
@@ -311,6 +332,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketRetentionEpochs", wireType)
+			}
+			m.PacketRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
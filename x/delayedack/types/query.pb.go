@@ -234,11 +234,220 @@ func (m *QueryRollappPacketListResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryArchivedPacketsRequest is the request type for the Query/ArchivedPackets RPC method.
+// Exactly one of the sender, the receiver or the packet UID must be set.
+type QueryArchivedPacketsRequest struct {
+	Sender     string             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver   string             `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	PacketUid  string             `protobuf:"bytes,3,opt,name=packet_uid,json=packetUid,proto3" json:"packet_uid,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryArchivedPacketsRequest) Reset()         { *m = QueryArchivedPacketsRequest{} }
+func (m *QueryArchivedPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedPacketsRequest) ProtoMessage()    {}
+func (*QueryArchivedPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{4}
+}
+func (m *QueryArchivedPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedPacketsRequest.Merge(m, src)
+}
+func (m *QueryArchivedPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryArchivedPacketsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryArchivedPacketsRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QueryArchivedPacketsRequest) GetPacketUid() string {
+	if m != nil {
+		return m.PacketUid
+	}
+	return ""
+}
+
+func (m *QueryArchivedPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryArchivedPacketsResponse is the response type for the Query/ArchivedPackets RPC method.
+type QueryArchivedPacketsResponse struct {
+	Packets    []ArchivedPacket    `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryArchivedPacketsResponse) Reset()         { *m = QueryArchivedPacketsResponse{} }
+func (m *QueryArchivedPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedPacketsResponse) ProtoMessage()    {}
+func (*QueryArchivedPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{5}
+}
+func (m *QueryArchivedPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArchivedPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArchivedPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArchivedPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedPacketsResponse.Merge(m, src)
+}
+func (m *QueryArchivedPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArchivedPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArchivedPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryArchivedPacketsResponse) GetPackets() []ArchivedPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryArchivedPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPausedRollappsRequest is the request type for the Query/PausedRollapps RPC method.
+type QueryPausedRollappsRequest struct {
+}
+
+func (m *QueryPausedRollappsRequest) Reset()         { *m = QueryPausedRollappsRequest{} }
+func (m *QueryPausedRollappsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedRollappsRequest) ProtoMessage()    {}
+func (*QueryPausedRollappsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{6}
+}
+func (m *QueryPausedRollappsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedRollappsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedRollappsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedRollappsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedRollappsRequest.Merge(m, src)
+}
+func (m *QueryPausedRollappsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedRollappsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedRollappsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedRollappsRequest proto.InternalMessageInfo
+
+// QueryPausedRollappsResponse is the response type for the Query/PausedRollapps RPC method.
+type QueryPausedRollappsResponse struct {
+	RollappIds []string `protobuf:"bytes,1,rep,name=rollapp_ids,json=rollappIds,proto3" json:"rollapp_ids,omitempty"`
+}
+
+func (m *QueryPausedRollappsResponse) Reset()         { *m = QueryPausedRollappsResponse{} }
+func (m *QueryPausedRollappsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedRollappsResponse) ProtoMessage()    {}
+func (*QueryPausedRollappsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{7}
+}
+func (m *QueryPausedRollappsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedRollappsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedRollappsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedRollappsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedRollappsResponse.Merge(m, src)
+}
+func (m *QueryPausedRollappsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedRollappsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedRollappsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedRollappsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedRollappsResponse) GetRollappIds() []string {
+	if m != nil {
+		return m.RollappIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.delayedack.QueryParamsResponse")
 	proto.RegisterType((*QueryRollappPacketsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryRollappPacketsRequest")
 	proto.RegisterType((*QueryRollappPacketListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryRollappPacketListResponse")
+	proto.RegisterType((*QueryArchivedPacketsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryArchivedPacketsRequest")
+	proto.RegisterType((*QueryArchivedPacketsResponse)(nil), "dymensionxyz.dymension.delayedack.QueryArchivedPacketsResponse")
+	proto.RegisterType((*QueryPausedRollappsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPausedRollappsRequest")
+	proto.RegisterType((*QueryPausedRollappsResponse)(nil), "dymensionxyz.dymension.delayedack.QueryPausedRollappsResponse")
 }
 
 func init() {
@@ -246,42 +455,54 @@ func init() {
 }
 
 var fileDescriptor_0d5f080aa12bfc36 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xcd, 0x24, 0x31, 0xd0, 0x29, 0xf4, 0x30, 0xf6, 0x10, 0x96, 0xb2, 0xc6, 0x05, 0x35, 0xf5,
-	0xcf, 0x0c, 0xd9, 0xa2, 0x9e, 0x8a, 0xb4, 0xa0, 0x41, 0xf0, 0x90, 0xae, 0x9e, 0x7a, 0x50, 0x26,
-	0xc9, 0xb0, 0x2e, 0xcd, 0xee, 0x4c, 0x77, 0x26, 0xa5, 0x6b, 0xe9, 0xc5, 0x93, 0x27, 0x11, 0xfc,
-	0x16, 0x7e, 0x0f, 0xa1, 0xc7, 0x82, 0x07, 0x3d, 0x89, 0x24, 0x7e, 0x10, 0xd9, 0x99, 0xcd, 0x66,
-	0x63, 0x8d, 0x49, 0x7b, 0xcb, 0x6e, 0xde, 0x9b, 0xf7, 0xde, 0xef, 0xf7, 0x66, 0xe1, 0x83, 0x7e,
-	0x12, 0xb2, 0x48, 0x06, 0x3c, 0x3a, 0x4e, 0xde, 0x91, 0xfc, 0x81, 0xf4, 0xd9, 0x80, 0x26, 0xac,
-	0x4f, 0x7b, 0x07, 0xe4, 0x70, 0xc8, 0xe2, 0x04, 0x8b, 0x98, 0x2b, 0x8e, 0x6e, 0x16, 0xe1, 0x38,
-	0x7f, 0xc0, 0x53, 0xb8, 0xb5, 0xee, 0x73, 0x9f, 0x6b, 0x34, 0x49, 0x7f, 0x19, 0xa2, 0xb5, 0xe1,
-	0x73, 0xee, 0x0f, 0x18, 0xa1, 0x22, 0x20, 0x34, 0x8a, 0xb8, 0xa2, 0x2a, 0xe0, 0x91, 0xcc, 0xfe,
-	0xbd, 0xdb, 0xe3, 0x32, 0xe4, 0x92, 0x74, 0xa9, 0x64, 0x46, 0x8f, 0x1c, 0xb5, 0xba, 0x4c, 0xd1,
-	0x16, 0x11, 0xd4, 0x0f, 0x22, 0x0d, 0xce, 0xb0, 0x78, 0xb1, 0x63, 0x41, 0x63, 0x1a, 0xe6, 0x67,
-	0xcf, 0xc1, 0xf7, 0x78, 0x18, 0xf2, 0x88, 0x48, 0x45, 0xd5, 0x70, 0x82, 0x75, 0xff, 0x8f, 0x8d,
-	0xf9, 0x60, 0x40, 0x85, 0x78, 0x23, 0x68, 0xef, 0x80, 0x29, 0xc3, 0x71, 0xd6, 0x21, 0xda, 0x4b,
-	0x1d, 0x77, 0xb4, 0xa8, 0xc7, 0x0e, 0x87, 0x4c, 0x2a, 0xe7, 0x35, 0xbc, 0x3e, 0xf3, 0x56, 0x0a,
-	0x1e, 0x49, 0x86, 0xda, 0xb0, 0x66, 0xcc, 0xd5, 0x41, 0x03, 0x34, 0x57, 0xdd, 0x4d, 0xbc, 0x70,
-	0xa0, 0xd8, 0x1c, 0xb1, 0x5b, 0x3d, 0xfb, 0x79, 0xa3, 0xe4, 0x65, 0x74, 0xe7, 0x43, 0x19, 0x5a,
-	0x5a, 0xc0, 0x33, 0x9e, 0x3a, 0xda, 0xd2, 0x44, 0x1e, 0x6d, 0xc0, 0x95, 0xcc, 0xec, 0xf3, 0xbe,
-	0x96, 0x5a, 0xf1, 0xa6, 0x2f, 0xd0, 0x36, 0xac, 0x99, 0xd8, 0xf5, 0x72, 0x03, 0x34, 0xd7, 0xdc,
-	0x5b, 0xf3, 0x5c, 0x98, 0xdc, 0xf8, 0xa5, 0x06, 0x7b, 0x19, 0x09, 0x3d, 0x85, 0x55, 0x95, 0x08,
-	0x56, 0xaf, 0x68, 0x72, 0x6b, 0x01, 0x79, 0xc6, 0x20, 0x7e, 0x95, 0x08, 0xe6, 0x69, 0x3a, 0x7a,
-	0x06, 0xe1, 0x74, 0xb9, 0xf5, 0xaa, 0x9e, 0xc7, 0x6d, 0x6c, 0x9a, 0x80, 0xd3, 0x26, 0x60, 0xd3,
-	0xbc, 0xac, 0x09, 0xb8, 0x43, 0x7d, 0x96, 0xe5, 0xf3, 0x0a, 0x4c, 0xe7, 0x2b, 0x80, 0xf6, 0xc5,
-	0x51, 0xbc, 0x08, 0xa4, 0xca, 0xc7, 0xbe, 0x0f, 0xd7, 0xe2, 0x99, 0x39, 0xd5, 0x41, 0xa3, 0xd2,
-	0x5c, 0x75, 0xef, 0x5f, 0xc6, 0x7b, 0xb6, 0x81, 0xbf, 0x4e, 0x42, 0xed, 0x99, 0x18, 0x65, 0x1d,
-	0xe3, 0xce, 0xc2, 0x18, 0xc6, 0x58, 0x31, 0x87, 0xfb, 0xb1, 0x02, 0xaf, 0xe9, 0x1c, 0xe8, 0x0b,
-	0x80, 0x35, 0xb3, 0x75, 0xf4, 0x70, 0x89, 0x82, 0x5c, 0xac, 0x9f, 0xf5, 0xe8, 0xb2, 0x34, 0xe3,
-	0xc7, 0x69, 0xbd, 0xff, 0xf6, 0xfb, 0x73, 0xf9, 0x1e, 0xda, 0x24, 0xcb, 0xde, 0x32, 0xf4, 0x1d,
-	0x40, 0xd8, 0x66, 0x6a, 0x32, 0x8e, 0xed, 0x65, 0x95, 0xff, 0x59, 0x5c, 0x6b, 0xe7, 0x4a, 0xf4,
-	0xe2, 0xb2, 0x9d, 0xb6, 0xce, 0xb0, 0x83, 0x9e, 0x2c, 0x95, 0x41, 0xab, 0x93, 0x93, 0xfc, 0x72,
-	0x9c, 0x92, 0x13, 0x53, 0xf3, 0xd3, 0xdd, 0xbd, 0xb3, 0x91, 0x0d, 0xce, 0x47, 0x36, 0xf8, 0x35,
-	0xb2, 0xc1, 0xa7, 0xb1, 0x5d, 0x3a, 0x1f, 0xdb, 0xa5, 0x1f, 0x63, 0xbb, 0xb4, 0xff, 0xd8, 0x0f,
-	0xd4, 0xdb, 0x61, 0x37, 0xad, 0xc9, 0x3c, 0x91, 0xa3, 0x2d, 0x72, 0x5c, 0x54, 0x4a, 0x2b, 0x2f,
-	0xbb, 0x35, 0xfd, 0xcd, 0xd8, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xbb, 0xe9, 0x96, 0x77,
-	0x05, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0x16, 0xbe, 0xe5, 0xdb, 0x47, 0x82, 0xc9, 0x48, 0x4c, 0xb3, 0xd4, 0x82, 0x4d, 0x54,
+	0xf0, 0xc7, 0x4e, 0x5a, 0x44, 0x13, 0x0d, 0x10, 0x48, 0xb4, 0x31, 0xf1, 0x50, 0x56, 0xbd, 0x70,
+	0xb0, 0x99, 0x76, 0x27, 0xcb, 0x86, 0x76, 0x67, 0xd9, 0xd9, 0x36, 0x54, 0xc2, 0xc5, 0x93, 0x47,
+	0x13, 0xff, 0x0b, 0x0f, 0x9e, 0x8d, 0x77, 0x13, 0x12, 0x0f, 0x92, 0x78, 0xd0, 0x93, 0x31, 0xe0,
+	0x1f, 0x62, 0x76, 0x66, 0xba, 0x74, 0xa1, 0xd8, 0x85, 0x70, 0xdb, 0xd9, 0x79, 0x9f, 0xf7, 0xde,
+	0xe7, 0x7d, 0xde, 0x7b, 0x03, 0x77, 0xad, 0x6e, 0x8b, 0xba, 0xdc, 0x61, 0xee, 0x76, 0xf7, 0x35,
+	0x8e, 0x0e, 0xd8, 0xa2, 0x4d, 0xd2, 0xa5, 0x16, 0x69, 0x6c, 0xe2, 0xad, 0x36, 0xf5, 0xbb, 0x86,
+	0xe7, 0xb3, 0x80, 0xa1, 0x6b, 0xfd, 0xe6, 0x46, 0x74, 0x30, 0x8e, 0xcc, 0xf5, 0x49, 0x9b, 0xd9,
+	0x4c, 0x58, 0xe3, 0xf0, 0x4b, 0x02, 0xf5, 0xbc, 0xcd, 0x98, 0xdd, 0xa4, 0x98, 0x78, 0x0e, 0x26,
+	0xae, 0xcb, 0x02, 0x12, 0x38, 0xcc, 0xe5, 0xea, 0xf6, 0x56, 0x83, 0xf1, 0x16, 0xe3, 0xb8, 0x4e,
+	0x38, 0x95, 0xf1, 0x70, 0xa7, 0x54, 0xa7, 0x01, 0x29, 0x61, 0x8f, 0xd8, 0x8e, 0x2b, 0x8c, 0x95,
+	0xad, 0x31, 0x3c, 0x63, 0x8f, 0xf8, 0xa4, 0x15, 0xf9, 0x3e, 0xc5, 0xbe, 0xc1, 0x5a, 0x2d, 0xe6,
+	0x62, 0x1e, 0x90, 0xa0, 0xdd, 0xb3, 0x2d, 0xff, 0xdb, 0xd6, 0x67, 0xcd, 0x26, 0xf1, 0xbc, 0x9a,
+	0x47, 0x1a, 0x9b, 0x34, 0x50, 0x18, 0x3c, 0x3c, 0x1f, 0xe2, 0x37, 0x36, 0x9c, 0x0e, 0x95, 0x80,
+	0xe2, 0x24, 0xa0, 0xb5, 0x90, 0x62, 0x55, 0x64, 0x69, 0xd2, 0xad, 0x36, 0xe5, 0x41, 0xf1, 0x15,
+	0x5c, 0x8e, 0xfd, 0xe5, 0x1e, 0x73, 0x39, 0x45, 0x15, 0xc8, 0x48, 0x36, 0x39, 0x6d, 0x46, 0x9b,
+	0x1d, 0x2f, 0xcf, 0x19, 0x43, 0x15, 0x30, 0xa4, 0x8b, 0xd5, 0xd1, 0xbd, 0x5f, 0xd3, 0x29, 0x53,
+	0xc1, 0x8b, 0x6f, 0xd3, 0xa0, 0x8b, 0x00, 0xa6, 0x24, 0x51, 0x15, 0x1c, 0x7a, 0xe1, 0x51, 0x1e,
+	0xb2, 0x8a, 0xdd, 0x53, 0x4b, 0x84, 0xca, 0x9a, 0x47, 0x3f, 0xd0, 0x22, 0x64, 0x64, 0x9d, 0x72,
+	0xe9, 0x19, 0x6d, 0x76, 0xa2, 0x7c, 0xfd, 0xb4, 0x2c, 0x64, 0xa1, 0x8c, 0xe7, 0xc2, 0xd8, 0x54,
+	0x20, 0xf4, 0x18, 0x46, 0x83, 0xae, 0x47, 0x73, 0x23, 0x02, 0x5c, 0x1a, 0x02, 0x8e, 0x25, 0x68,
+	0xbc, 0xe8, 0x7a, 0xd4, 0x14, 0x70, 0xf4, 0x04, 0xe0, 0xa8, 0x1b, 0x72, 0xa3, 0xa2, 0x1e, 0x37,
+	0x0c, 0xd9, 0x3a, 0x46, 0xd8, 0x3a, 0x86, 0x6c, 0x55, 0xd5, 0x3a, 0x46, 0x95, 0xd8, 0x54, 0xf1,
+	0x33, 0xfb, 0x90, 0xc5, 0x2f, 0x1a, 0x14, 0x4e, 0x96, 0xe2, 0x99, 0xc3, 0x83, 0xa8, 0xec, 0xeb,
+	0x30, 0xe1, 0xf7, 0x5f, 0x86, 0xe5, 0x1f, 0x99, 0x1d, 0x2f, 0xdf, 0x39, 0x4b, 0xee, 0x4a, 0x81,
+	0x63, 0x9e, 0x50, 0x25, 0x46, 0x23, 0x2d, 0x68, 0xdc, 0x1c, 0x4a, 0x43, 0x26, 0x16, 0xe3, 0xf1,
+	0x49, 0x83, 0x29, 0xc1, 0x63, 0x45, 0xf6, 0x97, 0x75, 0x4c, 0xd3, 0x2b, 0x90, 0xe1, 0xd4, 0xb5,
+	0xa8, 0xaf, 0x04, 0x55, 0x27, 0xa4, 0xc3, 0xff, 0x3e, 0x6d, 0x50, 0xa7, 0x43, 0x7d, 0x11, 0x3e,
+	0x6b, 0x46, 0x67, 0x74, 0x35, 0x4c, 0x2e, 0xf4, 0x52, 0x6b, 0x3b, 0x96, 0x10, 0x2c, 0x6b, 0x66,
+	0xe5, 0x9f, 0x97, 0x8e, 0x75, 0x61, 0x12, 0x7c, 0xd6, 0x20, 0x3f, 0x38, 0x75, 0x25, 0xc0, 0x1a,
+	0x8c, 0x79, 0xb1, 0xca, 0x97, 0x12, 0x34, 0x7e, 0xdc, 0x99, 0x2a, 0xff, 0x98, 0x77, 0xd1, 0x75,
+	0xcf, 0xab, 0x49, 0xaa, 0x92, 0x36, 0xa7, 0x96, 0x92, 0x3c, 0x1a, 0xe4, 0x25, 0x98, 0x1a, 0x78,
+	0xab, 0x88, 0x4d, 0xc3, 0x78, 0x6f, 0x8d, 0x38, 0x96, 0x24, 0x97, 0x35, 0x21, 0x1a, 0x35, 0x5e,
+	0xfe, 0x98, 0x81, 0xff, 0x84, 0x03, 0xf4, 0x41, 0x83, 0x8c, 0x9c, 0x65, 0xb4, 0x90, 0x80, 0xfd,
+	0xc9, 0xa5, 0xa2, 0xdf, 0x3f, 0x2b, 0x4c, 0x26, 0x59, 0x2c, 0xbd, 0xf9, 0xfe, 0xe7, 0x7d, 0xfa,
+	0x36, 0x9a, 0xc3, 0x49, 0x97, 0x2d, 0xfa, 0xa1, 0x01, 0x54, 0x68, 0xd0, 0x6b, 0xf2, 0xc5, 0xa4,
+	0x91, 0x07, 0xae, 0x23, 0x7d, 0xe5, 0x5c, 0xf0, 0xfe, 0x11, 0x2e, 0x56, 0x04, 0x87, 0x15, 0xb4,
+	0x9c, 0x88, 0x83, 0x88, 0x8e, 0x77, 0x22, 0x1d, 0x76, 0xf1, 0x8e, 0x5c, 0x5e, 0xbb, 0xe8, 0x9b,
+	0x06, 0x97, 0x8e, 0xb5, 0x29, 0x5a, 0x4a, 0x9a, 0xdf, 0xe0, 0xd1, 0xd4, 0x97, 0xcf, 0x8d, 0x57,
+	0xec, 0x1e, 0x09, 0x76, 0x0b, 0x68, 0x3e, 0xf9, 0xf3, 0x63, 0xd5, 0x7a, 0x93, 0xf0, 0x55, 0x83,
+	0x89, 0x78, 0x7b, 0x26, 0xd7, 0x6b, 0x60, 0xd3, 0xeb, 0x4b, 0xe7, 0x85, 0x2b, 0x3a, 0x0f, 0x05,
+	0x9d, 0x7b, 0xa8, 0x9c, 0x48, 0xac, 0xd0, 0x45, 0x4d, 0x49, 0xc5, 0x57, 0xd7, 0xf6, 0x0e, 0x0a,
+	0xda, 0xfe, 0x41, 0x41, 0xfb, 0x7d, 0x50, 0xd0, 0xde, 0x1d, 0x16, 0x52, 0xfb, 0x87, 0x85, 0xd4,
+	0xcf, 0xc3, 0x42, 0x6a, 0xfd, 0x81, 0xed, 0x04, 0x1b, 0xed, 0x7a, 0xb8, 0x9c, 0x4f, 0xf3, 0xdb,
+	0x99, 0xc7, 0xdb, 0xfd, 0xce, 0xc3, 0x87, 0x86, 0xd7, 0x33, 0xe2, 0xa5, 0x9e, 0xff, 0x3b, 0x00,
+	0xb6, 0xf1, 0xef, 0x9a, 0x1e, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -300,6 +521,10 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Queries a list of RollappPacket items by rollappID.
 	GetPackets(ctx context.Context, in *QueryRollappPacketsRequest, opts ...grpc.CallOption) (*QueryRollappPacketListResponse, error)
+	// Queries the archived summaries of the deleted rollapp packets by sender, receiver or packet UID.
+	ArchivedPackets(ctx context.Context, in *QueryArchivedPacketsRequest, opts ...grpc.CallOption) (*QueryArchivedPacketsResponse, error)
+	// Queries the rollapps whose packets finalization is paused by governance.
+	PausedRollapps(ctx context.Context, in *QueryPausedRollappsRequest, opts ...grpc.CallOption) (*QueryPausedRollappsResponse, error)
 }
 
 type queryClient struct {
@@ -328,12 +553,34 @@ func (c *queryClient) GetPackets(ctx context.Context, in *QueryRollappPacketsReq
 	return out, nil
 }
 
+func (c *queryClient) ArchivedPackets(ctx context.Context, in *QueryArchivedPacketsRequest, opts ...grpc.CallOption) (*QueryArchivedPacketsResponse, error) {
+	out := new(QueryArchivedPacketsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/ArchivedPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedRollapps(ctx context.Context, in *QueryPausedRollappsRequest, opts ...grpc.CallOption) (*QueryPausedRollappsResponse, error) {
+	out := new(QueryPausedRollappsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/PausedRollapps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Queries a list of RollappPacket items by rollappID.
 	GetPackets(context.Context, *QueryRollappPacketsRequest) (*QueryRollappPacketListResponse, error)
+	// Queries the archived summaries of the deleted rollapp packets by sender, receiver or packet UID.
+	ArchivedPackets(context.Context, *QueryArchivedPacketsRequest) (*QueryArchivedPacketsResponse, error)
+	// Queries the rollapps whose packets finalization is paused by governance.
+	PausedRollapps(context.Context, *QueryPausedRollappsRequest) (*QueryPausedRollappsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -346,6 +593,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) GetPackets(ctx context.Context, req *QueryRollappPacketsRequest) (*QueryRollappPacketListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPackets not implemented")
 }
+func (*UnimplementedQueryServer) ArchivedPackets(ctx context.Context, req *QueryArchivedPacketsRequest) (*QueryArchivedPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedPackets not implemented")
+}
+func (*UnimplementedQueryServer) PausedRollapps(ctx context.Context, req *QueryPausedRollappsRequest) (*QueryPausedRollappsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedRollapps not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -387,6 +640,42 @@ func _Query_GetPackets_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ArchivedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/ArchivedPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedPackets(ctx, req.(*QueryArchivedPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedRollapps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedRollappsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedRollapps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/PausedRollapps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedRollapps(ctx, req.(*QueryPausedRollappsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.delayedack.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -399,6 +688,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPackets",
 			Handler:    _Query_GetPackets_Handler,
 		},
+		{
+			MethodName: "ArchivedPackets",
+			Handler:    _Query_ArchivedPackets_Handler,
+		},
+		{
+			MethodName: "PausedRollapps",
+			Handler:    _Query_PausedRollapps_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/delayedack/query.proto",
@@ -561,72 +858,232 @@ func (m *QueryRollappPacketListResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryArchivedPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryArchivedPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRollappPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryArchivedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if len(m.PacketUid) > 0 {
+		i -= len(m.PacketUid)
+		copy(dAtA[i:], m.PacketUid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PacketUid)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRollappPacketListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryArchivedPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RollappPackets) > 0 {
-		for _, e := range m.RollappPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedRollappsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedRollappsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedRollappsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedRollappsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedRollappsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedRollappsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappIds) > 0 {
+		for iNdEx := len(m.RollappIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RollappIds[iNdEx])
+			copy(dAtA[i:], m.RollappIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRollappPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappPacketListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RollappPackets) > 0 {
+		for _, e := range m.RollappPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -634,6 +1091,74 @@ func (m *QueryRollappPacketListResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryArchivedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PacketUid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausedRollappsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedRollappsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RollappIds) > 0 {
+		for _, s := range m.RollappIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -650,25 +1175,264 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -690,7 +1454,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -713,15 +1477,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRollappPacketListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRollappPacketListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappPackets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -748,7 +1512,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RollappPackets = append(m.RollappPackets, types.RollappPacket{})
+			if err := m.RollappPackets[len(m.RollappPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -773,7 +1574,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryArchivedPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -796,15 +1597,15 @@ func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArchivedPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArchivedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -832,13 +1633,13 @@ func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -848,16 +1649,29 @@ func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= types.Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketUid", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -867,11 +1681,24 @@ func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -929,7 +1756,7 @@ func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryArchivedPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -952,15 +1779,15 @@ func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappPacketListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArchivedPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappPacketListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArchivedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -987,8 +1814,8 @@ func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappPackets = append(m.RollappPackets, types.RollappPacket{})
-			if err := m.RollappPackets[len(m.RollappPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packets = append(m.Packets, ArchivedPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1049,6 +1876,138 @@ func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPausedRollappsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedRollappsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedRollappsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedRollappsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedRollappsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedRollappsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappIds = append(m.RollappIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
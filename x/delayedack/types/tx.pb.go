@@ -361,6 +361,188 @@ func (m *MsgFinalizeRollappPacketsByReceiverResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFinalizeRollappPacketsByReceiverResponse proto.InternalMessageInfo
 
+// MsgPauseRollappFinalization suspends the finalization of the rollapp packets, while an incident is investigated.
+type MsgPauseRollappFinalization struct {
+	// Authority is the authority address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// RollappID is the ID of the rollapp.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *MsgPauseRollappFinalization) Reset()         { *m = MsgPauseRollappFinalization{} }
+func (m *MsgPauseRollappFinalization) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseRollappFinalization) ProtoMessage()    {}
+func (*MsgPauseRollappFinalization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{6}
+}
+func (m *MsgPauseRollappFinalization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseRollappFinalization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseRollappFinalization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseRollappFinalization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseRollappFinalization.Merge(m, src)
+}
+func (m *MsgPauseRollappFinalization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseRollappFinalization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseRollappFinalization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseRollappFinalization proto.InternalMessageInfo
+
+func (m *MsgPauseRollappFinalization) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgPauseRollappFinalization) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type MsgPauseRollappFinalizationResponse struct {
+}
+
+func (m *MsgPauseRollappFinalizationResponse) Reset()         { *m = MsgPauseRollappFinalizationResponse{} }
+func (m *MsgPauseRollappFinalizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseRollappFinalizationResponse) ProtoMessage()    {}
+func (*MsgPauseRollappFinalizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{7}
+}
+func (m *MsgPauseRollappFinalizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseRollappFinalizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseRollappFinalizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseRollappFinalizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseRollappFinalizationResponse.Merge(m, src)
+}
+func (m *MsgPauseRollappFinalizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseRollappFinalizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseRollappFinalizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseRollappFinalizationResponse proto.InternalMessageInfo
+
+// MsgResumeRollappFinalization resumes the finalization of the rollapp packets.
+type MsgResumeRollappFinalization struct {
+	// Authority is the authority address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// RollappID is the ID of the rollapp.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *MsgResumeRollappFinalization) Reset()         { *m = MsgResumeRollappFinalization{} }
+func (m *MsgResumeRollappFinalization) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeRollappFinalization) ProtoMessage()    {}
+func (*MsgResumeRollappFinalization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{8}
+}
+func (m *MsgResumeRollappFinalization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeRollappFinalization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeRollappFinalization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeRollappFinalization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeRollappFinalization.Merge(m, src)
+}
+func (m *MsgResumeRollappFinalization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeRollappFinalization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeRollappFinalization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeRollappFinalization proto.InternalMessageInfo
+
+func (m *MsgResumeRollappFinalization) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResumeRollappFinalization) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type MsgResumeRollappFinalizationResponse struct {
+}
+
+func (m *MsgResumeRollappFinalizationResponse) Reset()         { *m = MsgResumeRollappFinalizationResponse{} }
+func (m *MsgResumeRollappFinalizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeRollappFinalizationResponse) ProtoMessage()    {}
+func (*MsgResumeRollappFinalizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{9}
+}
+func (m *MsgResumeRollappFinalizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeRollappFinalizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeRollappFinalizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeRollappFinalizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeRollappFinalizationResponse.Merge(m, src)
+}
+func (m *MsgResumeRollappFinalizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeRollappFinalizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeRollappFinalizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeRollappFinalizationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgFinalizePacket)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacket")
 	proto.RegisterType((*MsgFinalizePacketResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketResponse")
@@ -368,6 +550,10 @@ func init() {
 	proto.RegisterType((*MsgFinalizePacketsUntilHeightResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketsUntilHeightResponse")
 	proto.RegisterType((*MsgFinalizeRollappPacketsByReceiver)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizeRollappPacketsByReceiver")
 	proto.RegisterType((*MsgFinalizeRollappPacketsByReceiverResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizeRollappPacketsByReceiverResponse")
+	proto.RegisterType((*MsgPauseRollappFinalization)(nil), "dymensionxyz.dymension.delayedack.MsgPauseRollappFinalization")
+	proto.RegisterType((*MsgPauseRollappFinalizationResponse)(nil), "dymensionxyz.dymension.delayedack.MsgPauseRollappFinalizationResponse")
+	proto.RegisterType((*MsgResumeRollappFinalization)(nil), "dymensionxyz.dymension.delayedack.MsgResumeRollappFinalization")
+	proto.RegisterType((*MsgResumeRollappFinalizationResponse)(nil), "dymensionxyz.dymension.delayedack.MsgResumeRollappFinalizationResponse")
 }
 
 func init() {
@@ -375,41 +561,47 @@ func init() {
 }
 
 var fileDescriptor_604a74c1ca57f5ed = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x4d, 0x1b, 0x91, 0x57, 0x29, 0xd0, 0x43, 0x2a, 0xc6, 0xa8, 0x56, 0x08, 0x42,
-	0x8d, 0x02, 0xd8, 0x6a, 0x8a, 0x84, 0x84, 0x18, 0x50, 0x91, 0xaa, 0x32, 0x14, 0x15, 0x03, 0x0b,
-	0x4b, 0xe4, 0xda, 0x8f, 0xc4, 0xaa, 0x73, 0x67, 0x7c, 0x6e, 0x14, 0x97, 0x05, 0x21, 0xd8, 0xf9,
-	0x18, 0x8c, 0x15, 0x23, 0x9f, 0x80, 0xb1, 0x23, 0x23, 0x4a, 0x86, 0x7e, 0x08, 0x16, 0x14, 0xdf,
-	0xc5, 0xc4, 0x44, 0x69, 0x21, 0x30, 0xd9, 0xef, 0xde, 0xbb, 0xff, 0xfb, 0xdd, 0xff, 0xec, 0x07,
-	0x0d, 0x2f, 0xe9, 0x22, 0x13, 0x3e, 0x67, 0xfd, 0xe4, 0xc8, 0xca, 0x02, 0xcb, 0xc3, 0xc0, 0x49,
-	0xd0, 0x73, 0xdc, 0x03, 0x2b, 0xee, 0x9b, 0x61, 0xc4, 0x63, 0x4e, 0xaf, 0x4f, 0xd6, 0x9a, 0x59,
-	0x60, 0xfe, 0xaa, 0xd5, 0xaf, 0xb8, 0x5c, 0x74, 0xb9, 0xb0, 0xba, 0xa2, 0x6d, 0xf5, 0x36, 0x46,
-	0x0f, 0xb9, 0x57, 0x6f, 0xce, 0xe8, 0xe3, 0xf2, 0x6e, 0x97, 0x33, 0x2b, 0xe2, 0x41, 0xe0, 0x84,
-	0x61, 0x2b, 0x74, 0xdc, 0x03, 0x8c, 0xe5, 0x9e, 0xda, 0xe7, 0x05, 0x58, 0xd9, 0x15, 0xed, 0x6d,
-	0x9f, 0x39, 0x81, 0x7f, 0x84, 0x7b, 0x69, 0x8e, 0xae, 0x42, 0x49, 0x20, 0xf3, 0x30, 0xd2, 0x48,
-	0x95, 0xd4, 0xcb, 0xb6, 0x8a, 0xe8, 0x1a, 0xc0, 0x58, 0xc5, 0xf7, 0xb4, 0x85, 0x34, 0x57, 0x56,
-	0x2b, 0x8f, 0x3d, 0x6a, 0xc2, 0x65, 0x29, 0xde, 0x0a, 0x23, 0xce, 0x5f, 0xb5, 0x3a, 0xe8, 0xb7,
-	0x3b, 0xb1, 0x56, 0xac, 0x92, 0xfa, 0xa2, 0xbd, 0x22, 0x53, 0x7b, 0xa3, 0xcc, 0x4e, 0x9a, 0xa0,
-	0x36, 0x2c, 0xab, 0xfa, 0x38, 0x09, 0x51, 0x5b, 0xac, 0x92, 0x7a, 0xa5, 0xb9, 0x61, 0xce, 0xb0,
-	0x40, 0x1e, 0xc3, 0xb4, 0x65, 0x3b, 0x49, 0x6a, 0x3e, 0x4f, 0x42, 0xb4, 0x41, 0xaa, 0x8c, 0xde,
-	0xe9, 0x6d, 0xa0, 0x4a, 0x53, 0x44, 0x6e, 0xcb, 0xed, 0x38, 0x8c, 0x61, 0xa0, 0x2d, 0xa5, 0xa8,
-	0x97, 0x64, 0xe6, 0x59, 0xe4, 0x3e, 0x92, 0xeb, 0x74, 0x1d, 0x2e, 0x8e, 0xab, 0xf1, 0xf5, 0x21,
-	0x32, 0x17, 0xb5, 0x52, 0x4a, 0x5b, 0x51, 0xa5, 0x6a, 0xf5, 0xfe, 0xf2, 0xbb, 0xd3, 0xe3, 0x86,
-	0xb2, 0xa1, 0x76, 0x0d, 0xae, 0x4e, 0x79, 0x66, 0xa3, 0x08, 0x39, 0x13, 0x58, 0x7b, 0x03, 0x6b,
-	0x53, 0x49, 0xf1, 0x82, 0xc5, 0x7e, 0xa0, 0x4e, 0x3d, 0xa7, 0xb9, 0xab, 0x50, 0xca, 0xf9, 0xa9,
-	0xa2, 0x3c, 0xd9, 0x3a, 0xdc, 0x3c, 0xb3, 0x79, 0x46, 0xf9, 0x81, 0xc0, 0x8d, 0x89, 0xca, 0x9c,
-	0xa9, 0x62, 0x2b, 0xb1, 0xd1, 0x45, 0xbf, 0x87, 0xd1, 0xbc, 0xb0, 0x3a, 0x5c, 0x88, 0x94, 0x44,
-	0x8a, 0x5b, 0xb6, 0xb3, 0x38, 0x0f, 0x7c, 0x07, 0x6e, 0xfd, 0x01, 0xc6, 0x18, 0xbb, 0xf9, 0xa3,
-	0x08, 0xc5, 0x5d, 0xd1, 0xa6, 0xef, 0x09, 0x54, 0x7e, 0xfb, 0x66, 0xef, 0x9a, 0xe7, 0xfe, 0x3a,
-	0xe6, 0x94, 0x37, 0xfa, 0x83, 0x79, 0x76, 0x8d, 0x71, 0xe8, 0x27, 0x02, 0xfa, 0x19, 0x37, 0xfd,
-	0x70, 0x1e, 0xf1, 0x49, 0x05, 0x7d, 0xe7, 0x5f, 0x15, 0x32, 0xd4, 0x2f, 0x04, 0xaa, 0xe7, 0xde,
-	0xf6, 0xf6, 0xdf, 0xb5, 0x9b, 0xa5, 0xa3, 0x3f, 0xf9, 0x3f, 0x3a, 0x63, 0x78, 0x7d, 0xe9, 0xed,
-	0xe9, 0x71, 0x83, 0x6c, 0x3d, 0xfd, 0x3a, 0x30, 0xc8, 0xc9, 0xc0, 0x20, 0xdf, 0x07, 0x06, 0xf9,
-	0x38, 0x34, 0x0a, 0x27, 0x43, 0xa3, 0xf0, 0x6d, 0x68, 0x14, 0x5e, 0xde, 0x6b, 0xfb, 0x71, 0xe7,
-	0x70, 0x7f, 0x34, 0x23, 0xac, 0x19, 0x53, 0xb0, 0xb7, 0x69, 0xf5, 0x73, 0x23, 0x37, 0x09, 0x51,
-	0xec, 0x97, 0xd2, 0x31, 0xb8, 0xf9, 0x33, 0x00, 0x00, 0xff, 0xff, 0x16, 0xed, 0x0f, 0x08, 0xa4,
-	0x05, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc6, 0xbb, 0xfd, 0x13, 0xbd, 0x99, 0x4a, 0x79, 0xa9, 0x91, 0x8a, 0xeb, 0xb6, 0x56, 0x48,
+	0x29, 0xad, 0x0a, 0xd8, 0x6a, 0x8b, 0x84, 0x54, 0x21, 0x40, 0x45, 0x0a, 0xe5, 0x10, 0x14, 0x0c,
+	0x5c, 0xb8, 0x44, 0xae, 0xbd, 0x38, 0x26, 0xb6, 0xd7, 0x78, 0x9d, 0x28, 0x2e, 0x17, 0x84, 0xe0,
+	0xce, 0x67, 0x40, 0x42, 0xe2, 0x58, 0x71, 0xe4, 0x13, 0xf4, 0xd8, 0x23, 0x47, 0x94, 0x1c, 0xfa,
+	0x35, 0x50, 0xec, 0xb5, 0x93, 0x10, 0x39, 0x09, 0x09, 0x9c, 0x92, 0xd9, 0x99, 0x79, 0x9e, 0xdf,
+	0x8e, 0x32, 0x59, 0xd8, 0xd1, 0x03, 0x1b, 0x3b, 0xd4, 0x24, 0x4e, 0x33, 0x38, 0x91, 0x93, 0x40,
+	0xd6, 0xb1, 0xa5, 0x06, 0x58, 0x57, 0xb5, 0x9a, 0xec, 0x37, 0x25, 0xd7, 0x23, 0x3e, 0xe1, 0xae,
+	0xf6, 0xd6, 0x4a, 0x49, 0x20, 0x75, 0x6b, 0x85, 0x2b, 0x1a, 0xa1, 0x36, 0xa1, 0xb2, 0x4d, 0x0d,
+	0xb9, 0xb1, 0xdb, 0xf9, 0x88, 0x7a, 0x85, 0xbd, 0x14, 0x1f, 0x8d, 0xd8, 0x36, 0x71, 0x64, 0x8f,
+	0x58, 0x96, 0xea, 0xba, 0x15, 0x57, 0xd5, 0x6a, 0xd8, 0x8f, 0x7a, 0x0a, 0xdf, 0x66, 0x61, 0xa9,
+	0x44, 0x8d, 0xa2, 0xe9, 0xa8, 0x96, 0x79, 0x82, 0xcb, 0x61, 0x8e, 0x5b, 0x86, 0x0c, 0xc5, 0x8e,
+	0x8e, 0x3d, 0x1e, 0xe5, 0xd1, 0x76, 0x56, 0x61, 0x11, 0xb7, 0x0e, 0x10, 0xab, 0x98, 0x3a, 0x3f,
+	0x1b, 0xe6, 0xb2, 0xec, 0xe4, 0xb1, 0xce, 0x49, 0x70, 0x39, 0x12, 0xaf, 0xb8, 0x1e, 0x21, 0xaf,
+	0x2a, 0x55, 0x6c, 0x1a, 0x55, 0x9f, 0x9f, 0xcb, 0xa3, 0xed, 0x79, 0x65, 0x29, 0x4a, 0x95, 0x3b,
+	0x99, 0xa3, 0x30, 0xc1, 0x29, 0xb0, 0xc8, 0xea, 0xfd, 0xc0, 0xc5, 0xfc, 0x7c, 0x1e, 0x6d, 0xe7,
+	0xf6, 0x76, 0xa5, 0x94, 0x11, 0x44, 0xd7, 0x90, 0x94, 0xc8, 0x2e, 0x22, 0x95, 0x9e, 0x07, 0x2e,
+	0x56, 0x20, 0x52, 0xe9, 0x7c, 0xe7, 0x6e, 0x02, 0xc7, 0x34, 0xa9, 0xa7, 0x55, 0xb4, 0xaa, 0xea,
+	0x38, 0xd8, 0xe2, 0x17, 0x42, 0xd4, 0x4b, 0x51, 0xe6, 0x99, 0xa7, 0x3d, 0x8c, 0xce, 0xb9, 0x2d,
+	0xf8, 0x3f, 0xae, 0xc6, 0x6f, 0xea, 0xd8, 0xd1, 0x30, 0x9f, 0x09, 0x69, 0x73, 0xac, 0x94, 0x9d,
+	0x1e, 0x2c, 0xbe, 0xbf, 0x38, 0xdd, 0x61, 0x63, 0x28, 0xac, 0xc2, 0xca, 0xc0, 0xcc, 0x14, 0x4c,
+	0x5d, 0xe2, 0x50, 0x5c, 0x78, 0x0b, 0xeb, 0x03, 0x49, 0xfa, 0xc2, 0xf1, 0x4d, 0x8b, 0xdd, 0x7a,
+	0xc2, 0xe1, 0x2e, 0x43, 0xa6, 0x6f, 0x9e, 0x2c, 0xea, 0x27, 0xdb, 0x82, 0xcd, 0xa1, 0xe6, 0x09,
+	0xe5, 0x47, 0x04, 0x1b, 0x3d, 0x95, 0x7d, 0x43, 0xa5, 0x87, 0x81, 0x82, 0x35, 0x6c, 0x36, 0xb0,
+	0x37, 0x29, 0xac, 0x00, 0xff, 0x79, 0x4c, 0x22, 0xc4, 0xcd, 0x2a, 0x49, 0xdc, 0x0f, 0x7c, 0x0b,
+	0x6e, 0x8c, 0x81, 0x91, 0x60, 0xbf, 0x86, 0xd5, 0x12, 0x35, 0xca, 0x6a, 0x9d, 0xc6, 0xb5, 0xac,
+	0x55, 0xf5, 0x4d, 0xe2, 0x70, 0x6b, 0x90, 0x55, 0xeb, 0x7e, 0x95, 0x78, 0xa6, 0x1f, 0x30, 0xe0,
+	0xee, 0xc1, 0x08, 0xe6, 0x83, 0x5c, 0x87, 0xab, 0x5b, 0x5e, 0xd8, 0x84, 0x8d, 0x21, 0x5e, 0x09,
+	0x52, 0x0d, 0xd6, 0x4a, 0xd4, 0x50, 0x30, 0xad, 0xdb, 0xff, 0x9e, 0xe9, 0x3a, 0x5c, 0x1b, 0x66,
+	0x16, 0x43, 0xed, 0x9d, 0x65, 0x60, 0xae, 0x44, 0x0d, 0xee, 0x03, 0x82, 0xdc, 0x6f, 0xbb, 0x7d,
+	0x5b, 0x1a, 0xf9, 0x17, 0x23, 0x0d, 0xfc, 0x86, 0x84, 0xbb, 0x93, 0x74, 0xc5, 0x38, 0xdc, 0x57,
+	0x04, 0xc2, 0x90, 0x8d, 0x78, 0x30, 0x89, 0x78, 0xaf, 0x82, 0x70, 0x34, 0xad, 0x42, 0x82, 0xfa,
+	0x1d, 0x41, 0x7e, 0xe4, 0x56, 0x14, 0xff, 0xcc, 0x2e, 0x4d, 0x47, 0x78, 0xf2, 0x77, 0x74, 0x12,
+	0xf8, 0xcf, 0x08, 0xf8, 0xd4, 0xe5, 0xb8, 0x37, 0x9e, 0x59, 0x5a, 0xbf, 0x50, 0x9c, 0xae, 0x3f,
+	0x81, 0xfc, 0x82, 0x60, 0x25, 0x7d, 0x5d, 0xee, 0x8f, 0xe7, 0x92, 0x2a, 0x20, 0x3c, 0x9a, 0x52,
+	0x20, 0xe6, 0x14, 0x16, 0xde, 0x5d, 0x9c, 0xee, 0xa0, 0xc3, 0xa7, 0x67, 0x2d, 0x11, 0x9d, 0xb7,
+	0x44, 0xf4, 0xb3, 0x25, 0xa2, 0x4f, 0x6d, 0x71, 0xe6, 0xbc, 0x2d, 0xce, 0xfc, 0x68, 0x8b, 0x33,
+	0x2f, 0xef, 0x18, 0xa6, 0x5f, 0xad, 0x1f, 0x77, 0x1e, 0x26, 0x39, 0xe5, 0xe9, 0x6d, 0xec, 0xcb,
+	0xcd, 0xbe, 0x77, 0x3e, 0x70, 0x31, 0x3d, 0xce, 0x84, 0x6f, 0xef, 0xfe, 0xaf, 0x01, 0x00, 0x68,
+	0x1d, 0x6f, 0x84, 0x19, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -431,6 +623,10 @@ type MsgClient interface {
 	// FinalizeRollappPacketsByReceiver finalizes the rollapp packets for the specified receiver until the latest
 	// finalized height inclusively.
 	FinalizeRollappPacketsByReceiver(ctx context.Context, in *MsgFinalizeRollappPacketsByReceiver, opts ...grpc.CallOption) (*MsgFinalizeRollappPacketsByReceiverResponse, error)
+	// PauseRollappFinalization suspends the finalization of the rollapp packets. Only the gov module can do it.
+	PauseRollappFinalization(ctx context.Context, in *MsgPauseRollappFinalization, opts ...grpc.CallOption) (*MsgPauseRollappFinalizationResponse, error)
+	// ResumeRollappFinalization resumes the finalization of the rollapp packets. Only the gov module can do it.
+	ResumeRollappFinalization(ctx context.Context, in *MsgResumeRollappFinalization, opts ...grpc.CallOption) (*MsgResumeRollappFinalizationResponse, error)
 }
 
 type msgClient struct {
@@ -468,6 +664,24 @@ func (c *msgClient) FinalizeRollappPacketsByReceiver(ctx context.Context, in *Ms
 	return out, nil
 }
 
+func (c *msgClient) PauseRollappFinalization(ctx context.Context, in *MsgPauseRollappFinalization, opts ...grpc.CallOption) (*MsgPauseRollappFinalizationResponse, error) {
+	out := new(MsgPauseRollappFinalizationResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Msg/PauseRollappFinalization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeRollappFinalization(ctx context.Context, in *MsgResumeRollappFinalization, opts ...grpc.CallOption) (*MsgResumeRollappFinalizationResponse, error) {
+	out := new(MsgResumeRollappFinalizationResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Msg/ResumeRollappFinalization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// FinalizePacket finalizes a singe packet.
@@ -477,6 +691,10 @@ type MsgServer interface {
 	// FinalizeRollappPacketsByReceiver finalizes the rollapp packets for the specified receiver until the latest
 	// finalized height inclusively.
 	FinalizeRollappPacketsByReceiver(context.Context, *MsgFinalizeRollappPacketsByReceiver) (*MsgFinalizeRollappPacketsByReceiverResponse, error)
+	// PauseRollappFinalization suspends the finalization of the rollapp packets. Only the gov module can do it.
+	PauseRollappFinalization(context.Context, *MsgPauseRollappFinalization) (*MsgPauseRollappFinalizationResponse, error)
+	// ResumeRollappFinalization resumes the finalization of the rollapp packets. Only the gov module can do it.
+	ResumeRollappFinalization(context.Context, *MsgResumeRollappFinalization) (*MsgResumeRollappFinalizationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -492,6 +710,12 @@ func (*UnimplementedMsgServer) FinalizePacketsUntilHeight(ctx context.Context, r
 func (*UnimplementedMsgServer) FinalizeRollappPacketsByReceiver(ctx context.Context, req *MsgFinalizeRollappPacketsByReceiver) (*MsgFinalizeRollappPacketsByReceiverResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeRollappPacketsByReceiver not implemented")
 }
+func (*UnimplementedMsgServer) PauseRollappFinalization(ctx context.Context, req *MsgPauseRollappFinalization) (*MsgPauseRollappFinalizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRollappFinalization not implemented")
+}
+func (*UnimplementedMsgServer) ResumeRollappFinalization(ctx context.Context, req *MsgResumeRollappFinalization) (*MsgResumeRollappFinalizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRollappFinalization not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -551,6 +775,42 @@ func _Msg_FinalizeRollappPacketsByReceiver_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PauseRollappFinalization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseRollappFinalization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseRollappFinalization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Msg/PauseRollappFinalization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseRollappFinalization(ctx, req.(*MsgPauseRollappFinalization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeRollappFinalization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeRollappFinalization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeRollappFinalization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Msg/ResumeRollappFinalization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeRollappFinalization(ctx, req.(*MsgResumeRollappFinalization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.delayedack.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -567,6 +827,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizeRollappPacketsByReceiver",
 			Handler:    _Msg_FinalizeRollappPacketsByReceiver_Handler,
 		},
+		{
+			MethodName: "PauseRollappFinalization",
+			Handler:    _Msg_PauseRollappFinalization_Handler,
+		},
+		{
+			MethodName: "ResumeRollappFinalization",
+			Handler:    _Msg_ResumeRollappFinalization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/delayedack/tx.proto",
@@ -786,6 +1054,126 @@ func (m *MsgFinalizeRollappPacketsByReceiverResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPauseRollappFinalization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseRollappFinalization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseRollappFinalization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseRollappFinalizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseRollappFinalizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseRollappFinalizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeRollappFinalization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeRollappFinalization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeRollappFinalization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeRollappFinalizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeRollappFinalizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeRollappFinalizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -883,25 +1271,330 @@ func (m *MsgFinalizeRollappPacketsByReceiver) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgFinalizeRollappPacketsByReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseRollappFinalization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseRollappFinalizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeRollappFinalization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeRollappFinalizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizePacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizePacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketProofHeight", wireType)
+			}
+			m.PacketProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			m.PacketType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketType |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizePacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizePacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizePacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgFinalizeRollappPacketsByReceiverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizePacketsUntilHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -924,10 +1617,10 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizePacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizePacketsUntilHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizePacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizePacketsUntilHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -996,9 +1689,9 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.PacketProofHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1008,16 +1701,116 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PacketProofHeight |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PacketType = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizePacketsUntilHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizePacketsUntilHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizePacketsUntilHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeRollappPacketsByReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeRollappPacketsByReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeRollappPacketsByReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1027,14 +1820,27 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PacketType |= types.RollappPacket_Type(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1062,13 +1868,13 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketSrcChannel = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.PacketSequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1078,11 +1884,24 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PacketSequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1104,7 +1923,7 @@ func (m *MsgFinalizePacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizePacketResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFinalizeRollappPacketsByReceiverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1127,10 +1946,10 @@ func (m *MsgFinalizePacketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizePacketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinalizeRollappPacketsByReceiverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizePacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinalizeRollappPacketsByReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1154,7 +1973,7 @@ func (m *MsgFinalizePacketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizePacketsUntilHeight) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseRollappFinalization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1177,15 +1996,15 @@ func (m *MsgFinalizePacketsUntilHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizePacketsUntilHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseRollappFinalization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizePacketsUntilHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseRollappFinalization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1213,7 +2032,7 @@ func (m *MsgFinalizePacketsUntilHeight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1247,25 +2066,6 @@ func (m *MsgFinalizePacketsUntilHeight) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1287,7 +2087,7 @@ func (m *MsgFinalizePacketsUntilHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizePacketsUntilHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseRollappFinalizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1310,10 +2110,10 @@ func (m *MsgFinalizePacketsUntilHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizePacketsUntilHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseRollappFinalizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizePacketsUntilHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseRollappFinalizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1337,7 +2137,7 @@ func (m *MsgFinalizePacketsUntilHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeRollappPacketsByReceiver) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeRollappFinalization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1360,15 +2160,15 @@ func (m *MsgFinalizeRollappPacketsByReceiver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeRollappPacketsByReceiver: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeRollappFinalization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeRollappPacketsByReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeRollappFinalization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1396,7 +2196,7 @@ func (m *MsgFinalizeRollappPacketsByReceiver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1430,38 +2230,6 @@ func (m *MsgFinalizeRollappPacketsByReceiver) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1483,7 +2251,7 @@ func (m *MsgFinalizeRollappPacketsByReceiver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinalizeRollappPacketsByReceiverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeRollappFinalizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1506,10 +2274,10 @@ func (m *MsgFinalizeRollappPacketsByReceiverResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinalizeRollappPacketsByReceiverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeRollappFinalizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinalizeRollappPacketsByReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeRollappFinalizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
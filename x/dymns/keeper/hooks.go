@@ -73,7 +73,11 @@ func (h rollappHooks) AfterStateFinalized(_ sdk.Context, _ string, _ *rollapptyp
 	return nil
 }
 
-func (h rollappHooks) FraudSubmitted(_ sdk.Context, _ string, _ uint64, _ string) error {
+func (h rollappHooks) FraudSubmitted(_ sdk.Context, _ string, _ uint64, _, _ string) error {
+	return nil
+}
+
+func (h rollappHooks) RollappRecovered(_ sdk.Context, _ string) error {
 	return nil
 }
 
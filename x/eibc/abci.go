@@ -0,0 +1,12 @@
+package eibc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
+)
+
+// EndBlocker is called every block to escalate the fees of the demand orders which are due.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.EscalateDemandOrderFees(ctx)
+}
@@ -26,6 +26,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListDemandOrdersByStatus())
+	cmd.AddCommand(CmdListFulfillmentIntents())
+	cmd.AddCommand(CmdQueryRollappRiskLimits())
+	cmd.AddCommand(CmdQueryOrderBookStats())
+	cmd.AddCommand(CmdQueryFeeSubsidy())
+	cmd.AddCommand(CmdListFeeSubsidies())
+	cmd.AddCommand(CmdQueryFeeFloor())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func CmdQueryFeeFloor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-floor [rollapp-id]",
+		Short:   "Show the eIBC fee floor of a rollapp",
+		Long:    `Query the minimal fee percentage of the demand orders of a rollapp and whether it overrides the module param.`,
+		Example: "dymd query eibc fee-floor rollapp_1234-1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeFloor(cmd.Context(), &types.QueryFeeFloorRequest{
+				RollappId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
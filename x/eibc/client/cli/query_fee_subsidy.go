@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func CmdQueryFeeSubsidy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-subsidy [rollapp-id]",
+		Short:   "Show the eIBC fee subsidy pool of a rollapp",
+		Long:    `Query the eIBC fee subsidy pool of a rollapp, its balance, what it has paid out and what it can still pay out in the current epoch.`,
+		Example: "dymd query eibc fee-subsidy rollapp_1234-1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeSubsidy(cmd.Context(), &types.QueryFeeSubsidyRequest{
+				RollappId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListFeeSubsidies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-subsidies",
+		Short:   "List the eIBC fee subsidy pools of all rollapps",
+		Example: "dymd query eibc fee-subsidies",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeSubsidies(cmd.Context(), &types.QueryFeeSubsidiesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func CmdListFulfillmentIntents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-fulfillment-intents",
+		Short: "List the standing fulfillment intents",
+		Long:  `Query the standing fulfillment intents, optionally filtered by fulfiller and rollapp.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			request := &types.QueryFulfillmentIntentsRequest{Pagination: pageReq}
+
+			request.RollappId, err = cmd.Flags().GetString("rollapp")
+			if err != nil {
+				return err
+			}
+
+			request.Fulfiller, err = cmd.Flags().GetString("fulfiller")
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FulfillmentIntents(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringP("rollapp", "r", "", "Rollapp ID")
+	cmd.Flags().StringP("fulfiller", "a", "", "Filter by fulfiller address")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
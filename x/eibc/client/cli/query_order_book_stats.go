@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func CmdQueryOrderBookStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "order-book-stats [rollapp-id]",
+		Short:   "Show statistics of the pending demand orders of a rollapp",
+		Long:    `Query the count, total price, unfulfilled volume, fee percentiles and oldest order age of the pending demand orders of a rollapp per denom.`,
+		Example: "dymd query eibc order-book-stats rollapp_1234-1 --denom adym",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom, err := cmd.Flags().GetString("denom")
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OrderBookStats(cmd.Context(), &types.QueryOrderBookStatsRequest{
+				RollappId: args[0],
+				Denom:     denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringP("denom", "d", "", "Filter by denom")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func CmdQueryRollappRiskLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "risk-limits [rollapp-id]",
+		Short:   "Show the eIBC risk limits of a rollapp",
+		Long:    `Query the eIBC risk limits of a rollapp, the outstanding order value and whether its demand orders are paused.`,
+		Example: "dymd query eibc risk-limits rollapp_1234-1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RollappRiskLimits(cmd.Context(), &types.QueryRollappRiskLimitsRequest{
+				RollappId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
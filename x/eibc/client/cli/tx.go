@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -9,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
@@ -25,7 +29,16 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(NewFulfillOrderTxCmd())
+	cmd.AddCommand(NewFulfillOrdersTxCmd())
 	cmd.AddCommand(NewUpdateDemandOrderTxCmd())
+	cmd.AddCommand(NewFulfillOrderPartialTxCmd())
+	cmd.AddCommand(NewCreateFulfillmentIntentTxCmd())
+	cmd.AddCommand(NewCancelFulfillmentIntentTxCmd())
+	cmd.AddCommand(NewSetRollappRiskLimitsTxCmd())
+	cmd.AddCommand(NewTransferFulfillmentTxCmd())
+	cmd.AddCommand(NewSetFeeSubsidyTxCmd())
+	cmd.AddCommand(NewFundFeeSubsidyTxCmd())
+	cmd.AddCommand(NewWithdrawFeeSubsidyTxCmd())
 
 	return cmd
 }
@@ -65,6 +78,53 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewFulfillOrdersTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fulfill-orders [order-id:expected-fee-amount]...",
+		Short:   "Fulfill a batch of eibc orders",
+		Example: "dymd tx eibc fulfill-orders <order-id>:<expected-fee-amount> <order-id>:<expected-fee-amount> --best-effort",
+		Long: `Fulfill a batch of eibc orders by providing the order IDs with their expected fee amounts.
+		With --best-effort, the orders which can't be fulfilled are skipped instead of failing the transaction.
+		`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			bestEffort, err := cmd.Flags().GetBool("best-effort")
+			if err != nil {
+				return err
+			}
+
+			orders := make([]types.OrderFulfillment, 0, len(args))
+			for _, arg := range args {
+				orderId, fee, ok := strings.Cut(arg, ":")
+				if !ok {
+					return fmt.Errorf("invalid order, expected <order-id>:<expected-fee-amount>: %s", arg)
+				}
+				orders = append(orders, types.NewOrderFulfillment(orderId, fee))
+			}
+
+			msg := types.NewMsgFulfillOrders(
+				clientCtx.GetFromAddress().String(),
+				orders,
+				bestEffort,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool("best-effort", false, "Skip the orders which can't be fulfilled")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewUpdateDemandOrderTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "update-demand-order [order-id] [new-fee-amount]",
@@ -96,3 +156,288 @@ func NewUpdateDemandOrderTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewFulfillOrderPartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fulfill-order-partial [order-id] [expected-fee-amount] [amount]",
+		Short:   "Fulfill a part of an eibc order",
+		Example: "dymd tx eibc fulfill-order-partial <order-id> <expected-fee-amount> <amount>",
+		Long: `Fulfill a part of an eibc order by providing the order ID, the expected fee amount and the amount of the price to pay.
+		Once the order is finalized, the fulfiller receives a share of the funds pro-rata to the amount paid.
+		`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			orderId := args[0]
+			fee := args[1]
+			amount := args[2]
+
+			msg := types.NewMsgFulfillOrderPartial(
+				clientCtx.GetFromAddress().String(),
+				orderId,
+				fee,
+				amount,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateFulfillmentIntentTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-fulfillment-intent [rollapp-id] [denom] [min-fee-percentage] [max-price] [budget]",
+		Short:   "Create a standing intent to fulfill eibc orders",
+		Example: "dymd tx eibc create-fulfillment-intent rollapp_1234-1 adym 0.01 1000000 10000000",
+		Long: `Create a standing intent to fulfill eibc orders of a rollapp and denom.
+		New demand orders with a fee percentage (fee divided by price) of at least min-fee-percentage and a price of
+		at most max-price are fulfilled immediately from the budget, which is escrowed in the module.
+		`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			minFeePercentage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("min fee percentage: %w", err)
+			}
+			maxPrice, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid max price: %s", args[3])
+			}
+			budget, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid budget: %s", args[4])
+			}
+
+			msg := types.NewMsgCreateFulfillmentIntent(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				minFeePercentage,
+				maxPrice,
+				budget,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCancelFulfillmentIntentTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-fulfillment-intent [intent-id]",
+		Short:   "Cancel a fulfillment intent and get back its remaining budget",
+		Example: "dymd tx eibc cancel-fulfillment-intent 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			intentId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("intent id: %w", err)
+			}
+
+			msg := types.NewMsgCancelFulfillmentIntent(
+				clientCtx.GetFromAddress().String(),
+				intentId,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetRollappRiskLimitsTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-rollapp-risk-limits [rollapp-id] [max-outstanding] [max-order-price]",
+		Short:   "Set the eIBC risk limits of a rollapp, as the rollapp owner",
+		Long:    "Set the eIBC risk limits of a rollapp per denom. Pass empty limits to remove them.",
+		Example: `dymd tx eibc set-rollapp-risk-limits rollapp_1234-1 1000000adym 10000adym`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			maxOutstanding, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("max outstanding: %w", err)
+			}
+			maxOrderPrice, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("max order price: %w", err)
+			}
+
+			msg := types.NewMsgSetRollappRiskLimits(
+				clientCtx.GetFromAddress().String(),
+				types.NewRollappRiskLimits(args[0], maxOutstanding, maxOrderPrice),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTransferFulfillmentTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-fulfillment [order-id] [new-fulfiller-address]",
+		Short:   "Transfer the claim of a fulfilled eibc order",
+		Example: "dymd tx eibc transfer-fulfillment <order-id> <new-fulfiller-address>",
+		Long: `Transfer the claim of a fulfilled eibc order to a new address.
+		The new fulfiller receives the funds of the underlying packet once it is finalized.
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferFulfillment(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetFeeSubsidyTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-fee-subsidy [rollapp-id] [subsidy-percentage] [epoch-cap]",
+		Short:   "Configure the eIBC fee subsidy pool of a rollapp, as the rollapp owner",
+		Long:    "Configure the part of the order fee the pool pays on top of it to the fulfiller and the maximal amount the pool pays out per epoch.",
+		Example: `dymd tx eibc set-fee-subsidy rollapp_1234-1 0.5 10000adym`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			percentage, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("subsidy percentage: %w", err)
+			}
+			epochCap, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("epoch cap: %w", err)
+			}
+
+			msg := types.NewMsgSetFeeSubsidy(clientCtx.GetFromAddress().String(), args[0], percentage, epochCap)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewFundFeeSubsidyTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund-fee-subsidy [rollapp-id] [amount]",
+		Short:   "Fund the eIBC fee subsidy pool of a rollapp, as the rollapp owner",
+		Example: `dymd tx eibc fund-fee-subsidy rollapp_1234-1 100000adym`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("amount: %w", err)
+			}
+
+			msg := types.NewMsgFundFeeSubsidy(clientCtx.GetFromAddress().String(), args[0], amount)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawFeeSubsidyTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-fee-subsidy [rollapp-id] [amount]",
+		Short:   "Withdraw funds from the eIBC fee subsidy pool of a rollapp, as the rollapp owner",
+		Example: `dymd tx eibc withdraw-fee-subsidy rollapp_1234-1 100000adym`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("amount: %w", err)
+			}
+
+			msg := types.NewMsgWithdrawFeeSubsidy(clientCtx.GetFromAddress().String(), args[0], amount)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,26 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		if err != nil {
 			panic(err)
 		}
+		k.ScheduleFeeEscalation(ctx, &demandOrderCopy)
+	}
+	// Add the fulfillment intents, their budgets are already held by the module account
+	nextIntentID := uint64(1)
+	for _, intent := range genState.FulfillmentIntents {
+		k.SetFulfillmentIntent(ctx, intent)
+		if intent.Id >= nextIntentID {
+			nextIntentID = intent.Id + 1
+		}
+	}
+	k.SetNextFulfillmentIntentID(ctx, nextIntentID)
+	for _, limits := range genState.RollappRiskLimits {
+		k.SetRollappRiskLimits(ctx, limits)
+	}
+	// Add the fee subsidy pools, their balances are already held by the module account
+	for _, subsidy := range genState.FeeSubsidies {
+		k.SetFeeSubsidy(ctx, subsidy)
+	}
+	for _, floor := range genState.RollappFeeFloors {
+		k.SetRollappFeeFloor(ctx, floor)
 	}
 }
 
@@ -33,6 +53,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	for i, order := range allDemandOrders {
 		genesis.DemandOrders[i] = *order
 	}
+	genesis.FulfillmentIntents = k.ListFulfillmentIntents(ctx)
+	genesis.RollappRiskLimits = k.ListRollappRiskLimits(ctx)
+	genesis.FeeSubsidies = k.ListFeeSubsidies(ctx)
+	genesis.RollappFeeFloors = k.ListRollappFeeFloors(ctx)
 
 	return genesis
 }
@@ -53,9 +53,10 @@ func TestInitGenesis(t *testing.T) {
 func TestExportGenesis(t *testing.T) {
 	k, ctx := keepertest.EibcKeeper(t)
 	params := types.Params{
-		EpochIdentifier: "week",
-		TimeoutFee:      sdk.NewDecWithPrec(4, 1),
-		ErrackFee:       sdk.NewDecWithPrec(4, 1),
+		EpochIdentifier:  "week",
+		TimeoutFee:       sdk.NewDecWithPrec(4, 1),
+		ErrackFee:        sdk.NewDecWithPrec(4, 1),
+		MinFeePercentage: sdk.NewDecWithPrec(1, 2),
 	}
 	// Set some demand orders
 	demandOrders := []types.DemandOrder{
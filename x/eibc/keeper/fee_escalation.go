@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// ScheduleFeeEscalation queues the next fee escalation of the order.
+// Nothing is queued if the order has no fee escalation schedule, if its fee already reached the cap,
+// or if the order is no longer open for fulfillment.
+func (k Keeper) ScheduleFeeEscalation(ctx sdk.Context, order *types.DemandOrder) {
+	if order.FeeEscalation == nil || order.FeeEscalation.IsCapped(order.GetFeeAmount()) {
+		return
+	}
+	if order.TrackingPacketStatus != commontypes.Status_PENDING || order.IsFulfilled() || order.IsPartiallyFulfilled() {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFeeEscalationKey(order.FeeEscalation.NextHeight, order.Id), []byte{})
+}
+
+// EscalateDemandOrderFees raises the fees of the orders whose fee escalation is due at the current height.
+// Escalations of orders which were fulfilled, finalized or had their schedule cancelled in the meantime are dropped.
+func (k Keeper) EscalateDemandOrderFees(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FeeEscalationKeyPrefix, types.GetFeeEscalationsByHeightPrefix(ctx.BlockHeight()+1))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close() // nolint: errcheck
+
+	prefixLen := len(types.GetFeeEscalationsByHeightPrefix(0))
+	for _, key := range keys {
+		store.Delete(key)
+		orderId := string(key[prefixLen:])
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.escalateDemandOrderFee(ctx, orderId)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Escalate demand order fee.", "order", orderId, "error", err)
+		}
+	}
+}
+
+func (k Keeper) escalateDemandOrderFee(ctx sdk.Context, orderId string) error {
+	order, err := k.GetDemandOrder(ctx, commontypes.Status_PENDING, orderId)
+	if errors.Is(err, types.ErrDemandOrderDoesNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if order.FeeEscalation == nil || order.IsFulfilled() || order.IsPartiallyFulfilled() {
+		return nil
+	}
+
+	order.FeeEscalation.NextHeight = ctx.BlockHeight() + int64(order.FeeEscalation.Interval)
+	if err := k.UpdateDemandOrderFee(ctx, order, order.FeeEscalation.NextFee(order.GetFeeAmount())); err != nil {
+		return err
+	}
+	k.ScheduleFeeEscalation(ctx, order)
+
+	return nil
+}
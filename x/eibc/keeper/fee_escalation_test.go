@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeEscalation() {
+	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dacktypes.NewParams("hour", sdk.ZeroDec(), 0, 0))
+	memo := `{"eibc":{"fee":"100","fee_escalation":{"max_fee":"250","step":"100","interval":2}}}`
+
+	createOrder := func(sequence uint64) *types.DemandOrder {
+		data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "1000", eibcSenderAddr.String(), eibcReceiverAddr.String(), memo)
+		p := channeltypes.NewPacket(data.GetBytes(), sequence, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		raPacket := commontypes.RollappPacket{
+			RollappId: "testRollappId",
+			Status:    commontypes.Status_PENDING,
+			Type:      commontypes.RollappPacket_ON_RECV,
+			Packet:    &p,
+		}
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, raPacket)
+		err := suite.App.EIBCKeeper.EIBCDemandOrderHandler(suite.Ctx, raPacket, data)
+		suite.Require().NoError(err)
+		order, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, types.BuildDemandIDFromPacketKey(string(raPacket.RollappPacketKey())))
+		suite.Require().NoError(err)
+		return order
+	}
+	escalateAt := func(height int64) sdk.Context {
+		ctx := suite.Ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		suite.App.EIBCKeeper.EscalateDemandOrderFees(ctx)
+		return ctx
+	}
+	requireFeeAndPrice := func(order *types.DemandOrder, fee, price int64) {
+		order, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(math.NewInt(fee), order.GetFeeAmount())
+		suite.Require().Equal(math.NewInt(price), order.Price[0].Amount)
+	}
+
+	start := suite.Ctx.BlockHeight()
+	escalated := createOrder(2)
+	cancelled := createOrder(3)
+	suite.Require().Equal(types.NewFeeEscalation(math.NewInt(250), math.NewInt(100), 2, start+2), escalated.FeeEscalation)
+	requireFeeAndPrice(escalated, 100, 900)
+
+	// The recipient takes over the fee of the second order
+	_, err := suite.msgServer.UpdateDemandOrder(suite.Ctx, types.NewMsgUpdateDemandOrder(eibcReceiverAddr.String(), cancelled.Id, "150"))
+	suite.Require().NoError(err)
+
+	// Nothing is due before the interval passes
+	escalateAt(start + 1)
+	requireFeeAndPrice(escalated, 100, 900)
+
+	ctx := escalateAt(start + 2)
+	requireFeeAndPrice(escalated, 200, 800)
+	requireFeeAndPrice(cancelled, 150, 850)
+	suite.AssertEventEmitted(ctx, "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated", 1)
+
+	// The fee is capped by the max fee, after which the schedule is over
+	escalateAt(start + 4)
+	requireFeeAndPrice(escalated, 250, 750)
+	ctx = escalateAt(start + 6)
+	requireFeeAndPrice(escalated, 250, 750)
+	suite.AssertEventEmitted(ctx, "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated", 0)
+}
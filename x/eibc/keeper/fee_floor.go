@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// SetRollappFeeFloor stores the fee floor override of the rollapp.
+func (k Keeper) SetRollappFeeFloor(ctx sdk.Context, floor types.RollappFeeFloor) {
+	ctx.KVStore(k.storeKey).Set(types.GetRollappFeeFloorKey(floor.RollappId), k.cdc.MustMarshal(&floor))
+}
+
+// DeleteRollappFeeFloor removes the fee floor override of the rollapp.
+func (k Keeper) DeleteRollappFeeFloor(ctx sdk.Context, rollappId string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetRollappFeeFloorKey(rollappId))
+}
+
+// GetRollappFeeFloor returns the fee floor override of the rollapp.
+func (k Keeper) GetRollappFeeFloor(ctx sdk.Context, rollappId string) (types.RollappFeeFloor, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRollappFeeFloorKey(rollappId))
+	if bz == nil {
+		return types.RollappFeeFloor{}, false
+	}
+	var floor types.RollappFeeFloor
+	k.cdc.MustUnmarshal(bz, &floor)
+	return floor, true
+}
+
+// ListRollappFeeFloors returns the fee floor overrides of all rollapps.
+func (k Keeper) ListRollappFeeFloors(ctx sdk.Context) (list []types.RollappFeeFloor) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RollappFeeFloorKeyPrefix)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RollappFeeFloor
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// GetMinFeePercentage returns the fee floor applied to the demand orders of the rollapp.
+// The override of the rollapp takes precedence over the module param.
+func (k Keeper) GetMinFeePercentage(ctx sdk.Context, rollappId string) (minFeePercentage sdk.Dec, overridden bool) {
+	if floor, found := k.GetRollappFeeFloor(ctx, rollappId); found {
+		return floor.MinFeePercentage, true
+	}
+	return k.MinFeePercentage(ctx), false
+}
+
+// checkFeeFloor returns an error if the fee of a demand order created on packet receipt is below the
+// fee floor of the rollapp.
+func (k Keeper) checkFeeFloor(ctx sdk.Context, order *types.DemandOrder, amount string) error {
+	amt, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return fmt.Errorf("invalid packet amount: %s", amount)
+	}
+	minFeePercentage, _ := k.GetMinFeePercentage(ctx, order.RollappId)
+	return types.CheckFeeFloor(order.GetFeeAmount(), amt, minFeePercentage)
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeFloor() {
+	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dacktypes.NewParams("hour", sdk.ZeroDec(), 0, 0))
+	rollappId, _ := suite.CreateDefaultRollappAndProposer()
+
+	sequence := uint64(0)
+	createOrder := func(fee string) bool {
+		sequence++
+		memo := fmt.Sprintf(`{"eibc":{"fee":"%s"}}`, fee)
+		data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "1000", eibcSenderAddr.String(), eibcReceiverAddr.String(), memo)
+		p := channeltypes.NewPacket(data.GetBytes(), sequence, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		raPacket := commontypes.RollappPacket{
+			RollappId: rollappId,
+			Status:    commontypes.Status_PENDING,
+			Type:      commontypes.RollappPacket_ON_RECV,
+			Packet:    &p,
+		}
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, raPacket)
+		err := suite.App.EIBCKeeper.EIBCDemandOrderHandler(suite.Ctx, raPacket, data)
+		suite.Require().NoError(err)
+		_, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, types.BuildDemandIDFromPacketKey(string(raPacket.RollappPacketKey())))
+		if err != nil {
+			suite.Require().ErrorIs(err, types.ErrDemandOrderDoesNotExist)
+			return false
+		}
+		return true
+	}
+	queryFloor := func() *types.QueryFeeFloorResponse {
+		res, err := suite.queryClient.FeeFloor(sdk.WrapSDKContext(suite.Ctx), &types.QueryFeeFloorRequest{RollappId: rollappId})
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// No floor by default
+	suite.Require().True(createOrder("0"))
+
+	// Orders below the module param are not created
+	params := suite.App.EIBCKeeper.GetParams(suite.Ctx)
+	params.MinFeePercentage = sdk.NewDecWithPrec(1, 2) // 1%
+	suite.App.EIBCKeeper.SetParams(suite.Ctx, params)
+	suite.Require().False(createOrder("9"))
+	suite.AssertEventEmitted(suite.Ctx, "dymensionxyz.dymension.eibc.EventDemandOrderCreationSkipped", 1)
+	suite.Require().True(createOrder("10"))
+	suite.Require().Equal(params.MinFeePercentage, queryFloor().MinFeePercentage)
+
+	// Only the governance can override the floor of a rollapp
+	floor := types.NewRollappFeeFloor(rollappId, sdk.NewDecWithPrec(5, 2)) // 5%
+	_, err := suite.msgServer.SetRollappFeeFloor(suite.Ctx, types.NewMsgSetRollappFeeFloor(eibcSenderAddr.String(), floor, false))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = suite.msgServer.SetRollappFeeFloor(suite.Ctx, types.NewMsgSetRollappFeeFloor(gov, floor, false))
+	suite.Require().NoError(err)
+
+	// The override takes precedence over the module param
+	res := queryFloor()
+	suite.Require().True(res.Overridden)
+	suite.Require().Equal(floor.MinFeePercentage, res.MinFeePercentage)
+	suite.Require().False(createOrder("10"))
+	suite.Require().True(createOrder(math.NewInt(50).String()))
+
+	// Removing the override falls back to the module param
+	_, err = suite.msgServer.SetRollappFeeFloor(suite.Ctx, types.NewMsgSetRollappFeeFloor(gov, types.RollappFeeFloor{RollappId: rollappId}, true))
+	suite.Require().NoError(err)
+	suite.Require().False(queryFloor().Overridden)
+	suite.Require().True(createOrder("10"))
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// SetFeeSubsidy stores the fee subsidy pool of the rollapp.
+func (k Keeper) SetFeeSubsidy(ctx sdk.Context, subsidy types.RollappFeeSubsidy) {
+	ctx.KVStore(k.storeKey).Set(types.GetRollappFeeSubsidyKey(subsidy.RollappId), k.cdc.MustMarshal(&subsidy))
+}
+
+// GetFeeSubsidy returns the fee subsidy pool of the rollapp.
+func (k Keeper) GetFeeSubsidy(ctx sdk.Context, rollappId string) (types.RollappFeeSubsidy, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRollappFeeSubsidyKey(rollappId))
+	if bz == nil {
+		return types.NewRollappFeeSubsidy(rollappId, math.LegacyZeroDec(), nil), false
+	}
+	var subsidy types.RollappFeeSubsidy
+	k.cdc.MustUnmarshal(bz, &subsidy)
+	return subsidy, true
+}
+
+// ListFeeSubsidies returns the fee subsidy pools of all rollapps.
+func (k Keeper) ListFeeSubsidies(ctx sdk.Context) (list []types.RollappFeeSubsidy) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RollappFeeSubsidyKeyPrefix)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RollappFeeSubsidy
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// FundFeeSubsidy escrows the funds in the module and adds them to the fee subsidy pool of the rollapp.
+func (k Keeper) FundFeeSubsidy(ctx sdk.Context, rollappId string, funder sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, amount); err != nil {
+		return fmt.Errorf("escrow fee subsidy: %w", err)
+	}
+
+	subsidy, _ := k.GetFeeSubsidy(ctx, rollappId)
+	subsidy.Balance = subsidy.Balance.Add(amount...)
+	k.SetFeeSubsidy(ctx, subsidy)
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventFeeSubsidyFunded{
+		RollappId: rollappId,
+		Amount:    amount.String(),
+		Balance:   subsidy.Balance.String(),
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// WithdrawFeeSubsidy removes the funds from the fee subsidy pool of the rollapp and sends them to the recipient.
+func (k Keeper) WithdrawFeeSubsidy(ctx sdk.Context, rollappId string, recipient sdk.AccAddress, amount sdk.Coins) error {
+	subsidy, found := k.GetFeeSubsidy(ctx, rollappId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrFeeSubsidyNotFound, "rollapp: %s", rollappId)
+	}
+	balance, negative := subsidy.Balance.SafeSub(amount...)
+	if negative {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "pool balance %s is smaller than %s", subsidy.Balance, amount)
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return fmt.Errorf("withdraw fee subsidy: %w", err)
+	}
+
+	subsidy.Balance = balance
+	k.SetFeeSubsidy(ctx, subsidy)
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventFeeSubsidyWithdrawn{
+		RollappId: rollappId,
+		Amount:    amount.String(),
+		Balance:   subsidy.Balance.String(),
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// payFeeSubsidies tops up the fees earned by the fulfillers of the order once its packet is finalized, so that
+// nothing is paid for a packet reverted on fraud. Each fulfiller of a partially fulfilled order earns the share
+// of the fee pro-rata to the slice of the price they paid.
+func (k Keeper) payFeeSubsidies(ctx sdk.Context, order *types.DemandOrder) error {
+	if order.IsPartiallyFulfilled() {
+		for _, f := range order.PartialFulfillments {
+			feeShare := order.GetFeeAmount().Mul(f.Amount).Quo(order.Price[0].Amount)
+			if err := k.payFeeSubsidy(ctx, order, f.FulfillerAddress, feeShare); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !order.IsFulfilled() {
+		return nil
+	}
+	return k.payFeeSubsidy(ctx, order, order.FulfillerAddress, order.GetFeeAmount())
+}
+
+// payFeeSubsidy tops up the fee earned by the fulfiller of the order from the fee subsidy pool of the rollapp.
+// The fee is the part of the order fee earned by the fulfiller. The subsidy is bounded by the pool balance and
+// by what is left of the epoch cap. The recipient of the order earns nothing for fulfilling it, otherwise it
+// could drain the pool by fulfilling its own orders.
+func (k Keeper) payFeeSubsidy(ctx sdk.Context, order *types.DemandOrder, fulfiller string, fee math.Int) error {
+	if fulfiller == order.Recipient {
+		return nil
+	}
+
+	subsidy, found := k.GetFeeSubsidy(ctx, order.RollappId)
+	if !found {
+		return nil
+	}
+
+	paid := subsidy.Subsidy(sdk.NewCoin(order.Price[0].Denom, fee))
+	if !paid.IsPositive() {
+		return nil
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(fulfiller), sdk.NewCoins(paid)); err != nil {
+		return fmt.Errorf("pay fee subsidy: %w", err)
+	}
+
+	subsidy.Balance = subsidy.Balance.Sub(paid)
+	subsidy.EpochPaid = subsidy.EpochPaid.Add(paid)
+	subsidy.TotalPaid = subsidy.TotalPaid.Add(paid)
+	k.SetFeeSubsidy(ctx, subsidy)
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventFeeSubsidyPaid{
+		OrderId:   order.Id,
+		RollappId: order.RollappId,
+		Fulfiller: fulfiller,
+		Amount:    paid.String(),
+		Balance:   subsidy.Balance.String(),
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// resetFeeSubsidyEpochs starts a new epoch for the fee subsidy pools of all rollapps.
+func (k Keeper) resetFeeSubsidyEpochs(ctx sdk.Context) {
+	for _, subsidy := range k.ListFeeSubsidies(ctx) {
+		if subsidy.EpochPaid.IsZero() {
+			continue
+		}
+		subsidy.EpochPaid = nil
+		k.SetFeeSubsidy(ctx, subsidy)
+	}
+}
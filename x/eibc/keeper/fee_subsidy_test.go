@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeSubsidy() {
+	rollappId, _ := suite.CreateDefaultRollappAndProposer()
+	rollapp, found := suite.App.RollappKeeper.GetRollapp(suite.Ctx, rollappId)
+	suite.Require().True(found)
+	owner := sdk.MustAccAddressFromBech32(rollapp.Owner)
+	denom := sdk.DefaultBondDenom
+	suite.FundAcc(owner, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)))
+	fulfiller := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1000))[0]
+
+	sequence := uint64(0)
+	createOrder := func() *types.DemandOrder {
+		sequence++
+		p := channeltypes.NewPacket(transferPacketData.GetBytes(), sequence, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		raPacket := commontypes.RollappPacket{
+			RollappId: rollappId,
+			Status:    commontypes.Status_PENDING,
+			Type:      commontypes.RollappPacket_ON_RECV,
+			Packet:    &p,
+		}
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, raPacket)
+		order := types.NewDemandOrder(raPacket, math.NewInt(100), math.NewInt(50), denom, eibcReceiverAddr.String())
+		suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, order))
+		return order
+	}
+	// fulfillBy fulfills the order and updates its packet to the given status, returning the subsidy received
+	// by the fulfiller on top of the paid price
+	fulfillBy := func(fulfiller sdk.AccAddress, order *types.DemandOrder, status commontypes.Status) math.Int {
+		before := suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount
+		subsidy, _ := suite.App.EIBCKeeper.GetFeeSubsidy(suite.Ctx, rollappId)
+		_, err := suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), order.Id, "50"))
+		suite.Require().NoError(err)
+
+		// nothing is paid before the packet is finalized
+		afterFulfillment, _ := suite.App.EIBCKeeper.GetFeeSubsidy(suite.Ctx, rollappId)
+		suite.Require().Equal(subsidy.TotalPaid, afterFulfillment.TotalPaid)
+
+		order, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+		suite.Require().NoError(err)
+		rollappPacket, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, order.TrackingPacketKey)
+		suite.Require().NoError(err)
+		_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketWithStatus(suite.Ctx, *rollappPacket, status)
+		suite.Require().NoError(err)
+
+		paid := suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount.Sub(before)
+		if fulfiller.String() == order.Recipient {
+			// the recipient paid the price to itself
+			return paid
+		}
+		return paid.Add(order.Price[0].Amount)
+	}
+	fulfill := func(order *types.DemandOrder) math.Int {
+		return fulfillBy(fulfiller, order, commontypes.Status_FINALIZED)
+	}
+	querySubsidy := func() *types.QueryFeeSubsidyResponse {
+		res, err := suite.queryClient.FeeSubsidy(sdk.WrapSDKContext(suite.Ctx), &types.QueryFeeSubsidyRequest{RollappId: rollappId})
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// Only the rollapp owner can manage the pool
+	stranger := apptesting.CreateRandomAccounts(1)[0]
+	epochCap := sdk.NewCoins(sdk.NewInt64Coin(denom, 30))
+	_, err := suite.msgServer.SetFeeSubsidy(suite.Ctx, types.NewMsgSetFeeSubsidy(stranger.String(), rollappId, sdk.NewDecWithPrec(5, 1), epochCap))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// Orders are not subsidized before the pool is funded
+	suite.Require().True(fulfill(createOrder()).IsZero())
+
+	_, err = suite.msgServer.SetFeeSubsidy(suite.Ctx, types.NewMsgSetFeeSubsidy(owner.String(), rollappId, sdk.NewDecWithPrec(5, 1), epochCap))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.FundFeeSubsidy(suite.Ctx, types.NewMsgFundFeeSubsidy(owner.String(), rollappId, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	suite.Require().NoError(err)
+
+	// Nothing is paid for a reverted packet
+	suite.Require().True(fulfillBy(fulfiller, createOrder(), commontypes.Status_REVERTED).IsZero())
+
+	// Nothing is paid to the recipient fulfilling its own order
+	suite.Require().True(fulfillBy(eibcReceiverAddr, createOrder(), commontypes.Status_FINALIZED).IsZero())
+
+	// The pool pays half of the fee on top of it, up to the epoch cap
+	suite.Require().Equal(math.NewInt(25), fulfill(createOrder()))
+	suite.AssertEventEmitted(suite.Ctx, "dymensionxyz.dymension.eibc.EventFeeSubsidyPaid", 1)
+	suite.Require().Equal(math.NewInt(5), fulfill(createOrder()))
+	suite.Require().True(fulfill(createOrder()).IsZero())
+
+	res := querySubsidy()
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 70)), res.Subsidy.Balance)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 30)), res.Subsidy.EpochPaid)
+	suite.Require().True(res.EpochRemaining.IsZero())
+
+	// The cap is reset in the next epoch
+	err = suite.App.EIBCKeeper.GetEpochHooks().AfterEpochEnd(suite.Ctx, suite.App.EIBCKeeper.EpochIdentifier(suite.Ctx), 1)
+	suite.Require().NoError(err)
+	res = querySubsidy()
+	suite.Require().True(res.Subsidy.EpochPaid.IsZero())
+	suite.Require().Equal(epochCap, res.EpochRemaining)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 30)), res.Subsidy.TotalPaid)
+
+	// The owner can withdraw what is left in the pool
+	_, err = suite.msgServer.WithdrawFeeSubsidy(suite.Ctx, types.NewMsgWithdrawFeeSubsidy(owner.String(), rollappId, sdk.NewCoins(sdk.NewInt64Coin(denom, 80))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	_, err = suite.msgServer.WithdrawFeeSubsidy(suite.Ctx, types.NewMsgWithdrawFeeSubsidy(owner.String(), rollappId, sdk.NewCoins(sdk.NewInt64Coin(denom, 70))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(970), suite.App.BankKeeper.GetBalance(suite.Ctx, owner, denom).Amount)
+	suite.Require().True(fulfill(createOrder()).IsZero())
+}
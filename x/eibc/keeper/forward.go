@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// forwardOrderFunds hands the price paid to the recipient of an order whose packet carries packet-forward-middleware
+// instructions over to the middleware, the same way it would forward the packet once finalized. The middleware
+// tracks the forward as in flight for the rollapp packet: it retries it on timeout and writes the acknowledgement
+// of the rollapp packet back along the original route once the forward completes.
+// The forward is nonrefundable: the fulfiller already paid for the rollapp packet, so a failed forward can't be
+// refunded on the rollapp. Once the forward times out for good or gets an error ack, the middleware moves the funds
+// to the hub account the rollapp sender can recover them from: the receiver of the rollapp packet, which is the
+// recipient of the order, and acknowledges the rollapp packet with a success ack describing the failure.
+// Since the forward happens on fulfillment, the instructions are dropped when the packet is finalized and the
+// funds go to the fulfiller instead.
+func (k Keeper) forwardOrderFunds(ctx sdk.Context, order *types.DemandOrder) error {
+	forward, rollappPacket, err := k.getOrderForwardMetadata(ctx, order)
+	if err != nil || forward == nil {
+		return err
+	}
+	data, err := rollappPacket.GetTransferPacketData()
+	if err != nil {
+		return fmt.Errorf("get transfer packet data: %w", err)
+	}
+
+	timeout := time.Duration(forward.Timeout)
+	if timeout <= 0 {
+		timeout = packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp
+	}
+	// the middleware of the transfer stack doesn't retry on timeout by default
+	var retries uint8
+	if forward.Retries != nil {
+		retries = *forward.Retries
+	}
+
+	for _, coin := range order.Price {
+		err = k.pfm.ForwardTransferPacket(
+			ctx,
+			nil,
+			*rollappPacket.Packet,
+			data.Sender,
+			order.Recipient,
+			forward,
+			coin,
+			retries,
+			timeout,
+			nil,
+			true,
+		)
+		if err != nil {
+			return fmt.Errorf("forward order funds: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkOrderNotForwarded returns an error if the packet of the order asks to be forwarded. The middleware
+// acknowledges the rollapp packet once per forward, so such orders can only be fulfilled in full.
+func (k Keeper) checkOrderNotForwarded(ctx sdk.Context, order *types.DemandOrder) error {
+	forward, _, err := k.getOrderForwardMetadata(ctx, order)
+	if err != nil {
+		return err
+	}
+	if forward != nil {
+		return dacktypes.ErrMemoHashPFMandEIBC
+	}
+	return nil
+}
+
+// getOrderForwardMetadata returns the packet-forward-middleware instructions of the packet of the order, if any.
+func (k Keeper) getOrderForwardMetadata(ctx sdk.Context, order *types.DemandOrder) (*pfmtypes.ForwardMetadata, *commontypes.RollappPacket, error) {
+	if order.Type != commontypes.RollappPacket_ON_RECV {
+		return nil, nil, nil
+	}
+
+	rollappPacket, err := k.dack.GetRollappPacket(ctx, order.TrackingPacketKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get rollapp packet: %w", err)
+	}
+	data, err := rollappPacket.GetTransferPacketData()
+	if err != nil {
+		return nil, nil, fmt.Errorf("get transfer packet data: %w", err)
+	}
+	forward, err := dacktypes.ParseForwardMetadata(data.Memo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse forward metadata: %w", err)
+	}
+	return forward, rollappPacket, nil
+}
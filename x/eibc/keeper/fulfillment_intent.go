@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// SetFulfillmentIntent stores the fulfillment intent together with its rollapp and denom index.
+func (k Keeper) SetFulfillmentIntent(ctx sdk.Context, intent types.FulfillmentIntent) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFulfillmentIntentKey(intent.Id), k.cdc.MustMarshal(&intent))
+	store.Set(types.GetFulfillmentIntentByRollappDenomKey(intent.RollappId, intent.Denom, intent.Id), []byte{})
+}
+
+// GetFulfillmentIntent returns the fulfillment intent with the given id.
+func (k Keeper) GetFulfillmentIntent(ctx sdk.Context, id uint64) (types.FulfillmentIntent, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFulfillmentIntentKey(id))
+	if bz == nil {
+		return types.FulfillmentIntent{}, types.ErrFulfillmentIntentNotFound
+	}
+	var intent types.FulfillmentIntent
+	k.cdc.MustUnmarshal(bz, &intent)
+	return intent, nil
+}
+
+func (k Keeper) deleteFulfillmentIntent(ctx sdk.Context, intent types.FulfillmentIntent) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFulfillmentIntentKey(intent.Id))
+	store.Delete(types.GetFulfillmentIntentByRollappDenomKey(intent.RollappId, intent.Denom, intent.Id))
+}
+
+// ListFulfillmentIntents returns all fulfillment intents ordered by id.
+func (k Keeper) ListFulfillmentIntents(ctx sdk.Context) (list []types.FulfillmentIntent) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FulfillmentIntentKeyPrefix)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FulfillmentIntent
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// listFulfillmentIntentsByRollappDenom returns the fulfillment intents of the rollapp and denom ordered by id.
+func (k Keeper) listFulfillmentIntentsByRollappDenom(ctx sdk.Context, rollappId, denom string) (list []types.FulfillmentIntent, err error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetFulfillmentIntentsByRollappDenomPrefix(rollappId, denom)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		intent, err := k.GetFulfillmentIntent(ctx, id)
+		if err != nil {
+			// the index is always written together with the intent
+			return nil, fmt.Errorf("fulfillment intent index is out of sync: %d: %w", id, err)
+		}
+		list = append(list, intent)
+	}
+
+	return list, nil
+}
+
+// GetNextFulfillmentIntentID returns the id to be used for the next fulfillment intent.
+func (k Keeper) GetNextFulfillmentIntentID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextFulfillmentIntentIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextFulfillmentIntentID sets the id to be used for the next fulfillment intent.
+func (k Keeper) SetNextFulfillmentIntentID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextFulfillmentIntentIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// CreateFulfillmentIntent escrows the budget of the fulfiller in the module and stores a new fulfillment intent.
+func (k Keeper) CreateFulfillmentIntent(ctx sdk.Context, intent types.FulfillmentIntent) (types.FulfillmentIntent, error) {
+	intent.Id = k.GetNextFulfillmentIntentID(ctx)
+
+	budget := sdk.NewCoins(sdk.NewCoin(intent.Denom, intent.Budget))
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, intent.GetFulfillerBech32Address(), types.ModuleName, budget); err != nil {
+		return types.FulfillmentIntent{}, fmt.Errorf("escrow fulfillment intent budget: %w", err)
+	}
+
+	k.SetFulfillmentIntent(ctx, intent)
+	k.SetNextFulfillmentIntentID(ctx, intent.Id+1)
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventFulfillmentIntentCreated{Intent: intent}); err != nil {
+		return types.FulfillmentIntent{}, fmt.Errorf("emit event: %w", err)
+	}
+
+	return intent, nil
+}
+
+// CloseFulfillmentIntent deletes the fulfillment intent and returns its remaining budget to the fulfiller.
+func (k Keeper) CloseFulfillmentIntent(ctx sdk.Context, intent types.FulfillmentIntent) error {
+	refund := sdk.NewCoins(sdk.NewCoin(intent.Denom, intent.Budget))
+	if !refund.IsZero() {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, intent.GetFulfillerBech32Address(), refund); err != nil {
+			return fmt.Errorf("refund fulfillment intent budget: %w", err)
+		}
+	}
+
+	k.deleteFulfillmentIntent(ctx, intent)
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventFulfillmentIntentClosed{
+		IntentId: intent.Id,
+		Refund:   refund.String(),
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// fulfillByIntents fulfills a newly created demand order from the first matching fulfillment intent, if any.
+// Intents are matched in the order of their creation. The order price is paid to the recipient from the
+// escrowed budget and the intent owner becomes the fulfiller of the order.
+func (k Keeper) fulfillByIntents(ctx sdk.Context, order *types.DemandOrder) error {
+	intents, err := k.listFulfillmentIntentsByRollappDenom(ctx, order.RollappId, order.Price[0].Denom)
+	if err != nil {
+		return err
+	}
+
+	for _, intent := range intents {
+		if !intent.Matches(order) {
+			continue
+		}
+
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, order.GetRecipientBech32Address(), order.Price); err != nil {
+			return fmt.Errorf("send coins from fulfillment intent: %w", err)
+		}
+		if err := k.forwardOrderFunds(ctx, order); err != nil {
+			return err
+		}
+
+		intent.Budget = intent.Budget.Sub(order.Price[0].Amount)
+		if intent.Budget.IsZero() {
+			if err := k.CloseFulfillmentIntent(ctx, intent); err != nil {
+				return err
+			}
+		} else {
+			k.SetFulfillmentIntent(ctx, intent)
+		}
+
+		if err := k.SetOrderFulfilled(ctx, order, intent.GetFulfillerBech32Address()); err != nil {
+			return err
+		}
+
+		if err := uevent.EmitTypedEvent(ctx, order.GetFulfilledEvent()); err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+
+		if err := uevent.EmitTypedEvent(ctx, &types.EventDemandOrderFulfilledByIntent{
+			OrderId:         order.Id,
+			IntentId:        intent.Id,
+			RemainingBudget: intent.Budget.String(),
+		}); err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+
+		return nil
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestFulfillmentIntents() {
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, sdk.NewInt(10_000))
+	picky := testAddresses[0]
+	fulfiller := testAddresses[1]
+	denom := sdk.DefaultBondDenom
+	rollappId := "testRollappId"
+
+	// An intent asking for a too high fee
+	_, err := suite.msgServer.CreateFulfillmentIntent(suite.Ctx, types.NewMsgCreateFulfillmentIntent(
+		picky.String(), rollappId, denom, sdk.NewDecWithPrec(1, 2), math.NewInt(2000), math.NewInt(5000),
+	))
+	suite.Require().NoError(err)
+
+	// An intent which matches the order
+	res, err := suite.msgServer.CreateFulfillmentIntent(suite.Ctx, types.NewMsgCreateFulfillmentIntent(
+		fulfiller.String(), rollappId, denom, sdk.NewDecWithPrec(1, 3), math.NewInt(2000), math.NewInt(1500),
+	))
+	suite.Require().NoError(err)
+	intentId := res.Id
+	suite.Require().Equal(math.NewInt(8500), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+
+	// The intents are listed by pages
+	page, err := suite.queryClient.FulfillmentIntents(sdk.WrapSDKContext(suite.Ctx), &types.QueryFulfillmentIntentsRequest{
+		RollappId:  rollappId,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(page.Intents, 1)
+	suite.Require().Equal(picky.String(), page.Intents[0].FulfillerAddress)
+	suite.Require().NotNil(page.Pagination.NextKey)
+
+	// A timed out packet creates an order with price 999 and fee 1
+	data := transfertypes.NewFungibleTokenPacketData(denom, "1000", eibcSenderAddr.String(), eibcReceiverAddr.String(), "")
+	timeoutPacket := channeltypes.NewPacket(data.GetBytes(), 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	timeoutRollappPacket := commontypes.RollappPacket{
+		RollappId: rollappId,
+		Status:    commontypes.Status_PENDING,
+		Type:      commontypes.RollappPacket_ON_TIMEOUT,
+		Packet:    &timeoutPacket,
+	}
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, timeoutRollappPacket)
+	err = suite.App.EIBCKeeper.EIBCDemandOrderHandler(suite.Ctx, timeoutRollappPacket, data)
+	suite.Require().NoError(err)
+
+	// The order is fulfilled right away by the matching intent
+	orderId := types.BuildDemandIDFromPacketKey(string(timeoutRollappPacket.RollappPacketKey()))
+	order, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, orderId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fulfiller.String(), order.FulfillerAddress)
+	suite.Require().Equal(math.NewInt(999), suite.App.BankKeeper.GetBalance(suite.Ctx, eibcSenderAddr, denom).Amount)
+
+	// The refund of the packet goes to the fulfiller
+	raPacket, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, order.TrackingPacketKey)
+	suite.Require().NoError(err)
+	packetData, err := raPacket.GetTransferPacketData()
+	suite.Require().NoError(err)
+	suite.Require().Equal(fulfiller.String(), packetData.Sender)
+
+	intent, err := suite.App.EIBCKeeper.GetFulfillmentIntent(suite.Ctx, intentId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(501), intent.Budget)
+
+	// Only the owner can cancel the intent
+	_, err = suite.msgServer.CancelFulfillmentIntent(suite.Ctx, types.NewMsgCancelFulfillmentIntent(picky.String(), intentId))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// The remaining budget is refunded on cancellation
+	_, err = suite.msgServer.CancelFulfillmentIntent(suite.Ctx, types.NewMsgCancelFulfillmentIntent(fulfiller.String(), intentId))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(9001), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+	_, err = suite.App.EIBCKeeper.GetFulfillmentIntent(suite.Ctx, intentId)
+	suite.Require().ErrorIs(err, types.ErrFulfillmentIntentNotFound)
+
+	// The intent of the picky fulfiller is untouched
+	intents, err := suite.queryClient.FulfillmentIntents(sdk.WrapSDKContext(suite.Ctx), &types.QueryFulfillmentIntentsRequest{RollappId: rollappId})
+	suite.Require().NoError(err)
+	suite.Require().Len(intents.Intents, 1)
+	suite.Require().Equal(picky.String(), intents.Intents[0].FulfillerAddress)
+	suite.Require().Equal(math.NewInt(5000), intents.Intents[0].Budget)
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
@@ -64,6 +66,75 @@ func (q Querier) DemandOrdersByStatus(goCtx context.Context, req *types.QueryDem
 	return &types.QueryDemandOrdersByStatusResponse{DemandOrders: demandOrders}, nil
 }
 
+func (q Querier) FulfillmentIntents(goCtx context.Context, req *types.QueryFulfillmentIntentsRequest) (*types.QueryFulfillmentIntentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	intents := make([]types.FulfillmentIntent, 0)
+	intentStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.FulfillmentIntentKeyPrefix)
+
+	pageRes, err := query.FilteredPaginate(intentStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var intent types.FulfillmentIntent
+		if err := q.cdc.Unmarshal(value, &intent); err != nil {
+			return false, err
+		}
+		if req.Fulfiller != "" && intent.FulfillerAddress != req.Fulfiller {
+			return false, nil
+		}
+		if req.RollappId != "" && intent.RollappId != req.RollappId {
+			return false, nil
+		}
+		if accumulate {
+			intents = append(intents, intent)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFulfillmentIntentsResponse{Intents: intents, Pagination: pageRes}, nil
+}
+
+func (q Querier) RollappRiskLimits(goCtx context.Context, req *types.QueryRollappRiskLimitsRequest) (*types.QueryRollappRiskLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "rollapp id cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	limits, _ := q.GetRollappRiskLimits(ctx, req.RollappId)
+	paused, reason := q.IsRollappPaused(ctx, req.RollappId)
+
+	return &types.QueryRollappRiskLimitsResponse{
+		Limits:      limits,
+		Outstanding: q.GetOutstandingOrderValue(ctx, req.RollappId),
+		Paused:      paused,
+		PauseReason: reason,
+	}, nil
+}
+
+func (q Querier) OrderBookStats(goCtx context.Context, req *types.QueryOrderBookStatsRequest) (*types.QueryOrderBookStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "rollapp id cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stats, err := q.GetOrderBookStats(ctx, req.RollappId, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOrderBookStatsResponse{Stats: stats}, nil
+}
+
 func filterOpts(req *types.QueryDemandOrdersByStatusRequest) []filterOption {
 	var opts []filterOption
 	if req.RollappId != "" {
@@ -129,3 +200,49 @@ func isRecipient(recipient string) filterOption {
 		return order.Recipient == recipient
 	}
 }
+
+func (q Querier) FeeSubsidy(goCtx context.Context, req *types.QueryFeeSubsidyRequest) (*types.QueryFeeSubsidyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "rollapp id cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	subsidy, found := q.GetFeeSubsidy(ctx, req.RollappId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrFeeSubsidyNotFound.Error())
+	}
+
+	return &types.QueryFeeSubsidyResponse{
+		Subsidy:        subsidy,
+		EpochRemaining: subsidy.EpochRemaining(),
+	}, nil
+}
+
+func (q Querier) FeeSubsidies(goCtx context.Context, req *types.QueryFeeSubsidiesRequest) (*types.QueryFeeSubsidiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryFeeSubsidiesResponse{Subsidies: q.ListFeeSubsidies(ctx)}, nil
+}
+
+func (q Querier) FeeFloor(goCtx context.Context, req *types.QueryFeeFloorRequest) (*types.QueryFeeFloorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "rollapp id cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minFeePercentage, overridden := q.GetMinFeePercentage(ctx, req.RollappId)
+
+	return &types.QueryFeeFloorResponse{
+		MinFeePercentage: minFeePercentage,
+		Overridden:       overridden,
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	eibckeeper "github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
@@ -100,3 +101,107 @@ func (suite *KeeperTestSuite) TestQueryDemandOrdersByStatus() {
 	suite.Require().NotNil(res.DemandOrders)
 	suite.Require().Equal(false, res.DemandOrders[0].IsFulfilled(), "Expected 0 demand orders with fulfillment state unfulfilled")
 }
+
+func (suite *KeeperTestSuite) TestQueryOrderBookStats() {
+	keeper := suite.App.EIBCKeeper
+	recipient := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1000))[0].String()
+
+	// price and fee of the orders, and whether they are fulfilled
+	orders := []struct {
+		denom     string
+		price     int64
+		fee       int64
+		height    int64
+		fulfilled bool
+	}{
+		{"stake", 100, 10, 5, false},
+		{"stake", 100, 20, 3, false},
+		{"stake", 200, 10, 1, true},
+		{"stake", 100, 30, 7, false},
+		{"adym", 50, 5, 9, false},
+	}
+	for i, o := range orders {
+		p := packet
+		p.Sequence = uint64(i + 1)
+		raPacket := commontypes.RollappPacket{
+			RollappId: "testRollappId",
+			Status:    commontypes.Status_PENDING,
+			Type:      commontypes.RollappPacket_ON_RECV,
+			Packet:    &p,
+		}
+		order := types.NewDemandOrder(raPacket, math.NewInt(o.price), math.NewInt(o.fee), o.denom, recipient)
+		order.CreationHeight = o.height
+		if o.fulfilled {
+			order.FulfillerAddress = recipient
+		}
+		suite.Require().NoError(keeper.SetDemandOrder(suite.Ctx, order))
+	}
+
+	// A finalized order is not in the stats
+	p := packet
+	p.Sequence = uint64(len(orders) + 1)
+	finalized := types.NewDemandOrder(commontypes.RollappPacket{RollappId: "testRollappId", Packet: &p}, math.NewInt(100), math.NewInt(10), "stake", recipient)
+	suite.Require().NoError(keeper.SetDemandOrder(suite.Ctx, finalized))
+	_, err := keeper.UpdateDemandOrderWithStatus(suite.Ctx, finalized, commontypes.Status_FINALIZED)
+	suite.Require().NoError(err)
+
+	querier := eibckeeper.NewQuerier(keeper)
+	ctx := suite.Ctx.WithBlockHeight(10)
+	res, err := querier.OrderBookStats(sdk.WrapSDKContext(ctx), &types.QueryOrderBookStatsRequest{RollappId: "testRollappId", Denom: "stake"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.OrderBookStats{{
+		RollappId:         "testRollappId",
+		Denom:             "stake",
+		PendingCount:      4,
+		PendingPrice:      math.NewInt(500),
+		UnfulfilledCount:  3,
+		UnfulfilledVolume: math.NewInt(300),
+		FeePercentiles: types.FeePercentiles{
+			P25: sdk.NewDecWithPrec(1, 1),
+			P50: sdk.NewDecWithPrec(2, 1),
+			P75: sdk.NewDecWithPrec(3, 1),
+			P90: sdk.NewDecWithPrec(3, 1),
+		},
+		OldestOrderCreationHeight: 3,
+		OldestOrderAge:            7,
+	}}, res.Stats)
+
+	// All the denoms of the rollapp are returned without a denom
+	res, err = querier.OrderBookStats(sdk.WrapSDKContext(ctx), &types.QueryOrderBookStatsRequest{RollappId: "testRollappId"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 2)
+	suite.Require().Equal("adym", res.Stats[0].Denom)
+	suite.Require().Equal(uint64(1), res.Stats[0].PendingCount)
+	suite.Require().Equal("stake", res.Stats[1].Denom)
+
+	// Rollapps without pending orders have no stats
+	res, err = querier.OrderBookStats(sdk.WrapSDKContext(ctx), &types.QueryOrderBookStatsRequest{RollappId: "otherRollappId"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Stats)
+}
+
+func (suite *KeeperTestSuite) TestReindexPendingDemandOrders() {
+	keeper := suite.App.EIBCKeeper
+	recipient := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1000))[0].String()
+
+	// An order stored before the creation height existed, and a newer one
+	var orders []*types.DemandOrder
+	for i, height := range []int64{0, 5} {
+		p := packet
+		p.Sequence = uint64(i + 1)
+		raPacket := commontypes.RollappPacket{RollappId: "testRollappId", Status: commontypes.Status_PENDING, Type: commontypes.RollappPacket_ON_RECV, Packet: &p}
+		order := types.NewDemandOrder(raPacket, math.NewInt(100), math.NewInt(10), "stake", recipient)
+		order.CreationHeight = height
+		suite.Require().NoError(keeper.SetDemandOrder(suite.Ctx, order))
+		orders = append(orders, order)
+	}
+
+	ctx := suite.Ctx.WithBlockHeight(12)
+	suite.Require().NoError(keeper.ReindexPendingDemandOrders(ctx))
+
+	for i, expected := range []int64{12, 5} {
+		order, err := keeper.GetDemandOrder(ctx, commontypes.Status_PENDING, orders[i].Id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, order.CreationHeight)
+	}
+}
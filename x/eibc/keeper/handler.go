@@ -8,6 +8,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 	"github.com/dymensionxyz/sdk-utils/utils/uibc"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 	"github.com/pkg/errors"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
@@ -45,18 +46,52 @@ func (k Keeper) EIBCDemandOrderHandler(ctx sdk.Context, rollappPacket commontype
 	if eibcDemandOrder == nil {
 		return nil
 	}
+	eibcDemandOrder.CreationHeight = ctx.BlockHeight()
 	if err := eibcDemandOrder.Validate(); err != nil {
 		return fmt.Errorf("validate eibc data: %w", err)
 	}
+	// Orders with a fee below the fee floor are not created, the packet just waits for finalization.
+	// The fee of timeout and errack orders is set by the module params, so the floor applies only on receipt.
+	if rollappPacket.Type == commontypes.RollappPacket_ON_RECV {
+		if err := k.checkFeeFloor(ctx, eibcDemandOrder, data.Amount); err != nil {
+			return k.skipDemandOrder(ctx, eibcDemandOrder, err)
+		}
+	}
+	// Orders which break the rollapp risk limits are not created, the packet just waits for finalization.
+	if err := k.checkRiskLimits(ctx, eibcDemandOrder); err != nil {
+		return k.skipDemandOrder(ctx, eibcDemandOrder, err)
+	}
 	err = k.SetDemandOrder(ctx, eibcDemandOrder)
 	if err != nil {
 		return fmt.Errorf("set eibc demand order: %w", err)
 	}
+	k.ScheduleFeeEscalation(ctx, eibcDemandOrder)
 
 	if err = uevent.EmitTypedEvent(ctx, eibcDemandOrder.GetCreatedEvent()); err != nil {
 		return fmt.Errorf("emit event: %w", err)
 	}
 
+	// Try to fulfill the new order right away from the standing fulfillment intents.
+	// A failure here must not affect the packet, the order just stays open for regular fulfillment.
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.fulfillByIntents(ctx, eibcDemandOrder)
+	})
+	if err != nil {
+		k.Logger(ctx).Error("Fulfill demand order by intents.", "order", eibcDemandOrder.Id, "error", err)
+	}
+
+	return nil
+}
+
+// skipDemandOrder emits the reason why the demand order is not created.
+func (k Keeper) skipDemandOrder(ctx sdk.Context, order *types.DemandOrder, reason error) error {
+	if err := uevent.EmitTypedEvent(ctx, &types.EventDemandOrderCreationSkipped{
+		PacketKey: order.TrackingPacketKey,
+		RollappId: order.RollappId,
+		Reason:    reason.Error(),
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
 	return nil
 }
 
@@ -81,6 +116,10 @@ func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketDat
 	if err := eibcMetaData.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf("validate eibc metadata: %w", err)
 	}
+	// The funds are forwarded on fulfillment, so the forward instructions must be valid up front
+	if _, err := dacktypes.ParseForwardMetadata(fungibleTokenPacketData.Memo); err != nil {
+		return nil, err
+	}
 
 	// Calculate the demand order price and validate it,
 	amt, _ := sdk.NewIntFromString(fungibleTokenPacketData.Amount) // guaranteed ok and positive by above validation
@@ -94,6 +133,16 @@ func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketDat
 	demandOrderRecipient := fungibleTokenPacketData.Receiver // who we tried to send to
 
 	order := types.NewDemandOrder(*rollappPacket, demandOrderPrice, fee, demandOrderDenom, demandOrderRecipient)
+
+	if e := eibcMetaData.FeeEscalation; e != nil {
+		maxFee, step, _ := e.MaxFeeAndStepInt() // guaranteed ok by above validation
+		// The fee is never raised above the max fee, so the price at the max fee must be valid too
+		if _, err := types.CalcPriceWithBridgingFee(amt, maxFee, k.dack.BridgingFee(ctx)); err != nil {
+			return nil, fmt.Errorf("fee escalation max fee: %w", err)
+		}
+		order.FeeEscalation = types.NewFeeEscalation(maxFee, step, e.Interval, ctx.BlockHeight()+int64(e.Interval))
+	}
+
 	return order, nil
 }
 
@@ -39,14 +39,44 @@ func (suite *KeeperTestSuite) TestCreateDemandOrderOnRecv() {
 			expectedErr: true,
 		},
 		{
-			name:        "PFM memo - fail",
+			name:          "PFM memo - create demand order",
+			memo:          `{"eibc":{"fee":"100"},"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`,
+			expectedErr:   false,
+			expectedFee:   "100",
+			expectedPrice: "890",
+		},
+		{
+			name:          "fee escalation memo - create demand order",
+			memo:          `{"eibc":{"fee":"100","fee_escalation":{"max_fee":"500","step":"10","interval":5}}}`,
+			expectedErr:   false,
+			expectedFee:   "100",
+			expectedPrice: "890",
+		},
+		{
+			name:        "fee escalation max fee lower than fee - fail",
+			memo:        `{"eibc":{"fee":"100","fee_escalation":{"max_fee":"50","step":"10","interval":5}}}`,
+			expectedErr: true,
+		},
+		{
+			name:        "fee escalation max fee higher than amount - fail",
+			memo:        `{"eibc":{"fee":"100","fee_escalation":{"max_fee":"1000","step":"10","interval":5}}}`,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid PFM memo - fail",
 			memo:        `{"forward":{}}`,
 			expectedErr: true,
 		},
 	}
 
+	// the cases modify the memo of the shared packet, restore it for the other tests
+	defer func(memo string) {
+		transferPacketData.Memo = memo
+		packet = channeltypes.NewPacket(transferPacketData.GetBytes(), 1, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	}(transferPacketData.Memo)
+
 	// set 1% bridging fee
-	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0) // 1%
+	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0, 0) // 1%
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 
 	amt, _ := sdk.NewIntFromString(transferPacketData.Amount)
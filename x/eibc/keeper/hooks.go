@@ -4,10 +4,14 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	delayeacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 	types "github.com/dymensionxyz/dymension/v3/x/eibc/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
 /* -------------------------------------------------------------------------- */
@@ -52,6 +56,29 @@ func (d delayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *commo
 		return err
 	}
 
+	if packet.Status != commontypes.Status_FINALIZED {
+		return nil
+	}
+
+	// Pay out the finalized funds of a partially fulfilled order to its fulfillers. A failed settlement must not
+	// fail the finalization of the other packets, so it is isolated and the funds stay in the escrow.
+	if demandOrder.IsPartiallyFulfilled() {
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return d.settlePartialFulfillments(ctx, demandOrder)
+		})
+		if err != nil {
+			d.Logger(ctx).Error("Settle partial fulfillments.", "order", demandOrder.Id, "error", err)
+		}
+	}
+
+	// The fee subsidy is only paid once the packet can no longer be reverted
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return d.payFeeSubsidies(ctx, demandOrder)
+	})
+	if err != nil {
+		d.Logger(ctx).Error("Pay fee subsidies.", "order", demandOrder.Id, "error", err)
+	}
+
 	return nil
 }
 
@@ -85,3 +112,71 @@ func (d delayedAckHooks) AfterPacketDeleted(ctx sdk.Context, rollappPacket *comm
 
 	return nil
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                rollapp hooks                               */
+/* -------------------------------------------------------------------------- */
+var _ rollapptypes.RollappHooks = rollappHooks{}
+
+type rollappHooks struct {
+	rollapptypes.StubRollappCreatedHooks
+	Keeper
+}
+
+func (k Keeper) GetRollappHooks() rollapptypes.RollappHooks {
+	return rollappHooks{Keeper: k}
+}
+
+// FraudSubmitted is called after the rollapp is frozen because of a fraud.
+// No demand orders are created for the rollapp while it is frozen.
+func (r rollappHooks) FraudSubmitted(ctx sdk.Context, rollappID string, _ uint64, _, _ string) error {
+	return r.emitRollappPaused(ctx, rollappID)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                               sequencer hooks                              */
+/* -------------------------------------------------------------------------- */
+var _ sequencertypes.SequencerHooks = sequencerHooks{}
+
+type sequencerHooks struct {
+	sequencertypes.BaseSequencerHook
+	Keeper
+}
+
+func (k Keeper) GetSequencerHooks() sequencertypes.SequencerHooks {
+	return sequencerHooks{Keeper: k}
+}
+
+// AfterProposerRotated is called when the proposer of the rollapp changes.
+// No demand orders are created for the rollapp while it has no proposer.
+func (s sequencerHooks) AfterProposerRotated(ctx sdk.Context, rollappId, _ string) error {
+	return s.emitRollappPaused(ctx, rollappId)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 epoch hooks                                */
+/* -------------------------------------------------------------------------- */
+var _ epochstypes.EpochHooks = epochHooks{}
+
+type epochHooks struct {
+	Keeper
+}
+
+func (k Keeper) GetEpochHooks() epochstypes.EpochHooks {
+	return epochHooks{Keeper: k}
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (e epochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook.
+// The fee subsidy pools can pay out up to their epoch cap again in the new epoch.
+func (e epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier != e.EpochIdentifier(ctx) {
+		return nil
+	}
+	e.resetFeeSubsidyEpochs(ctx)
+	return nil
+}
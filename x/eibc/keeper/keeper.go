@@ -3,11 +3,13 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
@@ -25,6 +27,11 @@ type (
 		ak         types.AccountKeeper
 		bk         types.BankKeeper
 		dack       types.DelayedAckKeeper
+		pfm        types.PacketForwardKeeper
+		rk         types.RollappKeeper
+		sk         types.SequencerKeeper
+
+		authority string // authority is the x/gov module account
 	}
 )
 
@@ -36,7 +43,14 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	delayedAckKeeper types.DelayedAckKeeper,
+	rollappKeeper types.RollappKeeper,
+	sequencerKeeper types.SequencerKeeper,
+	authority string,
 ) *Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid x/eibc authority address: %w", err))
+	}
+
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
@@ -50,6 +64,9 @@ func NewKeeper(
 		ak:         accountKeeper,
 		bk:         bankKeeper,
 		dack:       delayedAckKeeper,
+		rk:         rollappKeeper,
+		sk:         sequencerKeeper,
+		authority:  authority,
 	}
 }
 
@@ -67,8 +84,22 @@ func (k Keeper) SetDemandOrder(ctx sdk.Context, order *types.DemandOrder) error
 	if err != nil {
 		return err
 	}
+	// keep the outstanding value of the rollapp in sync with the stored version of the order
+	if bz := store.Get(demandOrderKey); bz != nil {
+		var prev types.DemandOrder
+		k.cdc.MustUnmarshal(bz, &prev)
+		k.addOutstandingOrderValue(ctx, &prev, prev.OutstandingValue().Neg())
+	}
+	k.addOutstandingOrderValue(ctx, order, order.OutstandingValue())
 	store.Set(demandOrderKey, data)
 
+	indexKey := types.GetPendingDemandOrderByRollappDenomKey(order.RollappId, order.Price[0].Denom, order.Id)
+	if order.TrackingPacketStatus == commontypes.Status_PENDING {
+		store.Set(indexKey, []byte(order.Id))
+	} else {
+		store.Delete(indexKey)
+	}
+
 	return nil
 }
 
@@ -78,7 +109,13 @@ func (k Keeper) deleteDemandOrder(ctx sdk.Context, order *types.DemandOrder) err
 	if err != nil {
 		return err
 	}
+	if bz := store.Get(demandOrderKey); bz != nil {
+		var prev types.DemandOrder
+		k.cdc.MustUnmarshal(bz, &prev)
+		k.addOutstandingOrderValue(ctx, &prev, prev.OutstandingValue().Neg())
+	}
 	store.Delete(demandOrderKey)
+	store.Delete(types.GetPendingDemandOrderByRollappDenomKey(order.RollappId, order.Price[0].Denom, order.Id))
 	return nil
 }
 
@@ -119,6 +156,72 @@ func (k Keeper) SetOrderFulfilled(ctx sdk.Context, order *types.DemandOrder, ful
 	return nil
 }
 
+// TransferOrderFulfillment moves the claim on the finalized funds of a fulfilled order to a new fulfiller.
+// The underlying packet is updated by the hooks, the same way as on fulfillment.
+func (k Keeper) TransferOrderFulfillment(ctx sdk.Context, order *types.DemandOrder, newFulfillerAddress sdk.AccAddress) error {
+	prevFulfiller := order.FulfillerAddress
+	order.FulfillerAddress = newFulfillerAddress.String()
+	if err := k.SetDemandOrder(ctx, order); err != nil {
+		return err
+	}
+
+	if err := k.hooks.AfterFulfillmentTransferred(ctx, order, newFulfillerAddress.String()); err != nil {
+		return err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventDemandOrderFulfillmentTransferred{
+		OrderId: order.Id,
+		From:    prevFulfiller,
+		To:      order.FulfillerAddress,
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateDemandOrderFee sets the fee of the order and recalculates its price from the amount of the underlying packet.
+func (k Keeper) UpdateDemandOrderFee(ctx sdk.Context, order *types.DemandOrder, newFee math.Int) error {
+	raPacket, err := k.dack.GetRollappPacket(ctx, order.TrackingPacketKey)
+	if err != nil {
+		return err
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(raPacket.GetPacket().GetData(), &data); err != nil {
+		return err
+	}
+
+	// Get the bridging fee multiplier
+	// ErrAck or Timeout packets do not incur bridging fees
+	bridgingFeeMultiplier := k.dack.BridgingFee(ctx)
+	raPacketType := raPacket.GetType()
+	if raPacketType != commontypes.RollappPacket_ON_RECV {
+		bridgingFeeMultiplier = sdk.ZeroDec()
+	}
+
+	// calculate the new price: transferTotal - newFee - bridgingFee
+	transferTotal, _ := sdk.NewIntFromString(data.Amount)
+	newPrice, err := types.CalcPriceWithBridgingFee(transferTotal, newFee, bridgingFeeMultiplier)
+	if err != nil {
+		return err
+	}
+
+	denom := order.Price[0].Denom
+	order.Fee = sdk.NewCoins(sdk.NewCoin(denom, newFee))
+	order.Price = sdk.NewCoins(sdk.NewCoin(denom, newPrice))
+
+	if err = k.SetDemandOrder(ctx, order); err != nil {
+		return err
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, order.GetUpdatedEvent()); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
 // GetDemandOrder returns the demand order with the given id and status.
 func (k Keeper) GetDemandOrder(ctx sdk.Context, status commontypes.Status, id string) (*types.DemandOrder, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -215,3 +318,9 @@ func (k *Keeper) GetHooks() types.EIBCHooks {
 func (k *Keeper) SetDelayedAckKeeper(delayedAckKeeper types.DelayedAckKeeper) {
 	k.dack = delayedAckKeeper
 }
+
+// SetPacketForwardKeeper sets the packet forward middleware keeper.
+// must be called when initializing the keeper.
+func (k *Keeper) SetPacketForwardKeeper(packetForwardKeeper types.PacketForwardKeeper) {
+	k.pfm = packetForwardKeeper
+}
@@ -7,8 +7,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
@@ -28,48 +28,208 @@ var _ types.MsgServer = msgServer{}
 
 func (m msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrder) (*types.MsgFulfillOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	logger := ctx.Logger()
 
 	err := msg.ValidateBasic()
 	if err != nil {
 		return nil, err
 	}
 
-	demandOrder, err := m.GetOutstandingOrder(ctx, msg.OrderId)
+	// Check that the fulfiller has enough balance to fulfill the order
+	fulfillerAccount := m.ak.GetAccount(ctx, msg.GetFulfillerBech32Address())
+	if fulfillerAccount == nil {
+		return nil, types.ErrFulfillerAddressDoesNotExist
+	}
+
+	if err = m.fulfillOrder(ctx, fulfillerAccount.GetAddress(), msg.OrderId, msg.ExpectedFee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFulfillOrderResponse{}, nil
+}
+
+// FulfillOrders implements types.MsgServer.
+func (m msgServer) FulfillOrders(goCtx context.Context, msg *types.MsgFulfillOrders) (*types.MsgFulfillOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
 	if err != nil {
 		return nil, err
 	}
 
+	fulfillerAccount := m.ak.GetAccount(ctx, msg.GetFulfillerBech32Address())
+	if fulfillerAccount == nil {
+		return nil, types.ErrFulfillerAddressDoesNotExist
+	}
+
+	fulfilled := make([]string, 0, len(msg.Orders))
+	for _, o := range msg.Orders {
+		if !msg.BestEffort {
+			if err = m.fulfillOrder(ctx, fulfillerAccount.GetAddress(), o.OrderId, o.ExpectedFee); err != nil {
+				return nil, errorsmod.Wrapf(err, "order: %s", o.OrderId)
+			}
+			fulfilled = append(fulfilled, o.OrderId)
+			continue
+		}
+
+		// In best effort mode, an order which can't be fulfilled is skipped without any of its changes
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return m.fulfillOrder(ctx, fulfillerAccount.GetAddress(), o.OrderId, o.ExpectedFee)
+		})
+		if err != nil {
+			m.Logger(ctx).Debug("Skipping demand order fulfillment", "order_id", o.OrderId, "error", err)
+			continue
+		}
+		fulfilled = append(fulfilled, o.OrderId)
+	}
+
+	return &types.MsgFulfillOrdersResponse{FulfilledOrderIds: fulfilled}, nil
+}
+
+// fulfillOrder pays the price of the order to its recipient and marks the order as fulfilled by the fulfiller.
+func (m msgServer) fulfillOrder(ctx sdk.Context, fulfiller sdk.AccAddress, orderId, expectedFeeStr string) error {
+	demandOrder, err := m.GetOutstandingOrder(ctx, orderId)
+	if err != nil {
+		return err
+	}
+
+	// Partially fulfilled orders can only be completed by partial fulfillments
+	if demandOrder.IsPartiallyFulfilled() {
+		return types.ErrOrderPartiallyFulfilled
+	}
+
 	// Check that the fulfiller expected fee is equal to the demand order fee
-	expectedFee, _ := sdk.NewIntFromString(msg.ExpectedFee)
+	expectedFee, _ := sdk.NewIntFromString(expectedFeeStr)
 	orderFee := demandOrder.GetFeeAmount()
 	if !orderFee.Equal(expectedFee) {
+		return types.ErrExpectedFeeNotMet
+	}
+
+	// Send the funds from the fulfiller to the eibc packet original recipient
+	err = m.bk.SendCoins(ctx, fulfiller, demandOrder.GetRecipientBech32Address(), demandOrder.Price)
+	if err != nil {
+		ctx.Logger().Error("Failed to send coins", "error", err)
+		return err
+	}
+
+	// Forward the funds onwards if the packet asks to be forwarded
+	if err = m.Keeper.forwardOrderFunds(ctx, demandOrder); err != nil {
+		return err
+	}
+
+	// Fulfill the order by updating the order status and underlying packet recipient
+	if err = m.Keeper.SetOrderFulfilled(ctx, demandOrder, fulfiller); err != nil {
+		return err
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, demandOrder.GetFulfilledEvent()); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// FulfillOrderPartial implements types.MsgServer.
+func (m msgServer) FulfillOrderPartial(goCtx context.Context, msg *types.MsgFulfillOrderPartial) (*types.MsgFulfillOrderPartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	demandOrder, err := m.GetOutstandingOrder(ctx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the fulfiller expected fee is equal to the demand order fee
+	expectedFee, _ := sdk.NewIntFromString(msg.ExpectedFee)
+	if !demandOrder.GetFeeAmount().Equal(expectedFee) {
 		return nil, types.ErrExpectedFeeNotMet
 	}
 
-	// Check that the fulfiller has enough balance to fulfill the order
+	amount, _ := sdk.NewIntFromString(msg.Amount)
+	if amount.GT(demandOrder.RemainingPrice()) {
+		return nil, types.ErrPartialFulfillmentTooLarge
+	}
+
+	if err = m.Keeper.checkOrderNotForwarded(ctx, demandOrder); err != nil {
+		return nil, err
+	}
+
 	fulfillerAccount := m.ak.GetAccount(ctx, msg.GetFulfillerBech32Address())
 	if fulfillerAccount == nil {
 		return nil, types.ErrFulfillerAddressDoesNotExist
 	}
 
-	// Send the funds from the fulfiller to the eibc packet original recipient
-	err = m.bk.SendCoins(ctx, fulfillerAccount.GetAddress(), demandOrder.GetRecipientBech32Address(), demandOrder.Price)
+	// Send the slice of the price from the fulfiller to the eibc packet original recipient
+	slice := sdk.NewCoins(sdk.NewCoin(demandOrder.Price[0].Denom, amount))
+	err = m.bk.SendCoins(ctx, fulfillerAccount.GetAddress(), demandOrder.GetRecipientBech32Address(), slice)
 	if err != nil {
-		logger.Error("Failed to send coins", "error", err)
 		return nil, err
 	}
 
-	// Fulfill the order by updating the order status and underlying packet recipient
-	if err = m.Keeper.SetOrderFulfilled(ctx, demandOrder, fulfillerAccount.GetAddress()); err != nil {
+	if err = m.Keeper.SetOrderPartiallyFulfilled(ctx, demandOrder, fulfillerAccount.GetAddress(), amount); err != nil {
 		return nil, err
 	}
 
-	if err = uevent.EmitTypedEvent(ctx, demandOrder.GetFulfilledEvent()); err != nil {
-		return nil, fmt.Errorf("emit event: %w", err)
+	if demandOrder.IsFulfilled() {
+		if err = uevent.EmitTypedEvent(ctx, demandOrder.GetFulfilledEvent()); err != nil {
+			return nil, fmt.Errorf("emit event: %w", err)
+		}
 	}
 
-	return &types.MsgFulfillOrderResponse{}, nil
+	return &types.MsgFulfillOrderPartialResponse{Fulfilled: demandOrder.IsFulfilled()}, nil
+}
+
+// CreateFulfillmentIntent implements types.MsgServer.
+func (m msgServer) CreateFulfillmentIntent(goCtx context.Context, msg *types.MsgCreateFulfillmentIntent) (*types.MsgCreateFulfillmentIntentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	intent, err := m.Keeper.CreateFulfillmentIntent(ctx, types.NewFulfillmentIntent(
+		0,
+		msg.FulfillerAddress,
+		msg.RollappId,
+		msg.Denom,
+		msg.MinFeePercentage,
+		msg.MaxPrice,
+		msg.Budget,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateFulfillmentIntentResponse{Id: intent.Id}, nil
+}
+
+// CancelFulfillmentIntent implements types.MsgServer.
+func (m msgServer) CancelFulfillmentIntent(goCtx context.Context, msg *types.MsgCancelFulfillmentIntent) (*types.MsgCancelFulfillmentIntentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	intent, err := m.GetFulfillmentIntent(ctx, msg.IntentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if intent.FulfillerAddress != msg.FulfillerAddress {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the intent owner can cancel the intent")
+	}
+
+	if err = m.CloseFulfillmentIntent(ctx, intent); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelFulfillmentIntentResponse{}, nil
 }
 
 // UpdateDemandOrder implements types.MsgServer.
@@ -94,45 +254,91 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the recipient can update the order")
 	}
 
-	raPacket, err := m.dack.GetRollappPacket(ctx, demandOrder.TrackingPacketKey)
-	if err != nil {
+	// The fee shares of the fulfillers are bound to the price they paid, so it can't change anymore
+	if demandOrder.IsPartiallyFulfilled() {
+		return nil, types.ErrOrderPartiallyFulfilled
+	}
+
+	// The recipient takes over the fee, so the escalation schedule, if any, is cancelled
+	demandOrder.FeeEscalation = nil
+
+	newFeeInt, _ := sdk.NewIntFromString(msg.NewFee)
+	if err = m.UpdateDemandOrderFee(ctx, demandOrder, newFeeInt); err != nil {
 		return nil, err
 	}
 
-	var data transfertypes.FungibleTokenPacketData
-	if err := transfertypes.ModuleCdc.UnmarshalJSON(raPacket.GetPacket().GetData(), &data); err != nil {
+	return &types.MsgUpdateDemandOrderResponse{}, nil
+}
+
+// SetRollappRiskLimits implements types.MsgServer.
+func (m msgServer) SetRollappRiskLimits(goCtx context.Context, msg *types.MsgSetRollappRiskLimits) (*types.MsgSetRollappRiskLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
 		return nil, err
 	}
 
-	// Get the bridging fee multiplier
-	// ErrAck or Timeout packets do not incur bridging fees
-	bridgingFeeMultiplier := m.dack.BridgingFee(ctx)
-	raPacketType := raPacket.GetType()
-	if raPacketType != commontypes.RollappPacket_ON_RECV {
-		bridgingFeeMultiplier = sdk.ZeroDec()
+	rollapp, found := m.rk.GetRollapp(ctx, msg.Limits.RollappId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "rollapp: %s", msg.Limits.RollappId)
 	}
 
-	// calculate the new price: transferTotal - newFee - bridgingFee
-	newFeeInt, _ := sdk.NewIntFromString(msg.NewFee)
-	transferTotal, _ := sdk.NewIntFromString(data.Amount)
-	newPrice, err := types.CalcPriceWithBridgingFee(transferTotal, newFeeInt, bridgingFeeMultiplier)
+	// Check that the signer is the governance or the rollapp owner
+	if msg.Signer != m.authority && msg.Signer != rollapp.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the governance or the rollapp owner can set the risk limits")
+	}
+
+	m.Keeper.SetRollappRiskLimits(ctx, msg.Limits)
+
+	if err = uevent.EmitTypedEvent(ctx, &types.EventRollappRiskLimitsSet{
+		Limits: msg.Limits,
+		Signer: msg.Signer,
+	}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgSetRollappRiskLimitsResponse{}, nil
+}
+
+// TransferFulfillment implements types.MsgServer.
+func (m msgServer) TransferFulfillment(goCtx context.Context, msg *types.MsgTransferFulfillment) (*types.MsgTransferFulfillmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
 	if err != nil {
 		return nil, err
 	}
 
-	denom := demandOrder.Price[0].Denom
-	demandOrder.Fee = sdk.NewCoins(sdk.NewCoin(denom, newFeeInt))
-	demandOrder.Price = sdk.NewCoins(sdk.NewCoin(denom, newPrice))
-
-	if err = m.SetDemandOrder(ctx, demandOrder); err != nil {
+	// The claim can be transferred only until the underlying packet is finalized
+	demandOrder, err := m.GetDemandOrder(ctx, commontypes.Status_PENDING, msg.OrderId)
+	if err != nil {
 		return nil, err
 	}
+	if !demandOrder.IsFulfilled() {
+		return nil, types.ErrDemandOrderNotFulfilled
+	}
 
-	if err = uevent.EmitTypedEvent(ctx, demandOrder.GetUpdatedEvent()); err != nil {
-		return nil, fmt.Errorf("emit event: %w", err)
+	// The finalized funds of partially fulfilled orders are split by the order escrow
+	if demandOrder.IsPartiallyFulfilled() {
+		return nil, types.ErrOrderPartiallyFulfilled
 	}
 
-	return &types.MsgUpdateDemandOrderResponse{}, nil
+	// Check that the signer holds the claim
+	if demandOrder.FulfillerAddress != msg.FulfillerAddress {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the fulfiller can transfer the fulfillment")
+	}
+
+	// The new fulfiller must be able to receive the finalized funds
+	if m.BlockedAddr(msg.NewFulfillerAddress) {
+		return nil, types.ErrBlockedAddress
+	}
+
+	if err = m.TransferOrderFulfillment(ctx, demandOrder, msg.GetNewFulfillerBech32Address()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferFulfillmentResponse{}, nil
 }
 
 func (m msgServer) GetOutstandingOrder(ctx sdk.Context, orderId string) (*types.DemandOrder, error) {
@@ -144,3 +350,114 @@ func (m msgServer) GetOutstandingOrder(ctx sdk.Context, orderId string) (*types.
 
 	return demandOrder, demandOrder.ValidateOrderIsOutstanding()
 }
+
+// SetFeeSubsidy implements types.MsgServer.
+func (m msgServer) SetFeeSubsidy(goCtx context.Context, msg *types.MsgSetFeeSubsidy) (*types.MsgSetFeeSubsidyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = m.checkRollappOwner(ctx, msg.RollappId, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	subsidy, _ := m.GetFeeSubsidy(ctx, msg.RollappId)
+	subsidy.SubsidyPercentage = msg.SubsidyPercentage
+	subsidy.EpochCap = msg.EpochCap
+	m.Keeper.SetFeeSubsidy(ctx, subsidy)
+
+	if err = uevent.EmitTypedEvent(ctx, &types.EventFeeSubsidySet{
+		RollappId:         msg.RollappId,
+		SubsidyPercentage: msg.SubsidyPercentage.String(),
+		EpochCap:          msg.EpochCap.String(),
+	}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgSetFeeSubsidyResponse{}, nil
+}
+
+// FundFeeSubsidy implements types.MsgServer.
+func (m msgServer) FundFeeSubsidy(goCtx context.Context, msg *types.MsgFundFeeSubsidy) (*types.MsgFundFeeSubsidyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = m.checkRollappOwner(ctx, msg.RollappId, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	if err = m.Keeper.FundFeeSubsidy(ctx, msg.RollappId, sdk.MustAccAddressFromBech32(msg.Owner), msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundFeeSubsidyResponse{}, nil
+}
+
+// WithdrawFeeSubsidy implements types.MsgServer.
+func (m msgServer) WithdrawFeeSubsidy(goCtx context.Context, msg *types.MsgWithdrawFeeSubsidy) (*types.MsgWithdrawFeeSubsidyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = m.checkRollappOwner(ctx, msg.RollappId, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	if err = m.Keeper.WithdrawFeeSubsidy(ctx, msg.RollappId, sdk.MustAccAddressFromBech32(msg.Owner), msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawFeeSubsidyResponse{}, nil
+}
+
+// SetRollappFeeFloor implements types.MsgServer.
+func (m msgServer) SetRollappFeeFloor(goCtx context.Context, msg *types.MsgSetRollappFeeFloor) (*types.MsgSetRollappFeeFloorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the governance can set the rollapp fee floor")
+	}
+
+	event := &types.EventRollappFeeFloorSet{RollappId: msg.Floor.RollappId}
+	if msg.Remove {
+		m.DeleteRollappFeeFloor(ctx, msg.Floor.RollappId)
+	} else {
+		if _, found := m.rk.GetRollapp(ctx, msg.Floor.RollappId); !found {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "rollapp: %s", msg.Floor.RollappId)
+		}
+		m.Keeper.SetRollappFeeFloor(ctx, msg.Floor)
+		event.MinFeePercentage = msg.Floor.MinFeePercentage.String()
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgSetRollappFeeFloorResponse{}, nil
+}
+
+func (m msgServer) checkRollappOwner(ctx sdk.Context, rollappId, signer string) error {
+	rollapp, found := m.rk.GetRollapp(ctx, rollappId)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "rollapp: %s", rollappId)
+	}
+	if signer != rollapp.Owner {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the rollapp owner can manage the fee subsidy")
+	}
+	return nil
+}
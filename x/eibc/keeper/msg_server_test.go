@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
@@ -227,7 +228,7 @@ func (suite *KeeperTestSuite) TestMsgUpdateDemandOrder() {
 	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, sdk.NewInt(100_000))
 	eibcSupplyAddr := testAddresses[0]
 
-	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0) // 1%
+	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0, 0) // 1%
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 	denom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
 
@@ -299,7 +300,7 @@ func (suite *KeeperTestSuite) TestUpdateDemandOrderOnAckOrTimeout() {
 	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, sdk.NewInt(100_000))
 	eibcSupplyAddr := testAddresses[0]
 
-	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0) // 1%
+	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0, 0) // 1%
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 
 	denom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
@@ -331,3 +332,215 @@ func (suite *KeeperTestSuite) TestUpdateDemandOrderOnAckOrTimeout() {
 	suite.Assert().Equal(updatedDemandOrder.Fee.AmountOf(denom), newFee)
 	suite.Assert().Equal(updatedDemandOrder.Price.AmountOf(denom), expectedNewPrice)
 }
+
+func (suite *KeeperTestSuite) TestMsgFulfillOrderPartial() {
+	// Create and fund the accounts
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, sdk.NewInt(1000))
+	recipient := testAddresses[0]
+	fulfillerA := testAddresses[1]
+	fulfillerB := testAddresses[2]
+	denom := sdk.DefaultBondDenom
+
+	// Set the rollapp packet and create a demand order with price 200 and fee 50
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(200), math.NewInt(50), denom, recipient.String())
+	err := suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder)
+	suite.Require().NoError(err)
+
+	// Wrong expected fee
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerA.String(), demandOrder.Id, "30", "50"))
+	suite.Require().ErrorIs(err, types.ErrExpectedFeeNotMet)
+
+	// First slice
+	res, err := suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerA.String(), demandOrder.Id, "50", "50"))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Fulfilled)
+
+	demandOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().False(demandOrder.IsFulfilled())
+	suite.Require().Equal(math.NewInt(150), demandOrder.RemainingPrice())
+
+	// The order is still listed as unfulfilled
+	orders, err := suite.App.EIBCKeeper.ListDemandOrdersByStatus(suite.Ctx, commontypes.Status_PENDING, 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(orders, 1)
+
+	// The underlying packet is redirected to the order escrow
+	escrow := demandOrder.PartialFulfillmentEscrowAddress()
+	packet, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, demandOrder.TrackingPacketKey)
+	suite.Require().NoError(err)
+	data, err := packet.GetTransferPacketData()
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrow.String(), data.Receiver)
+	suite.Require().Equal(eibcReceiverAddr.String(), packet.OriginalTransferTarget)
+
+	// Full fulfillment and fee updates are not allowed anymore
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfillerB.String(), demandOrder.Id, "50"))
+	suite.Require().ErrorIs(err, types.ErrOrderPartiallyFulfilled)
+	_, err = suite.msgServer.UpdateDemandOrder(suite.Ctx, types.NewMsgUpdateDemandOrder(recipient.String(), demandOrder.Id, "60"))
+	suite.Require().ErrorIs(err, types.ErrOrderPartiallyFulfilled)
+
+	// A slice larger than the remaining price
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerB.String(), demandOrder.Id, "50", "151"))
+	suite.Require().ErrorIs(err, types.ErrPartialFulfillmentTooLarge)
+
+	// The last slice completes the order
+	res, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillerB.String(), demandOrder.Id, "50", "150"))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Fulfilled)
+
+	demandOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(demandOrder.IsFulfilled())
+	suite.Require().Equal(escrow.String(), demandOrder.FulfillerAddress)
+
+	// The recipient got the whole price
+	suite.Require().Equal(math.NewInt(1200), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount)
+
+	// Simulate the finalized transfer to the escrow: price + fee
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, recipient, escrow, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(250))))
+	suite.Require().NoError(err)
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketWithStatus(suite.Ctx, *packet, commontypes.Status_FINALIZED)
+	suite.Require().NoError(err)
+
+	// Fulfillers are paid pro-rata: A paid 50 of 200, B paid 150 of 200
+	suite.Require().Equal(math.NewInt(1000-50+62), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerA, denom).Amount)
+	suite.Require().Equal(math.NewInt(1000-150+188), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerB, denom).Amount)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, escrow, denom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSettlePartiallyFilledOrder() {
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, sdk.NewInt(1000))
+	recipient := testAddresses[0]
+	fulfiller := testAddresses[1]
+	denom := sdk.DefaultBondDenom
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(200), math.NewInt(50), denom, recipient.String())
+	err := suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder)
+	suite.Require().NoError(err)
+
+	// Only a quarter of the order is filled
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller.String(), demandOrder.Id, "50", "50"))
+	suite.Require().NoError(err)
+
+	escrow := demandOrder.PartialFulfillmentEscrowAddress()
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, recipient, escrow, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(250))))
+	suite.Require().NoError(err)
+
+	packet, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, demandOrder.TrackingPacketKey)
+	suite.Require().NoError(err)
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketWithStatus(suite.Ctx, *packet, commontypes.Status_FINALIZED)
+	suite.Require().NoError(err)
+
+	// The fulfiller gets a quarter of the funds, the recipient gets the rest back
+	suite.Require().Equal(math.NewInt(1000-50+62), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+	suite.Require().Equal(math.NewInt(1000+50-250+188), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestMsgTransferFulfillment() {
+	// Create and fund the accounts
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 4, sdk.NewInt(1000))
+	recipient := testAddresses[0]
+	fulfiller := testAddresses[1]
+	buyer := testAddresses[2]
+	nextBuyer := testAddresses[3]
+	denom := sdk.DefaultBondDenom
+
+	// Set the rollapp packet and create a demand order with price 200 and fee 50
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(200), math.NewInt(50), denom, recipient.String())
+	err := suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder)
+	suite.Require().NoError(err)
+
+	// An unfulfilled order has no claim to transfer
+	_, err = suite.msgServer.TransferFulfillment(suite.Ctx, types.NewMsgTransferFulfillment(fulfiller.String(), demandOrder.Id, buyer.String()))
+	suite.Require().ErrorIs(err, types.ErrDemandOrderNotFulfilled)
+
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), demandOrder.Id, "50"))
+	suite.Require().NoError(err)
+
+	// Only the fulfiller can transfer the claim
+	_, err = suite.msgServer.TransferFulfillment(suite.Ctx, types.NewMsgTransferFulfillment(buyer.String(), demandOrder.Id, nextBuyer.String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// The claim can't be transferred to a blocked address
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	_, err = suite.msgServer.TransferFulfillment(suite.Ctx, types.NewMsgTransferFulfillment(fulfiller.String(), demandOrder.Id, moduleAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrBlockedAddress)
+
+	// The claim can be transferred several times, the packet always keeps the original recipient
+	_, err = suite.msgServer.TransferFulfillment(suite.Ctx, types.NewMsgTransferFulfillment(fulfiller.String(), demandOrder.Id, buyer.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, "dymensionxyz.dymension.eibc.EventDemandOrderFulfillmentTransferred", 1)
+	_, err = suite.msgServer.TransferFulfillment(suite.Ctx, types.NewMsgTransferFulfillment(buyer.String(), demandOrder.Id, nextBuyer.String()))
+	suite.Require().NoError(err)
+
+	demandOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(nextBuyer.String(), demandOrder.FulfillerAddress)
+
+	packet, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, demandOrder.TrackingPacketKey)
+	suite.Require().NoError(err)
+	data, err := packet.GetTransferPacketData()
+	suite.Require().NoError(err)
+	suite.Require().Equal(nextBuyer.String(), data.Receiver)
+	suite.Require().Equal(eibcReceiverAddr.String(), packet.OriginalTransferTarget)
+
+	// The claim can't be transferred once the packet is finalized
+	_, err = suite.App.EIBCKeeper.UpdateDemandOrderWithStatus(suite.Ctx, demandOrder, commontypes.Status_FINALIZED)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.TransferFulfillment(suite.Ctx, types.NewMsgTransferFulfillment(nextBuyer.String(), demandOrder.Id, buyer.String()))
+	suite.Require().ErrorIs(err, types.ErrDemandOrderDoesNotExist)
+}
+
+func (suite *KeeperTestSuite) TestMsgFulfillOrders() {
+	fulfiller := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, sdk.NewInt(1000))[0]
+	denom := sdk.DefaultBondDenom
+
+	// Create 3 demand orders with price 200 and fee 50
+	orders := make([]*types.DemandOrder, 3)
+	for i := range orders {
+		p := channeltypes.NewPacket(transferPacketData.GetBytes(), uint64(i+1), portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		raPacket := commontypes.RollappPacket{
+			RollappId: rollappPacket.RollappId,
+			Status:    commontypes.Status_PENDING,
+			Type:      commontypes.RollappPacket_ON_RECV,
+			Packet:    &p,
+		}
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, raPacket)
+		orders[i] = types.NewDemandOrder(raPacket, math.NewInt(200), math.NewInt(50), denom, eibcReceiverAddr.String())
+		suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, orders[i]))
+	}
+	batch := []types.OrderFulfillment{
+		types.NewOrderFulfillment(orders[0].Id, "50"),
+		types.NewOrderFulfillment(orders[1].Id, "50"),
+		types.NewOrderFulfillment(orders[2].Id, "50"),
+	}
+
+	// A competing fulfiller takes the first order
+	_, err := suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), orders[0].Id, "50"))
+	suite.Require().NoError(err)
+
+	// Duplicate orders are rejected
+	_, err = suite.msgServer.FulfillOrders(suite.Ctx, types.NewMsgFulfillOrders(fulfiller.String(), append(batch, batch[1]), false))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// Without best effort the whole batch fails
+	_, err = suite.msgServer.FulfillOrders(suite.Ctx, types.NewMsgFulfillOrders(fulfiller.String(), batch, false))
+	suite.Require().ErrorIs(err, types.ErrDemandAlreadyFulfilled)
+
+	// With best effort the taken order is skipped
+	res, err := suite.msgServer.FulfillOrders(suite.Ctx, types.NewMsgFulfillOrders(fulfiller.String(), batch, true))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{orders[1].Id, orders[2].Id}, res.FulfilledOrderIds)
+	suite.AssertEventEmitted(suite.Ctx, "dymensionxyz.dymension.eibc.EventDemandOrderFulfilled", 3)
+
+	for _, order := range orders {
+		order, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(fulfiller.String(), order.FulfillerAddress)
+	}
+	suite.Require().Equal(math.NewInt(400), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+}
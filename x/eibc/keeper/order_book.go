@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// listPendingDemandOrdersByRollapp returns the pending demand orders of the rollapp grouped by denom, using the index.
+// If the denom is not empty, only the orders in that denom are returned.
+func (k Keeper) listPendingDemandOrdersByRollapp(ctx sdk.Context, rollappId, denom string) (map[string][]*types.DemandOrder, error) {
+	prefix := types.GetPendingDemandOrdersByRollappPrefix(rollappId)
+	if denom != "" {
+		prefix = types.GetPendingDemandOrdersByRollappDenomPrefix(rollappId, denom)
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close() // nolint: errcheck
+
+	byDenom := make(map[string][]*types.DemandOrder)
+	for ; iterator.Valid(); iterator.Next() {
+		// the denom may contain the key separator, so the order id is kept in the value
+		id := string(iterator.Value())
+		order, err := k.GetDemandOrder(ctx, commontypes.Status_PENDING, id)
+		if err != nil {
+			// the index is always written together with the order
+			return nil, fmt.Errorf("pending demand order index is out of sync: %s: %w", id, err)
+		}
+		orderDenom := order.Price[0].Denom
+		if denom != "" && orderDenom != denom {
+			continue
+		}
+		byDenom[orderDenom] = append(byDenom[orderDenom], order)
+	}
+	return byDenom, nil
+}
+
+// GetOrderBookStats returns the statistics of the pending demand orders of the rollapp per denom, sorted by denom.
+// If the denom is not empty, only the statistics of that denom are returned.
+func (k Keeper) GetOrderBookStats(ctx sdk.Context, rollappId, denom string) ([]types.OrderBookStats, error) {
+	byDenom, err := k.listPendingDemandOrdersByRollapp(ctx, rollappId, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, 0, len(byDenom))
+	for d := range byDenom {
+		denoms = append(denoms, d)
+	}
+	sort.Strings(denoms)
+
+	stats := make([]types.OrderBookStats, 0, len(denoms))
+	for _, d := range denoms {
+		stats = append(stats, types.NewOrderBookStats(rollappId, d, byDenom[d], ctx.BlockHeight()))
+	}
+	return stats, nil
+}
+
+// ReindexPendingDemandOrders rebuilds the state derived from the pending demand orders, i.e. the index by
+// rollapp and denom and the outstanding order values. It is meant for orders stored before the derived state existed.
+// The creation height of these orders is unknown, so it is set to the current height.
+func (k Keeper) ReindexPendingDemandOrders(ctx sdk.Context) error {
+	orders, err := k.ListDemandOrdersByStatus(ctx, commontypes.Status_PENDING, 0)
+	if err != nil {
+		return err
+	}
+	for _, order := range orders {
+		// the order is not counted in the derived state yet, so the raw entry is dropped before setting it again
+		key, err := types.GetDemandOrderKey(order.TrackingPacketStatus, order.Id)
+		if err != nil {
+			return err
+		}
+		ctx.KVStore(k.storeKey).Delete(key)
+		if order.CreationHeight == 0 {
+			order.CreationHeight = ctx.BlockHeight()
+		}
+		if err := k.SetDemandOrder(ctx, order); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.EpochIdentifier(ctx),
 		k.TimeoutFee(ctx),
 		k.ErrAckFee(ctx),
+		k.MinFeePercentage(ctx),
 	)
 }
 
@@ -33,3 +34,8 @@ func (k Keeper) ErrAckFee(ctx sdk.Context) (res sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyErrAckFee, &res)
 	return
 }
+
+func (k Keeper) MinFeePercentage(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinFeePercentage, &res)
+	return
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// SetOrderPartiallyFulfilled records a slice of the order price paid by the fulfiller.
+// The first slice redirects the underlying packet to the order escrow, so the finalized funds can be split
+// between the fulfillers. The slice which completes the price marks the order as fulfilled.
+func (k Keeper) SetOrderPartiallyFulfilled(ctx sdk.Context, order *types.DemandOrder, fulfillerAddress sdk.AccAddress, amount math.Int) error {
+	if amount.GT(order.RemainingPrice()) {
+		return types.ErrPartialFulfillmentTooLarge
+	}
+
+	escrow := order.PartialFulfillmentEscrowAddress()
+	firstSlice := !order.IsPartiallyFulfilled()
+
+	fulfillment := types.PartialFulfillment{
+		FulfillerAddress: fulfillerAddress.String(),
+		Amount:           amount,
+	}
+	order.PartialFulfillments = append(order.PartialFulfillments, fulfillment)
+	if order.RemainingPrice().IsZero() {
+		order.FulfillerAddress = escrow.String()
+	}
+
+	if err := k.SetDemandOrder(ctx, order); err != nil {
+		return err
+	}
+
+	// The underlying packet is redirected only once, all the following slices share the same escrow.
+	if firstSlice {
+		if err := k.hooks.AfterDemandOrderFulfilled(ctx, order, escrow.String()); err != nil {
+			return err
+		}
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, order.GetPartiallyFulfilledEvent(fulfillment)); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// settlePartialFulfillments pays out the funds received by the escrow of a partially fulfilled order
+// once the underlying packet is finalized. Each fulfiller gets a share of the funds pro-rata to the slice
+// of the price they paid, and the recipient gets the share of the unfilled part of the order.
+// If the escrow holds nothing, e.g. because the transfer failed and the funds were refunded on the rollapp,
+// there is nothing to pay out.
+func (k Keeper) settlePartialFulfillments(ctx sdk.Context, order *types.DemandOrder) error {
+	escrow := order.PartialFulfillmentEscrowAddress()
+	denom := order.Price[0].Denom
+
+	total := k.bk.GetBalance(ctx, escrow, denom).Amount
+	if !total.IsPositive() {
+		return nil
+	}
+
+	for _, payout := range order.SplitPartialFulfillmentFunds(total) {
+		if !payout.Amount.IsPositive() {
+			continue
+		}
+		receiver := sdk.MustAccAddressFromBech32(payout.FulfillerAddress)
+		if err := k.bk.SendCoins(ctx, escrow, receiver, sdk.NewCoins(sdk.NewCoin(denom, payout.Amount))); err != nil {
+			return fmt.Errorf("send partial fulfillment payout: %w", err)
+		}
+
+		if err := uevent.EmitTypedEvent(ctx, &types.EventPartialFulfillmentSettled{
+			OrderId:  order.Id,
+			Receiver: payout.FulfillerAddress,
+			Amount:   sdk.NewCoin(denom, payout.Amount).String(),
+		}); err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+	}
+
+	return nil
+}
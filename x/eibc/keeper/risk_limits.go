@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// SetRollappRiskLimits stores the risk limits of the rollapp. Empty limits are removed from the store.
+func (k Keeper) SetRollappRiskLimits(ctx sdk.Context, limits types.RollappRiskLimits) {
+	store := ctx.KVStore(k.storeKey)
+	if limits.IsEmpty() {
+		store.Delete(types.GetRollappRiskLimitsKey(limits.RollappId))
+		return
+	}
+	store.Set(types.GetRollappRiskLimitsKey(limits.RollappId), k.cdc.MustMarshal(&limits))
+}
+
+// GetRollappRiskLimits returns the risk limits of the rollapp.
+func (k Keeper) GetRollappRiskLimits(ctx sdk.Context, rollappId string) (types.RollappRiskLimits, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRollappRiskLimitsKey(rollappId))
+	if bz == nil {
+		return types.RollappRiskLimits{RollappId: rollappId}, false
+	}
+	var limits types.RollappRiskLimits
+	k.cdc.MustUnmarshal(bz, &limits)
+	return limits, true
+}
+
+// ListRollappRiskLimits returns the risk limits of all rollapps.
+func (k Keeper) ListRollappRiskLimits(ctx sdk.Context) (list []types.RollappRiskLimits) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RollappRiskLimitsKeyPrefix)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RollappRiskLimits
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// GetOutstandingOrderValue returns the total remaining price of the outstanding orders of the rollapp.
+func (k Keeper) GetOutstandingOrderValue(ctx sdk.Context, rollappId string) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetOutstandingOrderValuesPrefix(rollappId)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close() // nolint: errcheck
+
+	var coins sdk.Coins
+	for ; iterator.Valid(); iterator.Next() {
+		var amt math.Int
+		if err := amt.Unmarshal(iterator.Value()); err != nil {
+			panic(fmt.Errorf("unmarshal outstanding order value: %w", err))
+		}
+		coins = coins.Add(sdk.NewCoin(string(iterator.Key()[len(prefix):]), amt))
+	}
+	return coins
+}
+
+func (k Keeper) getOutstandingOrderValue(ctx sdk.Context, rollappId, denom string) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetOutstandingOrderValueKey(rollappId, denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	var amt math.Int
+	if err := amt.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("unmarshal outstanding order value: %w", err))
+	}
+	return amt
+}
+
+// addOutstandingOrderValue adds the (possibly negative) delta to the outstanding value of the rollapp in the order denom.
+func (k Keeper) addOutstandingOrderValue(ctx sdk.Context, order *types.DemandOrder, delta math.Int) {
+	if delta.IsZero() {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOutstandingOrderValueKey(order.RollappId, order.Price[0].Denom)
+	amt := k.getOutstandingOrderValue(ctx, order.RollappId, order.Price[0].Denom).Add(delta)
+	if !amt.IsPositive() {
+		store.Delete(key)
+		return
+	}
+	bz, err := amt.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("marshal outstanding order value: %w", err))
+	}
+	store.Set(key, bz)
+}
+
+// IsRollappPaused returns true with the reason if no demand orders should be created for the rollapp,
+// i.e. the rollapp is frozen because of a fraud or it has no proposer.
+func (k Keeper) IsRollappPaused(ctx sdk.Context, rollappId string) (bool, string) {
+	rollapp, found := k.rk.GetRollapp(ctx, rollappId)
+	if !found {
+		return false, ""
+	}
+	if rollapp.Frozen {
+		return true, "rollapp is frozen"
+	}
+	if _, found := k.sk.GetProposer(ctx, rollappId); !found {
+		return true, "rollapp has no proposer"
+	}
+	return false, ""
+}
+
+// checkRiskLimits returns an error if the order cannot be created because the rollapp is paused
+// or the order breaks the risk limits of the rollapp.
+func (k Keeper) checkRiskLimits(ctx sdk.Context, order *types.DemandOrder) error {
+	if paused, reason := k.IsRollappPaused(ctx, order.RollappId); paused {
+		return errorsmod.Wrap(types.ErrRollappPaused, reason)
+	}
+	limits, found := k.GetRollappRiskLimits(ctx, order.RollappId)
+	if !found {
+		return nil
+	}
+	outstanding := k.getOutstandingOrderValue(ctx, order.RollappId, order.Price[0].Denom)
+	return limits.CheckOrder(order.Price[0], outstanding)
+}
+
+// emitRollappPaused emits an event if the creation of demand orders of the rollapp is paused.
+func (k Keeper) emitRollappPaused(ctx sdk.Context, rollappId string) error {
+	paused, reason := k.IsRollappPaused(ctx, rollappId)
+	if !paused {
+		return nil
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventRollappDemandOrdersPaused{
+		RollappId: rollappId,
+		Reason:    reason,
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
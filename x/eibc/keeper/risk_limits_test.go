@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uibc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestRollappRiskLimits() {
+	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dacktypes.NewParams("hour", sdk.ZeroDec(), 0, 0))
+	rollappId, _ := suite.CreateDefaultRollappAndProposer()
+	// the orders are in the ibc denom of the transferred token on the hub
+	orderDenom := uibc.GetForeignDenomTrace(cpchanid, sdk.DefaultBondDenom).IBCDenom()
+
+	sequence := uint64(0)
+	createOrder := func(rollappId string, amount int64) (*types.DemandOrder, bool) {
+		sequence++
+		data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, math.NewInt(amount).String(), eibcSenderAddr.String(), eibcReceiverAddr.String(), "")
+		p := channeltypes.NewPacket(data.GetBytes(), sequence, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		raPacket := commontypes.RollappPacket{
+			RollappId: rollappId,
+			Status:    commontypes.Status_PENDING,
+			Type:      commontypes.RollappPacket_ON_RECV,
+			Packet:    &p,
+		}
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, raPacket)
+		err := suite.App.EIBCKeeper.EIBCDemandOrderHandler(suite.Ctx, raPacket, data)
+		suite.Require().NoError(err)
+		order, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, types.BuildDemandIDFromPacketKey(string(raPacket.RollappPacketKey())))
+		if err != nil {
+			suite.Require().ErrorIs(err, types.ErrDemandOrderDoesNotExist)
+			return nil, false
+		}
+		return order, true
+	}
+	queryLimits := func(rollappId string) *types.QueryRollappRiskLimitsResponse {
+		res, err := suite.queryClient.RollappRiskLimits(sdk.WrapSDKContext(suite.Ctx), &types.QueryRollappRiskLimitsRequest{RollappId: rollappId})
+		suite.Require().NoError(err)
+		return res
+	}
+
+	limits := types.NewRollappRiskLimits(
+		rollappId,
+		sdk.NewCoins(sdk.NewInt64Coin(orderDenom, 800)),
+		sdk.NewCoins(sdk.NewInt64Coin(orderDenom, 500)),
+	)
+
+	// Only the governance or the rollapp owner can set the limits
+	stranger := apptesting.CreateRandomAccounts(1)[0]
+	_, err := suite.msgServer.SetRollappRiskLimits(suite.Ctx, types.NewMsgSetRollappRiskLimits(stranger.String(), limits))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	rollapp, found := suite.App.RollappKeeper.GetRollapp(suite.Ctx, rollappId)
+	suite.Require().True(found)
+	_, err = suite.msgServer.SetRollappRiskLimits(suite.Ctx, types.NewMsgSetRollappRiskLimits(rollapp.Owner, limits))
+	suite.Require().NoError(err)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = suite.msgServer.SetRollappRiskLimits(suite.Ctx, types.NewMsgSetRollappRiskLimits(gov, limits))
+	suite.Require().NoError(err)
+
+	// An order above the max order price is not created
+	first, created := createOrder(rollappId, 400)
+	suite.Require().True(created)
+	_, created = createOrder(rollappId, 600)
+	suite.Require().False(created)
+	suite.AssertEventEmitted(suite.Ctx, "dymensionxyz.dymension.eibc.EventDemandOrderCreationSkipped", 1)
+
+	// An order above the max outstanding value is not created
+	_, created = createOrder(rollappId, 400)
+	suite.Require().True(created)
+	_, created = createOrder(rollappId, 100)
+	suite.Require().False(created)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(orderDenom, 800)), queryLimits(rollappId).Outstanding)
+
+	// Fulfilled orders are not outstanding anymore
+	fulfiller := apptesting.CreateRandomAccounts(1)[0]
+	suite.FundAcc(fulfiller, first.Price)
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), first.Id, "0"))
+	suite.Require().NoError(err)
+	_, created = createOrder(rollappId, 100)
+	suite.Require().True(created)
+
+	res := queryLimits(rollappId)
+	suite.Require().Equal(limits, res.Limits)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(orderDenom, 500)), res.Outstanding)
+	suite.Require().False(res.Paused)
+
+	// No orders are created while the rollapp is frozen
+	rollapp.Frozen = true
+	suite.App.RollappKeeper.SetRollapp(suite.Ctx, rollapp)
+	_, created = createOrder(rollappId, 100)
+	suite.Require().False(created)
+	res = queryLimits(rollappId)
+	suite.Require().True(res.Paused)
+	suite.Require().Equal("rollapp is frozen", res.PauseReason)
+
+	// No orders are created while the rollapp has no proposer
+	noProposer := suite.CreateDefaultRollapp()
+	_, created = createOrder(noProposer, 100)
+	suite.Require().False(created)
+	res = queryLimits(noProposer)
+	suite.Require().True(res.Paused)
+	suite.Require().Equal("rollapp has no proposer", res.PauseReason)
+
+	// Empty limits remove the limits of the rollapp
+	_, err = suite.msgServer.SetRollappRiskLimits(suite.Ctx, types.NewMsgSetRollappRiskLimits(gov, types.RollappRiskLimits{RollappId: rollappId}))
+	suite.Require().NoError(err)
+	_, found = suite.App.EIBCKeeper.GetRollappRiskLimits(suite.Ctx, rollappId)
+	suite.Require().False(found)
+}
@@ -11,6 +11,16 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFulfillOrder{}, "eibc/MsgFulfillOrder", nil)
+	cdc.RegisterConcrete(&MsgFulfillOrders{}, "eibc/MsgFulfillOrders", nil)
+	cdc.RegisterConcrete(&MsgFulfillOrderPartial{}, "eibc/MsgFulfillOrderPartial", nil)
+	cdc.RegisterConcrete(&MsgCreateFulfillmentIntent{}, "eibc/MsgCreateFulfillmentIntent", nil)
+	cdc.RegisterConcrete(&MsgCancelFulfillmentIntent{}, "eibc/MsgCancelFulfillmentIntent", nil)
+	cdc.RegisterConcrete(&MsgSetRollappRiskLimits{}, "eibc/MsgSetRollappRiskLimits", nil)
+	cdc.RegisterConcrete(&MsgTransferFulfillment{}, "eibc/MsgTransferFulfillment", nil)
+	cdc.RegisterConcrete(&MsgSetFeeSubsidy{}, "eibc/MsgSetFeeSubsidy", nil)
+	cdc.RegisterConcrete(&MsgFundFeeSubsidy{}, "eibc/MsgFundFeeSubsidy", nil)
+	cdc.RegisterConcrete(&MsgWithdrawFeeSubsidy{}, "eibc/MsgWithdrawFeeSubsidy", nil)
+	cdc.RegisterConcrete(&MsgSetRollappFeeFloor{}, "eibc/MsgSetRollappFeeFloor", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -18,6 +28,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgFulfillOrder{},
+		&MsgFulfillOrders{},
+		&MsgFulfillOrderPartial{},
+		&MsgCreateFulfillmentIntent{},
+		&MsgCancelFulfillmentIntent{},
+		&MsgSetRollappRiskLimits{},
+		&MsgTransferFulfillment{},
+		&MsgSetFeeSubsidy{},
+		&MsgFundFeeSubsidy{},
+		&MsgWithdrawFeeSubsidy{},
+		&MsgSetRollappFeeFloor{},
 	)
 }
 
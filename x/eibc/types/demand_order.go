@@ -3,10 +3,12 @@ package types
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
@@ -60,6 +62,26 @@ func (m *DemandOrder) ValidateBasic() error {
 		return ErrInvalidRecipientAddress
 	}
 
+	filled := math.ZeroInt()
+	for _, f := range m.PartialFulfillments {
+		if _, err := sdk.AccAddressFromBech32(f.FulfillerAddress); err != nil {
+			return err
+		}
+		if f.Amount.IsNil() || !f.Amount.IsPositive() {
+			return fmt.Errorf("partial fulfillment amount must be positive: %s", f.Amount)
+		}
+		filled = filled.Add(f.Amount)
+	}
+	if filled.GT(m.Price[0].Amount) {
+		return ErrPartialFulfillmentTooLarge
+	}
+
+	if m.FeeEscalation != nil {
+		if err := m.FeeEscalation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -96,6 +118,15 @@ func (m *DemandOrder) GetFulfilledEvent() *EventDemandOrderFulfilled {
 	}
 }
 
+func (m *DemandOrder) GetPartiallyFulfilledEvent(fulfillment PartialFulfillment) *EventDemandOrderPartiallyFulfilled {
+	return &EventDemandOrderPartiallyFulfilled{
+		OrderId:        m.Id,
+		Fulfiller:      fulfillment.FulfillerAddress,
+		Amount:         sdk.NewCoin(m.Price[0].Denom, fulfillment.Amount).String(),
+		RemainingPrice: sdk.NewCoin(m.Price[0].Denom, m.RemainingPrice()).String(),
+	}
+}
+
 func (m *DemandOrder) GetUpdatedEvent() *EventDemandOrderFeeUpdated {
 	return &EventDemandOrderFeeUpdated{
 		OrderId: m.Id,
@@ -126,6 +157,11 @@ func (m *DemandOrder) GetFeeAmount() math.Int {
 	return m.Fee.AmountOf(m.Price[0].Denom)
 }
 
+// FeePercentage returns the fee of the demand order divided by its price.
+func (m *DemandOrder) FeePercentage() sdk.Dec {
+	return sdk.NewDecFromInt(m.GetFeeAmount()).QuoInt(m.Price[0].Amount)
+}
+
 func (m *DemandOrder) ValidateOrderIsOutstanding() error {
 	// Check that the order is not fulfilled yet
 	if m.IsFulfilled() {
@@ -142,6 +178,64 @@ func (m *DemandOrder) IsFulfilled() bool {
 	return m.FulfillerAddress != ""
 }
 
+// IsPartiallyFulfilled returns true if at least one slice of the order price was paid by a partial fulfillment.
+func (m *DemandOrder) IsPartiallyFulfilled() bool {
+	return len(m.PartialFulfillments) != 0
+}
+
+// PartiallyFulfilledAmount returns the sum of the slices of the order price paid so far.
+func (m *DemandOrder) PartiallyFulfilledAmount() math.Int {
+	filled := math.ZeroInt()
+	for _, f := range m.PartialFulfillments {
+		filled = filled.Add(f.Amount)
+	}
+	return filled
+}
+
+// RemainingPrice returns the part of the order price which is not paid yet.
+func (m *DemandOrder) RemainingPrice() math.Int {
+	return m.Price[0].Amount.Sub(m.PartiallyFulfilledAmount())
+}
+
+// OutstandingValue returns the part of the order price which still waits for a fulfiller.
+// It is zero for fulfilled orders and for orders whose underlying packet is no longer pending.
+func (m *DemandOrder) OutstandingValue() math.Int {
+	if m.TrackingPacketStatus != commontypes.Status_PENDING || m.IsFulfilled() {
+		return math.ZeroInt()
+	}
+	return m.RemainingPrice()
+}
+
+// PartialFulfillmentEscrowAddress returns the address which receives the finalized funds of a partially
+// fulfilled order until they are paid out. It is derived from the order id so funds of different orders never mix.
+func (m *DemandOrder) PartialFulfillmentEscrowAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte(m.Id))
+}
+
+// SplitPartialFulfillmentFunds splits the finalized funds of a partially fulfilled order pro-rata to the
+// slices of the price paid by every fulfiller. The unfilled part of the price is paid back to the recipient.
+// Rounding leftovers go to the last receiver.
+func (m *DemandOrder) SplitPartialFulfillmentFunds(total math.Int) []PartialFulfillment {
+	price := m.Price[0].Amount
+	shares := make([]PartialFulfillment, 0, len(m.PartialFulfillments)+1)
+	shares = append(shares, m.PartialFulfillments...)
+	if remaining := m.RemainingPrice(); remaining.IsPositive() {
+		shares = append(shares, PartialFulfillment{FulfillerAddress: m.Recipient, Amount: remaining})
+	}
+
+	payouts := make([]PartialFulfillment, len(shares))
+	paid := math.ZeroInt()
+	for i, share := range shares {
+		amt := total.Mul(share.Amount).Quo(price)
+		if i == len(shares)-1 {
+			amt = total.Sub(paid)
+		}
+		paid = paid.Add(amt)
+		payouts[i] = PartialFulfillment{FulfillerAddress: share.FulfillerAddress, Amount: amt}
+	}
+	return payouts
+}
+
 // BuildDemandIDFromPacketKey returns a unique demand order id from the packet key.
 // PacketKey is used as a foreign key of rollapp packet in the demand order and as the demand order id.
 // This is useful for when we want to get the demand order related to a specific rollapp packet and avoid
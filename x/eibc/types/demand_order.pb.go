@@ -43,6 +43,13 @@ type DemandOrder struct {
 	Type                 types1.RollappPacket_Type                `protobuf:"varint,10,opt,name=type,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"type,omitempty"`
 	// fulfiller_address is the bech32-encoded address of the account which fulfilled the order.
 	FulfillerAddress string `protobuf:"bytes,11,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// partial_fulfillments are the slices of the price paid so far by fulfillers which filled the order partially.
+	// Once their sum reaches the price, the order is fulfilled and fulfiller_address is set to the order escrow.
+	PartialFulfillments []PartialFulfillment `protobuf:"bytes,12,rep,name=partial_fulfillments,json=partialFulfillments,proto3" json:"partial_fulfillments"`
+	// fee_escalation is the optional schedule which raises the fee while the order is not fulfilled.
+	FeeEscalation *FeeEscalation `protobuf:"bytes,13,opt,name=fee_escalation,json=feeEscalation,proto3" json:"fee_escalation,omitempty"`
+	// creation_height is the hub height at which the order was created.
+	CreationHeight int64 `protobuf:"varint,14,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
 }
 
 func (m *DemandOrder) Reset()         { *m = DemandOrder{} }
@@ -141,8 +148,139 @@ func (m *DemandOrder) GetFulfillerAddress() string {
 	return ""
 }
 
+func (m *DemandOrder) GetPartialFulfillments() []PartialFulfillment {
+	if m != nil {
+		return m.PartialFulfillments
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetFeeEscalation() *FeeEscalation {
+	if m != nil {
+		return m.FeeEscalation
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+// PartialFulfillment is a slice of a demand order price paid by a single fulfiller.
+type PartialFulfillment struct {
+	// fulfiller_address is the bech32-encoded address of the account which paid the slice.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// amount is the part of the order price paid by the fulfiller.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *PartialFulfillment) Reset()         { *m = PartialFulfillment{} }
+func (m *PartialFulfillment) String() string { return proto.CompactTextString(m) }
+func (*PartialFulfillment) ProtoMessage()    {}
+func (*PartialFulfillment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{1}
+}
+func (m *PartialFulfillment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartialFulfillment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartialFulfillment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartialFulfillment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialFulfillment.Merge(m, src)
+}
+func (m *PartialFulfillment) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartialFulfillment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialFulfillment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialFulfillment proto.InternalMessageInfo
+
+func (m *PartialFulfillment) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
+// FeeEscalation is a schedule which raises the fee of a demand order, and lowers its price accordingly,
+// every interval hub blocks until the order is fulfilled or the fee reaches max_fee.
+type FeeEscalation struct {
+	// max_fee is the cap of the fee.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_fee"`
+	// step is the amount the fee is raised by at each escalation.
+	Step github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=step,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"step"`
+	// interval is the number of hub blocks between escalations.
+	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// next_height is the hub height of the next escalation.
+	NextHeight int64 `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *FeeEscalation) Reset()         { *m = FeeEscalation{} }
+func (m *FeeEscalation) String() string { return proto.CompactTextString(m) }
+func (*FeeEscalation) ProtoMessage()    {}
+func (*FeeEscalation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{2}
+}
+func (m *FeeEscalation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeEscalation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeEscalation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeEscalation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeEscalation.Merge(m, src)
+}
+func (m *FeeEscalation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeEscalation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeEscalation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeEscalation proto.InternalMessageInfo
+
+func (m *FeeEscalation) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *FeeEscalation) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DemandOrder)(nil), "dymensionxyz.dymension.eibc.DemandOrder")
+	proto.RegisterType((*PartialFulfillment)(nil), "dymensionxyz.dymension.eibc.PartialFulfillment")
+	proto.RegisterType((*FeeEscalation)(nil), "dymensionxyz.dymension.eibc.FeeEscalation")
 }
 
 func init() {
@@ -150,36 +288,49 @@ func init() {
 }
 
 var fileDescriptor_2fc99140861fbacd = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcd, 0x6e, 0x13, 0x3d,
-	0x14, 0x86, 0x33, 0x49, 0xda, 0xaf, 0x71, 0xa4, 0xaa, 0xf5, 0x57, 0x21, 0x53, 0x60, 0x1a, 0x21,
-	0x21, 0x45, 0x20, 0x6c, 0x92, 0x5e, 0x01, 0x05, 0x16, 0xd0, 0x05, 0x68, 0x60, 0x05, 0x42, 0x91,
-	0xc7, 0x3e, 0x09, 0x56, 0x66, 0xc6, 0x23, 0xdb, 0xa9, 0x3a, 0x5c, 0x05, 0xf7, 0xc0, 0x8e, 0x2b,
-	0xe9, 0xb2, 0x4b, 0x56, 0x80, 0x92, 0x1b, 0x41, 0x63, 0x4f, 0x7f, 0x40, 0x0a, 0x6c, 0x58, 0xcd,
-	0xcc, 0x39, 0xef, 0xeb, 0xc7, 0xe7, 0x67, 0x10, 0x95, 0x55, 0x0e, 0x85, 0x55, 0xba, 0x38, 0xad,
-	0x3e, 0xb2, 0xcb, 0x0f, 0x06, 0x2a, 0x15, 0x4c, 0x42, 0xce, 0x0b, 0x39, 0xd1, 0x46, 0x82, 0xa1,
-	0xa5, 0xd1, 0x4e, 0xe3, 0x5b, 0xd7, 0xf5, 0x57, 0x66, 0x5a, 0xeb, 0xf7, 0xf7, 0x66, 0x7a, 0xa6,
-	0xbd, 0x8e, 0xd5, 0x6f, 0xc1, 0xb2, 0x7f, 0x7f, 0x0d, 0x42, 0xe8, 0x3c, 0xd7, 0x05, 0xb3, 0x8e,
-	0xbb, 0x85, 0x6d, 0xb4, 0xe3, 0x3f, 0x6b, 0x8d, 0xce, 0x32, 0x5e, 0x96, 0x93, 0x92, 0x8b, 0x39,
-	0xb8, 0xc6, 0x13, 0x0b, 0x6d, 0x73, 0x6d, 0x59, 0xca, 0x2d, 0xb0, 0x93, 0x51, 0x0a, 0x8e, 0x8f,
-	0x98, 0xd0, 0xaa, 0x08, 0xf9, 0xbb, 0x9f, 0xbb, 0xa8, 0xff, 0xd4, 0x57, 0xf2, 0xb2, 0x2e, 0x04,
-	0x6f, 0xa3, 0xb6, 0x92, 0x24, 0x1a, 0x44, 0xc3, 0x5e, 0xd2, 0x56, 0x12, 0x53, 0xf4, 0xbf, 0x33,
-	0x5c, 0xcc, 0x55, 0x31, 0x6b, 0x0e, 0x9e, 0xcc, 0xa1, 0x22, 0x6d, 0x2f, 0xd8, 0xbd, 0x48, 0xbd,
-	0xf2, 0x99, 0x63, 0xa8, 0x30, 0x47, 0x1b, 0xa5, 0x51, 0x02, 0x48, 0x67, 0xd0, 0x19, 0xf6, 0xc7,
-	0x37, 0x69, 0xe0, 0xd3, 0x9a, 0x4f, 0x1b, 0x3e, 0x7d, 0xa2, 0x55, 0x71, 0xf4, 0xe8, 0xec, 0xdb,
-	0x41, 0xeb, 0xcb, 0xf7, 0x83, 0xe1, 0x4c, 0xb9, 0x0f, 0x8b, 0x94, 0x0a, 0x9d, 0xb3, 0xe6, 0xb2,
-	0xe1, 0xf1, 0xd0, 0xca, 0x39, 0x73, 0x55, 0x09, 0xd6, 0x1b, 0x6c, 0x12, 0x4e, 0xc6, 0xef, 0x51,
-	0x67, 0x0a, 0x40, 0xba, 0xff, 0x1e, 0x50, 0x9f, 0x8b, 0x6f, 0xa3, 0x9e, 0x01, 0xa1, 0x4a, 0x05,
-	0x85, 0x23, 0x1b, 0xbe, 0xce, 0xab, 0x00, 0x7e, 0x87, 0x6e, 0xfc, 0xde, 0x8f, 0x30, 0x23, 0xb2,
-	0x35, 0x88, 0x86, 0xdb, 0xe3, 0x7b, 0x74, 0xcd, 0x0e, 0x84, 0x21, 0xd1, 0xd7, 0x5e, 0x9c, 0xec,
-	0xfd, 0xda, 0xb9, 0x10, 0xc5, 0x77, 0x10, 0xba, 0x18, 0xa2, 0x92, 0xa4, 0xd7, 0xb0, 0x43, 0xe4,
-	0xb9, 0xc4, 0xcf, 0x50, 0xb7, 0xbe, 0x2d, 0x41, 0x9e, 0x34, 0xfa, 0x0b, 0x29, 0x09, 0xbe, 0x00,
-	0xa0, 0x6f, 0xaa, 0x12, 0x12, 0x6f, 0xc7, 0x0f, 0xd0, 0xee, 0x74, 0x91, 0x4d, 0x55, 0x96, 0x81,
-	0x99, 0x70, 0x29, 0x0d, 0x58, 0x4b, 0xfa, 0x1e, 0xb6, 0x73, 0x99, 0x78, 0x1c, 0xe2, 0x2f, 0xba,
-	0x5b, 0x9b, 0x3b, 0xff, 0x1d, 0x1d, 0x9f, 0x2d, 0xe3, 0xe8, 0x7c, 0x19, 0x47, 0x3f, 0x96, 0x71,
-	0xf4, 0x69, 0x15, 0xb7, 0xce, 0x57, 0x71, 0xeb, 0xeb, 0x2a, 0x6e, 0xbd, 0x1d, 0x5d, 0x6b, 0xee,
-	0x9a, 0xf5, 0x3c, 0x39, 0x64, 0xa7, 0xe1, 0x97, 0xf1, 0xbd, 0x4e, 0x37, 0xfd, 0xe6, 0x1d, 0xfe,
-	0x0c, 0x00, 0x00, 0xff, 0xff, 0x5d, 0xab, 0x41, 0x60, 0x5e, 0x03, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xd1, 0x4e, 0x13, 0x4d,
+	0x14, 0xee, 0xd2, 0x52, 0x60, 0xfa, 0xd3, 0x1f, 0x06, 0xf2, 0x67, 0x7f, 0xd4, 0xb6, 0x21, 0x51,
+	0x1b, 0x8c, 0xb3, 0xb6, 0x3c, 0x81, 0x55, 0xaa, 0xc8, 0x85, 0xb8, 0x7a, 0xa5, 0x31, 0x9b, 0xe9,
+	0xee, 0x69, 0x3b, 0xe9, 0xee, 0xcc, 0x66, 0x67, 0x4a, 0x5a, 0xdf, 0x80, 0x3b, 0x9f, 0xc3, 0x27,
+	0xe1, 0x92, 0x4b, 0xf5, 0x02, 0x0d, 0xbc, 0x88, 0xd9, 0x99, 0x6d, 0x01, 0xb1, 0x68, 0x88, 0x57,
+	0x3b, 0x73, 0xce, 0xf9, 0xce, 0x77, 0xce, 0x77, 0xce, 0x0e, 0x22, 0xc1, 0x38, 0x02, 0x2e, 0x99,
+	0xe0, 0xa3, 0xf1, 0x07, 0x67, 0x7a, 0x71, 0x80, 0x75, 0x7c, 0x27, 0x80, 0x88, 0xf2, 0xc0, 0x13,
+	0x49, 0x00, 0x09, 0x89, 0x13, 0xa1, 0x04, 0xbe, 0x75, 0x31, 0xfe, 0x1c, 0x4c, 0xd2, 0xf8, 0x8d,
+	0xf5, 0x9e, 0xe8, 0x09, 0x1d, 0xe7, 0xa4, 0x27, 0x03, 0xd9, 0xd8, 0x9a, 0x41, 0xe1, 0x8b, 0x28,
+	0x12, 0xdc, 0x91, 0x8a, 0xaa, 0xa1, 0xcc, 0x62, 0x9b, 0xd7, 0xc7, 0x26, 0x22, 0x0c, 0x69, 0x1c,
+	0x7b, 0x31, 0xf5, 0x07, 0xa0, 0x32, 0x4c, 0xc5, 0x17, 0x32, 0x12, 0xd2, 0xe9, 0x50, 0x09, 0xce,
+	0x41, 0xa3, 0x03, 0x8a, 0x36, 0x1c, 0x5f, 0x30, 0x6e, 0xfc, 0x9b, 0x87, 0x45, 0x54, 0x7a, 0xaa,
+	0x3b, 0x79, 0x99, 0x36, 0x82, 0xcb, 0x68, 0x8e, 0x05, 0xb6, 0x55, 0xb3, 0xea, 0x4b, 0xee, 0x1c,
+	0x0b, 0x30, 0x41, 0x6b, 0x2a, 0xa1, 0xfe, 0x80, 0xf1, 0x5e, 0x96, 0xd8, 0x1b, 0xc0, 0xd8, 0x9e,
+	0xd3, 0x01, 0xab, 0x13, 0xd7, 0xbe, 0xf6, 0xec, 0xc1, 0x18, 0x53, 0x34, 0x1f, 0x27, 0xcc, 0x07,
+	0x3b, 0x5f, 0xcb, 0xd7, 0x4b, 0xcd, 0xff, 0x89, 0xe1, 0x27, 0x29, 0x3f, 0xc9, 0xf8, 0xc9, 0x13,
+	0xc1, 0x78, 0xeb, 0xd1, 0xd1, 0x49, 0x35, 0xf7, 0xe9, 0x5b, 0xb5, 0xde, 0x63, 0xaa, 0x3f, 0xec,
+	0x10, 0x5f, 0x44, 0x4e, 0x56, 0xac, 0xf9, 0x3c, 0x94, 0xc1, 0xc0, 0x51, 0xe3, 0x18, 0xa4, 0x06,
+	0x48, 0xd7, 0x64, 0xc6, 0xef, 0x51, 0xbe, 0x0b, 0x60, 0x17, 0xfe, 0x3e, 0x41, 0x9a, 0x17, 0xdf,
+	0x46, 0x4b, 0x09, 0xf8, 0x2c, 0x66, 0xc0, 0x95, 0x3d, 0xaf, 0xfb, 0x3c, 0x37, 0xe0, 0x77, 0xe8,
+	0xbf, 0x9f, 0xf5, 0x30, 0x33, 0xb2, 0x17, 0x6b, 0x56, 0xbd, 0xdc, 0xbc, 0x4b, 0x66, 0xec, 0x80,
+	0x19, 0x12, 0x79, 0xad, 0x83, 0xdd, 0xf5, 0xcb, 0xca, 0x19, 0x2b, 0xbe, 0x83, 0xd0, 0x64, 0x88,
+	0x2c, 0xb0, 0x97, 0x32, 0x6e, 0x63, 0xd9, 0x0d, 0xf0, 0x0e, 0x2a, 0xa4, 0xd5, 0xda, 0x48, 0x33,
+	0x35, 0x7e, 0xc3, 0xe4, 0x1a, 0x9c, 0x21, 0x20, 0x6f, 0xc6, 0x31, 0xb8, 0x1a, 0x8e, 0x1f, 0xa0,
+	0xd5, 0xee, 0x30, 0xec, 0xb2, 0x30, 0x84, 0xc4, 0xa3, 0x41, 0x90, 0x80, 0x94, 0x76, 0x49, 0x93,
+	0xad, 0x4c, 0x1d, 0x8f, 0x8d, 0x1d, 0xf7, 0xd1, 0x7a, 0x4c, 0x13, 0xc5, 0x68, 0xe8, 0x65, 0xbe,
+	0x08, 0xb8, 0x92, 0xf6, 0x3f, 0x5a, 0x7d, 0x87, 0x5c, 0xb3, 0xf1, 0x64, 0xdf, 0x00, 0xdb, 0xe7,
+	0xb8, 0x56, 0x21, 0x9d, 0x89, 0xbb, 0x16, 0x5f, 0xf1, 0x48, 0xfc, 0x0a, 0x95, 0xbb, 0x00, 0x1e,
+	0x48, 0x9f, 0x86, 0x54, 0x31, 0xc1, 0xed, 0xe5, 0x9a, 0x55, 0x2f, 0x35, 0xb7, 0xae, 0xe5, 0x68,
+	0x03, 0xec, 0x4c, 0x11, 0xee, 0x72, 0xf7, 0xe2, 0x15, 0xdf, 0x47, 0xff, 0xfa, 0x09, 0xe8, 0xb3,
+	0xd7, 0x07, 0xd6, 0xeb, 0x2b, 0xbb, 0x5c, 0xb3, 0xea, 0x79, 0xb7, 0x3c, 0x31, 0x3f, 0xd7, 0xd6,
+	0x17, 0x85, 0xc5, 0xe2, 0xca, 0xc2, 0xe6, 0xa1, 0x85, 0xf0, 0xd5, 0x9a, 0x7f, 0xad, 0x97, 0x35,
+	0x43, 0xaf, 0x36, 0x2a, 0xd2, 0x48, 0x0c, 0xb9, 0x32, 0xbf, 0x48, 0x8b, 0xa4, 0x0d, 0x7f, 0x3d,
+	0xa9, 0xde, 0xfb, 0x83, 0x25, 0xdc, 0xe5, 0xca, 0xcd, 0xd0, 0x9b, 0x5f, 0x2c, 0xb4, 0x7c, 0xa9,
+	0x37, 0xfc, 0x0c, 0x2d, 0x44, 0x74, 0xe4, 0xa5, 0xab, 0x6f, 0xdd, 0x2c, 0x75, 0x44, 0x47, 0x6d,
+	0x00, 0xdc, 0x42, 0x05, 0xa9, 0x20, 0xbe, 0x61, 0x81, 0x1a, 0x8b, 0x37, 0xd0, 0x22, 0xe3, 0x0a,
+	0x92, 0x03, 0x1a, 0xda, 0xf9, 0x9a, 0x55, 0x2f, 0xb8, 0xd3, 0x3b, 0xae, 0xa2, 0x12, 0x87, 0x91,
+	0x9a, 0x28, 0x5e, 0xd0, 0x8a, 0xa3, 0xd4, 0x64, 0xd4, 0x6e, 0xed, 0x1d, 0x9d, 0x56, 0xac, 0xe3,
+	0xd3, 0x8a, 0xf5, 0xfd, 0xb4, 0x62, 0x7d, 0x3c, 0xab, 0xe4, 0x8e, 0xcf, 0x2a, 0xb9, 0xcf, 0x67,
+	0x95, 0xdc, 0xdb, 0xc6, 0x85, 0x22, 0x66, 0x3c, 0x76, 0x07, 0xdb, 0xce, 0xc8, 0x3c, 0xc0, 0xba,
+	0xa6, 0x4e, 0x51, 0xbf, 0x63, 0xdb, 0x3f, 0x06, 0x00, 0x65, 0x8e, 0xdf, 0xb0, 0xac, 0x05, 0x00,
+	0x00,
 }
 
 func (m *DemandOrder) Marshal() (dAtA []byte, err error) {
@@ -202,6 +353,37 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.FeeEscalation != nil {
+		{
+			size, err := m.FeeEscalation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PartialFulfillments) > 0 {
+		for iNdEx := len(m.PartialFulfillments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartialFulfillments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.FulfillerAddress) > 0 {
 		i -= len(m.FulfillerAddress)
 		copy(dAtA[i:], m.FulfillerAddress)
@@ -278,6 +460,99 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PartialFulfillment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartialFulfillment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartialFulfillment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeEscalation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeEscalation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeEscalation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextHeight != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Step.Size()
+		i -= size
+		if _, err := m.Step.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDemandOrder(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDemandOrder(v)
 	base := offset
@@ -333,6 +608,53 @@ func (m *DemandOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDemandOrder(uint64(l))
 	}
+	if len(m.PartialFulfillments) > 0 {
+		for _, e := range m.PartialFulfillments {
+			l = e.Size()
+			n += 1 + l + sovDemandOrder(uint64(l))
+		}
+	}
+	if m.FeeEscalation != nil {
+		l = m.FeeEscalation.Size()
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovDemandOrder(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *PartialFulfillment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	return n
+}
+
+func (m *FeeEscalation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxFee.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	l = m.Step.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovDemandOrder(uint64(m.Interval))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovDemandOrder(uint64(m.NextHeight))
+	}
 	return n
 }
 
@@ -637,6 +959,367 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialFulfillments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartialFulfillments = append(m.PartialFulfillments, PartialFulfillment{})
+			if err := m.PartialFulfillments[len(m.PartialFulfillments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEscalation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeEscalation == nil {
+				m.FeeEscalation = &FeeEscalation{}
+			}
+			if err := m.FeeEscalation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartialFulfillment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartialFulfillment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartialFulfillment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEscalation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEscalation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEscalation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Step.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDemandOrder(dAtA[iNdEx:])
@@ -21,4 +21,17 @@ var (
 	ErrNegativeFee                  = errorsmod.Register(ModuleName, 13, "Fee must be greater than or equal to 0")
 	ErrMultipleDenoms               = errorsmod.Register(ModuleName, 15, "Multiple denoms not allowed")
 	ErrEmptyPrice                   = errorsmod.Register(ModuleName, 16, "Price must be greater than 0")
+	ErrPartialFulfillmentTooLarge   = errorsmod.Register(ModuleName, 17, "Partial fulfillment amount exceeds the remaining price")
+	ErrOrderPartiallyFulfilled      = errorsmod.Register(ModuleName, 18, "Demand order is partially fulfilled")
+	ErrFulfillmentIntentNotFound    = errorsmod.Register(ModuleName, 19, "Fulfillment intent does not exist")
+	ErrInvalidFulfillmentIntent     = errorsmod.Register(ModuleName, 20, "Invalid fulfillment intent")
+	ErrInvalidFeeEscalation         = errorsmod.Register(ModuleName, 21, "Invalid fee escalation")
+	ErrInvalidRiskLimits            = errorsmod.Register(ModuleName, 22, "Invalid rollapp risk limits")
+	ErrRiskLimitExceeded            = errorsmod.Register(ModuleName, 23, "Rollapp risk limit exceeded")
+	ErrRollappPaused                = errorsmod.Register(ModuleName, 24, "Rollapp demand orders are paused")
+	ErrDemandOrderNotFulfilled      = errorsmod.Register(ModuleName, 25, "Demand order is not fulfilled")
+	ErrFeeSubsidyNotFound           = errorsmod.Register(ModuleName, 26, "Rollapp fee subsidy does not exist")
+	ErrInvalidFeeSubsidy            = errorsmod.Register(ModuleName, 27, "Invalid rollapp fee subsidy")
+	ErrInvalidFeeFloor              = errorsmod.Register(ModuleName, 28, "Invalid rollapp fee floor")
+	ErrFeeBelowFloor                = errorsmod.Register(ModuleName, 29, "Demand order fee is below the fee floor")
 )
@@ -361,431 +361,3800 @@ func (m *EventDemandOrderFulfilled) GetPacketType() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventDemandOrderCreated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCreated")
-	proto.RegisterType((*EventDemandOrderPacketStatusUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPacketStatusUpdated")
-	proto.RegisterType((*EventDemandOrderFeeUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated")
-	proto.RegisterType((*EventDemandOrderFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilled")
+// EventDemandOrderPartiallyFulfilled is emitted when a slice of the demand order price is paid.
+type EventDemandOrderPartiallyFulfilled struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// fulfiller is the address of the fulfiller of the slice.
+	Fulfiller string `protobuf:"bytes,2,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// amount is the part of the price paid by the fulfiller.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// remaining_price is the part of the price still left to be paid.
+	RemainingPrice string `protobuf:"bytes,4,opt,name=remaining_price,json=remainingPrice,proto3" json:"remaining_price,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/events.proto", fileDescriptor_87abe4479c806cf7)
+func (m *EventDemandOrderPartiallyFulfilled) Reset()         { *m = EventDemandOrderPartiallyFulfilled{} }
+func (m *EventDemandOrderPartiallyFulfilled) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderPartiallyFulfilled) ProtoMessage()    {}
+func (*EventDemandOrderPartiallyFulfilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{4}
 }
-
-var fileDescriptor_87abe4479c806cf7 = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xcb, 0x6e, 0x13, 0x31,
-	0x14, 0xcd, 0x34, 0x34, 0xc9, 0xdc, 0x96, 0x97, 0x55, 0xa9, 0xd3, 0x50, 0x86, 0x92, 0x0a, 0x29,
-	0x62, 0x31, 0x23, 0xe8, 0x1f, 0xf0, 0x88, 0x54, 0x75, 0x41, 0x09, 0xb0, 0x61, 0x33, 0x9a, 0x8c,
-	0x6f, 0x82, 0xd5, 0x89, 0x6d, 0xcd, 0x38, 0x4d, 0x87, 0xaf, 0xe0, 0x17, 0xf8, 0x09, 0xbe, 0x81,
-	0x65, 0x97, 0x2c, 0x51, 0x22, 0xfe, 0x03, 0xd9, 0x9e, 0x84, 0x30, 0x51, 0x80, 0x25, 0x3b, 0xdf,
-	0x73, 0x8f, 0x8f, 0x8f, 0xef, 0xb1, 0xa1, 0x4b, 0x8b, 0x31, 0xf2, 0x9c, 0x09, 0x7e, 0x55, 0x7c,
-	0x0c, 0x97, 0x45, 0x88, 0x6c, 0x90, 0x84, 0x78, 0x89, 0x5c, 0xe5, 0x81, 0xcc, 0x84, 0x12, 0xe4,
-	0xde, 0x2a, 0x33, 0x58, 0x16, 0x81, 0x66, 0xb6, 0xf7, 0x46, 0x62, 0x24, 0x0c, 0x2f, 0xd4, 0x2b,
-	0xbb, 0xa5, 0xfd, 0x78, 0x83, 0x78, 0x22, 0xc6, 0x63, 0xc1, 0xc3, 0x5c, 0xc5, 0x6a, 0x52, 0xca,
-	0xb7, 0x83, 0x3f, 0x19, 0xa1, 0x38, 0x8e, 0x39, 0x8d, 0x44, 0x46, 0x31, 0xb3, 0xfc, 0xce, 0xe7,
-	0x2d, 0xd8, 0x7f, 0xa9, 0xfd, 0xbd, 0x30, 0xbd, 0x57, 0xba, 0xf5, 0x3c, 0xc3, 0x58, 0x21, 0x25,
-	0x07, 0xd0, 0x32, 0xd4, 0x88, 0x51, 0xcf, 0x39, 0x72, 0xba, 0x6e, 0xbf, 0x69, 0xea, 0x53, 0x4a,
-	0xf6, 0x60, 0x5b, 0x66, 0x2c, 0x41, 0x6f, 0xcb, 0xe0, 0xb6, 0x20, 0x77, 0xa0, 0x3e, 0x44, 0xf4,
-	0xea, 0x06, 0xd3, 0x4b, 0xf2, 0x10, 0x76, 0x59, 0x1e, 0x0d, 0x27, 0xe9, 0x90, 0xa5, 0x29, 0x52,
-	0xef, 0xc6, 0x91, 0xd3, 0x6d, 0xf5, 0x77, 0x58, 0xde, 0x5b, 0x40, 0xe4, 0x18, 0x6e, 0xca, 0x38,
-	0xb9, 0x40, 0x15, 0xd9, 0x8b, 0x78, 0xdb, 0x66, 0xfb, 0xae, 0x05, 0xdf, 0x18, 0x8c, 0xdc, 0x07,
-	0x28, 0x49, 0x17, 0x58, 0x78, 0x0d, 0xc3, 0x70, 0x2d, 0x72, 0x86, 0x85, 0x6e, 0x67, 0x22, 0x4d,
-	0x63, 0x29, 0xb5, 0xd7, 0xa6, 0x6d, 0x97, 0xc8, 0x29, 0x25, 0x87, 0xe0, 0x66, 0x98, 0x30, 0xc9,
-	0x90, 0x2b, 0xaf, 0x55, 0x76, 0x17, 0x00, 0x79, 0x00, 0x3b, 0xa5, 0xb6, 0x2a, 0x24, 0x7a, 0xae,
-	0xe9, 0x97, 0xc7, 0xbd, 0x2d, 0x24, 0x76, 0xbe, 0x38, 0x70, 0x5c, 0x9d, 0xd1, 0xf9, 0x8a, 0xbb,
-	0x77, 0x92, 0xfe, 0x6d, 0x5e, 0xaf, 0xe1, 0x2e, 0xc7, 0x69, 0xf4, 0xfb, 0x45, 0xf5, 0xec, 0x6e,
-	0x3d, 0x7d, 0x14, 0x6c, 0x78, 0x11, 0x36, 0xde, 0xc0, 0x9e, 0xd1, 0xbf, 0xcd, 0x71, 0xba, 0x7a,
-	0xe8, 0xda, 0x68, 0xeb, 0x6b, 0xa3, 0xed, 0x9c, 0x43, 0xbb, 0xea, 0xbb, 0x87, 0xf8, 0x0f, 0x76,
-	0xf7, 0xa1, 0xa9, 0xed, 0xea, 0x30, 0x6d, 0xc0, 0x0d, 0x8e, 0xd3, 0x1e, 0x62, 0xe7, 0x87, 0x03,
-	0x07, 0x6b, 0x92, 0xcb, 0x28, 0xff, 0xa3, 0x07, 0x73, 0x08, 0xee, 0x42, 0x24, 0x2b, 0x23, 0xfd,
-	0x05, 0x54, 0x23, 0x87, 0x6a, 0xe4, 0xcf, 0xce, 0xbe, 0xce, 0x7c, 0xe7, 0x7a, 0xe6, 0x3b, 0xdf,
-	0x67, 0xbe, 0xf3, 0x69, 0xee, 0xd7, 0xae, 0xe7, 0x7e, 0xed, 0xdb, 0xdc, 0xaf, 0xbd, 0x7f, 0x32,
-	0x62, 0xea, 0xc3, 0x64, 0xa0, 0xd3, 0x09, 0x37, 0xfc, 0xb5, 0xcb, 0x93, 0xf0, 0xca, 0x7e, 0x38,
-	0xad, 0x9e, 0x0f, 0x1a, 0xe6, 0xab, 0x9d, 0xfc, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x6d, 0xea,
-	0xf7, 0x25, 0x04, 0x00, 0x00,
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderPartiallyFulfilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventDemandOrderCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderPartiallyFulfilled.Merge(m, src)
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderPartiallyFulfilled.DiscardUnknown(m)
 }
 
-func (m *EventDemandOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketType) > 0 {
-		i -= len(m.PacketType)
-		copy(dAtA[i:], m.PacketType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x42
+var xxx_messageInfo_EventDemandOrderPartiallyFulfilled proto.InternalMessageInfo
+
+func (m *EventDemandOrderPartiallyFulfilled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
 	}
-	if len(m.PacketKey) > 0 {
-		i -= len(m.PacketKey)
-		copy(dAtA[i:], m.PacketKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.PacketStatus) > 0 {
-		i -= len(m.PacketStatus)
-		copy(dAtA[i:], m.PacketStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetRemainingPrice() string {
+	if m != nil {
+		return m.RemainingPrice
 	}
-	if m.IsFulfilled {
-		i--
-		if m.IsFulfilled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// EventPartialFulfillmentSettled is emitted for every payout of the finalized funds of a partially fulfilled order.
+type EventPartialFulfillmentSettled struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// receiver is the address which got the payout. It is the order recipient for the unfilled part of the order.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// amount is the amount paid out.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventPartialFulfillmentSettled) Reset()         { *m = EventPartialFulfillmentSettled{} }
+func (m *EventPartialFulfillmentSettled) String() string { return proto.CompactTextString(m) }
+func (*EventPartialFulfillmentSettled) ProtoMessage()    {}
+func (*EventPartialFulfillmentSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{5}
+}
+func (m *EventPartialFulfillmentSettled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPartialFulfillmentSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPartialFulfillmentSettled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventPartialFulfillmentSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPartialFulfillmentSettled.Merge(m, src)
+}
+func (m *EventPartialFulfillmentSettled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPartialFulfillmentSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPartialFulfillmentSettled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPartialFulfillmentSettled proto.InternalMessageInfo
+
+func (m *EventPartialFulfillmentSettled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventPartialFulfillmentSettled) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPartialFulfillmentSettled) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventFulfillmentIntentCreated is emitted when a fulfillment intent is created.
+type EventFulfillmentIntentCreated struct {
+	// intent is the created intent.
+	Intent FulfillmentIntent `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent"`
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsFulfilled {
-		i--
-		if m.IsFulfilled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventFulfillmentIntentCreated) Reset()         { *m = EventFulfillmentIntentCreated{} }
+func (m *EventFulfillmentIntentCreated) String() string { return proto.CompactTextString(m) }
+func (*EventFulfillmentIntentCreated) ProtoMessage()    {}
+func (*EventFulfillmentIntentCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{6}
+}
+func (m *EventFulfillmentIntentCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFulfillmentIntentCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFulfillmentIntentCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.NewPacketStatus != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewPacketStatus))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventFulfillmentIntentCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFulfillmentIntentCreated.Merge(m, src)
+}
+func (m *EventFulfillmentIntentCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFulfillmentIntentCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFulfillmentIntentCreated.DiscardUnknown(m)
 }
 
-func (m *EventDemandOrderFeeUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventFulfillmentIntentCreated proto.InternalMessageInfo
+
+func (m *EventFulfillmentIntentCreated) GetIntent() FulfillmentIntent {
+	if m != nil {
+		return m.Intent
 	}
-	return dAtA[:n], nil
+	return FulfillmentIntent{}
 }
 
-func (m *EventDemandOrderFeeUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventFulfillmentIntentClosed is emitted when a fulfillment intent is cancelled or its budget is spent.
+type EventFulfillmentIntentClosed struct {
+	// intent_id is the unique identifier of the intent.
+	IntentId uint64 `protobuf:"varint,1,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	// refund is the remaining budget returned to the fulfiller.
+	Refund string `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"`
 }
 
-func (m *EventDemandOrderFeeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewFee) > 0 {
-		i -= len(m.NewFee)
-		copy(dAtA[i:], m.NewFee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewFee)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventFulfillmentIntentClosed) Reset()         { *m = EventFulfillmentIntentClosed{} }
+func (m *EventFulfillmentIntentClosed) String() string { return proto.CompactTextString(m) }
+func (*EventFulfillmentIntentClosed) ProtoMessage()    {}
+func (*EventFulfillmentIntentClosed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{7}
+}
+func (m *EventFulfillmentIntentClosed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFulfillmentIntentClosed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFulfillmentIntentClosed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventFulfillmentIntentClosed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFulfillmentIntentClosed.Merge(m, src)
+}
+func (m *EventFulfillmentIntentClosed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFulfillmentIntentClosed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFulfillmentIntentClosed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFulfillmentIntentClosed proto.InternalMessageInfo
+
+func (m *EventFulfillmentIntentClosed) GetIntentId() uint64 {
+	if m != nil {
+		return m.IntentId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventDemandOrderFulfilled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventFulfillmentIntentClosed) GetRefund() string {
+	if m != nil {
+		return m.Refund
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDemandOrderFulfilled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventDemandOrderFulfilledByIntent is emitted when a new demand order is fulfilled by a fulfillment intent.
+type EventDemandOrderFulfilledByIntent struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// intent_id is the unique identifier of the intent.
+	IntentId uint64 `protobuf:"varint,2,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	// remaining_budget is the budget left in the intent after the fulfillment.
+	RemainingBudget string `protobuf:"bytes,3,opt,name=remaining_budget,json=remainingBudget,proto3" json:"remaining_budget,omitempty"`
 }
 
-func (m *EventDemandOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketType) > 0 {
-		i -= len(m.PacketType)
-		copy(dAtA[i:], m.PacketType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Fulfiller) > 0 {
-		i -= len(m.Fulfiller)
-		copy(dAtA[i:], m.Fulfiller)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.PacketStatus) > 0 {
-		i -= len(m.PacketStatus)
-		copy(dAtA[i:], m.PacketStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.IsFulfilled {
-		i--
-		if m.IsFulfilled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventDemandOrderFulfilledByIntent) Reset()         { *m = EventDemandOrderFulfilledByIntent{} }
+func (m *EventDemandOrderFulfilledByIntent) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderFulfilledByIntent) ProtoMessage()    {}
+func (*EventDemandOrderFulfilledByIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{8}
+}
+func (m *EventDemandOrderFulfilledByIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderFulfilledByIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderFulfilledByIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventDemandOrderFulfilledByIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderFulfilledByIntent.Merge(m, src)
+}
+func (m *EventDemandOrderFulfilledByIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderFulfilledByIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderFulfilledByIntent.DiscardUnknown(m)
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventDemandOrderFulfilledByIntent proto.InternalMessageInfo
+
+func (m *EventDemandOrderFulfilledByIntent) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EventDemandOrderCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *EventDemandOrderFulfilledByIntent) GetIntentId() uint64 {
+	if m != nil {
+		return m.IntentId
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+func (m *EventDemandOrderFulfilledByIntent) GetRemainingBudget() string {
+	if m != nil {
+		return m.RemainingBudget
 	}
-	if m.IsFulfilled {
-		n += 2
+	return ""
+}
+
+// EventRollappRiskLimitsSet is emitted when the risk limits of a rollapp are set.
+type EventRollappRiskLimitsSet struct {
+	// limits are the new limits of the rollapp.
+	Limits RollappRiskLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits"`
+	// signer is the address which set the limits.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventRollappRiskLimitsSet) Reset()         { *m = EventRollappRiskLimitsSet{} }
+func (m *EventRollappRiskLimitsSet) String() string { return proto.CompactTextString(m) }
+func (*EventRollappRiskLimitsSet) ProtoMessage()    {}
+func (*EventRollappRiskLimitsSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{9}
+}
+func (m *EventRollappRiskLimitsSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRollappRiskLimitsSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRollappRiskLimitsSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventRollappRiskLimitsSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRollappRiskLimitsSet.Merge(m, src)
+}
+func (m *EventRollappRiskLimitsSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRollappRiskLimitsSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRollappRiskLimitsSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRollappRiskLimitsSet proto.InternalMessageInfo
+
+func (m *EventRollappRiskLimitsSet) GetLimits() RollappRiskLimits {
+	if m != nil {
+		return m.Limits
 	}
-	l = len(m.PacketKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return RollappRiskLimits{}
+}
+
+func (m *EventRollappRiskLimitsSet) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+// EventDemandOrderCreationSkipped is emitted when no demand order is created for an eIBC packet.
+// The packet goes through the regular delayed ack flow.
+type EventDemandOrderCreationSkipped struct {
+	// packet_key is the key of the packet.
+	PacketKey string `protobuf:"bytes,1,opt,name=packet_key,json=packetKey,proto3" json:"packet_key,omitempty"`
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// reason is the reason why the order was not created.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventDemandOrderCreationSkipped) Reset()         { *m = EventDemandOrderCreationSkipped{} }
+func (m *EventDemandOrderCreationSkipped) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderCreationSkipped) ProtoMessage()    {}
+func (*EventDemandOrderCreationSkipped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{10}
+}
+func (m *EventDemandOrderCreationSkipped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderCreationSkipped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderCreationSkipped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventDemandOrderCreationSkipped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderCreationSkipped.Merge(m, src)
+}
+func (m *EventDemandOrderCreationSkipped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderCreationSkipped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderCreationSkipped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderCreationSkipped proto.InternalMessageInfo
+
+func (m *EventDemandOrderCreationSkipped) GetPacketKey() string {
+	if m != nil {
+		return m.PacketKey
 	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *EventDemandOrderCreationSkipped) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return n
+	return ""
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDemandOrderCreationSkipped) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+// EventRollappDemandOrdersPaused is emitted when the creation of demand orders of a rollapp is paused.
+type EventRollappDemandOrdersPaused struct {
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// reason is the reason of the pause.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventRollappDemandOrdersPaused) Reset()         { *m = EventRollappDemandOrdersPaused{} }
+func (m *EventRollappDemandOrdersPaused) String() string { return proto.CompactTextString(m) }
+func (*EventRollappDemandOrdersPaused) ProtoMessage()    {}
+func (*EventRollappDemandOrdersPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{11}
+}
+func (m *EventRollappDemandOrdersPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRollappDemandOrdersPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRollappDemandOrdersPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.NewPacketStatus != 0 {
-		n += 1 + sovEvents(uint64(m.NewPacketStatus))
+}
+func (m *EventRollappDemandOrdersPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRollappDemandOrdersPaused.Merge(m, src)
+}
+func (m *EventRollappDemandOrdersPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRollappDemandOrdersPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRollappDemandOrdersPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRollappDemandOrdersPaused proto.InternalMessageInfo
+
+func (m *EventRollappDemandOrdersPaused) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	if m.IsFulfilled {
-		n += 2
+	return ""
+}
+
+func (m *EventRollappDemandOrdersPaused) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return n
+	return ""
 }
 
-func (m *EventDemandOrderFeeUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+// EventDemandOrderFulfillmentTransferred is emitted when the claim on the funds of a fulfilled order is transferred.
+type EventDemandOrderFulfillmentTransferred struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// from is the address which held the claim.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the address which holds the claim now.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *EventDemandOrderFulfillmentTransferred) Reset() {
+	*m = EventDemandOrderFulfillmentTransferred{}
+}
+func (m *EventDemandOrderFulfillmentTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderFulfillmentTransferred) ProtoMessage()    {}
+func (*EventDemandOrderFulfillmentTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{12}
+}
+func (m *EventDemandOrderFulfillmentTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderFulfillmentTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderFulfillmentTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventDemandOrderFulfillmentTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderFulfillmentTransferred.Merge(m, src)
+}
+func (m *EventDemandOrderFulfillmentTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderFulfillmentTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderFulfillmentTransferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderFulfillmentTransferred proto.InternalMessageInfo
+
+func (m *EventDemandOrderFulfillmentTransferred) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderFulfillmentTransferred) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EventDemandOrderFulfillmentTransferred) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+// EventFeeSubsidySet is emitted when the fee subsidy pool of a rollapp is configured.
+type EventFeeSubsidySet struct {
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// subsidy_percentage is the part of the order fee paid on top of it to the fulfiller.
+	SubsidyPercentage string `protobuf:"bytes,2,opt,name=subsidy_percentage,json=subsidyPercentage,proto3" json:"subsidy_percentage,omitempty"`
+	// epoch_cap is the maximal amount paid out by the pool per epoch.
+	EpochCap string `protobuf:"bytes,3,opt,name=epoch_cap,json=epochCap,proto3" json:"epoch_cap,omitempty"`
+}
+
+func (m *EventFeeSubsidySet) Reset()         { *m = EventFeeSubsidySet{} }
+func (m *EventFeeSubsidySet) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSubsidySet) ProtoMessage()    {}
+func (*EventFeeSubsidySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{13}
+}
+func (m *EventFeeSubsidySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSubsidySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSubsidySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSubsidySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSubsidySet.Merge(m, src)
+}
+func (m *EventFeeSubsidySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSubsidySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSubsidySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSubsidySet proto.InternalMessageInfo
+
+func (m *EventFeeSubsidySet) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidySet) GetSubsidyPercentage() string {
+	if m != nil {
+		return m.SubsidyPercentage
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidySet) GetEpochCap() string {
+	if m != nil {
+		return m.EpochCap
+	}
+	return ""
+}
+
+// EventFeeSubsidyFunded is emitted when funds are added to the fee subsidy pool of a rollapp.
+type EventFeeSubsidyFunded struct {
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// amount is the amount added to the pool.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// balance is the amount in the pool after the funding.
+	Balance string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *EventFeeSubsidyFunded) Reset()         { *m = EventFeeSubsidyFunded{} }
+func (m *EventFeeSubsidyFunded) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSubsidyFunded) ProtoMessage()    {}
+func (*EventFeeSubsidyFunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{14}
+}
+func (m *EventFeeSubsidyFunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSubsidyFunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSubsidyFunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSubsidyFunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSubsidyFunded.Merge(m, src)
+}
+func (m *EventFeeSubsidyFunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSubsidyFunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSubsidyFunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSubsidyFunded proto.InternalMessageInfo
+
+func (m *EventFeeSubsidyFunded) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidyFunded) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidyFunded) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+// EventFeeSubsidyWithdrawn is emitted when funds are withdrawn from the fee subsidy pool of a rollapp.
+type EventFeeSubsidyWithdrawn struct {
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// amount is the amount removed from the pool.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// balance is the amount in the pool after the withdrawal.
+	Balance string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *EventFeeSubsidyWithdrawn) Reset()         { *m = EventFeeSubsidyWithdrawn{} }
+func (m *EventFeeSubsidyWithdrawn) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSubsidyWithdrawn) ProtoMessage()    {}
+func (*EventFeeSubsidyWithdrawn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{15}
+}
+func (m *EventFeeSubsidyWithdrawn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSubsidyWithdrawn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSubsidyWithdrawn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSubsidyWithdrawn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSubsidyWithdrawn.Merge(m, src)
+}
+func (m *EventFeeSubsidyWithdrawn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSubsidyWithdrawn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSubsidyWithdrawn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSubsidyWithdrawn proto.InternalMessageInfo
+
+func (m *EventFeeSubsidyWithdrawn) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidyWithdrawn) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidyWithdrawn) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+// EventFeeSubsidyPaid is emitted when the fee subsidy pool of a rollapp tops up the fee of a fulfilled order, once its packet is finalized.
+type EventFeeSubsidyPaid struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// fulfiller is the address which received the subsidy.
+	Fulfiller string `protobuf:"bytes,3,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// amount is the subsidy paid.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// balance is the amount in the pool after the payment.
+	Balance string `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *EventFeeSubsidyPaid) Reset()         { *m = EventFeeSubsidyPaid{} }
+func (m *EventFeeSubsidyPaid) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSubsidyPaid) ProtoMessage()    {}
+func (*EventFeeSubsidyPaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{16}
+}
+func (m *EventFeeSubsidyPaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSubsidyPaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSubsidyPaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSubsidyPaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSubsidyPaid.Merge(m, src)
+}
+func (m *EventFeeSubsidyPaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSubsidyPaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSubsidyPaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSubsidyPaid proto.InternalMessageInfo
+
+func (m *EventFeeSubsidyPaid) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidyPaid) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidyPaid) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidyPaid) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventFeeSubsidyPaid) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+// EventRollappFeeFloorSet is emitted when the fee floor override of a rollapp is set or removed.
+type EventRollappFeeFloorSet struct {
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// min_fee_percentage is the new fee floor of the rollapp, empty if the override was removed.
+	MinFeePercentage string `protobuf:"bytes,2,opt,name=min_fee_percentage,json=minFeePercentage,proto3" json:"min_fee_percentage,omitempty"`
+}
+
+func (m *EventRollappFeeFloorSet) Reset()         { *m = EventRollappFeeFloorSet{} }
+func (m *EventRollappFeeFloorSet) String() string { return proto.CompactTextString(m) }
+func (*EventRollappFeeFloorSet) ProtoMessage()    {}
+func (*EventRollappFeeFloorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{17}
+}
+func (m *EventRollappFeeFloorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRollappFeeFloorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRollappFeeFloorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRollappFeeFloorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRollappFeeFloorSet.Merge(m, src)
+}
+func (m *EventRollappFeeFloorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRollappFeeFloorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRollappFeeFloorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRollappFeeFloorSet proto.InternalMessageInfo
+
+func (m *EventRollappFeeFloorSet) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRollappFeeFloorSet) GetMinFeePercentage() string {
+	if m != nil {
+		return m.MinFeePercentage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventDemandOrderCreated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCreated")
+	proto.RegisterType((*EventDemandOrderPacketStatusUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPacketStatusUpdated")
+	proto.RegisterType((*EventDemandOrderFeeUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated")
+	proto.RegisterType((*EventDemandOrderFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilled")
+	proto.RegisterType((*EventDemandOrderPartiallyFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPartiallyFulfilled")
+	proto.RegisterType((*EventPartialFulfillmentSettled)(nil), "dymensionxyz.dymension.eibc.EventPartialFulfillmentSettled")
+	proto.RegisterType((*EventFulfillmentIntentCreated)(nil), "dymensionxyz.dymension.eibc.EventFulfillmentIntentCreated")
+	proto.RegisterType((*EventFulfillmentIntentClosed)(nil), "dymensionxyz.dymension.eibc.EventFulfillmentIntentClosed")
+	proto.RegisterType((*EventDemandOrderFulfilledByIntent)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilledByIntent")
+	proto.RegisterType((*EventRollappRiskLimitsSet)(nil), "dymensionxyz.dymension.eibc.EventRollappRiskLimitsSet")
+	proto.RegisterType((*EventDemandOrderCreationSkipped)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCreationSkipped")
+	proto.RegisterType((*EventRollappDemandOrdersPaused)(nil), "dymensionxyz.dymension.eibc.EventRollappDemandOrdersPaused")
+	proto.RegisterType((*EventDemandOrderFulfillmentTransferred)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfillmentTransferred")
+	proto.RegisterType((*EventFeeSubsidySet)(nil), "dymensionxyz.dymension.eibc.EventFeeSubsidySet")
+	proto.RegisterType((*EventFeeSubsidyFunded)(nil), "dymensionxyz.dymension.eibc.EventFeeSubsidyFunded")
+	proto.RegisterType((*EventFeeSubsidyWithdrawn)(nil), "dymensionxyz.dymension.eibc.EventFeeSubsidyWithdrawn")
+	proto.RegisterType((*EventFeeSubsidyPaid)(nil), "dymensionxyz.dymension.eibc.EventFeeSubsidyPaid")
+	proto.RegisterType((*EventRollappFeeFloorSet)(nil), "dymensionxyz.dymension.eibc.EventRollappFeeFloorSet")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/events.proto", fileDescriptor_87abe4479c806cf7)
+}
+
+var fileDescriptor_87abe4479c806cf7 = []byte{
+	// 963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0x1b, 0x55,
+	0x14, 0xce, 0x38, 0x8e, 0x63, 0x9f, 0x94, 0x34, 0x1d, 0x0a, 0x99, 0xba, 0xa9, 0xd3, 0x4e, 0x05,
+	0x04, 0x44, 0x6d, 0xd1, 0xf2, 0x04, 0x29, 0x58, 0x8a, 0x5a, 0x09, 0x63, 0x17, 0x55, 0x62, 0x33,
+	0x1a, 0xcf, 0x1c, 0x3b, 0x57, 0x9e, 0xb9, 0x77, 0x74, 0xe7, 0x3a, 0xae, 0xd9, 0x20, 0x36, 0xac,
+	0x79, 0x02, 0x10, 0x2f, 0xc1, 0x33, 0x74, 0x99, 0x25, 0x2b, 0x84, 0x12, 0xf1, 0x1e, 0xe8, 0xfe,
+	0x8c, 0x7f, 0xc6, 0x8c, 0xe3, 0x05, 0x8b, 0xee, 0x7c, 0xce, 0x3d, 0x73, 0xbe, 0xef, 0x9e, 0xef,
+	0xcc, 0xe7, 0x81, 0x93, 0x70, 0x1a, 0x23, 0x4d, 0x09, 0xa3, 0x6f, 0xa6, 0x3f, 0xb4, 0x66, 0x41,
+	0x0b, 0x49, 0x3f, 0x68, 0xe1, 0x05, 0x52, 0x91, 0x36, 0x13, 0xce, 0x04, 0xb3, 0xef, 0x2f, 0x56,
+	0x36, 0x67, 0x41, 0x53, 0x56, 0xd6, 0xef, 0x0e, 0xd9, 0x90, 0xa9, 0xba, 0x96, 0xfc, 0xa5, 0x1f,
+	0xa9, 0x7f, 0x56, 0xd0, 0x3c, 0x60, 0x71, 0xcc, 0x68, 0x2b, 0x15, 0xbe, 0x18, 0x9b, 0xf6, 0xf5,
+	0xe6, 0x3a, 0x22, 0x21, 0xc6, 0x3e, 0x0d, 0x3d, 0xc6, 0x43, 0xe4, 0xa6, 0xfe, 0xcb, 0x75, 0xf5,
+	0x83, 0x71, 0x34, 0x20, 0x51, 0x14, 0x23, 0x15, 0x1e, 0xa1, 0x02, 0xa9, 0x30, 0x4f, 0x3d, 0x59,
+	0xf7, 0x14, 0x27, 0xe9, 0xc8, 0x8b, 0x48, 0x4c, 0xb2, 0x3b, 0xbb, 0xbf, 0x97, 0xe0, 0xf0, 0x6b,
+	0x39, 0x84, 0xaf, 0x14, 0x81, 0x6f, 0x24, 0xfe, 0x73, 0x8e, 0xbe, 0xc0, 0xd0, 0xbe, 0x07, 0x55,
+	0xc5, 0xc7, 0x23, 0xa1, 0x63, 0x3d, 0xb4, 0x4e, 0x6a, 0xdd, 0x5d, 0x15, 0x9f, 0x85, 0xf6, 0x5d,
+	0xd8, 0x49, 0x38, 0x09, 0xd0, 0x29, 0xa9, 0xbc, 0x0e, 0xec, 0x03, 0xd8, 0x1e, 0x20, 0x3a, 0xdb,
+	0x2a, 0x27, 0x7f, 0xda, 0x8f, 0xe0, 0x16, 0x49, 0x3d, 0x43, 0x16, 0x43, 0xa7, 0xfc, 0xd0, 0x3a,
+	0xa9, 0x76, 0xf7, 0x48, 0xda, 0xce, 0x52, 0xf6, 0x63, 0x78, 0x2f, 0xf1, 0x83, 0x11, 0x0a, 0x4f,
+	0x4f, 0xcb, 0xd9, 0x51, 0x8f, 0xdf, 0xd2, 0xc9, 0x9e, 0xca, 0xd9, 0x0f, 0x00, 0x4c, 0xd1, 0x08,
+	0xa7, 0x4e, 0x45, 0x55, 0xd4, 0x74, 0xe6, 0x05, 0x4e, 0xe5, 0x31, 0x67, 0x51, 0xe4, 0x27, 0x89,
+	0xe4, 0xba, 0xab, 0x8f, 0x4d, 0xe6, 0x2c, 0xb4, 0x8f, 0xa0, 0xc6, 0x31, 0x20, 0x09, 0x41, 0x2a,
+	0x9c, 0xaa, 0x39, 0xcd, 0x12, 0xf6, 0x31, 0xec, 0x99, 0xde, 0x62, 0x9a, 0xa0, 0x53, 0x53, 0xe7,
+	0x06, 0xee, 0xd5, 0x34, 0x41, 0xf7, 0x0f, 0x0b, 0x1e, 0xe7, 0x67, 0xd4, 0x59, 0x60, 0xf7, 0x5d,
+	0x12, 0xde, 0x34, 0xaf, 0x6f, 0xe1, 0x0e, 0xc5, 0x89, 0xb7, 0x7c, 0x51, 0x39, 0xbb, 0xfd, 0xa7,
+	0x1f, 0x35, 0x0b, 0xd6, 0x4e, 0xef, 0x50, 0x53, 0x63, 0x74, 0x6f, 0x53, 0x9c, 0x2c, 0x82, 0xae,
+	0x8c, 0x76, 0x7b, 0x65, 0xb4, 0x6e, 0x07, 0xea, 0x79, 0xde, 0x6d, 0xc4, 0x0d, 0xe8, 0x1e, 0xc2,
+	0xae, 0xa4, 0x2b, 0xc5, 0xd4, 0x02, 0x57, 0x28, 0x4e, 0xda, 0x88, 0xee, 0x3f, 0x16, 0xdc, 0x5b,
+	0x69, 0x39, 0x93, 0xf2, 0x1d, 0x5a, 0x98, 0x23, 0xa8, 0x65, 0x4d, 0xb8, 0x91, 0x74, 0x9e, 0xc8,
+	0x4b, 0x0e, 0x2b, 0x92, 0xff, 0x6a, 0x81, 0xbb, 0x2a, 0x39, 0x17, 0xc4, 0x8f, 0xa2, 0xe9, 0x46,
+	0x17, 0x5e, 0x22, 0x50, 0xca, 0x13, 0xf8, 0x10, 0x2a, 0x7e, 0xcc, 0xc6, 0x54, 0x98, 0xbb, 0x9b,
+	0xc8, 0xfe, 0x04, 0x6e, 0x73, 0x8c, 0x7d, 0x42, 0x09, 0x1d, 0x7a, 0x7a, 0x60, 0x65, 0x55, 0xb0,
+	0x3f, 0x4b, 0x77, 0x64, 0xd6, 0x65, 0xd0, 0x50, 0xfc, 0x0c, 0xa9, 0xf6, 0xdc, 0x0e, 0x7a, 0x28,
+	0xc4, 0x0d, 0xdc, 0xea, 0x50, 0xe5, 0x18, 0x20, 0xb9, 0x98, 0x51, 0x9b, 0xc5, 0x45, 0xcc, 0xdc,
+	0x18, 0x1e, 0x28, 0xc0, 0x05, 0xa4, 0x33, 0xe5, 0x3b, 0x99, 0x5b, 0xbc, 0x84, 0x8a, 0x36, 0x22,
+	0x85, 0xb6, 0xf7, 0xb4, 0xd9, 0x5c, 0x63, 0xa7, 0xcd, 0x95, 0x36, 0xa7, 0xe5, 0xb7, 0x7f, 0x1d,
+	0x6f, 0x75, 0x4d, 0x0f, 0xb7, 0x07, 0x47, 0x05, 0x70, 0x11, 0x4b, 0x31, 0xb4, 0xef, 0x43, 0x4d,
+	0x57, 0x66, 0xd7, 0x2b, 0x77, 0xab, 0x3a, 0x71, 0x16, 0xca, 0x3b, 0x70, 0x1c, 0x8c, 0x69, 0x98,
+	0x6d, 0xaf, 0x8e, 0xdc, 0x9f, 0x2d, 0x78, 0x54, 0xb8, 0xbd, 0xa7, 0x53, 0x0d, 0xb0, 0x6e, 0x70,
+	0x4b, 0xa8, 0xa5, 0x1c, 0xea, 0xa7, 0x70, 0x30, 0xd7, 0xae, 0x3f, 0x0e, 0x87, 0x98, 0xcd, 0x70,
+	0xae, 0xe9, 0xa9, 0x4a, 0xbb, 0x3f, 0x65, 0xaf, 0x51, 0x57, 0x7b, 0x54, 0x97, 0xa4, 0xa3, 0x97,
+	0xca, 0x96, 0x7b, 0x28, 0xe4, 0x24, 0xb5, 0x47, 0x6f, 0x34, 0xc9, 0x95, 0x16, 0xd9, 0x24, 0x75,
+	0x0f, 0x39, 0x8c, 0x94, 0x0c, 0xe9, 0x4c, 0x6a, 0x13, 0xb9, 0x13, 0x38, 0xfe, 0x4f, 0xe3, 0x27,
+	0x8c, 0xf6, 0x46, 0x24, 0x49, 0x30, 0xcc, 0xb9, 0xae, 0xb5, 0xde, 0x75, 0x4b, 0x79, 0xd7, 0x55,
+	0x2a, 0xf8, 0x29, 0xa3, 0xd9, 0x26, 0xe9, 0xc8, 0x7d, 0x6d, 0x56, 0xd7, 0x10, 0x5f, 0xc0, 0x4f,
+	0x3b, 0xfe, 0x38, 0xd5, 0xb8, 0x0b, 0x8d, 0xad, 0xe2, 0xc6, 0xa5, 0xa5, 0xc6, 0x43, 0xf8, 0xb8,
+	0x40, 0x5d, 0xb9, 0x3e, 0xaf, 0xb8, 0x4f, 0xd3, 0x01, 0x72, 0xbe, 0xfe, 0xdd, 0xb0, 0xa1, 0x3c,
+	0xe0, 0x2c, 0x36, 0xad, 0xd5, 0x6f, 0x7b, 0x1f, 0x4a, 0x82, 0x99, 0x5b, 0x94, 0x04, 0x73, 0x7f,
+	0x04, 0x5b, 0x2f, 0x27, 0x62, 0x6f, 0xdc, 0x4f, 0x49, 0x38, 0x95, 0xb2, 0xdd, 0xc0, 0xfa, 0x09,
+	0xd8, 0xa9, 0x2e, 0xf6, 0x12, 0xe4, 0x01, 0x52, 0xe1, 0x0f, 0x33, 0x3b, 0xbc, 0x63, 0x4e, 0x3a,
+	0xb3, 0x03, 0xb9, 0x6a, 0x98, 0xb0, 0xe0, 0xdc, 0x0b, 0xfc, 0xc4, 0x40, 0x57, 0x55, 0xe2, 0xb9,
+	0x9f, 0xb8, 0xe7, 0xf0, 0x41, 0x8e, 0x40, 0x7b, 0x4c, 0xc3, 0x8d, 0x26, 0x67, 0x5e, 0xee, 0xd2,
+	0x92, 0xed, 0x38, 0xb0, 0xdb, 0xf7, 0x23, 0x9f, 0x06, 0x99, 0x17, 0x67, 0xa1, 0x3b, 0x02, 0x27,
+	0x87, 0xf4, 0x9a, 0x88, 0xf3, 0x90, 0xfb, 0x13, 0xfa, 0xff, 0x83, 0xfd, 0x66, 0xc1, 0xfb, 0x39,
+	0xb4, 0x8e, 0x4f, 0xd6, 0xca, 0x75, 0xc3, 0x0e, 0x2e, 0xb9, 0xf0, 0x76, 0xb1, 0x0b, 0x97, 0x8b,
+	0x18, 0xee, 0x2c, 0x33, 0x1c, 0xc0, 0xe1, 0xe2, 0xee, 0xb6, 0x11, 0xdb, 0x11, 0x63, 0x7c, 0x03,
+	0xf9, 0x3f, 0x07, 0x3b, 0x26, 0x54, 0xfe, 0xa5, 0xae, 0xca, 0x7f, 0x10, 0x13, 0xda, 0x46, 0x9c,
+	0xab, 0x7f, 0xfa, 0xe2, 0xed, 0x55, 0xc3, 0xba, 0xbc, 0x6a, 0x58, 0x7f, 0x5f, 0x35, 0xac, 0x5f,
+	0xae, 0x1b, 0x5b, 0x97, 0xd7, 0x8d, 0xad, 0x3f, 0xaf, 0x1b, 0x5b, 0xdf, 0x7f, 0x31, 0x24, 0xe2,
+	0x7c, 0xdc, 0x97, 0x5f, 0x07, 0xad, 0x82, 0x4f, 0xbd, 0x8b, 0x67, 0xad, 0x37, 0xfa, 0x7b, 0x4f,
+	0xfe, 0xbb, 0xa5, 0xfd, 0x8a, 0xfa, 0xd4, 0x7b, 0xf6, 0xef, 0x00, 0xaf, 0x39, 0xc3, 0xad, 0x0a,
+	0x0b, 0x00, 0x00,
+}
+
+func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PacketStatus) > 0 {
+		i -= len(m.PacketStatus)
+		copy(dAtA[i:], m.PacketStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IsFulfilled {
+		i--
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderPacketStatusUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderPacketStatusUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderPacketStatusUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsFulfilled {
+		i--
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewPacketStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewPacketStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderFeeUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFeeUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFeeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFee) > 0 {
+		i -= len(m.NewFee)
+		copy(dAtA[i:], m.NewFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderFulfilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFulfilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PacketStatus) > 0 {
+		i -= len(m.PacketStatus)
+		copy(dAtA[i:], m.PacketStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IsFulfilled {
+		i--
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingPrice) > 0 {
+		i -= len(m.RemainingPrice)
+		copy(dAtA[i:], m.RemainingPrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RemainingPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPartialFulfillmentSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPartialFulfillmentSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPartialFulfillmentSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFulfillmentIntentCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFulfillmentIntentCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFulfillmentIntentCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFulfillmentIntentClosed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFulfillmentIntentClosed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFulfillmentIntentClosed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		i -= len(m.Refund)
+		copy(dAtA[i:], m.Refund)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Refund)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IntentId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.IntentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderFulfilledByIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFulfilledByIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFulfilledByIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingBudget) > 0 {
+		i -= len(m.RemainingBudget)
+		copy(dAtA[i:], m.RemainingBudget)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RemainingBudget)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IntentId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.IntentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRollappRiskLimitsSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRollappRiskLimitsSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRollappRiskLimitsSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderCreationSkipped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderCreationSkipped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderCreationSkipped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRollappDemandOrdersPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRollappDemandOrdersPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRollappDemandOrdersPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderFulfillmentTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFulfillmentTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFulfillmentTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeSubsidySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSubsidySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSubsidySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochCap) > 0 {
+		i -= len(m.EpochCap)
+		copy(dAtA[i:], m.EpochCap)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EpochCap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubsidyPercentage) > 0 {
+		i -= len(m.SubsidyPercentage)
+		copy(dAtA[i:], m.SubsidyPercentage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubsidyPercentage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeSubsidyFunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSubsidyFunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSubsidyFunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeSubsidyWithdrawn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSubsidyWithdrawn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSubsidyWithdrawn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeSubsidyPaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSubsidyPaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSubsidyPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRollappFeeFloorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRollappFeeFloorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRollappFeeFloorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinFeePercentage) > 0 {
+		i -= len(m.MinFeePercentage)
+		copy(dAtA[i:], m.MinFeePercentage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MinFeePercentage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventDemandOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderPacketStatusUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewPacketStatus != 0 {
+		n += 1 + sovEvents(uint64(m.NewPacketStatus))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDemandOrderFeeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFulfilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RemainingPrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPartialFulfillmentSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFulfillmentIntentCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Intent.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventFulfillmentIntentClosed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntentId != 0 {
+		n += 1 + sovEvents(uint64(m.IntentId))
+	}
+	l = len(m.Refund)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFulfilledByIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IntentId != 0 {
+		n += 1 + sovEvents(uint64(m.IntentId))
+	}
+	l = len(m.RemainingBudget)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRollappRiskLimitsSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limits.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderCreationSkipped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRollappDemandOrdersPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFulfillmentTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeSubsidySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubsidyPercentage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.EpochCap)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeSubsidyFunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeSubsidyWithdrawn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeSubsidyPaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRollappFeeFloorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MinFeePercentage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPacketStatus", wireType)
+			}
+			m.NewPacketStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPacketStatus |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderPartiallyFulfilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderPartiallyFulfilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderPartiallyFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPartialFulfillmentSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPartialFulfillmentSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPartialFulfillmentSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFulfillmentIntentCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFulfillmentIntentCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFulfillmentIntentCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFulfillmentIntentClosed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFulfillmentIntentClosed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFulfillmentIntentClosed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentId", wireType)
+			}
+			m.IntentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.NewFee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFulfilledByIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilledByIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilledByIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentId", wireType)
+			}
+			m.IntentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBudget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingBudget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRollappRiskLimitsSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRollappRiskLimitsSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRollappRiskLimitsSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventDemandOrderFulfilled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsFulfilled {
-		n += 2
-	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderCreationSkipped) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -808,15 +4177,15 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderCreationSkipped: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderCreationSkipped: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -844,11 +4213,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -876,11 +4245,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -908,13 +4277,177 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRollappDemandOrdersPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRollappDemandOrdersPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRollappDemandOrdersPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFulfillmentTransferred) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFulfillmentTransferred: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFulfillmentTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -924,15 +4457,27 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -960,11 +4505,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -992,9 +4537,59 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeSubsidySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeSubsidySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeSubsidySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
@@ -1026,9 +4621,9 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyPercentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1056,11 +4651,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.SubsidyPercentage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1088,7 +4683,7 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.EpochCap = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1111,7 +4706,7 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventFeeSubsidyFunded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1134,15 +4729,15 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeeSubsidyFunded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeeSubsidyFunded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1170,13 +4765,13 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPacketStatus", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.NewPacketStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1186,16 +4781,29 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewPacketStatus |= types.Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1205,12 +4813,24 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1232,7 +4852,7 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventFeeSubsidyWithdrawn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1255,15 +4875,15 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeeSubsidyWithdrawn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeeSubsidyWithdrawn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1291,11 +4911,11 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1323,7 +4943,39 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFee = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1346,7 +4998,7 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
+func (m *EventFeeSubsidyPaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,10 +5021,10 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeeSubsidyPaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeeSubsidyPaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1409,7 +5061,7 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1437,11 +5089,11 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1469,13 +5121,13 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1485,15 +5137,27 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1521,11 +5185,61 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.Balance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRollappFeeFloorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRollappFeeFloorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRollappFeeFloorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1553,11 +5267,11 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePercentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1585,7 +5299,7 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.MinFeePercentage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
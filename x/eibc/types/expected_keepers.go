@@ -1,9 +1,17 @@
 package types
 
 import (
+	"time"
+
+	"github.com/armon/go-metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -14,7 +22,10 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
 }
 
@@ -23,3 +34,30 @@ type DelayedAckKeeper interface {
 	BridgingFeeFromAmt(ctx sdk.Context, amt sdk.Int) (res sdk.Int)
 	BridgingFee(ctx sdk.Context) (res sdk.Dec)
 }
+
+// PacketForwardKeeper defines the expected interface needed to forward the funds of fulfilled orders.
+type PacketForwardKeeper interface {
+	ForwardTransferPacket(
+		ctx sdk.Context,
+		inFlightPacket *pfmtypes.InFlightPacket,
+		srcPacket channeltypes.Packet,
+		srcPacketSender string,
+		receiver string,
+		metadata *pfmtypes.ForwardMetadata,
+		token sdk.Coin,
+		maxRetries uint8,
+		timeout time.Duration,
+		labels []metrics.Label,
+		nonrefundable bool,
+	) error
+}
+
+// RollappKeeper defines the expected interface needed to check the state of rollapps.
+type RollappKeeper interface {
+	GetRollapp(ctx sdk.Context, rollappId string) (val rollapptypes.Rollapp, found bool)
+}
+
+// SequencerKeeper defines the expected interface needed to check the proposer of rollapps.
+type SequencerKeeper interface {
+	GetProposer(ctx sdk.Context, rollappId string) (val sequencertypes.Sequencer, found bool)
+}
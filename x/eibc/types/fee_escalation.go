@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+func NewFeeEscalation(maxFee, step math.Int, interval uint64, nextHeight int64) *FeeEscalation {
+	return &FeeEscalation{
+		MaxFee:     maxFee,
+		Step:       step,
+		Interval:   interval,
+		NextHeight: nextHeight,
+	}
+}
+
+func (m FeeEscalation) ValidateBasic() error {
+	if m.MaxFee.IsNil() || m.MaxFee.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidFeeEscalation, "max fee must not be negative")
+	}
+	if m.Step.IsNil() || !m.Step.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidFeeEscalation, "step must be positive")
+	}
+	if m.Interval == 0 {
+		return errorsmod.Wrap(ErrInvalidFeeEscalation, "interval must be positive")
+	}
+	return nil
+}
+
+// NextFee returns the fee following the given fee on the schedule, capped by the max fee.
+func (m FeeEscalation) NextFee(fee math.Int) math.Int {
+	return math.MinInt(fee.Add(m.Step), m.MaxFee)
+}
+
+// IsCapped returns true if the given fee already reached the max fee, so there is nothing left to escalate.
+func (m FeeEscalation) IsCapped(fee math.Int) bool {
+	return fee.GTE(m.MaxFee)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRollappFeeFloor creates a new fee floor override for the rollapp.
+func NewRollappFeeFloor(rollappId string, minFeePercentage sdk.Dec) RollappFeeFloor {
+	return RollappFeeFloor{
+		RollappId:        rollappId,
+		MinFeePercentage: minFeePercentage,
+	}
+}
+
+func (f RollappFeeFloor) ValidateBasic() error {
+	if f.RollappId == "" {
+		return fmt.Errorf("rollapp id cannot be empty")
+	}
+	return validateMinFeePercentage(f.MinFeePercentage)
+}
+
+// CheckFeeFloor returns an error if the fee is below the min fee percentage of the transferred amount.
+func CheckFeeFloor(fee, amount math.Int, minFeePercentage sdk.Dec) error {
+	if minFee := minFeePercentage.MulInt(amount); sdk.NewDecFromInt(fee).LT(minFee) {
+		return fmt.Errorf("%w: fee %s is below %s of the transferred amount %s", ErrFeeBelowFloor, fee, minFeePercentage, amount)
+	}
+	return nil
+}
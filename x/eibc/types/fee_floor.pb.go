@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/fee_floor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RollappFeeFloor overrides the min_fee_percentage module param for the demand orders of a rollapp.
+type RollappFeeFloor struct {
+	// rollapp_id is the rollapp the floor applies to.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// min_fee_percentage is the minimal fee of a demand order created on packet receipt, divided by the
+	// transferred amount. Below it, no demand order is created.
+	MinFeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_fee_percentage,json=minFeePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fee_percentage"`
+}
+
+func (m *RollappFeeFloor) Reset()         { *m = RollappFeeFloor{} }
+func (m *RollappFeeFloor) String() string { return proto.CompactTextString(m) }
+func (*RollappFeeFloor) ProtoMessage()    {}
+func (*RollappFeeFloor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae8d8b8f32f0276b, []int{0}
+}
+func (m *RollappFeeFloor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappFeeFloor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappFeeFloor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappFeeFloor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappFeeFloor.Merge(m, src)
+}
+func (m *RollappFeeFloor) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappFeeFloor) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappFeeFloor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappFeeFloor proto.InternalMessageInfo
+
+func (m *RollappFeeFloor) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RollappFeeFloor)(nil), "dymensionxyz.dymension.eibc.RollappFeeFloor")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/fee_floor.proto", fileDescriptor_ae8d8b8f32f0276b)
+}
+
+var fileDescriptor_ae8d8b8f32f0276b = []byte{
+	// 248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0xcc, 0x4d,
+	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
+	0xf5, 0xd3, 0x52, 0x53, 0xe3, 0xd3, 0x72, 0xf2, 0xf3, 0x8b, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
+	0x85, 0xa4, 0x91, 0x15, 0xeb, 0xc1, 0x39, 0x7a, 0x20, 0xc5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9,
+	0x60, 0x75, 0xfa, 0x20, 0x16, 0x44, 0x8b, 0x52, 0x1f, 0x23, 0x17, 0x7f, 0x50, 0x7e, 0x4e, 0x4e,
+	0x62, 0x41, 0x81, 0x5b, 0x6a, 0xaa, 0x1b, 0xc8, 0x30, 0x21, 0x59, 0x2e, 0xae, 0x22, 0x88, 0x50,
+	0x7c, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x27, 0x54, 0xc4, 0x33, 0x45, 0x28,
+	0x86, 0x4b, 0x28, 0x37, 0x33, 0x2f, 0x1e, 0x64, 0x79, 0x41, 0x6a, 0x51, 0x72, 0x6a, 0x5e, 0x49,
+	0x62, 0x7a, 0xaa, 0x04, 0x13, 0x48, 0x99, 0x93, 0xde, 0x89, 0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9,
+	0xab, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27, 0xe7, 0x17, 0xe7,
+	0xe6, 0x17, 0x43, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x3d, 0x97,
+	0xd4, 0xe4, 0x20, 0x81, 0xdc, 0xcc, 0x3c, 0xb7, 0xd4, 0xd4, 0x00, 0xb8, 0x39, 0x4e, 0xde, 0x27,
+	0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c,
+	0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x88, 0x64, 0x26, 0x8e, 0x50, 0x29,
+	0x33, 0xd6, 0xaf, 0x80, 0x04, 0x0d, 0xd8, 0x8a, 0x24, 0x36, 0xb0, 0x27, 0x8d, 0x01, 0x03, 0x00,
+	0x50, 0x1f, 0x78, 0xba, 0x46, 0x01, 0x00, 0x00,
+}
+
+func (m *RollappFeeFloor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappFeeFloor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappFeeFloor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinFeePercentage.Size()
+		i -= size
+		if _, err := m.MinFeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeFloor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintFeeFloor(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeFloor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeFloor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RollappFeeFloor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovFeeFloor(uint64(l))
+	}
+	l = m.MinFeePercentage.Size()
+	n += 1 + l + sovFeeFloor(uint64(l))
+	return n
+}
+
+func sovFeeFloor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeFloor(x uint64) (n int) {
+	return sovFeeFloor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RollappFeeFloor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeFloor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappFeeFloor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappFeeFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeFloor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeFloor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeFloor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeFloor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeFloor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeFloor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeFloor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeFloor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeFloor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeFloor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeFloor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeFloor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeFloor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeFloor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeFloor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeFloor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeFloor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeFloor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRollappFeeSubsidy creates a new empty fee subsidy pool for the rollapp.
+func NewRollappFeeSubsidy(rollappId string, subsidyPercentage sdk.Dec, epochCap sdk.Coins) RollappFeeSubsidy {
+	return RollappFeeSubsidy{
+		RollappId:         rollappId,
+		SubsidyPercentage: subsidyPercentage,
+		EpochCap:          epochCap,
+	}
+}
+
+func (s RollappFeeSubsidy) ValidateBasic() error {
+	if s.RollappId == "" {
+		return fmt.Errorf("rollapp id cannot be empty")
+	}
+	if err := validateSubsidyPercentage(s.SubsidyPercentage); err != nil {
+		return err
+	}
+	if err := s.EpochCap.Validate(); err != nil {
+		return fmt.Errorf("epoch cap: %w", err)
+	}
+	if err := s.Balance.Validate(); err != nil {
+		return fmt.Errorf("balance: %w", err)
+	}
+	if err := s.EpochPaid.Validate(); err != nil {
+		return fmt.Errorf("epoch paid: %w", err)
+	}
+	if err := s.TotalPaid.Validate(); err != nil {
+		return fmt.Errorf("total paid: %w", err)
+	}
+	return nil
+}
+
+// EpochRemaining returns the amount the pool can still pay out in the current epoch.
+func (s RollappFeeSubsidy) EpochRemaining() sdk.Coins {
+	remaining := sdk.NewCoins()
+	for _, c := range s.EpochCap {
+		amt := math.MinInt(c.Amount.Sub(s.EpochPaid.AmountOf(c.Denom)), s.Balance.AmountOf(c.Denom))
+		if amt.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(c.Denom, amt))
+		}
+	}
+	return remaining
+}
+
+// Subsidy returns the top-up of the given order fee, bounded by what the pool can still pay out in the current epoch.
+func (s RollappFeeSubsidy) Subsidy(fee sdk.Coin) sdk.Coin {
+	amt := s.SubsidyPercentage.MulInt(fee.Amount).TruncateInt()
+	return sdk.NewCoin(fee.Denom, math.MinInt(amt, s.EpochRemaining().AmountOf(fee.Denom)))
+}
+
+func validateSubsidyPercentage(p sdk.Dec) error {
+	if p.IsNil() || p.IsNegative() {
+		return fmt.Errorf("subsidy percentage must not be negative: %s", p)
+	}
+	return nil
+}
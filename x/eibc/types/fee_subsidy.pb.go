@@ -0,0 +1,636 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/fee_subsidy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RollappFeeSubsidy is a pool funded by the rollapp owner which tops up the fee paid to the fulfillers
+// of the demand orders of the rollapp. The pool funds are escrowed in the eibc module.
+type RollappFeeSubsidy struct {
+	// rollapp_id is the rollapp whose orders are subsidized.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// subsidy_percentage is the part of the order fee paid on top of it to the fulfiller.
+	SubsidyPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=subsidy_percentage,json=subsidyPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"subsidy_percentage"`
+	// epoch_cap is the maximal amount paid out by the pool per epoch. Orders in a denom without a cap are not subsidized.
+	EpochCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=epoch_cap,json=epochCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_cap"`
+	// balance is the amount left in the pool.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// epoch_paid is the amount paid out by the pool in the current epoch.
+	EpochPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=epoch_paid,json=epochPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_paid"`
+	// total_paid is the amount paid out by the pool since it was created.
+	TotalPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_paid,json=totalPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_paid"`
+}
+
+func (m *RollappFeeSubsidy) Reset()         { *m = RollappFeeSubsidy{} }
+func (m *RollappFeeSubsidy) String() string { return proto.CompactTextString(m) }
+func (*RollappFeeSubsidy) ProtoMessage()    {}
+func (*RollappFeeSubsidy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b29728ba0f055fb2, []int{0}
+}
+func (m *RollappFeeSubsidy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappFeeSubsidy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappFeeSubsidy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappFeeSubsidy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappFeeSubsidy.Merge(m, src)
+}
+func (m *RollappFeeSubsidy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappFeeSubsidy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappFeeSubsidy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappFeeSubsidy proto.InternalMessageInfo
+
+func (m *RollappFeeSubsidy) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *RollappFeeSubsidy) GetEpochCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochCap
+	}
+	return nil
+}
+
+func (m *RollappFeeSubsidy) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *RollappFeeSubsidy) GetEpochPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochPaid
+	}
+	return nil
+}
+
+func (m *RollappFeeSubsidy) GetTotalPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalPaid
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RollappFeeSubsidy)(nil), "dymensionxyz.dymension.eibc.RollappFeeSubsidy")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/fee_subsidy.proto", fileDescriptor_b29728ba0f055fb2)
+}
+
+var fileDescriptor_b29728ba0f055fb2 = []byte{
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xc1, 0x6e, 0xe2, 0x30,
+	0x10, 0x86, 0x93, 0x85, 0x65, 0x17, 0xef, 0x89, 0x68, 0x0f, 0x59, 0x56, 0x1b, 0xd0, 0x1e, 0x56,
+	0x5c, 0xb0, 0x97, 0xf2, 0x06, 0x50, 0x55, 0xaa, 0x7a, 0x41, 0xe9, 0xad, 0x52, 0x15, 0x39, 0xce,
+	0x34, 0xb8, 0x0d, 0xb1, 0x85, 0x03, 0x22, 0x7d, 0x8a, 0x3e, 0x47, 0x1f, 0xa1, 0x4f, 0xc0, 0x91,
+	0x63, 0xd5, 0x03, 0xad, 0xe0, 0x45, 0x2a, 0xc7, 0x29, 0xe2, 0x52, 0xa9, 0x07, 0x7a, 0xb2, 0x67,
+	0xfc, 0xcf, 0xff, 0xcd, 0x58, 0x83, 0xba, 0x51, 0x3e, 0x81, 0x54, 0x71, 0x91, 0x2e, 0xf2, 0x5b,
+	0xb2, 0x0b, 0x08, 0xf0, 0x90, 0x91, 0x2b, 0x80, 0x40, 0xcd, 0x42, 0xc5, 0xa3, 0x1c, 0xcb, 0xa9,
+	0xc8, 0x84, 0xf3, 0x7b, 0x5f, 0x8e, 0x77, 0x01, 0xd6, 0xf2, 0xe6, 0xcf, 0x58, 0xc4, 0xa2, 0xd0,
+	0x11, 0x7d, 0x33, 0x25, 0x4d, 0x8f, 0x09, 0x35, 0x11, 0x8a, 0x84, 0x54, 0x01, 0x99, 0xf7, 0x42,
+	0xc8, 0x68, 0x8f, 0x30, 0xc1, 0x53, 0xf3, 0xfe, 0xf7, 0xa1, 0x8a, 0x1a, 0xbe, 0x48, 0x12, 0x2a,
+	0xe5, 0x09, 0xc0, 0xb9, 0xc1, 0x39, 0x7f, 0x10, 0x9a, 0x9a, 0x64, 0xc0, 0x23, 0xd7, 0x6e, 0xdb,
+	0x9d, 0xba, 0x5f, 0x2f, 0x33, 0xa7, 0x91, 0x73, 0x89, 0x9c, 0xb2, 0xb1, 0x40, 0xc2, 0x94, 0x41,
+	0x9a, 0xd1, 0x18, 0xdc, 0x2f, 0x5a, 0x36, 0xc0, 0xcb, 0x75, 0xcb, 0x7a, 0x5a, 0xb7, 0xfe, 0xc5,
+	0x3c, 0x1b, 0xcf, 0x42, 0xcc, 0xc4, 0x84, 0x94, 0x3d, 0x98, 0xa3, 0xab, 0xa2, 0x1b, 0x92, 0xe5,
+	0x12, 0x14, 0x3e, 0x06, 0xe6, 0x37, 0x4a, 0xa7, 0xd1, 0xce, 0xc8, 0x19, 0xa3, 0x3a, 0x48, 0xc1,
+	0xc6, 0x01, 0xa3, 0xd2, 0xad, 0xb4, 0x2b, 0x9d, 0x1f, 0x47, 0xbf, 0xb0, 0x29, 0xc6, 0x7a, 0x0e,
+	0x5c, 0xce, 0x81, 0x87, 0x82, 0xa7, 0x83, 0xff, 0x1a, 0x78, 0xff, 0xdc, 0xea, 0x7c, 0x00, 0xa8,
+	0x0b, 0x94, 0xff, 0xbd, 0x70, 0x1f, 0x52, 0xe9, 0x00, 0xfa, 0x16, 0xd2, 0x84, 0xa6, 0x0c, 0xdc,
+	0xea, 0xe1, 0x39, 0x6f, 0xde, 0xce, 0x35, 0x42, 0x66, 0x20, 0x49, 0x79, 0xe4, 0x7e, 0x3d, 0x3c,
+	0xc9, 0xfc, 0xd7, 0x88, 0xf2, 0x48, 0xb3, 0x32, 0x91, 0xd1, 0xc4, 0xb0, 0x6a, 0x9f, 0xc0, 0x2a,
+	0xec, 0x35, 0x6b, 0x70, 0xb6, 0xdc, 0x78, 0xf6, 0x6a, 0xe3, 0xd9, 0x2f, 0x1b, 0xcf, 0xbe, 0xdb,
+	0x7a, 0xd6, 0x6a, 0xeb, 0x59, 0x8f, 0x5b, 0xcf, 0xba, 0xe8, 0xed, 0xd9, 0xbd, 0xb3, 0xe3, 0xf3,
+	0x3e, 0x59, 0x98, 0x45, 0x2f, 0xdc, 0xc3, 0x5a, 0xb1, 0x90, 0xfd, 0xd7, 0x01, 0x00, 0x15, 0x63,
+	0x0e, 0x8d, 0x14, 0x03, 0x00, 0x00,
+}
+
+func (m *RollappFeeSubsidy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappFeeSubsidy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappFeeSubsidy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalPaid) > 0 {
+		for iNdEx := len(m.TotalPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeSubsidy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EpochPaid) > 0 {
+		for iNdEx := len(m.EpochPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeSubsidy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeSubsidy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EpochCap) > 0 {
+		for iNdEx := len(m.EpochCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeSubsidy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.SubsidyPercentage.Size()
+		i -= size
+		if _, err := m.SubsidyPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeSubsidy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintFeeSubsidy(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeSubsidy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeSubsidy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RollappFeeSubsidy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovFeeSubsidy(uint64(l))
+	}
+	l = m.SubsidyPercentage.Size()
+	n += 1 + l + sovFeeSubsidy(uint64(l))
+	if len(m.EpochCap) > 0 {
+		for _, e := range m.EpochCap {
+			l = e.Size()
+			n += 1 + l + sovFeeSubsidy(uint64(l))
+		}
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovFeeSubsidy(uint64(l))
+		}
+	}
+	if len(m.EpochPaid) > 0 {
+		for _, e := range m.EpochPaid {
+			l = e.Size()
+			n += 1 + l + sovFeeSubsidy(uint64(l))
+		}
+	}
+	if len(m.TotalPaid) > 0 {
+		for _, e := range m.TotalPaid {
+			l = e.Size()
+			n += 1 + l + sovFeeSubsidy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeeSubsidy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeSubsidy(x uint64) (n int) {
+	return sovFeeSubsidy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RollappFeeSubsidy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeSubsidy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappFeeSubsidy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappFeeSubsidy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSubsidy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSubsidy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubsidyPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSubsidy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochCap = append(m.EpochCap, types.Coin{})
+			if err := m.EpochCap[len(m.EpochCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSubsidy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSubsidy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochPaid = append(m.EpochPaid, types.Coin{})
+			if err := m.EpochPaid[len(m.EpochPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeSubsidy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPaid = append(m.TotalPaid, types.Coin{})
+			if err := m.TotalPaid[len(m.TotalPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeSubsidy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeSubsidy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeSubsidy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeSubsidy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeSubsidy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeSubsidy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeSubsidy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeSubsidy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeSubsidy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeSubsidy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeSubsidy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeSubsidy = fmt.Errorf("proto: unexpected end of group")
+)
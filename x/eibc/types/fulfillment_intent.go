@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// NewFulfillmentIntent creates a new fulfillment intent.
+func NewFulfillmentIntent(id uint64, fulfiller, rollappId, denom string, minFeePercentage sdk.Dec, maxPrice, budget math.Int) FulfillmentIntent {
+	return FulfillmentIntent{
+		Id:               id,
+		FulfillerAddress: fulfiller,
+		RollappId:        rollappId,
+		Denom:            denom,
+		MinFeePercentage: minFeePercentage,
+		MaxPrice:         maxPrice,
+		Budget:           budget,
+	}
+}
+
+func (i FulfillmentIntent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(i.FulfillerAddress); err != nil {
+		return fmt.Errorf("fulfiller address: %w", err)
+	}
+	if i.RollappId == "" {
+		return fmt.Errorf("rollapp id cannot be empty")
+	}
+	if err := ibctransfertypes.ValidatePrefixedDenom(i.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+	if i.MinFeePercentage.IsNil() || i.MinFeePercentage.IsNegative() {
+		return fmt.Errorf("min fee percentage must not be negative: %s", i.MinFeePercentage)
+	}
+	if i.MaxPrice.IsNil() || !i.MaxPrice.IsPositive() {
+		return fmt.Errorf("max price must be positive: %s", i.MaxPrice)
+	}
+	if i.Budget.IsNil() || i.Budget.IsNegative() {
+		return fmt.Errorf("budget must not be negative: %s", i.Budget)
+	}
+	return nil
+}
+
+// Matches returns true if the demand order satisfies the criteria of the intent and the intent can afford it.
+func (i FulfillmentIntent) Matches(order *DemandOrder) bool {
+	if order.RollappId != i.RollappId || order.Price[0].Denom != i.Denom {
+		return false
+	}
+	price := order.Price[0].Amount
+	if price.GT(i.MaxPrice) || price.GT(i.Budget) {
+		return false
+	}
+	return order.FeePercentage().GTE(i.MinFeePercentage)
+}
+
+func (i FulfillmentIntent) GetFulfillerBech32Address() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(i.FulfillerAddress)
+}
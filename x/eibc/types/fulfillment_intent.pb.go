@@ -0,0 +1,618 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/fulfillment_intent.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FulfillmentIntent is a standing order of a fulfiller to fulfill demand orders matching its criteria.
+// The budget is escrowed in the eibc module and newly created demand orders are fulfilled from it immediately.
+type FulfillmentIntent struct {
+	// id is the unique identifier of the intent.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// fulfiller_address is the bech32-encoded address of the account which owns the intent.
+	// It receives the funds of the fulfilled orders once they are finalized.
+	FulfillerAddress string `protobuf:"bytes,2,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// rollapp_id is the rollapp whose demand orders are fulfilled.
+	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// denom is the denom of the demand orders which are fulfilled.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_fee_percentage is the minimal order fee divided by the order price, which the fulfiller accepts.
+	MinFeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_fee_percentage,json=minFeePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fee_percentage"`
+	// max_price is the maximal price of a single order which the fulfiller pays.
+	MaxPrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_price"`
+	// budget is the remaining escrowed amount available for fulfilling orders.
+	Budget github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=budget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"budget"`
+}
+
+func (m *FulfillmentIntent) Reset()         { *m = FulfillmentIntent{} }
+func (m *FulfillmentIntent) String() string { return proto.CompactTextString(m) }
+func (*FulfillmentIntent) ProtoMessage()    {}
+func (*FulfillmentIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9dceb264aae99b31, []int{0}
+}
+func (m *FulfillmentIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FulfillmentIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FulfillmentIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FulfillmentIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FulfillmentIntent.Merge(m, src)
+}
+func (m *FulfillmentIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *FulfillmentIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FulfillmentIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FulfillmentIntent proto.InternalMessageInfo
+
+func (m *FulfillmentIntent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FulfillmentIntent) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
+func (m *FulfillmentIntent) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *FulfillmentIntent) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*FulfillmentIntent)(nil), "dymensionxyz.dymension.eibc.FulfillmentIntent")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/fulfillment_intent.proto", fileDescriptor_9dceb264aae99b31)
+}
+
+var fileDescriptor_9dceb264aae99b31 = []byte{
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0x87, 0x93, 0xdc, 0xb6, 0xd7, 0xce, 0x42, 0xda, 0xa1, 0x8b, 0xa0, 0x98, 0x16, 0x17, 0x52,
+	0x10, 0x13, 0xa4, 0xbe, 0x80, 0x45, 0x0a, 0xa5, 0x9b, 0x92, 0xa5, 0x08, 0x21, 0xc9, 0x9c, 0xc6,
+	0xc1, 0xcc, 0x4c, 0xc8, 0x4c, 0x25, 0xf5, 0x29, 0x7c, 0xac, 0x2e, 0xbb, 0x14, 0x17, 0x45, 0xda,
+	0x97, 0x70, 0x29, 0xf9, 0x43, 0xec, 0xc6, 0x85, 0xae, 0x66, 0xce, 0x39, 0xbf, 0xef, 0x9b, 0xc5,
+	0x1c, 0x74, 0x43, 0x56, 0x0c, 0xb8, 0xa4, 0x82, 0x67, 0xab, 0x17, 0xa7, 0x2e, 0x1c, 0xa0, 0x41,
+	0xe8, 0x2c, 0x96, 0xf1, 0x82, 0xc6, 0x31, 0x03, 0xae, 0x3c, 0xca, 0x15, 0x70, 0x65, 0x27, 0xa9,
+	0x50, 0x02, 0x9f, 0x1e, 0x52, 0x76, 0x5d, 0xd8, 0x39, 0x75, 0xd2, 0x8b, 0x44, 0x24, 0x8a, 0x9c,
+	0x93, 0xdf, 0x4a, 0xe4, 0xfc, 0xd3, 0x40, 0xdd, 0xc9, 0xb7, 0x6f, 0x5a, 0xe8, 0xf0, 0x31, 0x32,
+	0x28, 0x31, 0xf5, 0x81, 0x3e, 0x6c, 0xb8, 0x06, 0x25, 0xf8, 0x12, 0x75, 0xab, 0x47, 0x21, 0xf5,
+	0x7c, 0x42, 0x52, 0x90, 0xd2, 0x34, 0x06, 0xfa, 0xb0, 0xed, 0x76, 0xea, 0xc1, 0x6d, 0xd9, 0xc7,
+	0x67, 0x08, 0xa5, 0x22, 0x8e, 0xfd, 0x24, 0xf1, 0x28, 0x31, 0xff, 0x15, 0xa9, 0x76, 0xd5, 0x99,
+	0x12, 0xdc, 0x43, 0x4d, 0x02, 0x5c, 0x30, 0xb3, 0x51, 0x4c, 0xca, 0x02, 0x3f, 0x20, 0xcc, 0x28,
+	0xf7, 0x16, 0x00, 0x5e, 0x02, 0x69, 0x08, 0x5c, 0xf9, 0x11, 0x98, 0xcd, 0x3c, 0x32, 0xb6, 0xd7,
+	0xdb, 0xbe, 0xf6, 0xbe, 0xed, 0x5f, 0x44, 0x54, 0x3d, 0x2e, 0x03, 0x3b, 0x14, 0xcc, 0x09, 0x85,
+	0x64, 0x42, 0x56, 0xc7, 0x95, 0x24, 0x4f, 0x8e, 0x5a, 0x25, 0x20, 0xed, 0x3b, 0x08, 0xdd, 0x0e,
+	0xa3, 0x7c, 0x02, 0x30, 0xaf, 0x3d, 0x78, 0x86, 0xda, 0xcc, 0xcf, 0xbc, 0x24, 0xa5, 0x21, 0x98,
+	0xad, 0x5f, 0x4b, 0xa7, 0x5c, 0xb9, 0x47, 0xcc, 0xcf, 0xe6, 0x39, 0x8f, 0x27, 0xa8, 0x15, 0x2c,
+	0x49, 0x04, 0xca, 0xfc, 0xff, 0x27, 0x53, 0x45, 0x8f, 0x67, 0xeb, 0x9d, 0xa5, 0x6f, 0x76, 0x96,
+	0xfe, 0xb1, 0xb3, 0xf4, 0xd7, 0xbd, 0xa5, 0x6d, 0xf6, 0x96, 0xf6, 0xb6, 0xb7, 0xb4, 0xfb, 0xeb,
+	0x03, 0xd3, 0x0f, 0x8b, 0xf0, 0x3c, 0x72, 0xb2, 0x72, 0x1b, 0x0a, 0x71, 0xd0, 0x2a, 0xbe, 0x73,
+	0xf4, 0x35, 0x00, 0xb6, 0x3b, 0x09, 0x07, 0x39, 0x02, 0x00, 0x00,
+}
+
+func (m *FulfillmentIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillmentIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FulfillmentIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Budget.Size()
+		i -= size
+		if _, err := m.Budget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFulfillmentIntent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFulfillmentIntent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinFeePercentage.Size()
+		i -= size
+		if _, err := m.MinFeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFulfillmentIntent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFulfillmentIntent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintFulfillmentIntent(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintFulfillmentIntent(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFulfillmentIntent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFulfillmentIntent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFulfillmentIntent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FulfillmentIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFulfillmentIntent(uint64(m.Id))
+	}
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovFulfillmentIntent(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovFulfillmentIntent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFulfillmentIntent(uint64(l))
+	}
+	l = m.MinFeePercentage.Size()
+	n += 1 + l + sovFulfillmentIntent(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovFulfillmentIntent(uint64(l))
+	l = m.Budget.Size()
+	n += 1 + l + sovFulfillmentIntent(uint64(l))
+	return n
+}
+
+func sovFulfillmentIntent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFulfillmentIntent(x uint64) (n int) {
+	return sovFulfillmentIntent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FulfillmentIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFulfillmentIntent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FulfillmentIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FulfillmentIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillmentIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillmentIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillmentIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillmentIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillmentIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillmentIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillmentIntent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFulfillmentIntent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFulfillmentIntent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFulfillmentIntent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFulfillmentIntent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFulfillmentIntent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFulfillmentIntent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFulfillmentIntent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFulfillmentIntent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFulfillmentIntent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFulfillmentIntent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFulfillmentIntent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFulfillmentIntent = fmt.Errorf("proto: unexpected end of group")
+)
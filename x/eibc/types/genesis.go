@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -23,5 +25,45 @@ func (gs GenesisState) Validate() error {
 		}
 		demandOrdersMap[demandOrder.Id] = struct{}{}
 	}
+	intentsMap := make(map[uint64]struct{})
+	for _, intent := range gs.GetFulfillmentIntents() {
+		if err := intent.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := intentsMap[intent.Id]; ok {
+			return fmt.Errorf("duplicate fulfillment intent id: %d", intent.Id)
+		}
+		intentsMap[intent.Id] = struct{}{}
+	}
+	limitsMap := make(map[string]struct{})
+	for _, limits := range gs.GetRollappRiskLimits() {
+		if err := limits.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := limitsMap[limits.RollappId]; ok {
+			return fmt.Errorf("duplicate rollapp risk limits: %s", limits.RollappId)
+		}
+		limitsMap[limits.RollappId] = struct{}{}
+	}
+	subsidiesMap := make(map[string]struct{})
+	for _, subsidy := range gs.GetFeeSubsidies() {
+		if err := subsidy.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := subsidiesMap[subsidy.RollappId]; ok {
+			return fmt.Errorf("duplicate rollapp fee subsidy: %s", subsidy.RollappId)
+		}
+		subsidiesMap[subsidy.RollappId] = struct{}{}
+	}
+	floorsMap := make(map[string]struct{})
+	for _, floor := range gs.GetRollappFeeFloors() {
+		if err := floor.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := floorsMap[floor.RollappId]; ok {
+			return fmt.Errorf("duplicate rollapp fee floor: %s", floor.RollappId)
+		}
+		floorsMap[floor.RollappId] = struct{}{}
+	}
 	return gs.Params.Validate()
 }
@@ -25,8 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the eibc module's genesis state.
 type GenesisState struct {
-	Params       Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	DemandOrders []DemandOrder `protobuf:"bytes,2,rep,name=demand_orders,json=demandOrders,proto3" json:"demand_orders"`
+	Params             Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	DemandOrders       []DemandOrder       `protobuf:"bytes,2,rep,name=demand_orders,json=demandOrders,proto3" json:"demand_orders"`
+	FulfillmentIntents []FulfillmentIntent `protobuf:"bytes,3,rep,name=fulfillment_intents,json=fulfillmentIntents,proto3" json:"fulfillment_intents"`
+	RollappRiskLimits  []RollappRiskLimits `protobuf:"bytes,4,rep,name=rollapp_risk_limits,json=rollappRiskLimits,proto3" json:"rollapp_risk_limits"`
+	FeeSubsidies       []RollappFeeSubsidy `protobuf:"bytes,5,rep,name=fee_subsidies,json=feeSubsidies,proto3" json:"fee_subsidies"`
+	RollappFeeFloors   []RollappFeeFloor   `protobuf:"bytes,6,rep,name=rollapp_fee_floors,json=rollappFeeFloors,proto3" json:"rollapp_fee_floors"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +80,34 @@ func (m *GenesisState) GetDemandOrders() []DemandOrder {
 	return nil
 }
 
+func (m *GenesisState) GetFulfillmentIntents() []FulfillmentIntent {
+	if m != nil {
+		return m.FulfillmentIntents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRollappRiskLimits() []RollappRiskLimits {
+	if m != nil {
+		return m.RollappRiskLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSubsidies() []RollappFeeSubsidy {
+	if m != nil {
+		return m.FeeSubsidies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRollappFeeFloors() []RollappFeeFloor {
+	if m != nil {
+		return m.RollappFeeFloors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.eibc.GenesisState")
 }
@@ -85,23 +117,33 @@ func init() {
 }
 
 var fileDescriptor_cfd2504316b5c400 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4c, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
-	0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4,
-	0x91, 0x95, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0xa5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x75,
-	0xfa, 0x20, 0x16, 0x44, 0x8b, 0x94, 0x06, 0x3e, 0xd3, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0xa1, 0x86,
-	0x4b, 0xe9, 0xe1, 0x53, 0x99, 0x92, 0x9a, 0x9b, 0x98, 0x97, 0x12, 0x9f, 0x5f, 0x94, 0x92, 0x5a,
-	0x04, 0x51, 0xaf, 0xb4, 0x8c, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0xbc, 0xe0, 0x92, 0xc4, 0x92, 0x54,
-	0x21, 0x47, 0x2e, 0x36, 0x88, 0x81, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xca, 0x7a, 0x78,
-	0x9c, 0xab, 0x17, 0x00, 0x56, 0xea, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xa3, 0x50,
-	0x30, 0x17, 0x2f, 0xb2, 0x4d, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x1a, 0x78, 0x4d,
-	0x72, 0x01, 0xeb, 0xf0, 0x07, 0x69, 0x80, 0x1a, 0xc7, 0x93, 0x82, 0x10, 0x2a, 0x76, 0xf2, 0x3e,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xc3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x1c, 0xbe, 0x2f, 0x33, 0xd6, 0xaf, 0x80, 0x04, 0x41, 0x49,
-	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xf3, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f,
-	0xc3, 0xf5, 0xf7, 0xb6, 0x01, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0x13, 0xb6, 0xf4, 0xe0, 0xdd, 0x95, 0xc0, 0xcb, 0x21, 0x2a, 0x52, 0x58, 0xc1, 0x25,
+	0x08, 0x70, 0xc4, 0x2e, 0x2f, 0x40, 0x85, 0x8a, 0x10, 0x48, 0xa0, 0xf6, 0x04, 0x97, 0x90, 0x34,
+	0x93, 0x60, 0x35, 0x89, 0x23, 0x8f, 0x8b, 0x1a, 0x9e, 0x82, 0x47, 0xe1, 0x31, 0x7a, 0xec, 0x91,
+	0x13, 0x42, 0xed, 0x8b, 0xa0, 0x38, 0xee, 0x1f, 0x8a, 0xc8, 0xe6, 0x66, 0x8f, 0xbf, 0xdf, 0x37,
+	0xf2, 0x37, 0x43, 0x1e, 0xc7, 0x55, 0x0e, 0x05, 0x72, 0x51, 0x2c, 0xaa, 0x6f, 0xfe, 0xee, 0xe2,
+	0x03, 0x8f, 0xa6, 0x7e, 0x0a, 0x05, 0x20, 0x47, 0x56, 0x4a, 0xa1, 0x04, 0xbd, 0x7f, 0x28, 0x65,
+	0xbb, 0x0b, 0xab, 0xa5, 0x83, 0x7b, 0xa9, 0x48, 0x85, 0xd6, 0xf9, 0xf5, 0xa9, 0x41, 0x06, 0x5e,
+	0x9b, 0x7b, 0x19, 0xca, 0x30, 0x37, 0xe6, 0x03, 0xd6, 0xa6, 0x8c, 0x21, 0x0f, 0x8b, 0x38, 0x10,
+	0x32, 0x06, 0x69, 0xf4, 0x2f, 0xda, 0xf4, 0xc9, 0x3c, 0x4b, 0x78, 0x96, 0xe5, 0x50, 0xa8, 0x80,
+	0x17, 0x0a, 0x0a, 0x65, 0xa8, 0x67, 0x6d, 0x94, 0xe4, 0x38, 0x0b, 0x32, 0x9e, 0x73, 0x85, 0x5d,
+	0xe4, 0x09, 0x40, 0x80, 0xf3, 0x08, 0x79, 0x5c, 0x19, 0xf9, 0x93, 0x9b, 0xe4, 0x49, 0x26, 0x84,
+	0xf9, 0xc0, 0xc3, 0x1f, 0x3d, 0x72, 0xf6, 0xba, 0xc9, 0x77, 0xa2, 0x42, 0x05, 0xf4, 0x25, 0xe9,
+	0x37, 0x89, 0x38, 0xf6, 0xa5, 0xed, 0x9d, 0x5e, 0x3d, 0x62, 0x2d, 0x79, 0xb3, 0x0f, 0x5a, 0x3a,
+	0xec, 0x2d, 0x7f, 0x3d, 0xb0, 0xc6, 0x06, 0xa4, 0x13, 0x72, 0x7e, 0x18, 0x15, 0x3a, 0xb7, 0x2e,
+	0x4f, 0xbc, 0xd3, 0x2b, 0xaf, 0xd5, 0xe9, 0x95, 0x26, 0xde, 0xd7, 0x80, 0xb1, 0x3b, 0x8b, 0xf7,
+	0x25, 0xa4, 0x40, 0x2e, 0xfe, 0xcd, 0x13, 0x9d, 0x13, 0x6d, 0xcd, 0x5a, 0xad, 0x47, 0x7b, 0xee,
+	0x8d, 0xc6, 0x4c, 0x03, 0x9a, 0x1c, 0x3f, 0x20, 0x8d, 0xc9, 0x85, 0x14, 0x59, 0x16, 0x96, 0x65,
+	0x70, 0x30, 0x08, 0xa7, 0xd7, 0xa1, 0xcd, 0xb8, 0xe1, 0xc6, 0x1c, 0x67, 0xef, 0x34, 0x65, 0xda,
+	0xdc, 0x95, 0xc7, 0x0f, 0xf4, 0x23, 0x39, 0xdf, 0xcf, 0x8d, 0x03, 0x3a, 0xb7, 0xbb, 0xfb, 0x8f,
+	0x00, 0x26, 0xcd, 0xbc, 0xb7, 0x39, 0x25, 0xdb, 0x0a, 0x07, 0xa4, 0x9f, 0x09, 0xdd, 0x7e, 0x60,
+	0x37, 0x6b, 0x74, 0xfa, 0xda, 0xff, 0x69, 0x47, 0xff, 0x51, 0x0d, 0x19, 0xf7, 0x3b, 0xf2, 0xef,
+	0x32, 0x0e, 0xdf, 0x2e, 0xd7, 0xae, 0xbd, 0x5a, 0xbb, 0xf6, 0xef, 0xb5, 0x6b, 0x7f, 0xdf, 0xb8,
+	0xd6, 0x6a, 0xe3, 0x5a, 0x3f, 0x37, 0xae, 0xf5, 0xe9, 0x79, 0xca, 0xd5, 0x97, 0x79, 0xc4, 0xa6,
+	0x22, 0xf7, 0xff, 0xb3, 0x84, 0x5f, 0xaf, 0xfd, 0x45, 0xb3, 0x89, 0xaa, 0x2a, 0x01, 0xa3, 0xbe,
+	0x5e, 0xc3, 0xeb, 0x3f, 0x03, 0x00, 0x1d, 0xc8, 0xcf, 0xe1, 0x01, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +166,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RollappFeeFloors) > 0 {
+		for iNdEx := len(m.RollappFeeFloors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollappFeeFloors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeeSubsidies) > 0 {
+		for iNdEx := len(m.FeeSubsidies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSubsidies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RollappRiskLimits) > 0 {
+		for iNdEx := len(m.RollappRiskLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollappRiskLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FulfillmentIntents) > 0 {
+		for iNdEx := len(m.FulfillmentIntents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FulfillmentIntents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DemandOrders) > 0 {
 		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -176,6 +274,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FulfillmentIntents) > 0 {
+		for _, e := range m.FulfillmentIntents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RollappRiskLimits) > 0 {
+		for _, e := range m.RollappRiskLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSubsidies) > 0 {
+		for _, e := range m.FeeSubsidies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RollappFeeFloors) > 0 {
+		for _, e := range m.RollappFeeFloors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +403,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentIntents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillmentIntents = append(m.FulfillmentIntents, FulfillmentIntent{})
+			if err := m.FulfillmentIntents[len(m.FulfillmentIntents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappRiskLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappRiskLimits = append(m.RollappRiskLimits, RollappRiskLimits{})
+			if err := m.RollappRiskLimits[len(m.RollappRiskLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSubsidies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSubsidies = append(m.FeeSubsidies, RollappFeeSubsidy{})
+			if err := m.FeeSubsidies[len(m.FeeSubsidies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappFeeFloors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappFeeFloors = append(m.RollappFeeFloors, RollappFeeFloor{})
+			if err := m.RollappFeeFloors[len(m.RollappFeeFloors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
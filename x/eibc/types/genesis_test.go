@@ -63,11 +63,12 @@ var validDemandOrder = types.DemandOrder{
 	Id:        "1",
 	Price:     sdk.Coins{sdk.NewInt64Coin("denom", 2)},
 	Fee:       sdk.Coins{sdk.NewInt64Coin("denom", 1)},
-	Recipient: "cosmos18wvvwfmq77a6d8tza4h5sfuy2yj3jj88yqg82a",
+	Recipient: "dym17g9cn4ss0h0dz5qhg2cg4zfnee6z3ftg3q6v58",
 }
 
 var validParams = types.Params{
-	EpochIdentifier: "hour",
-	TimeoutFee:      sdk.NewDecWithPrec(1, 1),
-	ErrackFee:       sdk.NewDecWithPrec(1, 1),
+	EpochIdentifier:  "hour",
+	TimeoutFee:       sdk.NewDecWithPrec(1, 1),
+	ErrackFee:        sdk.NewDecWithPrec(1, 1),
+	MinFeePercentage: sdk.NewDecWithPrec(1, 2),
 }
@@ -5,7 +5,11 @@ import (
 )
 
 type EIBCHooks interface {
+	// AfterDemandOrderFulfilled is called once per order, when its funds are claimed by a fulfiller.
+	// For partially fulfilled orders it is called on the first slice, with the order escrow as the fulfiller.
 	AfterDemandOrderFulfilled(ctx sdk.Context, demandOrder *DemandOrder, fulfillerAddress string) error
+	// AfterFulfillmentTransferred is called when the claim on the funds of a fulfilled order moves to a new fulfiller.
+	AfterFulfillmentTransferred(ctx sdk.Context, demandOrder *DemandOrder, newFulfillerAddress string) error
 }
 
 type MultiEIBCHooks []EIBCHooks
@@ -26,6 +30,16 @@ func (h MultiEIBCHooks) AfterDemandOrderFulfilled(ctx sdk.Context, demandOrder *
 	return nil
 }
 
+func (h MultiEIBCHooks) AfterFulfillmentTransferred(ctx sdk.Context, demandOrder *DemandOrder, newFulfillerAddress string) error {
+	for i := range h {
+		err := h[i].AfterFulfillmentTransferred(ctx, demandOrder, newFulfillerAddress)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type BaseEIBCHook struct{}
 
 var _ EIBCHooks = BaseEIBCHook{}
@@ -33,3 +47,7 @@ var _ EIBCHooks = BaseEIBCHook{}
 func (b BaseEIBCHook) AfterDemandOrderFulfilled(ctx sdk.Context, demandOrder *DemandOrder, fulfillerAddress string) error {
 	return nil
 }
+
+func (b BaseEIBCHook) AfterFulfillmentTransferred(ctx sdk.Context, demandOrder *DemandOrder, newFulfillerAddress string) error {
+	return nil
+}
@@ -1,14 +1,13 @@
 package types
 
 import (
-	"encoding/binary"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
 
-var _ binary.ByteOrder
-
 const (
 	// ModuleName defines the module name
 	ModuleName = "eibc"
@@ -36,6 +35,24 @@ var (
 	FinalizedDemandOrderKeyPrefix = []byte{0x00, 0x02}
 	// RevertedDemandOrderKeyPrefix is the prefix for reverted demand orders
 	RevertedDemandOrderKeyPrefix = []byte{0x00, 0x03}
+	// FulfillmentIntentKeyPrefix is the prefix for fulfillment intents by id
+	FulfillmentIntentKeyPrefix = []byte{0x01}
+	// FulfillmentIntentByRollappDenomKeyPrefix is the prefix for the index of fulfillment intents by rollapp and denom
+	FulfillmentIntentByRollappDenomKeyPrefix = []byte{0x02}
+	// NextFulfillmentIntentIDKey is the key for the id of the next fulfillment intent
+	NextFulfillmentIntentIDKey = []byte{0x03}
+	// FeeEscalationKeyPrefix is the prefix for the queue of demand order fee escalations by height
+	FeeEscalationKeyPrefix = []byte{0x04}
+	// RollappRiskLimitsKeyPrefix is the prefix for the risk limits of rollapps
+	RollappRiskLimitsKeyPrefix = []byte{0x05}
+	// OutstandingOrderValueKeyPrefix is the prefix for the total remaining price of outstanding orders by rollapp and denom
+	OutstandingOrderValueKeyPrefix = []byte{0x06}
+	// PendingDemandOrderByRollappDenomKeyPrefix is the prefix for the index of pending demand orders by rollapp and denom
+	PendingDemandOrderByRollappDenomKeyPrefix = []byte{0x07}
+	// RollappFeeSubsidyKeyPrefix is the prefix for the fee subsidy pools of rollapps
+	RollappFeeSubsidyKeyPrefix = []byte{0x08}
+	// RollappFeeFloorKeyPrefix is the prefix for the fee floor overrides of rollapps
+	RollappFeeFloorKeyPrefix = []byte{0x09}
 )
 
 // GetDemandOrderKey constructs a key for a specific DemandOrder.
@@ -54,3 +71,69 @@ func GetDemandOrderKey(packetStatus commontypes.Status, orderId string) ([]byte,
 	}
 	return []byte(fmt.Sprintf("%s%s%s%s%s", prefix, KeySeparator, packetStatus, KeySeparator, orderId)), nil
 }
+
+// GetFulfillmentIntentKey constructs a key for a specific FulfillmentIntent.
+func GetFulfillmentIntentKey(id uint64) []byte {
+	return append(append([]byte{}, FulfillmentIntentKeyPrefix...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetFulfillmentIntentsByRollappDenomPrefix constructs a prefix for the index of FulfillmentIntents of a rollapp and denom.
+func GetFulfillmentIntentsByRollappDenomPrefix(rollappId, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", FulfillmentIntentByRollappDenomKeyPrefix, rollappId, KeySeparator, denom, KeySeparator))
+}
+
+// GetFulfillmentIntentByRollappDenomKey constructs an index key for a specific FulfillmentIntent.
+// Intents are ordered by id under the rollapp and denom prefix, so the oldest intent is matched first.
+func GetFulfillmentIntentByRollappDenomKey(rollappId, denom string, id uint64) []byte {
+	return append(GetFulfillmentIntentsByRollappDenomPrefix(rollappId, denom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetFeeEscalationsByHeightPrefix constructs a prefix for the fee escalations due at a hub height.
+func GetFeeEscalationsByHeightPrefix(height int64) []byte {
+	return append(append([]byte{}, FeeEscalationKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetFeeEscalationKey constructs a key for the fee escalation of a specific DemandOrder due at a hub height.
+func GetFeeEscalationKey(height int64, orderId string) []byte {
+	return append(GetFeeEscalationsByHeightPrefix(height), []byte(orderId)...)
+}
+
+// GetRollappRiskLimitsKey constructs a key for the RollappRiskLimits of a rollapp.
+func GetRollappRiskLimitsKey(rollappId string) []byte {
+	return append(append([]byte{}, RollappRiskLimitsKeyPrefix...), []byte(rollappId)...)
+}
+
+// GetOutstandingOrderValuesPrefix constructs a prefix for the outstanding order values of a rollapp.
+func GetOutstandingOrderValuesPrefix(rollappId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", OutstandingOrderValueKeyPrefix, rollappId, KeySeparator))
+}
+
+// GetOutstandingOrderValueKey constructs a key for the outstanding order value of a rollapp in a denom.
+func GetOutstandingOrderValueKey(rollappId, denom string) []byte {
+	return append(GetOutstandingOrderValuesPrefix(rollappId), []byte(denom)...)
+}
+
+// GetPendingDemandOrdersByRollappPrefix constructs a prefix for the index of pending DemandOrders of a rollapp.
+func GetPendingDemandOrdersByRollappPrefix(rollappId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", PendingDemandOrderByRollappDenomKeyPrefix, rollappId, KeySeparator))
+}
+
+// GetPendingDemandOrdersByRollappDenomPrefix constructs a prefix for the index of pending DemandOrders of a rollapp and denom.
+func GetPendingDemandOrdersByRollappDenomPrefix(rollappId, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", GetPendingDemandOrdersByRollappPrefix(rollappId), denom, KeySeparator))
+}
+
+// GetPendingDemandOrderByRollappDenomKey constructs an index key for a specific pending DemandOrder.
+func GetPendingDemandOrderByRollappDenomKey(rollappId, denom, orderId string) []byte {
+	return append(GetPendingDemandOrdersByRollappDenomPrefix(rollappId, denom), []byte(orderId)...)
+}
+
+// GetRollappFeeSubsidyKey constructs a key for the RollappFeeSubsidy of a rollapp.
+func GetRollappFeeSubsidyKey(rollappId string) []byte {
+	return append(append([]byte{}, RollappFeeSubsidyKeyPrefix...), []byte(rollappId)...)
+}
+
+// GetRollappFeeFloorKey constructs a key for the RollappFeeFloor of a rollapp.
+func GetRollappFeeFloorKey(rollappId string) []byte {
+	return append(append([]byte{}, RollappFeeFloorKeyPrefix...), []byte(rollappId)...)
+}
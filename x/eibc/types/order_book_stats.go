@@ -0,0 +1,66 @@
+package types
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOrderBookStats aggregates the pending demand orders of a rollapp in a denom at the given hub height.
+func NewOrderBookStats(rollappId, denom string, orders []*DemandOrder, height int64) OrderBookStats {
+	stats := OrderBookStats{
+		RollappId:         rollappId,
+		Denom:             denom,
+		PendingPrice:      math.ZeroInt(),
+		UnfulfilledVolume: math.ZeroInt(),
+	}
+
+	var feePercentages []sdk.Dec
+	for _, order := range orders {
+		stats.PendingCount++
+		stats.PendingPrice = stats.PendingPrice.Add(order.Price.AmountOf(denom))
+
+		if order.IsFulfilled() {
+			continue
+		}
+		stats.UnfulfilledCount++
+		stats.UnfulfilledVolume = stats.UnfulfilledVolume.Add(order.RemainingPrice())
+		feePercentages = append(feePercentages, order.FeePercentage())
+		if stats.UnfulfilledCount == 1 || order.CreationHeight < stats.OldestOrderCreationHeight {
+			stats.OldestOrderCreationHeight = order.CreationHeight
+		}
+	}
+	if stats.UnfulfilledCount != 0 {
+		stats.OldestOrderAge = height - stats.OldestOrderCreationHeight
+	}
+	stats.FeePercentiles = NewFeePercentiles(feePercentages)
+
+	return stats
+}
+
+// NewFeePercentiles returns the percentiles of the fee percentages using the nearest-rank method.
+func NewFeePercentiles(feePercentages []sdk.Dec) FeePercentiles {
+	sorted := make([]sdk.Dec, len(feePercentages))
+	copy(sorted, feePercentages)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+	percentile := func(p int) sdk.Dec {
+		if len(sorted) == 0 {
+			return sdk.ZeroDec()
+		}
+		// nearest rank: the smallest value such that at least p percent of the values are less or equal to it
+		rank := (p*len(sorted) + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+
+	return FeePercentiles{
+		P25: percentile(25),
+		P50: percentile(50),
+		P75: percentile(75),
+		P90: percentile(90),
+	}
+}
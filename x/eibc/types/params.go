@@ -17,12 +17,15 @@ var (
 	KeyTimeoutFee = []byte("TimeoutFee")
 	// KeyErrAckFee is the key for the error acknowledgement fee
 	KeyErrAckFee = []byte("ErrAckFee")
+	// KeyMinFeePercentage is the key for the minimal fee percentage of demand orders created on packet receipt
+	KeyMinFeePercentage = []byte("MinFeePercentage")
 )
 
 const (
-	defaultEpochIdentifier = "hour"
-	defaultTimeoutFee      = "0.0015"
-	defaultErrAckFee       = "0.0015"
+	defaultEpochIdentifier  = "hour"
+	defaultTimeoutFee       = "0.0015"
+	defaultErrAckFee        = "0.0015"
+	defaultMinFeePercentage = "0"
 )
 
 // ParamKeyTable the param key table for launch module
@@ -31,17 +34,23 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(epochIdentifier string, timeoutFee sdk.Dec, errAckFee sdk.Dec) Params {
+func NewParams(epochIdentifier string, timeoutFee sdk.Dec, errAckFee sdk.Dec, minFeePercentage sdk.Dec) Params {
 	return Params{
-		EpochIdentifier: epochIdentifier,
-		TimeoutFee:      timeoutFee,
-		ErrackFee:       errAckFee,
+		EpochIdentifier:  epochIdentifier,
+		TimeoutFee:       timeoutFee,
+		ErrackFee:        errAckFee,
+		MinFeePercentage: minFeePercentage,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(defaultEpochIdentifier, sdk.MustNewDecFromStr(defaultTimeoutFee), sdk.MustNewDecFromStr(defaultErrAckFee))
+	return NewParams(
+		defaultEpochIdentifier,
+		sdk.MustNewDecFromStr(defaultTimeoutFee),
+		sdk.MustNewDecFromStr(defaultErrAckFee),
+		sdk.MustNewDecFromStr(defaultMinFeePercentage),
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -50,6 +59,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, validateEpochIdentifier),
 		paramtypes.NewParamSetPair(KeyTimeoutFee, &p.TimeoutFee, validateTimeoutFee),
 		paramtypes.NewParamSetPair(KeyErrAckFee, &p.ErrackFee, validateErrAckFee),
+		paramtypes.NewParamSetPair(KeyMinFeePercentage, &p.MinFeePercentage, validateMinFeePercentage),
 	}
 }
 
@@ -64,6 +74,9 @@ func (p Params) Validate() error {
 	if err := validateErrAckFee(p.ErrackFee); err != nil {
 		return fmt.Errorf("error acknowledgement fee: %w", err)
 	}
+	if err := validateMinFeePercentage(p.MinFeePercentage); err != nil {
+		return fmt.Errorf("min fee percentage: %w", err)
+	}
 	return nil
 }
 
@@ -121,3 +134,22 @@ func validateErrAckFee(i any) error {
 
 	return nil
 }
+
+func validateMinFeePercentage(i any) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("invalid min fee percentage: %+v", i)
+	}
+	if v.IsNegative() {
+		return ErrNegativeFee
+	}
+
+	if v.GTE(sdk.OneDec()) {
+		return ErrFeeTooHigh
+	}
+
+	return nil
+}
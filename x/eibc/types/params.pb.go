@@ -29,6 +29,9 @@ type Params struct {
 	EpochIdentifier string                                 `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
 	TimeoutFee      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=timeout_fee,json=timeoutFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"timeout_fee" yaml:"timeout_fee"`
 	ErrackFee       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=errack_fee,json=errackFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"errack_fee" yaml:"errack_fee"`
+	// min_fee_percentage is the minimal fee of a demand order created on packet receipt, divided by the
+	// transferred amount. Below it, no demand order is created and the packet waits for finalization.
+	MinFeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_fee_percentage,json=minFeePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fee_percentage" yaml:"min_fee_percentage"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,26 +82,29 @@ func init() {
 }
 
 var fileDescriptor_fa18b53f607a3f90 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
-	0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x91,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x75, 0xfa,
-	0x20, 0x16, 0x44, 0x8b, 0xd2, 0x42, 0x26, 0x2e, 0xb6, 0x00, 0xb0, 0x19, 0x42, 0x6e, 0x5c, 0x02,
-	0xa9, 0x05, 0xf9, 0xc9, 0x19, 0xf1, 0x99, 0x29, 0xa9, 0x79, 0x25, 0x99, 0x69, 0x99, 0xa9, 0x45,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0xd2, 0x9f, 0xee, 0xc9, 0x8b, 0x57, 0x26, 0xe6, 0xe6,
-	0x58, 0x29, 0xa1, 0xab, 0x50, 0x0a, 0xe2, 0x07, 0x0b, 0x79, 0xc2, 0x45, 0x84, 0x52, 0xb9, 0xb8,
-	0x4b, 0x32, 0x73, 0x53, 0xf3, 0x4b, 0x4b, 0xe2, 0xd3, 0x52, 0x53, 0x25, 0x98, 0xc0, 0x46, 0xb8,
-	0x9c, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x31, 0x94, 0xd2, 0x2d, 0x4e, 0xc9, 0xd6,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x73, 0x49, 0x4d, 0xfe, 0x74, 0x4f, 0x5e, 0x08, 0x62, 0x21,
-	0x92, 0x51, 0x4a, 0x41, 0x5c, 0x50, 0x9e, 0x5b, 0x6a, 0xaa, 0x50, 0x12, 0x17, 0x57, 0x6a, 0x51,
-	0x51, 0x62, 0x72, 0x36, 0xd8, 0x16, 0x66, 0xb0, 0x2d, 0xce, 0x24, 0xdb, 0x22, 0x08, 0xf5, 0x16,
-	0xdc, 0x24, 0xa5, 0x20, 0x4e, 0x08, 0xc7, 0x2d, 0x35, 0xd5, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06,
-	0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x44, 0xb2, 0x07,
-	0x47, 0x2c, 0x95, 0x19, 0xeb, 0x57, 0x40, 0xa2, 0x0a, 0x6c, 0x6d, 0x12, 0x1b, 0x38, 0xdc, 0x8d,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x58, 0x0e, 0xde, 0xd6, 0x01, 0x00, 0x00,
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6b, 0xf2, 0x40,
+	0x1c, 0xc6, 0x93, 0x57, 0x11, 0xbc, 0x77, 0xa8, 0x0d, 0x85, 0xda, 0x0a, 0x49, 0xc9, 0x50, 0x5c,
+	0x9a, 0xa3, 0xb8, 0x39, 0x5a, 0x11, 0x8a, 0x8b, 0x64, 0xec, 0x22, 0xc9, 0xf9, 0x37, 0x1e, 0xf6,
+	0x72, 0xe1, 0xee, 0x2c, 0xa6, 0x9f, 0xa2, 0x63, 0x87, 0x0e, 0xfd, 0x38, 0x8e, 0x8e, 0xa5, 0x43,
+	0x28, 0xfa, 0x0d, 0xfc, 0x04, 0x25, 0x17, 0x09, 0xd2, 0xd2, 0xc1, 0xe9, 0xee, 0x79, 0x78, 0xee,
+	0xf9, 0xdd, 0x71, 0x7f, 0xd4, 0x9e, 0xa4, 0x0c, 0x62, 0x49, 0x79, 0xbc, 0x4c, 0x9f, 0x71, 0x29,
+	0x30, 0xd0, 0x90, 0xe0, 0x24, 0x10, 0x01, 0x93, 0x5e, 0x22, 0xb8, 0xe2, 0x56, 0xeb, 0x30, 0xe9,
+	0x95, 0xc2, 0xcb, 0x93, 0x97, 0x67, 0x11, 0x8f, 0xb8, 0xce, 0xe1, 0x7c, 0x57, 0x1c, 0x71, 0xdf,
+	0x2a, 0xa8, 0x36, 0xd2, 0x1d, 0xd6, 0x00, 0x35, 0x20, 0xe1, 0x64, 0x36, 0xa6, 0x13, 0x88, 0x15,
+	0x9d, 0x52, 0x10, 0x4d, 0xf3, 0xca, 0x6c, 0xd7, 0x7b, 0xad, 0x5d, 0xe6, 0x9c, 0xa7, 0x01, 0x7b,
+	0xec, 0xba, 0x3f, 0x13, 0xae, 0x7f, 0xa2, 0xad, 0xfb, 0xd2, 0xb1, 0x00, 0xfd, 0x57, 0x94, 0x01,
+	0x5f, 0xa8, 0xf1, 0x14, 0xa0, 0xf9, 0x4f, 0x57, 0xf4, 0x57, 0x99, 0x63, 0x7c, 0x66, 0xce, 0x75,
+	0x44, 0xd5, 0x6c, 0x11, 0x7a, 0x84, 0x33, 0x4c, 0xb8, 0x64, 0x5c, 0xee, 0x97, 0x1b, 0x39, 0x99,
+	0x63, 0x95, 0x26, 0x20, 0xbd, 0x3e, 0x90, 0x5d, 0xe6, 0x58, 0x05, 0xf0, 0xa0, 0xca, 0xf5, 0xd1,
+	0x5e, 0x0d, 0x00, 0xac, 0x10, 0x21, 0x10, 0x22, 0x20, 0x73, 0x4d, 0xa9, 0x68, 0xca, 0xdd, 0xd1,
+	0x94, 0xd3, 0xfd, 0xb3, 0xca, 0x26, 0xd7, 0xaf, 0x17, 0x22, 0x67, 0xa4, 0xc8, 0x62, 0x34, 0xce,
+	0xed, 0x71, 0x02, 0x82, 0x40, 0xac, 0x82, 0x08, 0x9a, 0x55, 0xcd, 0x1a, 0x1e, 0xcd, 0xba, 0x28,
+	0x58, 0xbf, 0x1b, 0x5d, 0xbf, 0xc1, 0x68, 0x3c, 0x00, 0x18, 0x95, 0x56, 0xb7, 0xfa, 0xfa, 0xee,
+	0x18, 0xbd, 0xe1, 0x6a, 0x63, 0x9b, 0xeb, 0x8d, 0x6d, 0x7e, 0x6d, 0x6c, 0xf3, 0x65, 0x6b, 0x1b,
+	0xeb, 0xad, 0x6d, 0x7c, 0x6c, 0x6d, 0xe3, 0xe1, 0xf6, 0x00, 0xfb, 0xc7, 0x80, 0x3c, 0x75, 0xf0,
+	0xb2, 0x98, 0x12, 0x7d, 0x8b, 0xb0, 0xa6, 0xbf, 0xbc, 0xf3, 0x3d, 0x00, 0xa7, 0x65, 0xc5, 0x1c,
+	0x51, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +127,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinFeePercentage.Size()
+		i -= size
+		if _, err := m.MinFeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.ErrackFee.Size()
 		i -= size
@@ -176,6 +192,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.ErrackFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.MinFeePercentage.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -314,6 +332,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,7 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -389,572 +391,3772 @@ func (m *QueryDemandOrdersByStatusResponse) GetDemandOrders() []*DemandOrder {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentState", FulfillmentState_name, FulfillmentState_value)
-	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.eibc.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.eibc.QueryParamsResponse")
-	proto.RegisterType((*QueryGetDemandOrderRequest)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderRequest")
-	proto.RegisterType((*QueryDemandOrdersByStatusRequest)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusRequest")
-	proto.RegisterType((*QueryGetDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderResponse")
-	proto.RegisterType((*QueryDemandOrdersByStatusResponse)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusResponse")
+// QueryFulfillmentIntentsRequest is the request type for the Query/FulfillmentIntents RPC method.
+type QueryFulfillmentIntentsRequest struct {
+	// optional fulfiller address
+	Fulfiller string `protobuf:"bytes,1,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// optional rollapp_id
+	RollappId  string             `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/query.proto", fileDescriptor_d85bfe71ceb5f8dc)
+func (m *QueryFulfillmentIntentsRequest) Reset()         { *m = QueryFulfillmentIntentsRequest{} }
+func (m *QueryFulfillmentIntentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillmentIntentsRequest) ProtoMessage()    {}
+func (*QueryFulfillmentIntentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{6}
 }
-
-var fileDescriptor_d85bfe71ceb5f8dc = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x96, 0xb6, 0xdf, 0x6f, 0x87, 0x5f, 0x75, 0xec, 0x61, 0x53, 0xb0, 0x62, 0x09, 0xb1,
-	0x41, 0xdd, 0xa1, 0x25, 0xfe, 0x48, 0x0c, 0x26, 0x34, 0x6d, 0x4d, 0x03, 0x22, 0xae, 0x92, 0x18,
-	0x2e, 0x64, 0xdb, 0x1d, 0xea, 0xe8, 0xee, 0xce, 0xb2, 0x3b, 0x25, 0xac, 0x84, 0x8b, 0x57, 0x2f,
-	0x26, 0x5e, 0xfc, 0x4b, 0x3c, 0xf9, 0x07, 0x70, 0x24, 0x7a, 0xf1, 0x64, 0x0c, 0xf8, 0x87, 0x98,
-	0x9d, 0x99, 0xc2, 0x8a, 0xb0, 0x05, 0x6f, 0x7d, 0xaf, 0xef, 0xf3, 0xde, 0xe7, 0xf3, 0xd9, 0x37,
-	0x0f, 0xdc, 0x34, 0x03, 0x1b, 0x3b, 0x3e, 0xa1, 0xce, 0x4e, 0xf0, 0x16, 0x1d, 0x07, 0x08, 0x93,
-	0x76, 0x07, 0x6d, 0xf5, 0xb0, 0x17, 0x68, 0xae, 0x47, 0x19, 0x85, 0x13, 0xd1, 0x42, 0xed, 0x38,
-	0xd0, 0xc2, 0xc2, 0x42, 0xbe, 0x4b, 0xbb, 0x94, 0xd7, 0xa1, 0xf0, 0x97, 0x80, 0x14, 0x26, 0xbb,
-	0x94, 0x76, 0x2d, 0x8c, 0x0c, 0x97, 0x20, 0xc3, 0x71, 0x28, 0x33, 0x18, 0xa1, 0x8e, 0x2f, 0xff,
-	0x9d, 0xed, 0x50, 0xdf, 0xa6, 0x3e, 0x6a, 0x1b, 0x3e, 0x16, 0x93, 0xd0, 0x76, 0xa5, 0x8d, 0x99,
-	0x51, 0x41, 0xae, 0xd1, 0x25, 0x0e, 0x2f, 0x96, 0xb5, 0xe5, 0x38, 0x96, 0xae, 0xe1, 0x19, 0x76,
-	0xbf, 0xab, 0x16, 0x57, 0x69, 0x62, 0xdb, 0x70, 0xcc, 0x0d, 0xea, 0x99, 0xd8, 0xeb, 0xb3, 0x38,
-	0xa7, 0xbe, 0x43, 0x6d, 0x9b, 0x3a, 0xc8, 0x67, 0x06, 0xeb, 0xf5, 0x7b, 0x57, 0xe3, 0x6b, 0x3d,
-	0x6a, 0x59, 0x86, 0xeb, 0x6e, 0xb8, 0x46, 0xe7, 0x0d, 0x66, 0x02, 0x53, 0xca, 0x03, 0xf8, 0x2c,
-	0xd4, 0xb6, 0xca, 0x49, 0xea, 0x78, 0xab, 0x87, 0x7d, 0x56, 0x7a, 0x09, 0xae, 0xfe, 0x91, 0xf5,
-	0x5d, 0xea, 0xf8, 0x18, 0x2e, 0x82, 0x8c, 0x10, 0xa3, 0x2a, 0x53, 0x4a, 0x79, 0xb8, 0x3a, 0xad,
-	0xc5, 0x98, 0xae, 0x09, 0x70, 0x2d, 0xb5, 0xff, 0xe3, 0x7a, 0x42, 0x97, 0xc0, 0xd2, 0x6d, 0x50,
-	0xe0, 0x9d, 0x1f, 0x63, 0x56, 0xe7, 0x6a, 0x9f, 0x86, 0x62, 0xe5, 0x5c, 0x38, 0x06, 0x92, 0xc4,
-	0xe4, 0xcd, 0xb3, 0x7a, 0x92, 0x98, 0xa5, 0xf7, 0x43, 0x60, 0x8a, 0x97, 0x47, 0x6a, 0xfd, 0x5a,
-	0xf0, 0x9c, 0xab, 0xee, 0x83, 0x16, 0x40, 0x46, 0xd8, 0xc0, 0x81, 0x63, 0xd5, 0x99, 0xf3, 0x58,
-	0x09, 0x1f, 0x34, 0x89, 0x96, 0x20, 0xd8, 0x00, 0x29, 0x16, 0xb8, 0x58, 0x4d, 0x72, 0x70, 0x65,
-	0x00, 0x58, 0x17, 0x26, 0xae, 0x0a, 0x0f, 0x5f, 0x04, 0x2e, 0xd6, 0x39, 0x1c, 0x5e, 0x03, 0xa0,
-	0x6f, 0x30, 0x31, 0xd5, 0x21, 0x2e, 0x21, 0x2b, 0x33, 0x2d, 0x13, 0xe6, 0x41, 0xda, 0x22, 0x36,
-	0x61, 0x6a, 0x6a, 0x4a, 0x29, 0xa7, 0x75, 0x11, 0xc0, 0x75, 0x70, 0x65, 0xb3, 0x67, 0x6d, 0x12,
-	0xcb, 0xb2, 0xb1, 0xc3, 0x36, 0x42, 0x46, 0x58, 0x4d, 0x73, 0x22, 0x77, 0x62, 0xbd, 0x6d, 0x9e,
-	0xa0, 0x42, 0x39, 0x58, 0xcf, 0x6d, 0x9e, 0xca, 0xc0, 0x49, 0x90, 0x95, 0x39, 0xec, 0xa9, 0x19,
-	0xc1, 0xe7, 0x38, 0x11, 0xf2, 0x31, 0xb1, 0x43, 0x6d, 0xf5, 0x3f, 0xfe, 0x8f, 0x08, 0x42, 0x8c,
-	0x87, 0x3b, 0xc4, 0x25, 0xd8, 0x61, 0xea, 0xff, 0x52, 0x43, 0x3f, 0x51, 0x7a, 0x0d, 0x26, 0xce,
-	0xfc, 0x76, 0x72, 0x3b, 0x96, 0xc0, 0x48, 0x74, 0x81, 0xe5, 0x8e, 0x94, 0x63, 0x75, 0x44, 0xfb,
-	0x0c, 0x9b, 0x27, 0x41, 0xc9, 0x03, 0x37, 0x62, 0x3e, 0xbc, 0x9c, 0xf8, 0x04, 0x8c, 0x46, 0x27,
-	0x86, 0x0b, 0x30, 0x74, 0xa9, 0x91, 0x23, 0x91, 0x91, 0xfe, 0xec, 0x22, 0xc8, 0x9d, 0xf6, 0x15,
-	0x8e, 0x82, 0xec, 0xda, 0x4a, 0xbd, 0xd1, 0x6c, 0xad, 0x34, 0xea, 0xb9, 0x44, 0x18, 0x36, 0xd7,
-	0x96, 0x9b, 0xad, 0xe5, 0xe5, 0x46, 0x3d, 0xa7, 0xc0, 0x71, 0x30, 0xbc, 0xb6, 0x72, 0x92, 0x48,
-	0x56, 0x3f, 0xa7, 0x40, 0x9a, 0xf3, 0x86, 0x9f, 0x14, 0x90, 0x11, 0x2f, 0x00, 0xa2, 0x58, 0x3e,
-	0x7f, 0x3f, 0xbf, 0xc2, 0xdc, 0xc5, 0x01, 0xc2, 0x89, 0xd2, 0xad, 0x77, 0xdf, 0x7e, 0x7d, 0x4c,
-	0xce, 0xc0, 0x69, 0x34, 0xf8, 0x12, 0xc1, 0x2f, 0x0a, 0x18, 0x8f, 0xb8, 0x50, 0x0b, 0x5a, 0x26,
-	0xbc, 0x3f, 0x78, 0xe4, 0x99, 0x4f, 0xb6, 0xf0, 0xe0, 0xf2, 0x40, 0xc9, 0xf9, 0x1e, 0xe7, 0x3c,
-	0x07, 0x35, 0x74, 0xd1, 0x9b, 0x88, 0x76, 0x89, 0xb9, 0x07, 0xbf, 0x2a, 0x20, 0x7f, 0xd6, 0x5a,
-	0xc0, 0x85, 0xc1, 0x54, 0x62, 0xee, 0x48, 0xe1, 0xd1, 0xbf, 0xc2, 0xa5, 0x9e, 0x87, 0x5c, 0xcf,
-	0x5d, 0x38, 0x7f, 0x61, 0x3d, 0x3e, 0xda, 0x15, 0x47, 0x68, 0xaf, 0xb6, 0xb4, 0x7f, 0x58, 0x54,
-	0x0e, 0x0e, 0x8b, 0xca, 0xcf, 0xc3, 0xa2, 0xf2, 0xe1, 0xa8, 0x98, 0x38, 0x38, 0x2a, 0x26, 0xbe,
-	0x1f, 0x15, 0x13, 0xeb, 0x95, 0x2e, 0x61, 0xaf, 0x7a, 0xed, 0xf0, 0x00, 0x9d, 0xd7, 0x78, 0x7b,
-	0x1e, 0xed, 0x88, 0xee, 0xe1, 0x29, 0xf2, 0xdb, 0x19, 0x7e, 0xdb, 0xe7, 0x7f, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0xf2, 0x62, 0x5e, 0xac, 0x3d, 0x07, 0x00, 0x00,
+func (m *QueryFulfillmentIntentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillmentIntentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillmentIntentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFulfillmentIntentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillmentIntentsRequest.Merge(m, src)
+}
+func (m *QueryFulfillmentIntentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillmentIntentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillmentIntentsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryFulfillmentIntentsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryFulfillmentIntentsRequest) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Demand Order by id.
-	DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error)
-	// Queries a list of demand orders by status.
-	DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error)
+func (m *QueryFulfillmentIntentsRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFulfillmentIntentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryFulfillmentIntentsResponse is the response type for the Query/FulfillmentIntents RPC method.
+type QueryFulfillmentIntentsResponse struct {
+	// A list of fulfillment intents matching the request
+	Intents    []FulfillmentIntent `protobuf:"bytes,1,rep,name=intents,proto3" json:"intents"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFulfillmentIntentsResponse) Reset()         { *m = QueryFulfillmentIntentsResponse{} }
+func (m *QueryFulfillmentIntentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillmentIntentsResponse) ProtoMessage()    {}
+func (*QueryFulfillmentIntentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{7}
+}
+func (m *QueryFulfillmentIntentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillmentIntentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillmentIntentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFulfillmentIntentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillmentIntentsResponse.Merge(m, src)
+}
+func (m *QueryFulfillmentIntentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillmentIntentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillmentIntentsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error) {
-	out := new(QueryGetDemandOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrderById", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFulfillmentIntentsResponse proto.InternalMessageInfo
+
+func (m *QueryFulfillmentIntentsResponse) GetIntents() []FulfillmentIntent {
+	if m != nil {
+		return m.Intents
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error) {
-	out := new(QueryDemandOrdersByStatusResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFulfillmentIntentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Demand Order by id.
-	DemandOrderById(context.Context, *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error)
-	// Queries a list of demand orders by status.
-	DemandOrdersByStatus(context.Context, *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error)
+// QueryRollappRiskLimitsRequest is the request type for the Query/RollappRiskLimits RPC method.
+type QueryRollappRiskLimitsRequest struct {
+	// rollapp_id of the rollapp
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRollappRiskLimitsRequest) Reset()         { *m = QueryRollappRiskLimitsRequest{} }
+func (m *QueryRollappRiskLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRollappRiskLimitsRequest) ProtoMessage()    {}
+func (*QueryRollappRiskLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRollappRiskLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DemandOrderById(ctx context.Context, req *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DemandOrderById not implemented")
+func (m *QueryRollappRiskLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRollappRiskLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DemandOrdersByStatus(ctx context.Context, req *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DemandOrdersByStatus not implemented")
+func (m *QueryRollappRiskLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRollappRiskLimitsRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryRollappRiskLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRollappRiskLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRollappRiskLimitsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryRollappRiskLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRollappRiskLimitsRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_DemandOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDemandOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DemandOrderById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrderById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DemandOrderById(ctx, req.(*QueryGetDemandOrderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryRollappRiskLimitsResponse is the response type for the Query/RollappRiskLimits RPC method.
+type QueryRollappRiskLimitsResponse struct {
+	// limits of the rollapp, empty if no limits are set
+	Limits RollappRiskLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits"`
+	// outstanding is the total remaining price of the outstanding orders of the rollapp
+	Outstanding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=outstanding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"outstanding"`
+	// paused is true if no demand orders are created for the rollapp
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	// pause_reason explains why the demand orders are paused
+	PauseReason string `protobuf:"bytes,4,opt,name=pause_reason,json=pauseReason,proto3" json:"pause_reason,omitempty"`
 }
 
-func _Query_DemandOrdersByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDemandOrdersByStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DemandOrdersByStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DemandOrdersByStatus(ctx, req.(*QueryDemandOrdersByStatusRequest))
+func (m *QueryRollappRiskLimitsResponse) Reset()         { *m = QueryRollappRiskLimitsResponse{} }
+func (m *QueryRollappRiskLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRollappRiskLimitsResponse) ProtoMessage()    {}
+func (*QueryRollappRiskLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{9}
+}
+func (m *QueryRollappRiskLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRollappRiskLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRollappRiskLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.eibc.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DemandOrderById",
-			Handler:    _Query_DemandOrderById_Handler,
-		},
-		{
-			MethodName: "DemandOrdersByStatus",
-			Handler:    _Query_DemandOrdersByStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/eibc/query.proto",
+func (m *QueryRollappRiskLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRollappRiskLimitsResponse.Merge(m, src)
+}
+func (m *QueryRollappRiskLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRollappRiskLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRollappRiskLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRollappRiskLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRollappRiskLimitsResponse) GetLimits() RollappRiskLimits {
+	if m != nil {
+		return m.Limits
 	}
-	return dAtA[:n], nil
+	return RollappRiskLimits{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRollappRiskLimitsResponse) GetOutstanding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Outstanding
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRollappRiskLimitsResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRollappRiskLimitsResponse) GetPauseReason() string {
+	if m != nil {
+		return m.PauseReason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderBookStatsRequest is the request type for the Query/OrderBookStats RPC method.
+type QueryOrderBookStatsRequest struct {
+	// rollapp_id of the rollapp
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// optional denom, all denoms of the rollapp are returned if empty
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryOrderBookStatsRequest) Reset()         { *m = QueryOrderBookStatsRequest{} }
+func (m *QueryOrderBookStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookStatsRequest) ProtoMessage()    {}
+func (*QueryOrderBookStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{10}
+}
+func (m *QueryOrderBookStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetDemandOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryOrderBookStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookStatsRequest.Merge(m, src)
 }
-
-func (m *QueryGetDemandOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOrderBookStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetDemandOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryOrderBookStatsRequest proto.InternalMessageInfo
+
+func (m *QueryOrderBookStatsRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDemandOrdersByStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOrderBookStatsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDemandOrdersByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderBookStatsResponse is the response type for the Query/OrderBookStats RPC method.
+type QueryOrderBookStatsResponse struct {
+	// stats of the pending demand orders per denom
+	Stats []OrderBookStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
 }
 
-func (m *QueryDemandOrdersByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Fulfiller) > 0 {
-		i -= len(m.Fulfiller)
-		copy(dAtA[i:], m.Fulfiller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.FulfillmentState != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FulfillmentState))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryOrderBookStatsResponse) Reset()         { *m = QueryOrderBookStatsResponse{} }
+func (m *QueryOrderBookStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookStatsResponse) ProtoMessage()    {}
+func (*QueryOrderBookStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{11}
+}
+func (m *QueryOrderBookStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOrderBookStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookStatsResponse.Merge(m, src)
+}
+func (m *QueryOrderBookStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDemandOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOrderBookStatsResponse proto.InternalMessageInfo
+
+func (m *QueryOrderBookStatsResponse) GetStats() []OrderBookStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OrderBookStats are the aggregated statistics of the pending demand orders of a rollapp in a denom.
+// Pending orders are all the orders whose underlying packet is not finalized or reverted yet,
+// unfulfilled orders are the pending orders which still wait for a fulfiller.
+type OrderBookStats struct {
+	// rollapp_id of the rollapp
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// denom of the orders
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pending_count is the number of pending orders
+	PendingCount uint64 `protobuf:"varint,3,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	// pending_price is the total price of the pending orders
+	PendingPrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=pending_price,json=pendingPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pending_price"`
+	// unfulfilled_count is the number of unfulfilled orders
+	UnfulfilledCount uint64 `protobuf:"varint,5,opt,name=unfulfilled_count,json=unfulfilledCount,proto3" json:"unfulfilled_count,omitempty"`
+	// unfulfilled_volume is the total remaining price of the unfulfilled orders
+	UnfulfilledVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=unfulfilled_volume,json=unfulfilledVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unfulfilled_volume"`
+	// fee_percentiles are the percentiles of the fee divided by the price of the unfulfilled orders
+	FeePercentiles FeePercentiles `protobuf:"bytes,7,opt,name=fee_percentiles,json=feePercentiles,proto3" json:"fee_percentiles"`
+	// oldest_order_creation_height is the creation height of the oldest unfulfilled order
+	OldestOrderCreationHeight int64 `protobuf:"varint,8,opt,name=oldest_order_creation_height,json=oldestOrderCreationHeight,proto3" json:"oldest_order_creation_height,omitempty"`
+	// oldest_order_age is the number of hub blocks since the creation of the oldest unfulfilled order
+	OldestOrderAge int64 `protobuf:"varint,9,opt,name=oldest_order_age,json=oldestOrderAge,proto3" json:"oldest_order_age,omitempty"`
 }
 
-func (m *QueryGetDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DemandOrder != nil {
-		{
-			size, err := m.DemandOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *OrderBookStats) Reset()         { *m = OrderBookStats{} }
+func (m *OrderBookStats) String() string { return proto.CompactTextString(m) }
+func (*OrderBookStats) ProtoMessage()    {}
+func (*OrderBookStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{12}
+}
+func (m *OrderBookStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OrderBookStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookStats.Merge(m, src)
+}
+func (m *OrderBookStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookStats.DiscardUnknown(m)
 }
 
-func (m *QueryDemandOrdersByStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrderBookStats proto.InternalMessageInfo
+
+func (m *OrderBookStats) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDemandOrdersByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrderBookStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryDemandOrdersByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DemandOrders) > 0 {
-		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DemandOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *OrderBookStats) GetPendingCount() uint64 {
+	if m != nil {
+		return m.PendingCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OrderBookStats) GetUnfulfilledCount() uint64 {
+	if m != nil {
+		return m.UnfulfilledCount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *OrderBookStats) GetFeePercentiles() FeePercentiles {
+	if m != nil {
+		return m.FeePercentiles
 	}
-	var l int
-	_ = l
-	return n
+	return FeePercentiles{}
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrderBookStats) GetOldestOrderCreationHeight() int64 {
+	if m != nil {
+		return m.OldestOrderCreationHeight
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryGetDemandOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *OrderBookStats) GetOldestOrderAge() int64 {
+	if m != nil {
+		return m.OldestOrderAge
 	}
-	return n
+	return 0
 }
 
-func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+// FeePercentiles are percentiles of order fees divided by order prices, using the nearest-rank method.
+// All percentiles are zero if there are no orders.
+type FeePercentiles struct {
+	P25 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=p25,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p25"`
+	P50 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=p50,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p50"`
+	P75 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=p75,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p75"`
+	P90 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=p90,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"p90"`
+}
+
+func (m *FeePercentiles) Reset()         { *m = FeePercentiles{} }
+func (m *FeePercentiles) String() string { return proto.CompactTextString(m) }
+func (*FeePercentiles) ProtoMessage()    {}
+func (*FeePercentiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{13}
+}
+func (m *FeePercentiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePercentiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePercentiles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
+}
+func (m *FeePercentiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePercentiles.Merge(m, src)
+}
+func (m *FeePercentiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePercentiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePercentiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePercentiles proto.InternalMessageInfo
+
+// QueryFeeSubsidyRequest is the request type for the Query/FeeSubsidy RPC method.
+type QueryFeeSubsidyRequest struct {
+	// rollapp_id of the rollapp
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *QueryFeeSubsidyRequest) Reset()         { *m = QueryFeeSubsidyRequest{} }
+func (m *QueryFeeSubsidyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSubsidyRequest) ProtoMessage()    {}
+func (*QueryFeeSubsidyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{14}
+}
+func (m *QueryFeeSubsidyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSubsidyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSubsidyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryFeeSubsidyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSubsidyRequest.Merge(m, src)
+}
+func (m *QueryFeeSubsidyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSubsidyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSubsidyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSubsidyRequest proto.InternalMessageInfo
+
+func (m *QueryFeeSubsidyRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+	return ""
+}
+
+// QueryFeeSubsidyResponse is the response type for the Query/FeeSubsidy RPC method.
+type QueryFeeSubsidyResponse struct {
+	Subsidy RollappFeeSubsidy `protobuf:"bytes,1,opt,name=subsidy,proto3" json:"subsidy"`
+	// epoch_remaining is the amount the pool can still pay out in the current epoch
+	EpochRemaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=epoch_remaining,json=epochRemaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_remaining"`
+}
+
+func (m *QueryFeeSubsidyResponse) Reset()         { *m = QueryFeeSubsidyResponse{} }
+func (m *QueryFeeSubsidyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSubsidyResponse) ProtoMessage()    {}
+func (*QueryFeeSubsidyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{15}
+}
+func (m *QueryFeeSubsidyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSubsidyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSubsidyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.FulfillmentState != 0 {
-		n += 1 + sovQuery(uint64(m.FulfillmentState))
+}
+func (m *QueryFeeSubsidyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSubsidyResponse.Merge(m, src)
+}
+func (m *QueryFeeSubsidyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSubsidyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSubsidyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSubsidyResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSubsidyResponse) GetSubsidy() RollappFeeSubsidy {
+	if m != nil {
+		return m.Subsidy
 	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return RollappFeeSubsidy{}
+}
+
+func (m *QueryFeeSubsidyResponse) GetEpochRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochRemaining
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryFeeSubsidiesRequest is the request type for the Query/FeeSubsidies RPC method.
+type QueryFeeSubsidiesRequest struct {
+}
+
+func (m *QueryFeeSubsidiesRequest) Reset()         { *m = QueryFeeSubsidiesRequest{} }
+func (m *QueryFeeSubsidiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSubsidiesRequest) ProtoMessage()    {}
+func (*QueryFeeSubsidiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{16}
+}
+func (m *QueryFeeSubsidiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSubsidiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSubsidiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryFeeSubsidiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSubsidiesRequest.Merge(m, src)
+}
+func (m *QueryFeeSubsidiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSubsidiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSubsidiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSubsidiesRequest proto.InternalMessageInfo
+
+// QueryFeeSubsidiesResponse is the response type for the Query/FeeSubsidies RPC method.
+type QueryFeeSubsidiesResponse struct {
+	Subsidies []RollappFeeSubsidy `protobuf:"bytes,1,rep,name=subsidies,proto3" json:"subsidies"`
+}
+
+func (m *QueryFeeSubsidiesResponse) Reset()         { *m = QueryFeeSubsidiesResponse{} }
+func (m *QueryFeeSubsidiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSubsidiesResponse) ProtoMessage()    {}
+func (*QueryFeeSubsidiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{17}
+}
+func (m *QueryFeeSubsidiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSubsidiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSubsidiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryFeeSubsidiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSubsidiesResponse.Merge(m, src)
+}
+func (m *QueryFeeSubsidiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSubsidiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSubsidiesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDemandOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryFeeSubsidiesResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSubsidiesResponse) GetSubsidies() []RollappFeeSubsidy {
+	if m != nil {
+		return m.Subsidies
 	}
-	var l int
-	_ = l
-	if m.DemandOrder != nil {
-		l = m.DemandOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryFeeFloorRequest is the request type for the Query/FeeFloor RPC method.
+type QueryFeeFloorRequest struct {
+	// rollapp_id of the rollapp
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *QueryFeeFloorRequest) Reset()         { *m = QueryFeeFloorRequest{} }
+func (m *QueryFeeFloorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeFloorRequest) ProtoMessage()    {}
+func (*QueryFeeFloorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{18}
+}
+func (m *QueryFeeFloorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeFloorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeFloorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryFeeFloorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeFloorRequest.Merge(m, src)
+}
+func (m *QueryFeeFloorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeFloorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeFloorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryFeeFloorRequest proto.InternalMessageInfo
+
+func (m *QueryFeeFloorRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	var l int
-	_ = l
-	if len(m.DemandOrders) > 0 {
-		for _, e := range m.DemandOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryFeeFloorResponse is the response type for the Query/FeeFloor RPC method.
+type QueryFeeFloorResponse struct {
+	// min_fee_percentage is the fee floor applied to the demand orders of the rollapp
+	MinFeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_fee_percentage,json=minFeePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fee_percentage"`
+	// overridden is true if the rollapp overrides the module param
+	Overridden bool `protobuf:"varint,2,opt,name=overridden,proto3" json:"overridden,omitempty"`
+}
+
+func (m *QueryFeeFloorResponse) Reset()         { *m = QueryFeeFloorResponse{} }
+func (m *QueryFeeFloorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeFloorResponse) ProtoMessage()    {}
+func (*QueryFeeFloorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{19}
+}
+func (m *QueryFeeFloorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeFloorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeFloorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeFloorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeFloorResponse.Merge(m, src)
+}
+func (m *QueryFeeFloorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeFloorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeFloorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeFloorResponse proto.InternalMessageInfo
+
+func (m *QueryFeeFloorResponse) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentState", FulfillmentState_name, FulfillmentState_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.eibc.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.eibc.QueryParamsResponse")
+	proto.RegisterType((*QueryGetDemandOrderRequest)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderRequest")
+	proto.RegisterType((*QueryDemandOrdersByStatusRequest)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusRequest")
+	proto.RegisterType((*QueryGetDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderResponse")
+	proto.RegisterType((*QueryDemandOrdersByStatusResponse)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusResponse")
+	proto.RegisterType((*QueryFulfillmentIntentsRequest)(nil), "dymensionxyz.dymension.eibc.QueryFulfillmentIntentsRequest")
+	proto.RegisterType((*QueryFulfillmentIntentsResponse)(nil), "dymensionxyz.dymension.eibc.QueryFulfillmentIntentsResponse")
+	proto.RegisterType((*QueryRollappRiskLimitsRequest)(nil), "dymensionxyz.dymension.eibc.QueryRollappRiskLimitsRequest")
+	proto.RegisterType((*QueryRollappRiskLimitsResponse)(nil), "dymensionxyz.dymension.eibc.QueryRollappRiskLimitsResponse")
+	proto.RegisterType((*QueryOrderBookStatsRequest)(nil), "dymensionxyz.dymension.eibc.QueryOrderBookStatsRequest")
+	proto.RegisterType((*QueryOrderBookStatsResponse)(nil), "dymensionxyz.dymension.eibc.QueryOrderBookStatsResponse")
+	proto.RegisterType((*OrderBookStats)(nil), "dymensionxyz.dymension.eibc.OrderBookStats")
+	proto.RegisterType((*FeePercentiles)(nil), "dymensionxyz.dymension.eibc.FeePercentiles")
+	proto.RegisterType((*QueryFeeSubsidyRequest)(nil), "dymensionxyz.dymension.eibc.QueryFeeSubsidyRequest")
+	proto.RegisterType((*QueryFeeSubsidyResponse)(nil), "dymensionxyz.dymension.eibc.QueryFeeSubsidyResponse")
+	proto.RegisterType((*QueryFeeSubsidiesRequest)(nil), "dymensionxyz.dymension.eibc.QueryFeeSubsidiesRequest")
+	proto.RegisterType((*QueryFeeSubsidiesResponse)(nil), "dymensionxyz.dymension.eibc.QueryFeeSubsidiesResponse")
+	proto.RegisterType((*QueryFeeFloorRequest)(nil), "dymensionxyz.dymension.eibc.QueryFeeFloorRequest")
+	proto.RegisterType((*QueryFeeFloorResponse)(nil), "dymensionxyz.dymension.eibc.QueryFeeFloorResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/query.proto", fileDescriptor_d85bfe71ceb5f8dc)
+}
+
+var fileDescriptor_d85bfe71ceb5f8dc = []byte{
+	// 1595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0xe5, 0x8f, 0xd8, 0xe3, 0xcf, 0xec, 0xeb, 0x37, 0x2f, 0xa3, 0x24, 0x72, 0xc2, 0x20,
+	0x89, 0x61, 0x27, 0xa2, 0x2c, 0x47, 0x71, 0xbe, 0xdf, 0x46, 0xb1, 0x9d, 0x1a, 0x71, 0x5d, 0x87,
+	0x69, 0x8a, 0x22, 0x68, 0x21, 0xd0, 0xe2, 0x5a, 0xde, 0x5a, 0xe4, 0x32, 0x24, 0x65, 0xc4, 0x0d,
+	0x72, 0xe9, 0xb5, 0x97, 0xa2, 0x45, 0x81, 0xfc, 0x81, 0x5e, 0x7a, 0x28, 0xd0, 0x4b, 0x51, 0x14,
+	0xbd, 0x37, 0x45, 0x2f, 0x41, 0x7b, 0x29, 0x7a, 0x48, 0x8a, 0xa4, 0x3f, 0xa4, 0xe0, 0xee, 0xd2,
+	0x24, 0x65, 0x8b, 0xa2, 0x04, 0xf4, 0x24, 0x71, 0x39, 0xcf, 0xcc, 0x33, 0xcf, 0xee, 0xce, 0xce,
+	0x12, 0xce, 0x19, 0xbb, 0x26, 0xb6, 0x5c, 0x42, 0xad, 0xc7, 0xbb, 0x9f, 0xa8, 0x7b, 0x0f, 0x2a,
+	0x26, 0x1b, 0x55, 0xf5, 0x51, 0x03, 0x3b, 0xbb, 0x79, 0xdb, 0xa1, 0x1e, 0x45, 0xc7, 0xa2, 0x86,
+	0xf9, 0xbd, 0x87, 0xbc, 0x6f, 0x98, 0x9d, 0xac, 0xd1, 0x1a, 0x65, 0x76, 0xaa, 0xff, 0x8f, 0x43,
+	0xb2, 0xc7, 0x6b, 0x94, 0xd6, 0xea, 0x58, 0xd5, 0x6d, 0xa2, 0xea, 0x96, 0x45, 0x3d, 0xdd, 0x23,
+	0xd4, 0x72, 0xc5, 0xdb, 0x99, 0x2a, 0x75, 0x4d, 0xea, 0xaa, 0x1b, 0xba, 0x8b, 0x79, 0x24, 0x75,
+	0x67, 0x6e, 0x03, 0x7b, 0xfa, 0x9c, 0x6a, 0xeb, 0x35, 0x62, 0x31, 0x63, 0x61, 0x3b, 0x9d, 0xc4,
+	0xd2, 0xd6, 0x1d, 0xdd, 0x0c, 0xbc, 0xe6, 0x93, 0x2c, 0x0d, 0x6c, 0xea, 0x96, 0x51, 0xa1, 0x8e,
+	0x81, 0x1d, 0x61, 0x7f, 0x31, 0xc9, 0x7e, 0xb3, 0x51, 0xdf, 0x24, 0xf5, 0xba, 0x89, 0x2d, 0xaf,
+	0x42, 0x2c, 0x0f, 0x5b, 0x9e, 0x40, 0x5d, 0x48, 0x42, 0x39, 0xc4, 0xdd, 0xae, 0xd4, 0x89, 0x49,
+	0x3c, 0x37, 0x8d, 0xf9, 0x26, 0xc6, 0x15, 0xb7, 0xb1, 0xe1, 0x12, 0x43, 0x48, 0x9d, 0x9d, 0x6d,
+	0x67, 0xbe, 0x59, 0xa7, 0x34, 0x48, 0x20, 0x17, 0x95, 0x31, 0x10, 0xb0, 0x4a, 0x49, 0x20, 0xdd,
+	0x4c, 0x0b, 0x67, 0x55, 0x6a, 0x9a, 0xd4, 0x52, 0x5d, 0x4f, 0xf7, 0x1a, 0x01, 0xcf, 0x62, 0xb2,
+	0xad, 0x43, 0xeb, 0x75, 0xdd, 0xb6, 0x2b, 0xb6, 0x5e, 0xdd, 0xc6, 0x42, 0x0a, 0x65, 0x12, 0xd0,
+	0x3d, 0x7f, 0xf2, 0xd6, 0xd9, 0x2c, 0x68, 0xf8, 0x51, 0x03, 0xbb, 0x9e, 0xf2, 0x01, 0xfc, 0x27,
+	0x36, 0xea, 0xda, 0xd4, 0x72, 0x31, 0xba, 0x05, 0x03, 0x7c, 0xb6, 0x64, 0xe9, 0xa4, 0x34, 0x3d,
+	0x5c, 0x3c, 0x9d, 0x4f, 0x58, 0x55, 0x79, 0x0e, 0x2e, 0xf7, 0x3d, 0x7f, 0x39, 0xd5, 0xa3, 0x09,
+	0xa0, 0x72, 0x1e, 0xb2, 0xcc, 0xf3, 0x1d, 0xec, 0x2d, 0xb2, 0xe9, 0x7c, 0xd7, 0x9f, 0x4d, 0x11,
+	0x17, 0x8d, 0x41, 0x86, 0x18, 0xcc, 0xf9, 0x90, 0x96, 0x21, 0x86, 0xf2, 0x59, 0x2f, 0x9c, 0x64,
+	0xe6, 0x11, 0x5b, 0xb7, 0xbc, 0x7b, 0x9f, 0x65, 0x1d, 0x80, 0x6e, 0xc0, 0x00, 0x97, 0x81, 0x01,
+	0xc7, 0x8a, 0x67, 0x5a, 0xb1, 0xe2, 0x3a, 0xe4, 0x05, 0x5a, 0x80, 0xd0, 0x12, 0xf4, 0x79, 0xbb,
+	0x36, 0x96, 0x33, 0x0c, 0x3c, 0xd7, 0x06, 0xac, 0x71, 0x11, 0xd7, 0xb9, 0x86, 0xef, 0xed, 0xda,
+	0x58, 0x63, 0x70, 0x74, 0x02, 0x20, 0x10, 0x98, 0x18, 0x72, 0x2f, 0x4b, 0x61, 0x48, 0x8c, 0xac,
+	0x18, 0x68, 0x12, 0xfa, 0xd9, 0x9a, 0x92, 0xfb, 0x4e, 0x4a, 0xd3, 0xfd, 0x1a, 0x7f, 0x40, 0x0f,
+	0xe1, 0x70, 0x74, 0x91, 0xfa, 0x8c, 0xb0, 0xdc, 0xcf, 0x88, 0x5c, 0x48, 0xd4, 0x76, 0x39, 0x44,
+	0xf9, 0xe9, 0x60, 0x6d, 0x62, 0xb3, 0x69, 0x04, 0x1d, 0x87, 0x21, 0x31, 0x86, 0x1d, 0x79, 0x80,
+	0xf3, 0xd9, 0x1b, 0xf0, 0xf9, 0x18, 0xd8, 0xa2, 0xa6, 0x7c, 0x88, 0xbd, 0xe1, 0x0f, 0x3e, 0xc6,
+	0xc1, 0x55, 0x62, 0x13, 0x6c, 0x79, 0xf2, 0xa0, 0xc8, 0x21, 0x18, 0x50, 0x3e, 0x86, 0x63, 0x07,
+	0xce, 0x9d, 0x58, 0x1d, 0x77, 0x61, 0x24, 0xba, 0x43, 0xc5, 0x1a, 0x99, 0x4e, 0xcc, 0x23, 0xea,
+	0x67, 0xd8, 0x08, 0x1f, 0x14, 0x07, 0x4e, 0x25, 0x4c, 0xbc, 0x88, 0xf8, 0x0e, 0x8c, 0x46, 0x23,
+	0xfa, 0x0b, 0xa0, 0xb7, 0xa3, 0x90, 0x23, 0x91, 0x90, 0xae, 0xf2, 0xb5, 0x04, 0x39, 0x16, 0x34,
+	0xa2, 0xee, 0x0a, 0xab, 0x1b, 0x7b, 0x6b, 0x2d, 0x26, 0xaa, 0xd4, 0x2c, 0x6a, 0x7c, 0x0d, 0x64,
+	0x9a, 0xd7, 0xc0, 0x32, 0x40, 0x58, 0x1a, 0xd9, 0x12, 0x19, 0x2e, 0x9e, 0xcd, 0xf3, 0x02, 0x90,
+	0xf7, 0x0b, 0x40, 0x9e, 0x57, 0x6c, 0x51, 0x06, 0xf2, 0xeb, 0x7a, 0x0d, 0x8b, 0xc0, 0x5a, 0x04,
+	0xa9, 0xfc, 0x28, 0xc1, 0x54, 0x4b, 0x9e, 0x42, 0x9a, 0x35, 0x38, 0xc4, 0x4b, 0x5e, 0x20, 0x4a,
+	0x3e, 0xed, 0x7a, 0xe2, 0x9e, 0xc4, 0xb6, 0x0d, 0x9c, 0xa0, 0x3b, 0x31, 0xee, 0x19, 0xc6, 0xfd,
+	0x5c, 0x5b, 0xee, 0x9c, 0x4c, 0x8c, 0xfc, 0x4d, 0x38, 0xc1, 0xb8, 0x8b, 0x8d, 0xa4, 0x11, 0x77,
+	0x7b, 0x95, 0x15, 0xdb, 0x40, 0xe2, 0xb8, 0x88, 0x52, 0x93, 0x88, 0xca, 0xb3, 0x0c, 0xe4, 0x5a,
+	0x39, 0x10, 0xb9, 0xaf, 0xc2, 0x00, 0xaf, 0xdf, 0x62, 0x09, 0x26, 0xa7, 0xbe, 0xcf, 0x4f, 0x50,
+	0xb1, 0xb8, 0x0f, 0x64, 0xc2, 0x30, 0x6d, 0x78, 0xae, 0xa7, 0x5b, 0x06, 0xb1, 0x6a, 0x72, 0x86,
+	0xa9, 0x79, 0x34, 0x96, 0x7a, 0x90, 0xf4, 0x6d, 0x4a, 0xac, 0x72, 0xc1, 0x47, 0x7f, 0xf3, 0x6a,
+	0x6a, 0xba, 0x46, 0xbc, 0xad, 0xc6, 0x86, 0x5f, 0x38, 0x54, 0x51, 0xe4, 0xf9, 0xcf, 0x05, 0xd7,
+	0xd8, 0x56, 0xfd, 0x9a, 0xe1, 0x32, 0x80, 0xab, 0x45, 0xfd, 0xa3, 0x23, 0x7e, 0x8d, 0x6d, 0xb8,
+	0x98, 0xd7, 0x90, 0x41, 0x4d, 0x3c, 0xa1, 0x53, 0x30, 0xc2, 0xfe, 0x55, 0x1c, 0xac, 0xbb, 0xd4,
+	0x62, 0x75, 0x64, 0x48, 0x1b, 0x66, 0x63, 0x1a, 0x1b, 0x52, 0xee, 0x89, 0xda, 0xca, 0x96, 0x73,
+	0x99, 0xd2, 0x6d, 0x7f, 0xbb, 0xa4, 0xd4, 0x35, 0x2c, 0x08, 0x99, 0x48, 0x41, 0x50, 0x36, 0xe1,
+	0xd8, 0x81, 0x2e, 0x85, 0xd2, 0x77, 0xa0, 0xdf, 0xaf, 0x59, 0xc1, 0x1a, 0x9b, 0x4d, 0x14, 0x3a,
+	0xee, 0x43, 0xa8, 0xcc, 0xf1, 0xca, 0x17, 0x7d, 0x30, 0x16, 0x7f, 0xdf, 0x15, 0x5f, 0x74, 0x1a,
+	0x46, 0x6d, 0xcc, 0x84, 0xac, 0x54, 0x69, 0xc3, 0xf2, 0x98, 0x88, 0x7d, 0xda, 0x88, 0x18, 0xbc,
+	0xed, 0x8f, 0xa1, 0xfb, 0xa1, 0x91, 0xed, 0x90, 0x2a, 0xe6, 0x5a, 0x96, 0xf3, 0x3e, 0xa1, 0x3f,
+	0x5f, 0x4e, 0x9d, 0x4d, 0x31, 0x71, 0x2b, 0x96, 0xb7, 0xe7, 0x74, 0xdd, 0xf7, 0x81, 0x66, 0xe1,
+	0x70, 0xc3, 0x0a, 0x4a, 0x81, 0x21, 0xa2, 0xf7, 0xb3, 0xe8, 0x13, 0x91, 0x17, 0x9c, 0xc1, 0x47,
+	0x80, 0xa2, 0xc6, 0x3b, 0xb4, 0xde, 0x30, 0xb1, 0x3c, 0xd0, 0x15, 0x8d, 0x68, 0xd8, 0xf7, 0x99,
+	0x23, 0xf4, 0x10, 0xc6, 0xfd, 0x3e, 0xc3, 0xc6, 0x4e, 0x15, 0x5b, 0x1e, 0xa9, 0x63, 0x97, 0x95,
+	0xf9, 0x76, 0x13, 0xb4, 0x8c, 0xf1, 0x7a, 0x08, 0x11, 0x13, 0x34, 0xb6, 0x19, 0x1b, 0x45, 0xff,
+	0x87, 0xe3, 0xb4, 0x6e, 0x60, 0xd7, 0xe3, 0x35, 0xb7, 0x52, 0x75, 0x30, 0xdb, 0xd8, 0x95, 0x2d,
+	0x4c, 0x6a, 0x5b, 0xfc, 0xd4, 0xe8, 0xd5, 0x8e, 0x72, 0x1b, 0x36, 0xa5, 0xb7, 0x85, 0xc5, 0xdb,
+	0xcc, 0x00, 0x4d, 0xc3, 0x44, 0xcc, 0x81, 0x5e, 0xc3, 0xf2, 0x10, 0x03, 0x8d, 0x45, 0x40, 0xb7,
+	0x6a, 0xd8, 0xdf, 0xea, 0x63, 0x71, 0x4e, 0xe8, 0x2d, 0xe8, 0xb5, 0x8b, 0x25, 0x59, 0xea, 0x58,
+	0xa9, 0x45, 0x5c, 0xd5, 0x7c, 0x28, 0xf3, 0x50, 0x2a, 0xc8, 0x99, 0x2e, 0x3d, 0x94, 0x0a, 0xcc,
+	0xc3, 0x42, 0x49, 0xee, 0xed, 0xd2, 0xc3, 0x02, 0xe7, 0x70, 0xa5, 0x20, 0xf7, 0x75, 0xe9, 0xe1,
+	0x4a, 0x41, 0x59, 0x80, 0x23, 0xfc, 0x04, 0xc0, 0xf8, 0x3e, 0x6f, 0x3e, 0x53, 0x96, 0xcf, 0x57,
+	0x12, 0xfc, 0x6f, 0x1f, 0x32, 0x3c, 0x33, 0x44, 0x27, 0xdb, 0x49, 0xe1, 0x0c, 0x1d, 0x05, 0x67,
+	0x86, 0x70, 0x82, 0x3c, 0x18, 0xc7, 0x36, 0xad, 0x6e, 0x55, 0x1c, 0x6c, 0xea, 0xc4, 0xfa, 0x97,
+	0xaa, 0xe7, 0x18, 0x8b, 0xa1, 0x05, 0x21, 0x94, 0x2c, 0xc8, 0xf1, 0x04, 0x09, 0xde, 0xeb, 0x6b,
+	0x29, 0x1c, 0x3d, 0xe0, 0x9d, 0x48, 0x5f, 0x83, 0x21, 0x37, 0x18, 0x4c, 0x75, 0x68, 0xb6, 0x12,
+	0x20, 0x74, 0xa3, 0x94, 0x60, 0x32, 0x08, 0xb8, 0xec, 0x77, 0xfd, 0x29, 0x67, 0xe9, 0x2b, 0x09,
+	0xfe, 0xdb, 0x84, 0x13, 0x24, 0x3f, 0x04, 0x64, 0x12, 0xab, 0x12, 0xd9, 0xde, 0xfe, 0xfe, 0xe9,
+	0x6e, 0x3f, 0x4c, 0x98, 0xc4, 0x0a, 0xf7, 0x97, 0x5e, 0xc3, 0x28, 0x07, 0x40, 0x77, 0xb0, 0xe3,
+	0x10, 0xc3, 0xc0, 0xfc, 0x94, 0x1f, 0xd4, 0x22, 0x23, 0x33, 0xb7, 0x60, 0xa2, 0xb9, 0xf3, 0x44,
+	0xa3, 0x30, 0xf4, 0x60, 0x6d, 0x71, 0x69, 0x79, 0x65, 0x6d, 0x69, 0x71, 0xa2, 0xc7, 0x7f, 0x5c,
+	0x7e, 0xb0, 0xba, 0xbc, 0xb2, 0xba, 0xba, 0xb4, 0x38, 0x21, 0xa1, 0x71, 0x18, 0x7e, 0xb0, 0x16,
+	0x0e, 0x64, 0x8a, 0x3f, 0x8c, 0x42, 0x3f, 0x4b, 0x0d, 0x3d, 0x93, 0x60, 0x80, 0xdf, 0x11, 0x90,
+	0x9a, 0xa8, 0xf3, 0xfe, 0x0b, 0x4a, 0xb6, 0x90, 0x1e, 0xc0, 0x85, 0x53, 0x66, 0x3f, 0xfd, 0xfd,
+	0xef, 0x2f, 0x33, 0x67, 0xd0, 0x69, 0xb5, 0xfd, 0x65, 0x14, 0xfd, 0x24, 0xc1, 0x78, 0xa4, 0x4f,
+	0x2c, 0xef, 0xae, 0x18, 0x68, 0xa1, 0x7d, 0xc8, 0x03, 0x2f, 0x35, 0xd9, 0xcb, 0x9d, 0x03, 0x05,
+	0xe7, 0x4b, 0x8c, 0x73, 0x01, 0xe5, 0xd5, 0xb4, 0xd7, 0x62, 0xf5, 0x09, 0x31, 0x9e, 0xa2, 0xdf,
+	0x24, 0x98, 0x3c, 0xa8, 0x71, 0x46, 0x37, 0xda, 0x53, 0x49, 0xb8, 0x69, 0x65, 0x6f, 0x76, 0x0b,
+	0x17, 0xf9, 0x5c, 0x63, 0xf9, 0x94, 0xd0, 0x7c, 0xea, 0x7c, 0x5c, 0xf5, 0x09, 0xbf, 0xa6, 0x3d,
+	0x45, 0xbf, 0x48, 0x80, 0xf6, 0x37, 0xbc, 0xe8, 0x5a, 0x7b, 0x4e, 0x2d, 0xdb, 0xf9, 0xec, 0xf5,
+	0xee, 0xc0, 0x22, 0x9d, 0xcb, 0x2c, 0x9d, 0x22, 0x2a, 0xa8, 0x9d, 0x7d, 0x85, 0x70, 0xd1, 0xaf,
+	0x12, 0x1c, 0xde, 0xd7, 0x77, 0xa2, 0xab, 0xed, 0xd9, 0xb4, 0xea, 0x9a, 0xb3, 0xd7, 0xba, 0xc2,
+	0x8a, 0x44, 0x6e, 0xb0, 0x44, 0x16, 0x50, 0x49, 0x4d, 0xf9, 0x61, 0x44, 0x7d, 0x12, 0x56, 0xaf,
+	0xa7, 0xe8, 0x67, 0x69, 0x5f, 0xf3, 0x96, 0x62, 0xb3, 0x1c, 0xd8, 0xa5, 0x66, 0x2f, 0x77, 0x0e,
+	0x14, 0x49, 0x94, 0x59, 0x12, 0xd7, 0xd1, 0xd5, 0xc4, 0x24, 0x78, 0xcf, 0xb1, 0x41, 0xe9, 0x36,
+	0xbb, 0x6d, 0x37, 0x65, 0xf2, 0xbd, 0x04, 0x10, 0x96, 0x73, 0x34, 0x9f, 0x62, 0x79, 0x34, 0x1f,
+	0xc0, 0xd9, 0x8b, 0x9d, 0x81, 0x3a, 0x9a, 0x82, 0xc8, 0xc7, 0xa6, 0x38, 0xf1, 0xef, 0x24, 0x18,
+	0x89, 0x1e, 0x6a, 0xa8, 0xd4, 0x01, 0x8b, 0xf0, 0x80, 0xcc, 0x5e, 0xea, 0x14, 0x26, 0xe8, 0x17,
+	0x19, 0xfd, 0xf3, 0x68, 0x26, 0x25, 0x7d, 0x9f, 0xe2, 0xb7, 0x12, 0x0c, 0x06, 0xe7, 0x1b, 0x9a,
+	0x4b, 0x15, 0x38, 0x7a, 0x86, 0x66, 0x8b, 0x9d, 0x40, 0x3a, 0xaa, 0x40, 0x7b, 0x1f, 0xe9, 0x62,
+	0x22, 0x97, 0xef, 0x3e, 0x7f, 0x9d, 0x93, 0x5e, 0xbc, 0xce, 0x49, 0x7f, 0xbd, 0xce, 0x49, 0x9f,
+	0xbf, 0xc9, 0xf5, 0xbc, 0x78, 0x93, 0xeb, 0xf9, 0xe3, 0x4d, 0xae, 0xe7, 0xe1, 0x5c, 0xe4, 0xc4,
+	0x6d, 0xe1, 0x78, 0x67, 0x5e, 0x7d, 0xcc, 0xbd, 0xb3, 0x03, 0x78, 0x63, 0x80, 0x7d, 0x7f, 0x9b,
+	0xff, 0x67, 0x00, 0xd2, 0x33, 0x68, 0x90, 0xc2, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Demand Order by id.
+	DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error)
+	// Queries a list of demand orders by status.
+	DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error)
+	// Queries a list of fulfillment intents.
+	FulfillmentIntents(ctx context.Context, in *QueryFulfillmentIntentsRequest, opts ...grpc.CallOption) (*QueryFulfillmentIntentsResponse, error)
+	// Queries the risk limits of a rollapp and whether its demand orders are paused.
+	RollappRiskLimits(ctx context.Context, in *QueryRollappRiskLimitsRequest, opts ...grpc.CallOption) (*QueryRollappRiskLimitsResponse, error)
+	// Queries aggregated statistics of the pending demand orders of a rollapp per denom.
+	OrderBookStats(ctx context.Context, in *QueryOrderBookStatsRequest, opts ...grpc.CallOption) (*QueryOrderBookStatsResponse, error)
+	// Queries the fee subsidy pool of a rollapp.
+	FeeSubsidy(ctx context.Context, in *QueryFeeSubsidyRequest, opts ...grpc.CallOption) (*QueryFeeSubsidyResponse, error)
+	// Queries the fee subsidy pools of all rollapps.
+	FeeSubsidies(ctx context.Context, in *QueryFeeSubsidiesRequest, opts ...grpc.CallOption) (*QueryFeeSubsidiesResponse, error)
+	// Queries the fee floor applied to the demand orders of a rollapp.
+	FeeFloor(ctx context.Context, in *QueryFeeFloorRequest, opts ...grpc.CallOption) (*QueryFeeFloorResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error) {
+	out := new(QueryGetDemandOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrderById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error) {
+	out := new(QueryDemandOrdersByStatusResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FulfillmentIntents(ctx context.Context, in *QueryFulfillmentIntentsRequest, opts ...grpc.CallOption) (*QueryFulfillmentIntentsResponse, error) {
+	out := new(QueryFulfillmentIntentsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FulfillmentIntents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RollappRiskLimits(ctx context.Context, in *QueryRollappRiskLimitsRequest, opts ...grpc.CallOption) (*QueryRollappRiskLimitsResponse, error) {
+	out := new(QueryRollappRiskLimitsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/RollappRiskLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrderBookStats(ctx context.Context, in *QueryOrderBookStatsRequest, opts ...grpc.CallOption) (*QueryOrderBookStatsResponse, error) {
+	out := new(QueryOrderBookStatsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/OrderBookStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSubsidy(ctx context.Context, in *QueryFeeSubsidyRequest, opts ...grpc.CallOption) (*QueryFeeSubsidyResponse, error) {
+	out := new(QueryFeeSubsidyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FeeSubsidy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSubsidies(ctx context.Context, in *QueryFeeSubsidiesRequest, opts ...grpc.CallOption) (*QueryFeeSubsidiesResponse, error) {
+	out := new(QueryFeeSubsidiesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FeeSubsidies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeFloor(ctx context.Context, in *QueryFeeFloorRequest, opts ...grpc.CallOption) (*QueryFeeFloorResponse, error) {
+	out := new(QueryFeeFloorResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FeeFloor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Demand Order by id.
+	DemandOrderById(context.Context, *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error)
+	// Queries a list of demand orders by status.
+	DemandOrdersByStatus(context.Context, *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error)
+	// Queries a list of fulfillment intents.
+	FulfillmentIntents(context.Context, *QueryFulfillmentIntentsRequest) (*QueryFulfillmentIntentsResponse, error)
+	// Queries the risk limits of a rollapp and whether its demand orders are paused.
+	RollappRiskLimits(context.Context, *QueryRollappRiskLimitsRequest) (*QueryRollappRiskLimitsResponse, error)
+	// Queries aggregated statistics of the pending demand orders of a rollapp per denom.
+	OrderBookStats(context.Context, *QueryOrderBookStatsRequest) (*QueryOrderBookStatsResponse, error)
+	// Queries the fee subsidy pool of a rollapp.
+	FeeSubsidy(context.Context, *QueryFeeSubsidyRequest) (*QueryFeeSubsidyResponse, error)
+	// Queries the fee subsidy pools of all rollapps.
+	FeeSubsidies(context.Context, *QueryFeeSubsidiesRequest) (*QueryFeeSubsidiesResponse, error)
+	// Queries the fee floor applied to the demand orders of a rollapp.
+	FeeFloor(context.Context, *QueryFeeFloorRequest) (*QueryFeeFloorResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DemandOrderById(ctx context.Context, req *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemandOrderById not implemented")
+}
+func (*UnimplementedQueryServer) DemandOrdersByStatus(ctx context.Context, req *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemandOrdersByStatus not implemented")
+}
+func (*UnimplementedQueryServer) FulfillmentIntents(ctx context.Context, req *QueryFulfillmentIntentsRequest) (*QueryFulfillmentIntentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillmentIntents not implemented")
+}
+func (*UnimplementedQueryServer) RollappRiskLimits(ctx context.Context, req *QueryRollappRiskLimitsRequest) (*QueryRollappRiskLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollappRiskLimits not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookStats(ctx context.Context, req *QueryOrderBookStatsRequest) (*QueryOrderBookStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookStats not implemented")
+}
+func (*UnimplementedQueryServer) FeeSubsidy(ctx context.Context, req *QueryFeeSubsidyRequest) (*QueryFeeSubsidyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSubsidy not implemented")
+}
+func (*UnimplementedQueryServer) FeeSubsidies(ctx context.Context, req *QueryFeeSubsidiesRequest) (*QueryFeeSubsidiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSubsidies not implemented")
+}
+func (*UnimplementedQueryServer) FeeFloor(ctx context.Context, req *QueryFeeFloorRequest) (*QueryFeeFloorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeFloor not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DemandOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDemandOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DemandOrderById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrderById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DemandOrderById(ctx, req.(*QueryGetDemandOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DemandOrdersByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDemandOrdersByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DemandOrdersByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DemandOrdersByStatus(ctx, req.(*QueryDemandOrdersByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FulfillmentIntents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFulfillmentIntentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FulfillmentIntents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FulfillmentIntents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FulfillmentIntents(ctx, req.(*QueryFulfillmentIntentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RollappRiskLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRollappRiskLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RollappRiskLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/RollappRiskLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RollappRiskLimits(ctx, req.(*QueryRollappRiskLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBookStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/OrderBookStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookStats(ctx, req.(*QueryOrderBookStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSubsidy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSubsidyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSubsidy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FeeSubsidy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSubsidy(ctx, req.(*QueryFeeSubsidyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSubsidies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSubsidiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSubsidies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FeeSubsidies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSubsidies(ctx, req.(*QueryFeeSubsidiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeFloor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeFloorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeFloor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FeeFloor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeFloor(ctx, req.(*QueryFeeFloorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.eibc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DemandOrderById",
+			Handler:    _Query_DemandOrderById_Handler,
+		},
+		{
+			MethodName: "DemandOrdersByStatus",
+			Handler:    _Query_DemandOrdersByStatus_Handler,
+		},
+		{
+			MethodName: "FulfillmentIntents",
+			Handler:    _Query_FulfillmentIntents_Handler,
+		},
+		{
+			MethodName: "RollappRiskLimits",
+			Handler:    _Query_RollappRiskLimits_Handler,
+		},
+		{
+			MethodName: "OrderBookStats",
+			Handler:    _Query_OrderBookStats_Handler,
+		},
+		{
+			MethodName: "FeeSubsidy",
+			Handler:    _Query_FeeSubsidy_Handler,
+		},
+		{
+			MethodName: "FeeSubsidies",
+			Handler:    _Query_FeeSubsidies_Handler,
+		},
+		{
+			MethodName: "FeeFloor",
+			Handler:    _Query_FeeFloor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/eibc/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDemandOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDemandOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDemandOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDemandOrdersByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDemandOrdersByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDemandOrdersByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FulfillmentState != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FulfillmentState))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDemandOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DemandOrder != nil {
+		{
+			size, err := m.DemandOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDemandOrdersByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDemandOrdersByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDemandOrdersByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DemandOrders) > 0 {
+		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemandOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFulfillmentIntentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillmentIntentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillmentIntentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFulfillmentIntentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillmentIntentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillmentIntentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Intents) > 0 {
+		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappRiskLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappRiskLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappRiskLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappRiskLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappRiskLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappRiskLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PauseReason) > 0 {
+		i -= len(m.PauseReason)
+		copy(dAtA[i:], m.PauseReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PauseReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Outstanding) > 0 {
+		for iNdEx := len(m.Outstanding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outstanding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OldestOrderAge != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestOrderAge))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.OldestOrderCreationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestOrderCreationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.FeePercentiles.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.UnfulfilledVolume.Size()
+		i -= size
+		if _, err := m.UnfulfilledVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.UnfulfilledCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnfulfilledCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.PendingPrice.Size()
+		i -= size
+		if _, err := m.PendingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PendingCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePercentiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePercentiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePercentiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.P90.Size()
+		i -= size
+		if _, err := m.P90.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.P75.Size()
+		i -= size
+		if _, err := m.P75.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.P50.Size()
+		i -= size
+		if _, err := m.P50.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.P25.Size()
+		i -= size
+		if _, err := m.P25.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSubsidyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSubsidyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSubsidyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSubsidyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSubsidyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSubsidyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochRemaining) > 0 {
+		for iNdEx := len(m.EpochRemaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRemaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Subsidy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSubsidiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSubsidiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSubsidiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSubsidiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSubsidiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSubsidiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subsidies) > 0 {
+		for iNdEx := len(m.Subsidies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subsidies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeFloorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeFloorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeFloorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeFloorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeFloorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeFloorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinFeePercentage.Size()
+		i -= size
+		if _, err := m.MinFeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDemandOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.FulfillmentState != 0 {
+		n += 1 + sovQuery(uint64(m.FulfillmentState))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DemandOrder != nil {
+		l = m.DemandOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DemandOrders) > 0 {
+		for _, e := range m.DemandOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFulfillmentIntentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFulfillmentIntentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Intents) > 0 {
+		for _, e := range m.Intents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappRiskLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappRiskLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Outstanding) > 0 {
+		for _, e := range m.Outstanding {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.PauseReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderBookStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PendingCount != 0 {
+		n += 1 + sovQuery(uint64(m.PendingCount))
+	}
+	l = m.PendingPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UnfulfilledCount != 0 {
+		n += 1 + sovQuery(uint64(m.UnfulfilledCount))
+	}
+	l = m.UnfulfilledVolume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeePercentiles.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OldestOrderCreationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.OldestOrderCreationHeight))
+	}
+	if m.OldestOrderAge != 0 {
+		n += 1 + sovQuery(uint64(m.OldestOrderAge))
+	}
+	return n
+}
+
+func (m *FeePercentiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.P25.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.P50.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.P75.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.P90.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeSubsidyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSubsidyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subsidy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EpochRemaining) > 0 {
+		for _, e := range m.EpochRemaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeSubsidiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeSubsidiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subsidies) > 0 {
+		for _, e := range m.Subsidies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeFloorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeFloorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinFeePercentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Overridden {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
+			}
+			m.FulfillmentState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DemandOrder == nil {
+				m.DemandOrder = &DemandOrder{}
+			}
+			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
+			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFulfillmentIntentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFulfillmentIntentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFulfillmentIntentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFulfillmentIntentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFulfillmentIntentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFulfillmentIntentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intents = append(m.Intents, FulfillmentIntent{})
+			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappRiskLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappRiskLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappRiskLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappRiskLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappRiskLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappRiskLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outstanding = append(m.Outstanding, types1.Coin{})
+			if err := m.Outstanding[len(m.Outstanding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderBookStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -977,65 +4179,269 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderBookStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderBookStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, OrderBookStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCount", wireType)
+			}
+			m.PendingCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledCount", wireType)
+			}
+			m.UnfulfilledCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnfulfilledCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.UnfulfilledVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1062,10 +4468,48 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeePercentiles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestOrderCreationHeight", wireType)
+			}
+			m.OldestOrderCreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestOrderCreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestOrderAge", wireType)
+			}
+			m.OldestOrderAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestOrderAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1087,7 +4531,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *FeePercentiles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,15 +4554,15 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeePercentiles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeePercentiles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P25", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1146,63 +4590,15 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.P25.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1212,33 +4608,29 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= types.Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.P50.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P75", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1266,13 +4658,15 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			if err := m.P75.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1282,16 +4676,81 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.P90.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSubsidyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSubsidyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSubsidyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			m.FulfillmentState = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1301,16 +4760,79 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSubsidyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSubsidyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSubsidyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subsidy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1320,29 +4842,30 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			if err := m.Subsidy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRemaining", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1352,29 +4875,131 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.EpochRemaining = append(m.EpochRemaining, types1.Coin{})
+			if err := m.EpochRemaining[len(m.EpochRemaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSubsidiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSubsidiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSubsidiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSubsidiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSubsidiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSubsidiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subsidies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1384,23 +5009,25 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Subsidies = append(m.Subsidies, RollappFeeSubsidy{})
+			if err := m.Subsidies[len(m.Subsidies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1423,7 +5050,7 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeFloorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1446,17 +5073,17 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeFloorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeFloorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1466,27 +5093,23 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DemandOrder == nil {
-				m.DemandOrder = &DemandOrder{}
-			}
-			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1509,7 +5132,7 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeFloorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1532,17 +5155,17 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeFloorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeFloorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePercentage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1552,26 +5175,46 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
-			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinFeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overridden = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
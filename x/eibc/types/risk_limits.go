@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRollappRiskLimits creates new risk limits for the rollapp.
+func NewRollappRiskLimits(rollappId string, maxOutstanding, maxOrderPrice sdk.Coins) RollappRiskLimits {
+	return RollappRiskLimits{
+		RollappId:      rollappId,
+		MaxOutstanding: maxOutstanding,
+		MaxOrderPrice:  maxOrderPrice,
+	}
+}
+
+func (l RollappRiskLimits) ValidateBasic() error {
+	if l.RollappId == "" {
+		return fmt.Errorf("rollapp id cannot be empty")
+	}
+	if err := l.MaxOutstanding.Validate(); err != nil {
+		return fmt.Errorf("max outstanding: %w", err)
+	}
+	if err := l.MaxOrderPrice.Validate(); err != nil {
+		return fmt.Errorf("max order price: %w", err)
+	}
+	return nil
+}
+
+// IsEmpty returns true if no limit is set.
+func (l RollappRiskLimits) IsEmpty() bool {
+	return l.MaxOutstanding.Empty() && l.MaxOrderPrice.Empty()
+}
+
+// CheckOrder returns an error if a new order with the given price breaks the limits, given the current
+// total remaining price of the outstanding orders of the rollapp in the denom of the order.
+func (l RollappRiskLimits) CheckOrder(price sdk.Coin, outstanding math.Int) error {
+	if ok, maxPrice := l.MaxOrderPrice.Find(price.Denom); ok && price.Amount.GT(maxPrice.Amount) {
+		return errorsmod.Wrapf(ErrRiskLimitExceeded, "order price %s exceeds max order price %s", price, maxPrice)
+	}
+	if ok, maxOutstanding := l.MaxOutstanding.Find(price.Denom); ok && outstanding.Add(price.Amount).GT(maxOutstanding.Amount) {
+		return errorsmod.Wrapf(ErrRiskLimitExceeded, "outstanding %s%s with order price %s exceeds max outstanding %s",
+			outstanding, price.Denom, price, maxOutstanding)
+	}
+	return nil
+}
@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/risk_limits.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RollappRiskLimits are the limits on the demand orders created for the packets of a rollapp.
+// The limits are set per denom, orders in a denom without a limit are not limited.
+type RollappRiskLimits struct {
+	// rollapp_id is the rollapp the limits apply to.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// max_outstanding is the maximal total remaining price of the outstanding orders of the rollapp.
+	MaxOutstanding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_outstanding,json=maxOutstanding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_outstanding"`
+	// max_order_price is the maximal price of a single order of the rollapp.
+	MaxOrderPrice github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_order_price,json=maxOrderPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_order_price"`
+}
+
+func (m *RollappRiskLimits) Reset()         { *m = RollappRiskLimits{} }
+func (m *RollappRiskLimits) String() string { return proto.CompactTextString(m) }
+func (*RollappRiskLimits) ProtoMessage()    {}
+func (*RollappRiskLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8cce2f77d1549e6, []int{0}
+}
+func (m *RollappRiskLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappRiskLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappRiskLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappRiskLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappRiskLimits.Merge(m, src)
+}
+func (m *RollappRiskLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappRiskLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappRiskLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappRiskLimits proto.InternalMessageInfo
+
+func (m *RollappRiskLimits) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *RollappRiskLimits) GetMaxOutstanding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxOutstanding
+	}
+	return nil
+}
+
+func (m *RollappRiskLimits) GetMaxOrderPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxOrderPrice
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RollappRiskLimits)(nil), "dymensionxyz.dymension.eibc.RollappRiskLimits")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/risk_limits.proto", fileDescriptor_a8cce2f77d1549e6)
+}
+
+var fileDescriptor_a8cce2f77d1549e6 = []byte{
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0xd1, 0xbf, 0x4e, 0xf3, 0x30,
+	0x10, 0x00, 0xf0, 0xa4, 0x95, 0x3e, 0xa9, 0xf9, 0x04, 0x88, 0x88, 0xa1, 0x14, 0xe1, 0x56, 0x4c,
+	0x5d, 0x6a, 0x53, 0xfa, 0x06, 0x65, 0x42, 0x20, 0x81, 0x32, 0xb2, 0x44, 0xf9, 0x63, 0x85, 0x53,
+	0x9b, 0x5c, 0x94, 0x73, 0xab, 0x94, 0xa7, 0xe0, 0x05, 0x78, 0x01, 0x9e, 0xa4, 0x63, 0x47, 0x26,
+	0x40, 0xcd, 0x8b, 0x20, 0xc7, 0x51, 0xe9, 0xc2, 0xc6, 0x64, 0xdf, 0xf9, 0xce, 0x3f, 0xcb, 0xe7,
+	0x8c, 0xe2, 0x55, 0x2a, 0x33, 0x02, 0xcc, 0xca, 0xd5, 0xb3, 0xd8, 0x05, 0x42, 0x42, 0x18, 0x89,
+	0x02, 0x68, 0xe6, 0xcf, 0x21, 0x05, 0x45, 0x3c, 0x2f, 0x50, 0xa1, 0x7b, 0xb6, 0x5f, 0xce, 0x77,
+	0x01, 0xd7, 0xe5, 0xbd, 0x93, 0x04, 0x13, 0xac, 0xeb, 0x84, 0xde, 0x99, 0x96, 0x1e, 0x8b, 0x90,
+	0x52, 0x24, 0x11, 0x06, 0x24, 0xc5, 0x72, 0x1c, 0x4a, 0x15, 0x8c, 0x45, 0x84, 0x90, 0x99, 0xf3,
+	0x8b, 0xd7, 0x96, 0x73, 0xec, 0xe1, 0x7c, 0x1e, 0xe4, 0xb9, 0x07, 0x34, 0xbb, 0xab, 0x39, 0xf7,
+	0xdc, 0x71, 0x0a, 0x93, 0xf4, 0x21, 0xee, 0xda, 0x03, 0x7b, 0xd8, 0xf1, 0x3a, 0x4d, 0xe6, 0x26,
+	0x76, 0x95, 0x73, 0x94, 0x06, 0xa5, 0x8f, 0x0b, 0x45, 0x2a, 0xc8, 0x62, 0xc8, 0x92, 0x6e, 0x6b,
+	0xd0, 0x1e, 0xfe, 0xbf, 0x3a, 0xe5, 0x86, 0xe3, 0x9a, 0xe3, 0x0d, 0xc7, 0xaf, 0x11, 0xb2, 0xe9,
+	0xe5, 0xfa, 0xa3, 0x6f, 0xbd, 0x7d, 0xf6, 0x87, 0x09, 0xa8, 0xa7, 0x45, 0xc8, 0x23, 0x4c, 0x45,
+	0xf3, 0x36, 0xb3, 0x8c, 0x28, 0x9e, 0x09, 0xb5, 0xca, 0x25, 0xd5, 0x0d, 0xe4, 0x1d, 0xa6, 0x41,
+	0x79, 0xff, 0x43, 0xb8, 0xd4, 0xa8, 0x45, 0x2c, 0x0b, 0x3f, 0x2f, 0x20, 0x92, 0xdd, 0xf6, 0xdf,
+	0xab, 0x07, 0x5a, 0xd5, 0xc4, 0x83, 0x16, 0xa6, 0xb7, 0xeb, 0x2d, 0xb3, 0x37, 0x5b, 0x66, 0x7f,
+	0x6d, 0x99, 0xfd, 0x52, 0x31, 0x6b, 0x53, 0x31, 0xeb, 0xbd, 0x62, 0xd6, 0xe3, 0x78, 0xef, 0xca,
+	0x5f, 0xc6, 0xb8, 0x9c, 0x88, 0xd2, 0xcc, 0xb2, 0x16, 0xc2, 0x7f, 0xf5, 0x9f, 0x4f, 0xbe, 0x07,
+	0x00, 0x5c, 0x4b, 0x92, 0xa0, 0xf7, 0x01, 0x00, 0x00,
+}
+
+func (m *RollappRiskLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappRiskLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappRiskLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxOrderPrice) > 0 {
+		for iNdEx := len(m.MaxOrderPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxOrderPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRiskLimits(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MaxOutstanding) > 0 {
+		for iNdEx := len(m.MaxOutstanding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxOutstanding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRiskLimits(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRiskLimits(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRiskLimits(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRiskLimits(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RollappRiskLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRiskLimits(uint64(l))
+	}
+	if len(m.MaxOutstanding) > 0 {
+		for _, e := range m.MaxOutstanding {
+			l = e.Size()
+			n += 1 + l + sovRiskLimits(uint64(l))
+		}
+	}
+	if len(m.MaxOrderPrice) > 0 {
+		for _, e := range m.MaxOrderPrice {
+			l = e.Size()
+			n += 1 + l + sovRiskLimits(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRiskLimits(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRiskLimits(x uint64) (n int) {
+	return sovRiskLimits(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RollappRiskLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRiskLimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappRiskLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappRiskLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRiskLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRiskLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRiskLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutstanding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRiskLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRiskLimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRiskLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxOutstanding = append(m.MaxOutstanding, types.Coin{})
+			if err := m.MaxOutstanding[len(m.MaxOutstanding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRiskLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRiskLimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRiskLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxOrderPrice = append(m.MaxOrderPrice, types.Coin{})
+			if err := m.MaxOrderPrice[len(m.MaxOrderPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRiskLimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRiskLimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRiskLimits(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRiskLimits
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRiskLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRiskLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRiskLimits
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRiskLimits
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRiskLimits
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRiskLimits        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRiskLimits          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRiskLimits = fmt.Errorf("proto: unexpected end of group")
+)
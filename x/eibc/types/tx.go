@@ -12,7 +12,17 @@ import (
 
 var (
 	_ = sdk.Msg(&MsgFulfillOrder{})
+	_ = sdk.Msg(&MsgFulfillOrders{})
 	_ = sdk.Msg(&MsgUpdateDemandOrder{})
+	_ = sdk.Msg(&MsgFulfillOrderPartial{})
+	_ = sdk.Msg(&MsgCreateFulfillmentIntent{})
+	_ = sdk.Msg(&MsgCancelFulfillmentIntent{})
+	_ = sdk.Msg(&MsgSetRollappRiskLimits{})
+	_ = sdk.Msg(&MsgTransferFulfillment{})
+	_ = sdk.Msg(&MsgSetFeeSubsidy{})
+	_ = sdk.Msg(&MsgFundFeeSubsidy{})
+	_ = sdk.Msg(&MsgWithdrawFeeSubsidy{})
+	_ = sdk.Msg(&MsgSetRollappFeeFloor{})
 )
 
 func NewMsgFulfillOrder(fulfillerAddress, orderId, expectedFee string) *MsgFulfillOrder {
@@ -56,6 +66,83 @@ func (m *MsgFulfillOrder) GetFulfillerBech32Address() []byte {
 	return sdk.MustAccAddressFromBech32(m.FulfillerAddress)
 }
 
+func NewMsgFulfillOrders(fulfillerAddress string, orders []OrderFulfillment, bestEffort bool) *MsgFulfillOrders {
+	return &MsgFulfillOrders{
+		FulfillerAddress: fulfillerAddress,
+		Orders:           orders,
+		BestEffort:       bestEffort,
+	}
+}
+
+func NewOrderFulfillment(orderId, expectedFee string) OrderFulfillment {
+	return OrderFulfillment{
+		OrderId:     orderId,
+		ExpectedFee: expectedFee,
+	}
+}
+
+func (m *MsgFulfillOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(m.FulfillerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (m *MsgFulfillOrders) ValidateBasic() error {
+	if len(m.Orders) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "orders cannot be empty")
+	}
+	seen := make(map[string]struct{}, len(m.Orders))
+	for _, o := range m.Orders {
+		if err := validateCommon(o.OrderId, m.FulfillerAddress, o.ExpectedFee); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if _, ok := seen[o.OrderId]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate order id: %s", o.OrderId)
+		}
+		seen[o.OrderId] = struct{}{}
+	}
+	return nil
+}
+
+func (m *MsgFulfillOrders) GetFulfillerBech32Address() []byte {
+	return sdk.MustAccAddressFromBech32(m.FulfillerAddress)
+}
+
+func NewMsgFulfillOrderPartial(fulfillerAddress, orderId, expectedFee, amount string) *MsgFulfillOrderPartial {
+	return &MsgFulfillOrderPartial{
+		FulfillerAddress: fulfillerAddress,
+		OrderId:          orderId,
+		ExpectedFee:      expectedFee,
+		Amount:           amount,
+	}
+}
+
+func (m *MsgFulfillOrderPartial) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(m.FulfillerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (m *MsgFulfillOrderPartial) ValidateBasic() error {
+	err := validateCommon(m.OrderId, m.FulfillerAddress, m.ExpectedFee)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	amount, ok := sdk.NewIntFromString(m.Amount)
+	if !ok || !amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount must be a positive integer: %s", m.Amount)
+	}
+	return nil
+}
+
+func (m *MsgFulfillOrderPartial) GetFulfillerBech32Address() []byte {
+	return sdk.MustAccAddressFromBech32(m.FulfillerAddress)
+}
+
 func NewMsgUpdateDemandOrder(ownerAddr, orderId, newFee string) *MsgUpdateDemandOrder {
 	return &MsgUpdateDemandOrder{
 		OrderId:      orderId,
@@ -85,6 +172,235 @@ func (m *MsgUpdateDemandOrder) GetSignerAddr() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(m.OwnerAddress)
 }
 
+func NewMsgCreateFulfillmentIntent(fulfillerAddress, rollappId, denom string, minFeePercentage sdk.Dec, maxPrice, budget sdk.Int) *MsgCreateFulfillmentIntent {
+	return &MsgCreateFulfillmentIntent{
+		FulfillerAddress: fulfillerAddress,
+		RollappId:        rollappId,
+		Denom:            denom,
+		MinFeePercentage: minFeePercentage,
+		MaxPrice:         maxPrice,
+		Budget:           budget,
+	}
+}
+
+func (m *MsgCreateFulfillmentIntent) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(m.FulfillerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (m *MsgCreateFulfillmentIntent) ValidateBasic() error {
+	intent := NewFulfillmentIntent(0, m.FulfillerAddress, m.RollappId, m.Denom, m.MinFeePercentage, m.MaxPrice, m.Budget)
+	if err := intent.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ErrInvalidFulfillmentIntent, err.Error())
+	}
+	if !m.Budget.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidFulfillmentIntent, "budget must be positive")
+	}
+	return nil
+}
+
+func NewMsgCancelFulfillmentIntent(fulfillerAddress string, intentId uint64) *MsgCancelFulfillmentIntent {
+	return &MsgCancelFulfillmentIntent{
+		FulfillerAddress: fulfillerAddress,
+		IntentId:         intentId,
+	}
+}
+
+func (m *MsgCancelFulfillmentIntent) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(m.FulfillerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (m *MsgCancelFulfillmentIntent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FulfillerAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+func NewMsgSetRollappRiskLimits(signer string, limits RollappRiskLimits) *MsgSetRollappRiskLimits {
+	return &MsgSetRollappRiskLimits{
+		Signer: signer,
+		Limits: limits,
+	}
+}
+
+func (m *MsgSetRollappRiskLimits) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m *MsgSetRollappRiskLimits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := m.Limits.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRiskLimits, err.Error())
+	}
+	return nil
+}
+
+func NewMsgTransferFulfillment(fulfillerAddress, orderId, newFulfillerAddress string) *MsgTransferFulfillment {
+	return &MsgTransferFulfillment{
+		FulfillerAddress:    fulfillerAddress,
+		OrderId:             orderId,
+		NewFulfillerAddress: newFulfillerAddress,
+	}
+}
+
+func (m *MsgTransferFulfillment) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(m.FulfillerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (m *MsgTransferFulfillment) ValidateBasic() error {
+	if !isValidOrderId(m.OrderId) {
+		return errorsmod.Wrapf(ErrInvalidOrderID, "%s", m.OrderId)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.FulfillerAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewFulfillerAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "new fulfiller: %s", err)
+	}
+	if m.FulfillerAddress == m.NewFulfillerAddress {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new fulfiller must differ from the current fulfiller")
+	}
+	return nil
+}
+
+func (m *MsgTransferFulfillment) GetFulfillerBech32Address() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(m.FulfillerAddress)
+}
+
+func (m *MsgTransferFulfillment) GetNewFulfillerBech32Address() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(m.NewFulfillerAddress)
+}
+
+func NewMsgSetFeeSubsidy(owner, rollappId string, subsidyPercentage sdk.Dec, epochCap sdk.Coins) *MsgSetFeeSubsidy {
+	return &MsgSetFeeSubsidy{
+		Owner:             owner,
+		RollappId:         rollappId,
+		SubsidyPercentage: subsidyPercentage,
+		EpochCap:          epochCap,
+	}
+}
+
+func (m *MsgSetFeeSubsidy) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (m *MsgSetFeeSubsidy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := NewRollappFeeSubsidy(m.RollappId, m.SubsidyPercentage, m.EpochCap).ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeSubsidy, err.Error())
+	}
+	return nil
+}
+
+func NewMsgFundFeeSubsidy(owner, rollappId string, amount sdk.Coins) *MsgFundFeeSubsidy {
+	return &MsgFundFeeSubsidy{
+		Owner:     owner,
+		RollappId: rollappId,
+		Amount:    amount,
+	}
+}
+
+func (m *MsgFundFeeSubsidy) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (m *MsgFundFeeSubsidy) ValidateBasic() error {
+	return validateFeeSubsidyTransfer(m.Owner, m.RollappId, m.Amount)
+}
+
+func NewMsgWithdrawFeeSubsidy(owner, rollappId string, amount sdk.Coins) *MsgWithdrawFeeSubsidy {
+	return &MsgWithdrawFeeSubsidy{
+		Owner:     owner,
+		RollappId: rollappId,
+		Amount:    amount,
+	}
+}
+
+func (m *MsgWithdrawFeeSubsidy) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (m *MsgWithdrawFeeSubsidy) ValidateBasic() error {
+	return validateFeeSubsidyTransfer(m.Owner, m.RollappId, m.Amount)
+}
+
+func validateFeeSubsidyTransfer(owner, rollappId string, amount sdk.Coins) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if rollappId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rollapp id cannot be empty")
+	}
+	if !amount.IsValid() || amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be positive: %s", amount)
+	}
+	return nil
+}
+
+func NewMsgSetRollappFeeFloor(authority string, floor RollappFeeFloor, remove bool) *MsgSetRollappFeeFloor {
+	return &MsgSetRollappFeeFloor{
+		Authority: authority,
+		Floor:     floor,
+		Remove:    remove,
+	}
+}
+
+func (m *MsgSetRollappFeeFloor) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (m *MsgSetRollappFeeFloor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Remove {
+		if m.Floor.RollappId == "" {
+			return errorsmod.Wrap(ErrInvalidFeeFloor, "rollapp id cannot be empty")
+		}
+		return nil
+	}
+	if err := m.Floor.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeFloor, err.Error())
+	}
+	return nil
+}
+
 func isValidOrderId(orderId string) bool {
 	hashBytes, err := hex.DecodeString(orderId)
 	if err != nil {
@@ -6,7 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -129,6 +132,173 @@ func (m *MsgFulfillOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFulfillOrderResponse proto.InternalMessageInfo
 
+// MsgFulfillOrders defines the FulfillOrders request type.
+// It fulfills a batch of orders in a single message.
+type MsgFulfillOrders struct {
+	// fulfiller_address is the bech32-encoded address of the account which the message was sent from.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// orders are the orders to be fulfilled.
+	Orders []OrderFulfillment `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
+	// best_effort skips the orders which can't be fulfilled, e.g. because they were already fulfilled.
+	// Otherwise the message fails if any of the orders can't be fulfilled.
+	BestEffort bool `protobuf:"varint,3,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+}
+
+func (m *MsgFulfillOrders) Reset()         { *m = MsgFulfillOrders{} }
+func (m *MsgFulfillOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrders) ProtoMessage()    {}
+func (*MsgFulfillOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{2}
+}
+func (m *MsgFulfillOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrders.Merge(m, src)
+}
+func (m *MsgFulfillOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrders proto.InternalMessageInfo
+
+func (m *MsgFulfillOrders) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
+func (m *MsgFulfillOrders) GetOrders() []OrderFulfillment {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *MsgFulfillOrders) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
+}
+
+// OrderFulfillment is a single order fulfilled by MsgFulfillOrders.
+type OrderFulfillment struct {
+	// order_id is the unique identifier of the order to be fulfilled.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// expected_fee is the nominal fee set in the order.
+	ExpectedFee string `protobuf:"bytes,2,opt,name=expected_fee,json=expectedFee,proto3" json:"expected_fee,omitempty"`
+}
+
+func (m *OrderFulfillment) Reset()         { *m = OrderFulfillment{} }
+func (m *OrderFulfillment) String() string { return proto.CompactTextString(m) }
+func (*OrderFulfillment) ProtoMessage()    {}
+func (*OrderFulfillment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{3}
+}
+func (m *OrderFulfillment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderFulfillment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderFulfillment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderFulfillment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFulfillment.Merge(m, src)
+}
+func (m *OrderFulfillment) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderFulfillment) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFulfillment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderFulfillment proto.InternalMessageInfo
+
+func (m *OrderFulfillment) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderFulfillment) GetExpectedFee() string {
+	if m != nil {
+		return m.ExpectedFee
+	}
+	return ""
+}
+
+// MsgFulfillOrdersResponse defines the FulfillOrders response type.
+type MsgFulfillOrdersResponse struct {
+	// fulfilled_order_ids are the ids of the orders fulfilled by the message.
+	FulfilledOrderIds []string `protobuf:"bytes,1,rep,name=fulfilled_order_ids,json=fulfilledOrderIds,proto3" json:"fulfilled_order_ids,omitempty"`
+}
+
+func (m *MsgFulfillOrdersResponse) Reset()         { *m = MsgFulfillOrdersResponse{} }
+func (m *MsgFulfillOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrdersResponse) ProtoMessage()    {}
+func (*MsgFulfillOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{4}
+}
+func (m *MsgFulfillOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrdersResponse.Merge(m, src)
+}
+func (m *MsgFulfillOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrdersResponse proto.InternalMessageInfo
+
+func (m *MsgFulfillOrdersResponse) GetFulfilledOrderIds() []string {
+	if m != nil {
+		return m.FulfilledOrderIds
+	}
+	return nil
+}
+
 type MsgUpdateDemandOrder struct {
 	// owner_address is the bech32-encoded address of the account owns the order.
 	// This is expected to be the address of the order recipient.
@@ -143,7 +313,7 @@ func (m *MsgUpdateDemandOrder) Reset()         { *m = MsgUpdateDemandOrder{} }
 func (m *MsgUpdateDemandOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDemandOrder) ProtoMessage()    {}
 func (*MsgUpdateDemandOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{2}
+	return fileDescriptor_47537f11f512b254, []int{5}
 }
 func (m *MsgUpdateDemandOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,7 +370,7 @@ func (m *MsgUpdateDemandOrderResponse) Reset()         { *m = MsgUpdateDemandOrd
 func (m *MsgUpdateDemandOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDemandOrderResponse) ProtoMessage()    {}
 func (*MsgUpdateDemandOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{3}
+	return fileDescriptor_47537f11f512b254, []int{6}
 }
 func (m *MsgUpdateDemandOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,373 +399,4915 @@ func (m *MsgUpdateDemandOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateDemandOrderResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgFulfillOrder)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrder")
-	proto.RegisterType((*MsgFulfillOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderResponse")
-	proto.RegisterType((*MsgUpdateDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrder")
-	proto.RegisterType((*MsgUpdateDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrderResponse")
+// MsgFulfillOrderPartial defines the FulfillOrderPartial request type.
+// It allows a fulfiller to pay only a part of the order price and earn a pro-rata share of the fee.
+type MsgFulfillOrderPartial struct {
+	// fulfiller_address is the bech32-encoded address of the account which the message was sent from.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// order_id is the unique identifier of the order to be partially fulfilled.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// expected_fee is the nominal fee set in the order.
+	ExpectedFee string `protobuf:"bytes,3,opt,name=expected_fee,json=expectedFee,proto3" json:"expected_fee,omitempty"`
+	// amount is the part of the order price the fulfiller pays to the order recipient.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/tx.proto", fileDescriptor_47537f11f512b254)
+func (m *MsgFulfillOrderPartial) Reset()         { *m = MsgFulfillOrderPartial{} }
+func (m *MsgFulfillOrderPartial) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderPartial) ProtoMessage()    {}
+func (*MsgFulfillOrderPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{7}
 }
-
-var fileDescriptor_47537f11f512b254 = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
-	0xf5, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x91, 0x55, 0xe9, 0xc1, 0x39,
-	0x7a, 0x20, 0x55, 0x52, 0xe2, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0xb9, 0xc5, 0xe9, 0xfa,
-	0x65, 0x86, 0x20, 0x0a, 0xa2, 0x4b, 0x69, 0x0a, 0x23, 0x17, 0xbf, 0x6f, 0x71, 0xba, 0x5b, 0x69,
-	0x4e, 0x5a, 0x66, 0x4e, 0x8e, 0x7f, 0x51, 0x4a, 0x6a, 0x91, 0x90, 0x36, 0x97, 0x60, 0x1a, 0x84,
-	0x9f, 0x5a, 0x14, 0x9f, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x19, 0x24, 0x00, 0x97, 0x70, 0x84, 0x88, 0x0b, 0x49, 0x72, 0x71, 0xe4, 0x83, 0x74, 0xc5, 0x67,
-	0xa6, 0x48, 0x30, 0x81, 0xd5, 0xb0, 0x83, 0xf9, 0x9e, 0x29, 0x42, 0x8a, 0x5c, 0x3c, 0xa9, 0x15,
-	0x05, 0xa9, 0xc9, 0x25, 0xa9, 0x29, 0xf1, 0x69, 0xa9, 0xa9, 0x12, 0xcc, 0x60, 0x69, 0x6e, 0x98,
-	0x98, 0x5b, 0x6a, 0xaa, 0x95, 0x58, 0xd3, 0xf3, 0x0d, 0x5a, 0x98, 0xb6, 0x29, 0x49, 0x72, 0x89,
-	0xa3, 0xb9, 0x2a, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0xa9, 0x99, 0x91, 0x4b, 0xc4,
-	0xb7, 0x38, 0x3d, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0xd5, 0x25, 0x35, 0x37, 0x31, 0x2f, 0x05, 0xe2,
-	0x6c, 0x65, 0x2e, 0xde, 0xfc, 0xf2, 0x3c, 0x0c, 0x27, 0xf3, 0x80, 0x05, 0x89, 0x70, 0xae, 0x38,
-	0x17, 0x7b, 0x5e, 0x6a, 0x39, 0x92, 0x4b, 0xd9, 0xf2, 0x52, 0xcb, 0x41, 0x8e, 0x14, 0x02, 0x39,
-	0x12, 0xd5, 0x6c, 0x25, 0x39, 0x2e, 0x19, 0x6c, 0x8e, 0x80, 0xb9, 0xd2, 0xa8, 0x91, 0x89, 0x8b,
-	0xd9, 0xb7, 0x38, 0x5d, 0xa8, 0x84, 0x8b, 0x07, 0x25, 0x6c, 0x75, 0xf4, 0xf0, 0x44, 0x93, 0x1e,
-	0x9a, 0x9f, 0xa5, 0x4c, 0x48, 0x51, 0x0d, 0x0f, 0x21, 0x06, 0xa1, 0x66, 0x46, 0x2e, 0x41, 0xcc,
-	0x00, 0x32, 0x24, 0x64, 0x1a, 0x86, 0x16, 0x29, 0x4b, 0x92, 0xb5, 0x20, 0x5c, 0xe1, 0xe4, 0x7d,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x38, 0x12, 0x77, 0x99, 0xb1, 0x7e, 0x05, 0x34, 0x85, 0x57,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xd3, 0xab, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x69,
-	0xa9, 0xa4, 0x0d, 0x03, 0x00, 0x00,
+func (m *MsgFulfillOrderPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrderPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderPartial.Merge(m, src)
+}
+func (m *MsgFulfillOrderPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderPartial.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgFulfillOrderPartial proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error)
-	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
+func (m *MsgFulfillOrderPartial) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgFulfillOrderPartial) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgFulfillOrderPartial) GetExpectedFee() string {
+	if m != nil {
+		return m.ExpectedFee
+	}
+	return ""
 }
 
-func (c *msgClient) FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error) {
-	out := new(MsgFulfillOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgFulfillOrderPartial) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error) {
-	out := new(MsgUpdateDemandOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgFulfillOrderPartialResponse defines the FulfillOrderPartial response type.
+type MsgFulfillOrderPartialResponse struct {
+	// fulfilled is true if this slice completed the order.
+	Fulfilled bool `protobuf:"varint,1,opt,name=fulfilled,proto3" json:"fulfilled,omitempty"`
+}
+
+func (m *MsgFulfillOrderPartialResponse) Reset()         { *m = MsgFulfillOrderPartialResponse{} }
+func (m *MsgFulfillOrderPartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderPartialResponse) ProtoMessage()    {}
+func (*MsgFulfillOrderPartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{8}
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderPartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderPartialResponse.Merge(m, src)
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderPartialResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	FulfillOrder(context.Context, *MsgFulfillOrder) (*MsgFulfillOrderResponse, error)
-	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
+var xxx_messageInfo_MsgFulfillOrderPartialResponse proto.InternalMessageInfo
+
+func (m *MsgFulfillOrderPartialResponse) GetFulfilled() bool {
+	if m != nil {
+		return m.Fulfilled
+	}
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgCreateFulfillmentIntent defines the CreateFulfillmentIntent request type.
+// The budget is escrowed in the eibc module until it is spent on fulfilled orders or the intent is cancelled.
+type MsgCreateFulfillmentIntent struct {
+	// fulfiller_address is the bech32-encoded address of the account which the message was sent from.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// rollapp_id is the rollapp whose demand orders should be fulfilled.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// denom is the denom of the demand orders which should be fulfilled.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_fee_percentage is the minimal order fee divided by the order price, which the fulfiller accepts.
+	MinFeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_fee_percentage,json=minFeePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fee_percentage"`
+	// max_price is the maximal price of a single order which the fulfiller pays.
+	MaxPrice github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_price,json=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_price"`
+	// budget is the total amount escrowed for fulfilling orders.
+	Budget github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=budget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"budget"`
 }
 
-func (*UnimplementedMsgServer) FulfillOrder(ctx context.Context, req *MsgFulfillOrder) (*MsgFulfillOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
+func (m *MsgCreateFulfillmentIntent) Reset()         { *m = MsgCreateFulfillmentIntent{} }
+func (m *MsgCreateFulfillmentIntent) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFulfillmentIntent) ProtoMessage()    {}
+func (*MsgCreateFulfillmentIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{9}
 }
-func (*UnimplementedMsgServer) UpdateDemandOrder(ctx context.Context, req *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDemandOrder not implemented")
+func (m *MsgCreateFulfillmentIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCreateFulfillmentIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFulfillmentIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFulfillmentIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFulfillmentIntent.Merge(m, src)
+}
+func (m *MsgCreateFulfillmentIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFulfillmentIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFulfillmentIntent.DiscardUnknown(m)
 }
 
-func _Msg_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfillOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfillOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfillOrder(ctx, req.(*MsgFulfillOrder))
+var xxx_messageInfo_MsgCreateFulfillmentIntent proto.InternalMessageInfo
+
+func (m *MsgCreateFulfillmentIntent) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDemandOrder)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateFulfillmentIntent) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDemandOrder(ctx, in)
+	return ""
+}
+
+func (m *MsgCreateFulfillmentIntent) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDemandOrder(ctx, req.(*MsgUpdateDemandOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.eibc.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FulfillOrder",
-			Handler:    _Msg_FulfillOrder_Handler,
-		},
-		{
-			MethodName: "UpdateDemandOrder",
-			Handler:    _Msg_UpdateDemandOrder_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/eibc/tx.proto",
+// MsgCreateFulfillmentIntentResponse defines the CreateFulfillmentIntent response type.
+type MsgCreateFulfillmentIntentResponse struct {
+	// id is the unique identifier of the created intent.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgFulfillOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateFulfillmentIntentResponse) Reset()         { *m = MsgCreateFulfillmentIntentResponse{} }
+func (m *MsgCreateFulfillmentIntentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFulfillmentIntentResponse) ProtoMessage()    {}
+func (*MsgCreateFulfillmentIntentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{10}
+}
+func (m *MsgCreateFulfillmentIntentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFulfillmentIntentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFulfillmentIntentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgFulfillOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateFulfillmentIntentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFulfillmentIntentResponse.Merge(m, src)
+}
+func (m *MsgCreateFulfillmentIntentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFulfillmentIntentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFulfillmentIntentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFulfillOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExpectedFee) > 0 {
-		i -= len(m.ExpectedFee)
-		copy(dAtA[i:], m.ExpectedFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgCreateFulfillmentIntentResponse proto.InternalMessageInfo
+
+func (m *MsgCreateFulfillmentIntentResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.FulfillerAddress) > 0 {
-		i -= len(m.FulfillerAddress)
-		copy(dAtA[i:], m.FulfillerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+// MsgCancelFulfillmentIntent defines the CancelFulfillmentIntent request type.
+// The remaining budget is returned to the fulfiller.
+type MsgCancelFulfillmentIntent struct {
+	// fulfiller_address is the bech32-encoded address of the account which owns the intent.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// intent_id is the unique identifier of the intent to cancel.
+	IntentId uint64 `protobuf:"varint,2,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+}
+
+func (m *MsgCancelFulfillmentIntent) Reset()         { *m = MsgCancelFulfillmentIntent{} }
+func (m *MsgCancelFulfillmentIntent) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFulfillmentIntent) ProtoMessage()    {}
+func (*MsgCancelFulfillmentIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{11}
+}
+func (m *MsgCancelFulfillmentIntent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFulfillmentIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFulfillmentIntent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelFulfillmentIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFulfillmentIntent.Merge(m, src)
+}
+func (m *MsgCancelFulfillmentIntent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFulfillmentIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFulfillmentIntent.DiscardUnknown(m)
 }
 
-func (m *MsgFulfillOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelFulfillmentIntent proto.InternalMessageInfo
+
+func (m *MsgCancelFulfillmentIntent) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFulfillOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelFulfillmentIntent) GetIntentId() uint64 {
+	if m != nil {
+		return m.IntentId
+	}
+	return 0
 }
 
-func (m *MsgFulfillOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCancelFulfillmentIntentResponse defines the CancelFulfillmentIntent response type.
+type MsgCancelFulfillmentIntentResponse struct {
 }
 
-func (m *MsgUpdateDemandOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelFulfillmentIntentResponse) Reset()         { *m = MsgCancelFulfillmentIntentResponse{} }
+func (m *MsgCancelFulfillmentIntentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFulfillmentIntentResponse) ProtoMessage()    {}
+func (*MsgCancelFulfillmentIntentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{12}
+}
+func (m *MsgCancelFulfillmentIntentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFulfillmentIntentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFulfillmentIntentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelFulfillmentIntentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFulfillmentIntentResponse.Merge(m, src)
+}
+func (m *MsgCancelFulfillmentIntentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFulfillmentIntentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFulfillmentIntentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDemandOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelFulfillmentIntentResponse proto.InternalMessageInfo
+
+// MsgSetRollappRiskLimits defines the SetRollappRiskLimits request type.
+// The limits can be set by the governance or by the owner of the rollapp.
+type MsgSetRollappRiskLimits struct {
+	// signer is the bech32-encoded address of the governance module account or the rollapp owner.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// limits are the new limits of the rollapp. Empty limits remove the limits of the rollapp.
+	Limits RollappRiskLimits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits"`
 }
 
-func (m *MsgUpdateDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewFee) > 0 {
-		i -= len(m.NewFee)
-		copy(dAtA[i:], m.NewFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetRollappRiskLimits) Reset()         { *m = MsgSetRollappRiskLimits{} }
+func (m *MsgSetRollappRiskLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRollappRiskLimits) ProtoMessage()    {}
+func (*MsgSetRollappRiskLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{13}
+}
+func (m *MsgSetRollappRiskLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRollappRiskLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRollappRiskLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetRollappRiskLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRollappRiskLimits.Merge(m, src)
+}
+func (m *MsgSetRollappRiskLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRollappRiskLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRollappRiskLimits.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDemandOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetRollappRiskLimits proto.InternalMessageInfo
+
+func (m *MsgSetRollappRiskLimits) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRollappRiskLimits) GetLimits() RollappRiskLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return RollappRiskLimits{}
 }
 
-func (m *MsgUpdateDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetRollappRiskLimitsResponse defines the SetRollappRiskLimits response type.
+type MsgSetRollappRiskLimitsResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetRollappRiskLimitsResponse) Reset()         { *m = MsgSetRollappRiskLimitsResponse{} }
+func (m *MsgSetRollappRiskLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRollappRiskLimitsResponse) ProtoMessage()    {}
+func (*MsgSetRollappRiskLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{14}
 }
-func (m *MsgFulfillOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FulfillerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExpectedFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetRollappRiskLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRollappRiskLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRollappRiskLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetRollappRiskLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRollappRiskLimitsResponse.Merge(m, src)
+}
+func (m *MsgSetRollappRiskLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRollappRiskLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRollappRiskLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFulfillOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgSetRollappRiskLimitsResponse proto.InternalMessageInfo
+
+// MsgTransferFulfillment defines the TransferFulfillment request type.
+// It moves the claim on the finalized funds of a fulfilled order to another account.
+type MsgTransferFulfillment struct {
+	// fulfiller_address is the bech32-encoded address of the account which currently holds the claim.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// order_id is the unique identifier of the fulfilled order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// new_fulfiller_address is the bech32-encoded address of the account which receives the claim.
+	NewFulfillerAddress string `protobuf:"bytes,3,opt,name=new_fulfiller_address,json=newFulfillerAddress,proto3" json:"new_fulfiller_address,omitempty"`
+}
+
+func (m *MsgTransferFulfillment) Reset()         { *m = MsgTransferFulfillment{} }
+func (m *MsgTransferFulfillment) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferFulfillment) ProtoMessage()    {}
+func (*MsgTransferFulfillment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{15}
+}
+func (m *MsgTransferFulfillment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferFulfillment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferFulfillment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferFulfillment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferFulfillment.Merge(m, src)
+}
+func (m *MsgTransferFulfillment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferFulfillment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferFulfillment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferFulfillment proto.InternalMessageInfo
+
+func (m *MsgTransferFulfillment) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
+func (m *MsgTransferFulfillment) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *MsgTransferFulfillment) GetNewFulfillerAddress() string {
+	if m != nil {
+		return m.NewFulfillerAddress
+	}
+	return ""
+}
+
+// MsgTransferFulfillmentResponse defines the TransferFulfillment response type.
+type MsgTransferFulfillmentResponse struct {
+}
+
+func (m *MsgTransferFulfillmentResponse) Reset()         { *m = MsgTransferFulfillmentResponse{} }
+func (m *MsgTransferFulfillmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferFulfillmentResponse) ProtoMessage()    {}
+func (*MsgTransferFulfillmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{16}
+}
+func (m *MsgTransferFulfillmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferFulfillmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferFulfillmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferFulfillmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferFulfillmentResponse.Merge(m, src)
+}
+func (m *MsgTransferFulfillmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferFulfillmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferFulfillmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferFulfillmentResponse proto.InternalMessageInfo
+
+// MsgSetFeeSubsidy defines the SetFeeSubsidy request type.
+// It configures the fee subsidy pool of a rollapp. Only the rollapp owner can configure the pool.
+type MsgSetFeeSubsidy struct {
+	// owner is the bech32-encoded address of the rollapp owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// rollapp_id is the rollapp whose orders are subsidized.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// subsidy_percentage is the part of the order fee paid on top of it to the fulfiller.
+	SubsidyPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=subsidy_percentage,json=subsidyPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"subsidy_percentage"`
+	// epoch_cap is the maximal amount paid out by the pool per epoch.
+	EpochCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=epoch_cap,json=epochCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_cap"`
+}
+
+func (m *MsgSetFeeSubsidy) Reset()         { *m = MsgSetFeeSubsidy{} }
+func (m *MsgSetFeeSubsidy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSubsidy) ProtoMessage()    {}
+func (*MsgSetFeeSubsidy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{17}
+}
+func (m *MsgSetFeeSubsidy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeSubsidy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeSubsidy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeSubsidy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSubsidy.Merge(m, src)
+}
+func (m *MsgSetFeeSubsidy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeSubsidy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSubsidy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeSubsidy proto.InternalMessageInfo
+
+func (m *MsgSetFeeSubsidy) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgSetFeeSubsidy) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgSetFeeSubsidy) GetEpochCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochCap
+	}
+	return nil
+}
+
+// MsgSetFeeSubsidyResponse defines the SetFeeSubsidy response type.
+type MsgSetFeeSubsidyResponse struct {
+}
+
+func (m *MsgSetFeeSubsidyResponse) Reset()         { *m = MsgSetFeeSubsidyResponse{} }
+func (m *MsgSetFeeSubsidyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSubsidyResponse) ProtoMessage()    {}
+func (*MsgSetFeeSubsidyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{18}
+}
+func (m *MsgSetFeeSubsidyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeSubsidyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeSubsidyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeSubsidyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSubsidyResponse.Merge(m, src)
+}
+func (m *MsgSetFeeSubsidyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeSubsidyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSubsidyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeSubsidyResponse proto.InternalMessageInfo
+
+// MsgFundFeeSubsidy defines the FundFeeSubsidy request type.
+// The funds are escrowed in the eibc module until they are paid out or withdrawn.
+type MsgFundFeeSubsidy struct {
+	// owner is the bech32-encoded address of the rollapp owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// rollapp_id is the rollapp whose pool is funded.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// amount is added to the pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundFeeSubsidy) Reset()         { *m = MsgFundFeeSubsidy{} }
+func (m *MsgFundFeeSubsidy) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFeeSubsidy) ProtoMessage()    {}
+func (*MsgFundFeeSubsidy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{19}
+}
+func (m *MsgFundFeeSubsidy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFeeSubsidy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFeeSubsidy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundFeeSubsidy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFeeSubsidy.Merge(m, src)
+}
+func (m *MsgFundFeeSubsidy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFeeSubsidy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFeeSubsidy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundFeeSubsidy proto.InternalMessageInfo
+
+func (m *MsgFundFeeSubsidy) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgFundFeeSubsidy) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgFundFeeSubsidy) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgFundFeeSubsidyResponse defines the FundFeeSubsidy response type.
+type MsgFundFeeSubsidyResponse struct {
+}
+
+func (m *MsgFundFeeSubsidyResponse) Reset()         { *m = MsgFundFeeSubsidyResponse{} }
+func (m *MsgFundFeeSubsidyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFeeSubsidyResponse) ProtoMessage()    {}
+func (*MsgFundFeeSubsidyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{20}
+}
+func (m *MsgFundFeeSubsidyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFeeSubsidyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFeeSubsidyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundFeeSubsidyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFeeSubsidyResponse.Merge(m, src)
+}
+func (m *MsgFundFeeSubsidyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFeeSubsidyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFeeSubsidyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundFeeSubsidyResponse proto.InternalMessageInfo
+
+// MsgWithdrawFeeSubsidy defines the WithdrawFeeSubsidy request type.
+// The funds are returned from the pool to the rollapp owner.
+type MsgWithdrawFeeSubsidy struct {
+	// owner is the bech32-encoded address of the rollapp owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// rollapp_id is the rollapp whose pool is withdrawn from.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// amount is removed from the pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawFeeSubsidy) Reset()         { *m = MsgWithdrawFeeSubsidy{} }
+func (m *MsgWithdrawFeeSubsidy) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeeSubsidy) ProtoMessage()    {}
+func (*MsgWithdrawFeeSubsidy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{21}
+}
+func (m *MsgWithdrawFeeSubsidy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeeSubsidy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeeSubsidy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFeeSubsidy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeeSubsidy.Merge(m, src)
+}
+func (m *MsgWithdrawFeeSubsidy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeeSubsidy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeeSubsidy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFeeSubsidy proto.InternalMessageInfo
+
+func (m *MsgWithdrawFeeSubsidy) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgWithdrawFeeSubsidy) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgWithdrawFeeSubsidy) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawFeeSubsidyResponse defines the WithdrawFeeSubsidy response type.
+type MsgWithdrawFeeSubsidyResponse struct {
+}
+
+func (m *MsgWithdrawFeeSubsidyResponse) Reset()         { *m = MsgWithdrawFeeSubsidyResponse{} }
+func (m *MsgWithdrawFeeSubsidyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeeSubsidyResponse) ProtoMessage()    {}
+func (*MsgWithdrawFeeSubsidyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{22}
+}
+func (m *MsgWithdrawFeeSubsidyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeeSubsidyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeeSubsidyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFeeSubsidyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeeSubsidyResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFeeSubsidyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeeSubsidyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeeSubsidyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFeeSubsidyResponse proto.InternalMessageInfo
+
+// MsgSetRollappFeeFloor defines the SetRollappFeeFloor request type.
+// The fee floor override of a rollapp can be set only by the governance.
+type MsgSetRollappFeeFloor struct {
+	// authority is the bech32-encoded address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// floor is the new fee floor of the rollapp.
+	Floor RollappFeeFloor `protobuf:"bytes,2,opt,name=floor,proto3" json:"floor"`
+	// remove removes the override, the rollapp falls back to the module param.
+	Remove bool `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgSetRollappFeeFloor) Reset()         { *m = MsgSetRollappFeeFloor{} }
+func (m *MsgSetRollappFeeFloor) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRollappFeeFloor) ProtoMessage()    {}
+func (*MsgSetRollappFeeFloor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{23}
+}
+func (m *MsgSetRollappFeeFloor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRollappFeeFloor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRollappFeeFloor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRollappFeeFloor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRollappFeeFloor.Merge(m, src)
+}
+func (m *MsgSetRollappFeeFloor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRollappFeeFloor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRollappFeeFloor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRollappFeeFloor proto.InternalMessageInfo
+
+func (m *MsgSetRollappFeeFloor) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetRollappFeeFloor) GetFloor() RollappFeeFloor {
+	if m != nil {
+		return m.Floor
+	}
+	return RollappFeeFloor{}
+}
+
+func (m *MsgSetRollappFeeFloor) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+// MsgSetRollappFeeFloorResponse defines the SetRollappFeeFloor response type.
+type MsgSetRollappFeeFloorResponse struct {
+}
+
+func (m *MsgSetRollappFeeFloorResponse) Reset()         { *m = MsgSetRollappFeeFloorResponse{} }
+func (m *MsgSetRollappFeeFloorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRollappFeeFloorResponse) ProtoMessage()    {}
+func (*MsgSetRollappFeeFloorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{24}
+}
+func (m *MsgSetRollappFeeFloorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRollappFeeFloorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRollappFeeFloorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRollappFeeFloorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRollappFeeFloorResponse.Merge(m, src)
+}
+func (m *MsgSetRollappFeeFloorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRollappFeeFloorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRollappFeeFloorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRollappFeeFloorResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgFulfillOrder)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrder")
+	proto.RegisterType((*MsgFulfillOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderResponse")
+	proto.RegisterType((*MsgFulfillOrders)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrders")
+	proto.RegisterType((*OrderFulfillment)(nil), "dymensionxyz.dymension.eibc.OrderFulfillment")
+	proto.RegisterType((*MsgFulfillOrdersResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrdersResponse")
+	proto.RegisterType((*MsgUpdateDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrder")
+	proto.RegisterType((*MsgUpdateDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrderResponse")
+	proto.RegisterType((*MsgFulfillOrderPartial)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderPartial")
+	proto.RegisterType((*MsgFulfillOrderPartialResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderPartialResponse")
+	proto.RegisterType((*MsgCreateFulfillmentIntent)(nil), "dymensionxyz.dymension.eibc.MsgCreateFulfillmentIntent")
+	proto.RegisterType((*MsgCreateFulfillmentIntentResponse)(nil), "dymensionxyz.dymension.eibc.MsgCreateFulfillmentIntentResponse")
+	proto.RegisterType((*MsgCancelFulfillmentIntent)(nil), "dymensionxyz.dymension.eibc.MsgCancelFulfillmentIntent")
+	proto.RegisterType((*MsgCancelFulfillmentIntentResponse)(nil), "dymensionxyz.dymension.eibc.MsgCancelFulfillmentIntentResponse")
+	proto.RegisterType((*MsgSetRollappRiskLimits)(nil), "dymensionxyz.dymension.eibc.MsgSetRollappRiskLimits")
+	proto.RegisterType((*MsgSetRollappRiskLimitsResponse)(nil), "dymensionxyz.dymension.eibc.MsgSetRollappRiskLimitsResponse")
+	proto.RegisterType((*MsgTransferFulfillment)(nil), "dymensionxyz.dymension.eibc.MsgTransferFulfillment")
+	proto.RegisterType((*MsgTransferFulfillmentResponse)(nil), "dymensionxyz.dymension.eibc.MsgTransferFulfillmentResponse")
+	proto.RegisterType((*MsgSetFeeSubsidy)(nil), "dymensionxyz.dymension.eibc.MsgSetFeeSubsidy")
+	proto.RegisterType((*MsgSetFeeSubsidyResponse)(nil), "dymensionxyz.dymension.eibc.MsgSetFeeSubsidyResponse")
+	proto.RegisterType((*MsgFundFeeSubsidy)(nil), "dymensionxyz.dymension.eibc.MsgFundFeeSubsidy")
+	proto.RegisterType((*MsgFundFeeSubsidyResponse)(nil), "dymensionxyz.dymension.eibc.MsgFundFeeSubsidyResponse")
+	proto.RegisterType((*MsgWithdrawFeeSubsidy)(nil), "dymensionxyz.dymension.eibc.MsgWithdrawFeeSubsidy")
+	proto.RegisterType((*MsgWithdrawFeeSubsidyResponse)(nil), "dymensionxyz.dymension.eibc.MsgWithdrawFeeSubsidyResponse")
+	proto.RegisterType((*MsgSetRollappFeeFloor)(nil), "dymensionxyz.dymension.eibc.MsgSetRollappFeeFloor")
+	proto.RegisterType((*MsgSetRollappFeeFloorResponse)(nil), "dymensionxyz.dymension.eibc.MsgSetRollappFeeFloorResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/tx.proto", fileDescriptor_47537f11f512b254)
+}
+
+var fileDescriptor_47537f11f512b254 = []byte{
+	// 1285 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0xa9, 0x1b, 0xbf, 0xa4, 0xfd, 0x26, 0xdb, 0xb4, 0x71, 0xb6, 0xad, 0xd3, 0xfa,
+	0x5b, 0xa1, 0x88, 0x92, 0x35, 0x49, 0x5b, 0x10, 0x29, 0xa2, 0x22, 0x2d, 0x16, 0xa1, 0x8d, 0x1a,
+	0x6d, 0x41, 0x48, 0x08, 0x64, 0xad, 0xbd, 0xcf, 0xce, 0xaa, 0xde, 0x9d, 0xd5, 0xce, 0xe4, 0x57,
+	0x0f, 0x1c, 0xa8, 0x84, 0x54, 0xa9, 0x07, 0x0e, 0x70, 0xe3, 0x8e, 0x84, 0x38, 0x20, 0x71, 0xe2,
+	0xc0, 0x11, 0xa9, 0xc7, 0x1e, 0x2b, 0x0e, 0x05, 0x35, 0x07, 0xfe, 0x0d, 0x34, 0x3b, 0xe3, 0xf5,
+	0x7a, 0xbd, 0x6b, 0x67, 0xdd, 0x0a, 0x89, 0x93, 0x3d, 0x33, 0xef, 0xf3, 0xde, 0xe7, 0xbd, 0x79,
+	0xef, 0xcd, 0xd3, 0xc2, 0x25, 0xeb, 0xc0, 0x41, 0x97, 0xda, 0xc4, 0xdd, 0x3f, 0x78, 0x50, 0x09,
+	0x17, 0x15, 0xb4, 0xeb, 0x8d, 0x0a, 0xdb, 0xd7, 0x3d, 0x9f, 0x30, 0xa2, 0x9e, 0x8d, 0x4a, 0xe9,
+	0xe1, 0x42, 0xe7, 0x52, 0xda, 0x5c, 0x8b, 0xb4, 0x48, 0x20, 0x57, 0xe1, 0xff, 0x04, 0x44, 0x9b,
+	0x6f, 0x10, 0xea, 0x10, 0x5a, 0x71, 0x68, 0xab, 0xb2, 0xbb, 0xc2, 0x7f, 0xe4, 0xc1, 0xf2, 0x20,
+	0x8b, 0xbe, 0x4d, 0xef, 0xd7, 0xda, 0xb6, 0x63, 0x33, 0x2a, 0xc5, 0x2f, 0x0f, 0x12, 0x6f, 0x22,
+	0xd6, 0x9a, 0x6d, 0x42, 0x7c, 0x29, 0x5c, 0x92, 0x46, 0xeb, 0x26, 0xc5, 0xca, 0xee, 0x4a, 0x1d,
+	0x99, 0xb9, 0x52, 0x69, 0x10, 0xdb, 0x15, 0xe7, 0xe5, 0x6f, 0x15, 0xf8, 0xdf, 0x26, 0x6d, 0x55,
+	0x77, 0xda, 0x4d, 0xbb, 0xdd, 0xbe, 0xeb, 0x5b, 0xe8, 0xab, 0x97, 0x61, 0xb6, 0x29, 0xd6, 0xe8,
+	0xd7, 0x4c, 0xcb, 0xf2, 0x91, 0xd2, 0xa2, 0x72, 0x41, 0x59, 0x2a, 0x18, 0x33, 0xe1, 0xc1, 0xfb,
+	0x62, 0x5f, 0x5d, 0x80, 0x49, 0xc2, 0x51, 0x35, 0xdb, 0x2a, 0x8e, 0x07, 0x32, 0xc7, 0x83, 0xf5,
+	0x86, 0xa5, 0x5e, 0x84, 0x69, 0xdc, 0xf7, 0xb0, 0xc1, 0xd0, 0xaa, 0x35, 0x11, 0x8b, 0xb9, 0xe0,
+	0x78, 0xaa, 0xb3, 0x57, 0x45, 0x5c, 0x3b, 0xf3, 0xd5, 0xdf, 0x3f, 0xbf, 0xde, 0x6f, 0xad, 0xbc,
+	0x00, 0xf3, 0x31, 0x56, 0x06, 0x52, 0x8f, 0xb8, 0x14, 0xcb, 0xbf, 0x2b, 0x30, 0x13, 0x3b, 0xa3,
+	0xd9, 0x28, 0xdf, 0x86, 0x7c, 0x40, 0x91, 0x16, 0xc7, 0x2f, 0xe4, 0x96, 0xa6, 0x56, 0x97, 0xf5,
+	0x01, 0x97, 0xa9, 0x07, 0x16, 0xa4, 0x35, 0x07, 0x5d, 0xb6, 0x3e, 0xf1, 0xe4, 0xf9, 0xe2, 0x98,
+	0x21, 0x55, 0xa8, 0x8b, 0x30, 0x55, 0x47, 0xca, 0x6a, 0xd8, 0x6c, 0x12, 0x9f, 0x05, 0x3e, 0x4e,
+	0x1a, 0xc0, 0xb7, 0x3e, 0x08, 0x76, 0x52, 0x5d, 0xdc, 0x82, 0x99, 0xb8, 0xea, 0x9e, 0x60, 0x2a,
+	0x83, 0x83, 0x39, 0xde, 0x17, 0xcc, 0xf2, 0x47, 0x50, 0x8c, 0x07, 0xa6, 0x13, 0x35, 0x55, 0x87,
+	0x53, 0x1d, 0x0a, 0x56, 0xad, 0x63, 0x83, 0x87, 0x28, 0xb7, 0x54, 0x30, 0x42, 0x76, 0xd6, 0x5d,
+	0x61, 0x8d, 0x96, 0x1f, 0x2a, 0x30, 0xb7, 0x49, 0x5b, 0x9f, 0x78, 0x96, 0xc9, 0xf0, 0x16, 0x3a,
+	0xa6, 0x2b, 0xce, 0xd4, 0xff, 0xc3, 0x09, 0xb2, 0xe7, 0xf6, 0x45, 0x79, 0x3a, 0xd8, 0x3c, 0x42,
+	0x52, 0xcc, 0xc3, 0x71, 0x17, 0xf7, 0x22, 0xf9, 0x90, 0x77, 0x71, 0x8f, 0xa7, 0x82, 0xca, 0xe3,
+	0xd4, 0xab, 0xbb, 0x5c, 0x82, 0x73, 0x49, 0x24, 0xc2, 0x5c, 0xf8, 0x45, 0x81, 0x33, 0x31, 0x97,
+	0xb7, 0x4c, 0x9f, 0xd9, 0x66, 0xfb, 0x5f, 0x4c, 0x62, 0xf5, 0x0c, 0xe4, 0x4d, 0x87, 0xec, 0xb8,
+	0xac, 0x38, 0x21, 0x3c, 0x12, 0xab, 0xd4, 0x9b, 0x7f, 0x0f, 0x4a, 0xc9, 0xa4, 0xc3, 0xdb, 0x3a,
+	0x07, 0x85, 0xf0, 0x4a, 0x02, 0xd2, 0x93, 0x46, 0x77, 0xa3, 0xfc, 0x28, 0x07, 0xda, 0x26, 0x6d,
+	0xdd, 0xf4, 0xd1, 0x64, 0x18, 0x49, 0x9f, 0x0d, 0x97, 0xf1, 0x24, 0xca, 0xe4, 0xf9, 0x79, 0x00,
+	0x9f, 0xb4, 0xdb, 0xa6, 0xe7, 0x75, 0x7d, 0x2f, 0xc8, 0x9d, 0x0d, 0x4b, 0x9d, 0x83, 0x63, 0x16,
+	0xba, 0xc4, 0x91, 0x6e, 0x8b, 0x85, 0xfa, 0x39, 0xa8, 0x8e, 0xed, 0xf2, 0x70, 0xd4, 0x3c, 0xf4,
+	0x1b, 0xe8, 0x32, 0xb3, 0x85, 0xc2, 0xf9, 0x75, 0x9d, 0x57, 0xc7, 0x1f, 0xcf, 0x17, 0x5f, 0x6b,
+	0xd9, 0x6c, 0x7b, 0xa7, 0xae, 0x37, 0x88, 0x53, 0x91, 0x3d, 0x48, 0xfc, 0x2c, 0x53, 0xeb, 0x7e,
+	0x85, 0x1d, 0x78, 0x48, 0xf5, 0x5b, 0xd8, 0x30, 0x66, 0x1c, 0xdb, 0xad, 0x22, 0x6e, 0x85, 0x7a,
+	0xd4, 0xdb, 0x50, 0x70, 0xcc, 0xfd, 0x9a, 0xe7, 0xdb, 0x0d, 0x2c, 0x1e, 0xcb, 0xac, 0x74, 0xc3,
+	0x65, 0xc6, 0xa4, 0x63, 0xee, 0x6f, 0x71, 0xbc, 0x5a, 0x85, 0x7c, 0x7d, 0xc7, 0x6a, 0x21, 0x2b,
+	0xe6, 0x47, 0xd2, 0x24, 0xd1, 0xa9, 0x77, 0x79, 0x15, 0xca, 0xe9, 0x57, 0x11, 0xde, 0xe7, 0x49,
+	0x18, 0x97, 0x15, 0x3d, 0x61, 0x8c, 0xdb, 0x56, 0xf9, 0x4b, 0x71, 0x81, 0xa6, 0xdb, 0xc0, 0xf6,
+	0x4b, 0x5e, 0xe0, 0x59, 0x28, 0xd8, 0x01, 0xac, 0x73, 0x7f, 0x13, 0xc6, 0xa4, 0xd8, 0xd8, 0xb0,
+	0x52, 0x59, 0x5f, 0x82, 0x72, 0xba, 0xfd, 0xb0, 0xba, 0x1e, 0x2b, 0x41, 0x17, 0xbe, 0x87, 0xcc,
+	0x10, 0x09, 0x61, 0xd8, 0xf4, 0xfe, 0x9d, 0xe0, 0x29, 0xe2, 0x39, 0x4f, 0xed, 0x96, 0x8b, 0xbe,
+	0x24, 0x26, 0x57, 0xea, 0x1d, 0xc8, 0x8b, 0xc7, 0x2a, 0xe0, 0x32, 0xb5, 0xaa, 0x0f, 0xec, 0xad,
+	0x7d, 0x7a, 0x3b, 0xcd, 0x55, 0xe8, 0x58, 0x9b, 0xe2, 0xfc, 0xa5, 0xea, 0xf2, 0x45, 0x58, 0x4c,
+	0x61, 0x13, 0x32, 0xfe, 0x49, 0xf4, 0x83, 0x8f, 0x7d, 0xd3, 0xa5, 0xcd, 0xde, 0xd6, 0xfa, 0xaa,
+	0xfa, 0xc1, 0x2a, 0x9c, 0x0e, 0xfa, 0x57, 0x9f, 0x2e, 0x51, 0x21, 0xa7, 0x78, 0x37, 0x8b, 0xa9,
+	0x4b, 0xbd, 0x86, 0x0b, 0x50, 0x4a, 0x66, 0x1b, 0x3a, 0xf4, 0xfd, 0x78, 0xf0, 0xd8, 0xdd, 0x43,
+	0x56, 0x45, 0xbc, 0xb7, 0x53, 0xa7, 0xb6, 0x75, 0xc0, 0x8b, 0x32, 0x68, 0x93, 0x92, 0xbe, 0x58,
+	0x0c, 0xab, 0xe4, 0x2f, 0x40, 0xa5, 0x02, 0x1f, 0xad, 0xd9, 0xdc, 0x48, 0x35, 0x3b, 0x2b, 0x35,
+	0x45, 0x8a, 0x76, 0x1b, 0x0a, 0xe8, 0x91, 0xc6, 0x76, 0xad, 0x61, 0x7a, 0xc5, 0x89, 0xe0, 0x59,
+	0x5d, 0xd0, 0x05, 0x58, 0xe7, 0xb3, 0x87, 0x2e, 0x67, 0x0f, 0xfd, 0x26, 0xb1, 0xdd, 0xf5, 0x37,
+	0xb9, 0xc1, 0x1f, 0xff, 0x5c, 0x5c, 0x3a, 0x82, 0x41, 0x0e, 0xa0, 0xc6, 0x64, 0xa0, 0xfd, 0xa6,
+	0xe9, 0xad, 0x01, 0x0f, 0xa6, 0xf0, 0xb9, 0xac, 0x41, 0x31, 0x1e, 0x9d, 0x30, 0x74, 0xbf, 0x2a,
+	0x30, 0x1b, 0xb4, 0x59, 0xd7, 0x7a, 0xd9, 0xd8, 0x35, 0xc2, 0x06, 0x9f, 0x7b, 0xf5, 0x9e, 0x75,
+	0x5e, 0x8b, 0xa8, 0x5f, 0x67, 0x61, 0xa1, 0x8f, 0x7a, 0xe8, 0xd8, 0x6f, 0x0a, 0x9c, 0xde, 0xa4,
+	0xad, 0x4f, 0x6d, 0xb6, 0x6d, 0xf9, 0xe6, 0xde, 0x7f, 0xce, 0xb9, 0x45, 0x38, 0x9f, 0x48, 0x3f,
+	0x74, 0xf0, 0x07, 0xe1, 0x60, 0xb7, 0xd2, 0xab, 0x88, 0xd5, 0x36, 0x21, 0x3e, 0x7f, 0x17, 0xcd,
+	0x1d, 0xb6, 0x4d, 0x7c, 0x9b, 0x1d, 0x48, 0x27, 0xbb, 0x1b, 0xea, 0x87, 0x70, 0x2c, 0x18, 0x7d,
+	0x65, 0xeb, 0x79, 0xe3, 0x28, 0xad, 0xa7, 0xa3, 0x5a, 0x36, 0x1e, 0xa1, 0x80, 0x77, 0x37, 0x1f,
+	0x1d, 0xb2, 0x8b, 0x72, 0x9e, 0x93, 0xab, 0xb5, 0x93, 0xdc, 0x8d, 0xae, 0x45, 0xe9, 0x4a, 0x3f,
+	0xd1, 0x8e, 0x2b, 0xab, 0xcf, 0xa6, 0x21, 0xb7, 0x49, 0x5b, 0x2a, 0x83, 0xe9, 0x9e, 0x11, 0x7b,
+	0x30, 0xb7, 0xd8, 0x74, 0xa0, 0x5d, 0xcd, 0x22, 0x1d, 0x86, 0x71, 0x4c, 0xdd, 0x83, 0x13, 0xbd,
+	0x63, 0xf2, 0x72, 0x16, 0x45, 0x54, 0xbb, 0x96, 0x49, 0x3c, 0x62, 0xf8, 0xa1, 0x02, 0xb3, 0xfd,
+	0xa3, 0xe3, 0xca, 0x30, 0x75, 0x7d, 0x10, 0xed, 0x9d, 0xcc, 0x90, 0x08, 0x8b, 0x47, 0x0a, 0x9c,
+	0x4a, 0x1a, 0x0d, 0xaf, 0x64, 0x71, 0x4b, 0x82, 0xb4, 0xeb, 0x23, 0x80, 0x22, 0x5c, 0xbe, 0x53,
+	0x60, 0x3e, 0x6d, 0x60, 0x7b, 0x7b, 0x98, 0xea, 0x14, 0xa0, 0x76, 0x63, 0x44, 0x60, 0x9c, 0x57,
+	0xca, 0x1c, 0x32, 0x9c, 0x57, 0x32, 0x50, 0xbb, 0x31, 0x22, 0x30, 0xc2, 0xeb, 0xb1, 0x02, 0x73,
+	0x89, 0x83, 0xc7, 0xd0, 0x5a, 0x48, 0x42, 0x69, 0xef, 0x8e, 0x82, 0x8a, 0xa5, 0x52, 0xd2, 0x54,
+	0x31, 0x34, 0x95, 0x12, 0x40, 0xda, 0xf5, 0x11, 0x40, 0xbd, 0x55, 0xdd, 0x3b, 0x0f, 0x2c, 0x1f,
+	0xc1, 0xb9, 0xae, 0xb8, 0x76, 0x2d, 0x93, 0x78, 0xc4, 0xf0, 0x03, 0x38, 0x19, 0x7b, 0x4d, 0xf5,
+	0xe1, 0x45, 0x11, 0x95, 0xd7, 0xde, 0xca, 0x26, 0x1f, 0xb1, 0xfd, 0xb5, 0x02, 0x6a, 0xc2, 0x8b,
+	0xb7, 0x3a, 0x4c, 0x61, 0x3f, 0x46, 0x5b, 0xcb, 0x8e, 0x89, 0x11, 0x49, 0x78, 0x99, 0x56, 0x8f,
+	0x9e, 0x60, 0x1d, 0x8c, 0xb6, 0x96, 0x1d, 0xd3, 0x25, 0xb2, 0x7e, 0xfb, 0xc9, 0x8b, 0x92, 0xf2,
+	0xf4, 0x45, 0x49, 0xf9, 0xeb, 0x45, 0x49, 0xf9, 0xe6, 0xb0, 0x34, 0xf6, 0xf4, 0xb0, 0x34, 0xf6,
+	0xec, 0xb0, 0x34, 0xf6, 0xd9, 0x4a, 0xe4, 0x79, 0x4e, 0xf9, 0x56, 0xb4, 0x7b, 0xa5, 0xb2, 0x2f,
+	0xbf, 0x68, 0xf1, 0xd7, 0xba, 0x9e, 0x0f, 0xbe, 0x06, 0x5d, 0xf9, 0x67, 0x00, 0x71, 0xd1, 0xf7,
+	0x80, 0xfd, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error)
+	FulfillOrders(ctx context.Context, in *MsgFulfillOrders, opts ...grpc.CallOption) (*MsgFulfillOrdersResponse, error)
+	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
+	FulfillOrderPartial(ctx context.Context, in *MsgFulfillOrderPartial, opts ...grpc.CallOption) (*MsgFulfillOrderPartialResponse, error)
+	CreateFulfillmentIntent(ctx context.Context, in *MsgCreateFulfillmentIntent, opts ...grpc.CallOption) (*MsgCreateFulfillmentIntentResponse, error)
+	CancelFulfillmentIntent(ctx context.Context, in *MsgCancelFulfillmentIntent, opts ...grpc.CallOption) (*MsgCancelFulfillmentIntentResponse, error)
+	SetRollappRiskLimits(ctx context.Context, in *MsgSetRollappRiskLimits, opts ...grpc.CallOption) (*MsgSetRollappRiskLimitsResponse, error)
+	TransferFulfillment(ctx context.Context, in *MsgTransferFulfillment, opts ...grpc.CallOption) (*MsgTransferFulfillmentResponse, error)
+	SetFeeSubsidy(ctx context.Context, in *MsgSetFeeSubsidy, opts ...grpc.CallOption) (*MsgSetFeeSubsidyResponse, error)
+	FundFeeSubsidy(ctx context.Context, in *MsgFundFeeSubsidy, opts ...grpc.CallOption) (*MsgFundFeeSubsidyResponse, error)
+	WithdrawFeeSubsidy(ctx context.Context, in *MsgWithdrawFeeSubsidy, opts ...grpc.CallOption) (*MsgWithdrawFeeSubsidyResponse, error)
+	SetRollappFeeFloor(ctx context.Context, in *MsgSetRollappFeeFloor, opts ...grpc.CallOption) (*MsgSetRollappFeeFloorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error) {
+	out := new(MsgFulfillOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FulfillOrders(ctx context.Context, in *MsgFulfillOrders, opts ...grpc.CallOption) (*MsgFulfillOrdersResponse, error) {
+	out := new(MsgFulfillOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error) {
+	out := new(MsgUpdateDemandOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FulfillOrderPartial(ctx context.Context, in *MsgFulfillOrderPartial, opts ...grpc.CallOption) (*MsgFulfillOrderPartialResponse, error) {
+	out := new(MsgFulfillOrderPartialResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderPartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateFulfillmentIntent(ctx context.Context, in *MsgCreateFulfillmentIntent, opts ...grpc.CallOption) (*MsgCreateFulfillmentIntentResponse, error) {
+	out := new(MsgCreateFulfillmentIntentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CreateFulfillmentIntent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelFulfillmentIntent(ctx context.Context, in *MsgCancelFulfillmentIntent, opts ...grpc.CallOption) (*MsgCancelFulfillmentIntentResponse, error) {
+	out := new(MsgCancelFulfillmentIntentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CancelFulfillmentIntent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRollappRiskLimits(ctx context.Context, in *MsgSetRollappRiskLimits, opts ...grpc.CallOption) (*MsgSetRollappRiskLimitsResponse, error) {
+	out := new(MsgSetRollappRiskLimitsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/SetRollappRiskLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferFulfillment(ctx context.Context, in *MsgTransferFulfillment, opts ...grpc.CallOption) (*MsgTransferFulfillmentResponse, error) {
+	out := new(MsgTransferFulfillmentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/TransferFulfillment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeSubsidy(ctx context.Context, in *MsgSetFeeSubsidy, opts ...grpc.CallOption) (*MsgSetFeeSubsidyResponse, error) {
+	out := new(MsgSetFeeSubsidyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/SetFeeSubsidy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundFeeSubsidy(ctx context.Context, in *MsgFundFeeSubsidy, opts ...grpc.CallOption) (*MsgFundFeeSubsidyResponse, error) {
+	out := new(MsgFundFeeSubsidyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FundFeeSubsidy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFeeSubsidy(ctx context.Context, in *MsgWithdrawFeeSubsidy, opts ...grpc.CallOption) (*MsgWithdrawFeeSubsidyResponse, error) {
+	out := new(MsgWithdrawFeeSubsidyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/WithdrawFeeSubsidy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRollappFeeFloor(ctx context.Context, in *MsgSetRollappFeeFloor, opts ...grpc.CallOption) (*MsgSetRollappFeeFloorResponse, error) {
+	out := new(MsgSetRollappFeeFloorResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/SetRollappFeeFloor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	FulfillOrder(context.Context, *MsgFulfillOrder) (*MsgFulfillOrderResponse, error)
+	FulfillOrders(context.Context, *MsgFulfillOrders) (*MsgFulfillOrdersResponse, error)
+	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
+	FulfillOrderPartial(context.Context, *MsgFulfillOrderPartial) (*MsgFulfillOrderPartialResponse, error)
+	CreateFulfillmentIntent(context.Context, *MsgCreateFulfillmentIntent) (*MsgCreateFulfillmentIntentResponse, error)
+	CancelFulfillmentIntent(context.Context, *MsgCancelFulfillmentIntent) (*MsgCancelFulfillmentIntentResponse, error)
+	SetRollappRiskLimits(context.Context, *MsgSetRollappRiskLimits) (*MsgSetRollappRiskLimitsResponse, error)
+	TransferFulfillment(context.Context, *MsgTransferFulfillment) (*MsgTransferFulfillmentResponse, error)
+	SetFeeSubsidy(context.Context, *MsgSetFeeSubsidy) (*MsgSetFeeSubsidyResponse, error)
+	FundFeeSubsidy(context.Context, *MsgFundFeeSubsidy) (*MsgFundFeeSubsidyResponse, error)
+	WithdrawFeeSubsidy(context.Context, *MsgWithdrawFeeSubsidy) (*MsgWithdrawFeeSubsidyResponse, error)
+	SetRollappFeeFloor(context.Context, *MsgSetRollappFeeFloor) (*MsgSetRollappFeeFloorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) FulfillOrder(ctx context.Context, req *MsgFulfillOrder) (*MsgFulfillOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
+}
+func (*UnimplementedMsgServer) FulfillOrders(ctx context.Context, req *MsgFulfillOrders) (*MsgFulfillOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrders not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDemandOrder(ctx context.Context, req *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDemandOrder not implemented")
+}
+func (*UnimplementedMsgServer) FulfillOrderPartial(ctx context.Context, req *MsgFulfillOrderPartial) (*MsgFulfillOrderPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderPartial not implemented")
+}
+func (*UnimplementedMsgServer) CreateFulfillmentIntent(ctx context.Context, req *MsgCreateFulfillmentIntent) (*MsgCreateFulfillmentIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFulfillmentIntent not implemented")
+}
+func (*UnimplementedMsgServer) CancelFulfillmentIntent(ctx context.Context, req *MsgCancelFulfillmentIntent) (*MsgCancelFulfillmentIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFulfillmentIntent not implemented")
+}
+func (*UnimplementedMsgServer) SetRollappRiskLimits(ctx context.Context, req *MsgSetRollappRiskLimits) (*MsgSetRollappRiskLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRollappRiskLimits not implemented")
+}
+func (*UnimplementedMsgServer) TransferFulfillment(ctx context.Context, req *MsgTransferFulfillment) (*MsgTransferFulfillmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFulfillment not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeSubsidy(ctx context.Context, req *MsgSetFeeSubsidy) (*MsgSetFeeSubsidyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSubsidy not implemented")
+}
+func (*UnimplementedMsgServer) FundFeeSubsidy(ctx context.Context, req *MsgFundFeeSubsidy) (*MsgFundFeeSubsidyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundFeeSubsidy not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFeeSubsidy(ctx context.Context, req *MsgWithdrawFeeSubsidy) (*MsgWithdrawFeeSubsidyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeeSubsidy not implemented")
+}
+func (*UnimplementedMsgServer) SetRollappFeeFloor(ctx context.Context, req *MsgSetRollappFeeFloor) (*MsgSetRollappFeeFloorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRollappFeeFloor not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrder(ctx, req.(*MsgFulfillOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FulfillOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrders(ctx, req.(*MsgFulfillOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDemandOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDemandOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDemandOrder(ctx, req.(*MsgUpdateDemandOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FulfillOrderPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrderPartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrderPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderPartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrderPartial(ctx, req.(*MsgFulfillOrderPartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateFulfillmentIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFulfillmentIntent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFulfillmentIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/CreateFulfillmentIntent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFulfillmentIntent(ctx, req.(*MsgCreateFulfillmentIntent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelFulfillmentIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelFulfillmentIntent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelFulfillmentIntent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/CancelFulfillmentIntent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelFulfillmentIntent(ctx, req.(*MsgCancelFulfillmentIntent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRollappRiskLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRollappRiskLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRollappRiskLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/SetRollappRiskLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRollappRiskLimits(ctx, req.(*MsgSetRollappRiskLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferFulfillment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferFulfillment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferFulfillment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/TransferFulfillment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferFulfillment(ctx, req.(*MsgTransferFulfillment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeSubsidy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSubsidy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSubsidy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/SetFeeSubsidy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSubsidy(ctx, req.(*MsgSetFeeSubsidy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundFeeSubsidy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundFeeSubsidy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundFeeSubsidy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FundFeeSubsidy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundFeeSubsidy(ctx, req.(*MsgFundFeeSubsidy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFeeSubsidy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeeSubsidy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeeSubsidy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/WithdrawFeeSubsidy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeeSubsidy(ctx, req.(*MsgWithdrawFeeSubsidy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRollappFeeFloor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRollappFeeFloor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRollappFeeFloor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/SetRollappFeeFloor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRollappFeeFloor(ctx, req.(*MsgSetRollappFeeFloor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.eibc.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FulfillOrder",
+			Handler:    _Msg_FulfillOrder_Handler,
+		},
+		{
+			MethodName: "FulfillOrders",
+			Handler:    _Msg_FulfillOrders_Handler,
+		},
+		{
+			MethodName: "UpdateDemandOrder",
+			Handler:    _Msg_UpdateDemandOrder_Handler,
+		},
+		{
+			MethodName: "FulfillOrderPartial",
+			Handler:    _Msg_FulfillOrderPartial_Handler,
+		},
+		{
+			MethodName: "CreateFulfillmentIntent",
+			Handler:    _Msg_CreateFulfillmentIntent_Handler,
+		},
+		{
+			MethodName: "CancelFulfillmentIntent",
+			Handler:    _Msg_CancelFulfillmentIntent_Handler,
+		},
+		{
+			MethodName: "SetRollappRiskLimits",
+			Handler:    _Msg_SetRollappRiskLimits_Handler,
+		},
+		{
+			MethodName: "TransferFulfillment",
+			Handler:    _Msg_TransferFulfillment_Handler,
+		},
+		{
+			MethodName: "SetFeeSubsidy",
+			Handler:    _Msg_SetFeeSubsidy_Handler,
+		},
+		{
+			MethodName: "FundFeeSubsidy",
+			Handler:    _Msg_FundFeeSubsidy_Handler,
+		},
+		{
+			MethodName: "WithdrawFeeSubsidy",
+			Handler:    _Msg_WithdrawFeeSubsidy_Handler,
+		},
+		{
+			MethodName: "SetRollappFeeFloor",
+			Handler:    _Msg_SetRollappFeeFloor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/eibc/tx.proto",
+}
+
+func (m *MsgFulfillOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BestEffort {
+		i--
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderFulfillment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderFulfillment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderFulfillment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FulfilledOrderIds) > 0 {
+		for iNdEx := len(m.FulfilledOrderIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FulfilledOrderIds[iNdEx])
+			copy(dAtA[i:], m.FulfilledOrderIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.FulfilledOrderIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDemandOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDemandOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFee) > 0 {
+		i -= len(m.NewFee)
+		copy(dAtA[i:], m.NewFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDemandOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderPartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderPartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderPartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fulfilled {
+		i--
+		if m.Fulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFulfillmentIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFulfillmentIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFulfillmentIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Budget.Size()
+		i -= size
+		if _, err := m.Budget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxPrice.Size()
+		i -= size
+		if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinFeePercentage.Size()
+		i -= size
+		if _, err := m.MinFeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFulfillmentIntentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFulfillmentIntentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFulfillmentIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFulfillmentIntent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFulfillmentIntent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFulfillmentIntent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IntentId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntentId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFulfillmentIntentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFulfillmentIntentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFulfillmentIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRollappRiskLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRollappRiskLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRollappRiskLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRollappRiskLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRollappRiskLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRollappRiskLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferFulfillment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferFulfillment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferFulfillment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFulfillerAddress) > 0 {
+		i -= len(m.NewFulfillerAddress)
+		copy(dAtA[i:], m.NewFulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFulfillerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferFulfillmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferFulfillmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferFulfillmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeSubsidy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeSubsidy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSubsidy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochCap) > 0 {
+		for iNdEx := len(m.EpochCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.SubsidyPercentage.Size()
+		i -= size
+		if _, err := m.SubsidyPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeSubsidyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeSubsidyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSubsidyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFeeSubsidy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFeeSubsidy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFeeSubsidy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFeeSubsidyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFeeSubsidyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFeeSubsidyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFeeSubsidy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeeSubsidy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeeSubsidy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFeeSubsidyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeeSubsidyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeeSubsidyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRollappFeeFloor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRollappFeeFloor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRollappFeeFloor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Floor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRollappFeeFloorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRollappFeeFloorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRollappFeeFloorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFulfillOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfillOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFulfillOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	return n
+}
+
+func (m *OrderFulfillment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfillOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FulfilledOrderIds) > 0 {
+		for _, s := range m.FulfilledOrderIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *MsgUpdateDemandOrder) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFulfillOrderPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfillOrderPartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fulfilled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateFulfillmentIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinFeePercentage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Budget.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateFulfillmentIntentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelFulfillmentIntent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IntentId != 0 {
+		n += 1 + sovTx(uint64(m.IntentId))
+	}
+	return n
+}
+
+func (m *MsgCancelFulfillmentIntentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRollappRiskLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Limits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRollappRiskLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferFulfillment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferFulfillmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeSubsidy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SubsidyPercentage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.EpochCap) > 0 {
+		for _, e := range m.EpochCap {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetFeeSubsidyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundFeeSubsidy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundFeeSubsidyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFeeSubsidy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawFeeSubsidyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRollappFeeFloor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Floor.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetRollappFeeFloorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, OrderFulfillment{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BestEffort = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderFulfillment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderFulfillment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderFulfillment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfilledOrderIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfilledOrderIds = append(m.FulfilledOrderIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderPartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderPartialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fulfilled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFulfillmentIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFulfillmentIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFulfillmentIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFulfillmentIntentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFulfillmentIntentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFulfillmentIntentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFulfillmentIntent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFulfillmentIntent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFulfillmentIntent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntentId", wireType)
+			}
+			m.IntentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFulfillmentIntentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFulfillmentIntentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFulfillmentIntentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRollappRiskLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRollappRiskLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRollappRiskLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRollappRiskLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRollappRiskLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRollappRiskLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferFulfillment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferFulfillment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferFulfillment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgTransferFulfillmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferFulfillmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferFulfillmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.NewFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeSubsidy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeSubsidy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeSubsidy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubsidyPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubsidyPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochCap = append(m.EpochCap, types.Coin{})
+			if err := m.EpochCap[len(m.EpochCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateDemandOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgSetFeeSubsidyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeSubsidyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeSubsidyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgFundFeeSubsidy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -618,15 +5330,15 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundFeeSubsidy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundFeeSubsidy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -654,11 +5366,11 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -686,13 +5398,13 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -702,23 +5414,25 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -741,7 +5455,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundFeeSubsidyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -764,10 +5478,10 @@ func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundFeeSubsidyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundFeeSubsidyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -791,7 +5505,7 @@ func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFeeSubsidy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -814,15 +5528,15 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFeeSubsidy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFeeSubsidy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -850,11 +5564,11 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -882,11 +5596,145 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFeeSubsidyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFeeSubsidyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFeeSubsidyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRollappFeeFloor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRollappFeeFloor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRollappFeeFloor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -914,8 +5762,61 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFee = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Floor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -937,7 +5838,7 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRollappFeeFloorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -960,10 +5861,10 @@ func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRollappFeeFloorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRollappFeeFloorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -46,6 +47,34 @@ func (k Keeper) SetCanonicalClient(ctx sdk.Context, rollappId string, clientID s
 	// TODO: event and log
 }
 
+// RemoveCanonicalClient removes the canonical client association of the rollapp,
+// together with the block valHashes recorded for the client.
+func (k Keeper) RemoveCanonicalClient(ctx sdk.Context, rollappId string) {
+	clientID, found := k.GetCanonicalClient(ctx, rollappId)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRollappClientKey(rollappId))
+	store.Delete(types.CanonicalClientKey(clientID))
+
+	k.RemoveConsensusStateValHashes(ctx, clientID)
+}
+
+// RemoveConsensusStateValHashes removes all the block valHashes recorded for the client
+func (k Keeper) RemoveConsensusStateValHashes(ctx sdk.Context, clientID string) {
+	valHashStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConsensusStateValhashPrefixByClientID(clientID))
+	iterator := valHashStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close() // nolint: errcheck
+	for _, key := range keys {
+		valHashStore.Delete(key)
+	}
+}
+
 func (k Keeper) GetAllCanonicalClients(ctx sdk.Context) (clients []types.CanonicalClient) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.RollappClientKey)
@@ -73,6 +73,22 @@ func (hook rollappHook) AfterUpdateState(
 	return nil
 }
 
+// RollappRecovered is called after a frozen rollapp is recovered.
+// If the rollapp has a canonical channel, its client stays canonical, as it was restored by the governance,
+// but the block valHashes recorded from the reverted headers are dropped.
+// Otherwise, the canonical client is reset, so a new one is picked with the next state update.
+func (hook rollappHook) RollappRecovered(ctx sdk.Context, rollappId string) error {
+	rollapp, found := hook.k.rollappKeeper.GetRollapp(ctx, rollappId)
+	if found && rollapp.ChannelId != "" {
+		if clientID, ok := hook.k.GetCanonicalClient(ctx, rollappId); ok {
+			hook.k.RemoveConsensusStateValHashes(ctx, clientID)
+			return nil
+		}
+	}
+	hook.k.RemoveCanonicalClient(ctx, rollappId)
+	return nil
+}
+
 func (hook rollappHook) checkStateForHeight(ctx sdk.Context, rollappId string, bd rollapptypes.BlockDescriptor, canonicalClient string, sequencerPk tmprotocrypto.PublicKey, blockValHash []byte) error {
 	cs, _ := hook.k.ibcClientKeeper.GetClientState(ctx, canonicalClient)
 	height := ibcclienttypes.NewHeight(cs.GetLatestHeight().GetRevisionNumber(), bd.GetHeight())
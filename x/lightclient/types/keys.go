@@ -31,10 +31,15 @@ func GetRollappClientKey(rollappId string) []byte {
 }
 
 func ConsensusStateValhashKeyByClientID(clientID string, height uint64) []byte {
+	key := ConsensusStateValhashPrefixByClientID(clientID)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+	return key
+}
+
+func ConsensusStateValhashPrefixByClientID(clientID string) []byte {
 	key := ConsensusStateValhashKey
 	key = append(key, []byte(clientID)...)
 	key = append(key, keySeparator...)
-	key = append(key, sdk.Uint64ToBigEndian(height)...)
 	return key
 }
 
@@ -11,6 +11,7 @@ const (
 	FlagInitialSupply   = "initial-supply"
 	FlagMetadata        = "metadata"
 	FlagBech32Prefix    = "bech32-prefix"
+	FlagDisputePeriod   = "dispute-period-in-blocks"
 )
 
 // FlagSetUpdateRollapp returns flags for updating rollapps.
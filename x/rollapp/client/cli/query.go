@@ -24,8 +24,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListRollapp())
 	cmd.AddCommand(CmdShowRollapp())
 	cmd.AddCommand(CmdShowStateInfo())
+	cmd.AddCommand(CmdShowStateInfoByTime())
 	cmd.AddCommand(CmdShowLatestHeight())
 	cmd.AddCommand(CmdShowLatestStateIndex())
+	cmd.AddCommand(CmdQueryVulnerableDRSVersions())
 
 	return cmd
 }
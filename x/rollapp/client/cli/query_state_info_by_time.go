@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+const FlagHubTime = "hub-time"
+
+func CmdShowStateInfoByTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "state-by-time [rollapp-id] [timestamp]",
+		Short:   "Query the state and the rollapp block current at the specified time: a rollapp block timestamp, or a hub time if the hub-time flag is set.",
+		Example: "dymd query rollapp state-by-time ROLLAPP_CHAIN_ID 2024-10-01T12:00:00Z --hub-time",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId := args[0]
+
+			argTimestamp, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("timestamp must be in RFC3339 format: %w", err)
+			}
+
+			argHubTime, err := cmd.Flags().GetBool(FlagHubTime)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StateInfoByTime(cmd.Context(), &types.QueryStateInfoByTimeRequest{
+				RollappId: argRollappId,
+				Timestamp: argTimestamp,
+				HubTime:   argHubTime,
+			})
+			if err != nil {
+				return fmt.Errorf("state info by time: %w", err)
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagHubTime, false, "Indicates whether the timestamp is a hub time rather than a rollapp block timestamp")
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -26,6 +26,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddApp())
 	cmd.AddCommand(CmdUpdateApp())
 	cmd.AddCommand(CmdRemoveApp())
+	cmd.AddCommand(CmdSubmitFraudProof())
+	cmd.AddCommand(CmdForkRollapp())
+	cmd.AddCommand(CmdRegisterMaintenanceWindow())
 
 	return cmd
 }
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdForkRollapp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fork-rollapp [rollapp-id] [fork-height] [drs-version]",
+		Short:   "Hard-fork a rollapp frozen for running a vulnerable DRS version",
+		Example: "dymd tx rollapp fork-rollapp ROLLAPP_CHAIN_ID 1000 <drs_version>",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId, argDrsVersion := args[0], args[2]
+
+			argForkHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgForkRollapp{
+				Owner:      clientCtx.GetFromAddress().String(),
+				RollappId:  argRollappId,
+				ForkHeight: argForkHeight,
+				DrsVersion: argDrsVersion,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
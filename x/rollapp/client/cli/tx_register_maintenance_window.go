@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdRegisterMaintenanceWindow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-maintenance-window [rollapp-id] [start-height] [num-blocks]",
+		Short:   "Register a maintenance window during which the rollapp liveness events are postponed",
+		Example: "dymd tx rollapp register-maintenance-window ROLLAPP_CHAIN_ID 1000 600",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId := args[0]
+
+			argStartHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argNumBlocks, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterMaintenanceWindow{
+				Creator:     clientCtx.GetFromAddress().String(),
+				RollappId:   argRollappId,
+				StartHeight: argStartHeight,
+				NumBlocks:   argNumBlocks,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdSubmitFraudProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-fraud-proof [rollapp-id] [ibc-client-id] [signed-header-file]",
+		Short: "Submit a rollapp header signed by the sequencer that conflicts with its state update",
+		Long: `Submit a rollapp header signed by the sequencer that conflicts with its state update.
+The signed header file is the 'signed_header' field of the rollapp RPC /commit response for the disputed height.`,
+		Example: "dymd tx rollapp submit-fraud-proof ROLLAPP_CHAIN_ID 07-tendermint-0 signed_header.json",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// nolint:gofumpt
+			argRollappId, argClientId, argSignedHeaderFile := args[0], args[1], args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(argSignedHeaderFile)
+			if err != nil {
+				return fmt.Errorf("read signed header file: %w", err)
+			}
+			var signedHeader cmttypes.SignedHeader
+			if err = cmtjson.Unmarshal(bz, &signedHeader); err != nil {
+				return fmt.Errorf("unmarshal signed header: %w", err)
+			}
+
+			msg := &types.MsgSubmitFraudProof{
+				Submitter:    clientCtx.GetFromAddress().String(),
+				RollappId:    argRollappId,
+				IbcClientId:  argClientId,
+				SignedHeader: signedHeader.ToProto(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -33,6 +33,11 @@ func CmdUpdateRollapp() *cobra.Command {
 				return
 			}
 
+			disputePeriod, err := cmd.Flags().GetUint64(FlagDisputePeriod)
+			if err != nil {
+				return
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return
@@ -45,12 +50,14 @@ func CmdUpdateRollapp() *cobra.Command {
 				metadata,
 				genesisInfo,
 			)
+			msg.DisputePeriodInBlocks = disputePeriod
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().AddFlagSet(FlagSetUpdateRollapp())
+	cmd.Flags().Uint64(FlagDisputePeriod, 0, "The dispute period of the rollapp state updates, in hub blocks")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -29,6 +29,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.BlockHeightToFinalizationQueueList {
 		k.SetBlockHeightToFinalizationQueue(ctx, elem)
 	}
+	// Set all the timeToFinalizationQueue
+	for _, elem := range genState.TimeToFinalizationQueueList {
+		k.SetTimeToFinalizationQueue(ctx, elem)
+	}
 	for _, elem := range genState.LivenessEvents {
 		k.PutLivenessEvent(ctx, elem)
 	}
@@ -50,6 +54,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.LatestStateInfoIndexList = k.GetAllLatestStateInfoIndex(ctx)
 	genesis.LatestFinalizedStateIndexList = k.GetAllLatestFinalizedStateIndex(ctx)
 	genesis.BlockHeightToFinalizationQueueList = k.GetAllBlockHeightToFinalizationQueue(ctx)
+	genesis.TimeToFinalizationQueueList = k.GetAllTimeToFinalizationQueue(ctx)
 	genesis.LivenessEvents = k.GetLivenessEvents(ctx, nil)
 	apps := k.GetRollappApps(ctx, "")
 	var appList []types.App
@@ -62,9 +62,11 @@ func TestInitExportGenesis(t *testing.T) {
 		BlockHeightToFinalizationQueueList: []types.BlockHeightToFinalizationQueue{
 			{
 				CreationHeight: 0,
+				RollappId:      rollappID1,
 			},
 			{
 				CreationHeight: 1,
+				RollappId:      rollappID2,
 			},
 		},
 		AppList: []types.App{
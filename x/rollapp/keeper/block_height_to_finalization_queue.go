@@ -13,15 +13,18 @@ import (
 )
 
 // FinalizeRollappStates is called every block to finalize states when their dispute period over.
+// States whose block dispute period is over but whose time dispute period is not are moved to the
+// time finalization queue, and are finalized from there once their time comes.
 func (k Keeper) FinalizeRollappStates(ctx sdk.Context) {
-	if uint64(ctx.BlockHeight()) < k.DisputePeriodInBlocks(ctx) {
-		return
+	if uint64(ctx.BlockHeight()) >= k.DisputePeriodInBlocks(ctx) {
+		// check to see if there are pending  states to be finalized
+		finalizationHeight := uint64(ctx.BlockHeight() - int64(k.DisputePeriodInBlocks(ctx)))
+		pendingFinalizationQueue := k.GetAllFinalizationQueueUntilHeightInclusive(ctx, finalizationHeight)
+
+		k.FinalizeAllPending(ctx, pendingFinalizationQueue)
 	}
-	// check to see if there are pending  states to be finalized
-	finalizationHeight := uint64(ctx.BlockHeight() - int64(k.DisputePeriodInBlocks(ctx)))
-	pendingFinalizationQueue := k.GetAllFinalizationQueueUntilHeightInclusive(ctx, finalizationHeight)
 
-	k.FinalizeAllPending(ctx, pendingFinalizationQueue)
+	k.FinalizeAllPendingByTime(ctx, k.GetAllFinalizationQueueUntilTimeInclusive(ctx, ctx.BlockTime()))
 }
 
 func (k Keeper) FinalizeAllPending(ctx sdk.Context, pendingFinalizationQueue []types.BlockHeightToFinalizationQueue) {
@@ -42,6 +45,14 @@ func (k Keeper) finalizeQueueForHeight(ctx sdk.Context, queue types.BlockHeightT
 			// skip all subsequent state changes for this rollapp
 			continue
 		}
+		if k.deferToFinalizationHeight(ctx, stateInfoIndex) {
+			// the state has a longer dispute period than the current param, it's now waiting at a later height
+			continue
+		}
+		if k.deferToTimeQueue(ctx, stateInfoIndex) {
+			// the state is not ready to be finalized yet, it's now waiting in the time queue
+			continue
+		}
 		if err := k.finalizeStateForIndex(ctx, stateInfoIndex); err != nil {
 			// record the rollapp that had a failed state change at current height
 			failedRollapps[stateInfoIndex.RollappId] = stateInfoIndex.Index
@@ -94,11 +105,6 @@ func (k *Keeper) finalizePendingState(ctx sdk.Context, stateInfoIndex types.Stat
 }
 
 func (k Keeper) updateQueueForHeight(ctx sdk.Context, blockHeightToFinalizationQueue types.BlockHeightToFinalizationQueue, failedRollapps map[string]uint64) {
-	// remove the blockHeightToFinalizationQueue if all the rollapps' states are finalized
-	if len(failedRollapps) == 0 {
-		k.RemoveBlockHeightToFinalizationQueue(ctx, blockHeightToFinalizationQueue.CreationHeight)
-		return
-	}
 	// remove from the queue only the rollapps that were successfully finalized at all indices.
 	// while iterating the queue for deleting the successfully finalized states, we remove them if
 	// - rollapp was not found in the failedRollapps map
@@ -108,28 +114,98 @@ func (k Keeper) updateQueueForHeight(ctx sdk.Context, blockHeightToFinalizationQ
 			idx, failed := failedRollapps[si.RollappId]
 			return !failed || si.Index < idx
 		})
+	// remove the blockHeightToFinalizationQueue if all the rollapp's states are finalized
+	if len(blockHeightToFinalizationQueue.FinalizationQueue) == 0 {
+		k.RemoveBlockHeightToFinalizationQueue(ctx, blockHeightToFinalizationQueue.CreationHeight, blockHeightToFinalizationQueue.RollappId)
+		return
+	}
 	// save the current queue with "leftover" rollapp's state changes
 	k.SetBlockHeightToFinalizationQueue(ctx, blockHeightToFinalizationQueue)
 }
 
+// RollappDisputePeriodInBlocks returns the dispute period of the rollapp: the rollapp override if set,
+// the DisputePeriodInBlocks param otherwise. It applies to the states created from now on.
+func (k Keeper) RollappDisputePeriodInBlocks(ctx sdk.Context, rollappId string) uint64 {
+	if rollapp, found := k.GetRollapp(ctx, rollappId); found && rollapp.DisputePeriodInBlocks > 0 {
+		return rollapp.DisputePeriodInBlocks
+	}
+	return k.DisputePeriodInBlocks(ctx)
+}
+
+// stateDisputePeriodInBlocks returns the dispute period of the state: the rollapp dispute period
+// at the time the state was created if set, the DisputePeriodInBlocks param otherwise.
+func (k Keeper) stateDisputePeriodInBlocks(ctx sdk.Context, stateInfo types.StateInfo) uint64 {
+	if stateInfo.DisputePeriodInBlocks > 0 {
+		return stateInfo.DisputePeriodInBlocks
+	}
+	return k.DisputePeriodInBlocks(ctx)
+}
+
+// finalizationQueueHeight returns the height of the queue the state is added to. The queues are finalized
+// DisputePeriodInBlocks after their height, so the states of rollapps with a different dispute period
+// are shifted accordingly.
+func (k Keeper) finalizationQueueHeight(ctx sdk.Context, stateInfo types.StateInfo) uint64 {
+	finalizationHeight := stateInfo.CreationHeight + k.stateDisputePeriodInBlocks(ctx, stateInfo)
+	disputePeriod := k.DisputePeriodInBlocks(ctx)
+	if finalizationHeight < disputePeriod {
+		return 0
+	}
+	return finalizationHeight - disputePeriod
+}
+
+// deferToFinalizationHeight moves the state to a later finalization queue if its dispute period is not over yet,
+// which happens if the param was decreased after the state was queued. Returns true if the state was moved.
+func (k Keeper) deferToFinalizationHeight(ctx sdk.Context, stateInfoIndex types.StateInfoIndex) bool {
+	stateInfo, found := k.GetStateInfo(ctx, stateInfoIndex.RollappId, stateInfoIndex.Index)
+	if !found || stateInfo.DisputePeriodInBlocks == 0 {
+		return false
+	}
+	if uint64(ctx.BlockHeight()) >= stateInfo.CreationHeight+stateInfo.DisputePeriodInBlocks {
+		return false
+	}
+	k.AppendToBlockHeightToFinalizationQueue(ctx, k.finalizationQueueHeight(ctx, stateInfo), stateInfoIndex)
+	return true
+}
+
+// AppendToBlockHeightToFinalizationQueue adds the state to the finalization queue of the given height
+func (k Keeper) AppendToBlockHeightToFinalizationQueue(ctx sdk.Context, height uint64, stateInfoIndex types.StateInfoIndex) {
+	queue, found := k.GetBlockHeightToFinalizationQueue(ctx, height, stateInfoIndex.RollappId)
+	if !found {
+		queue = types.BlockHeightToFinalizationQueue{CreationHeight: height, RollappId: stateInfoIndex.RollappId}
+	}
+	queue.FinalizationQueue = append(queue.FinalizationQueue, stateInfoIndex)
+	k.SetBlockHeightToFinalizationQueue(ctx, queue)
+}
+
 // SetBlockHeightToFinalizationQueue set a specific blockHeightToFinalizationQueue in the store from its index
 func (k Keeper) SetBlockHeightToFinalizationQueue(ctx sdk.Context, blockHeightToFinalizationQueue types.BlockHeightToFinalizationQueue) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
 	b := k.cdc.MustMarshal(&blockHeightToFinalizationQueue)
-	store.Set(types.BlockHeightToFinalizationQueueKey(
+	key := types.BlockHeightToFinalizationQueueKey(
+		blockHeightToFinalizationQueue.CreationHeight,
+		blockHeightToFinalizationQueue.RollappId,
+	)
+	store.Set(key, b)
+
+	// index the queue by rollapp, the value is the key of the queue
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueByRollappKeyPrefix))
+	indexStore.Set(types.BlockHeightToFinalizationQueueByRollappKey(
+		blockHeightToFinalizationQueue.RollappId,
 		blockHeightToFinalizationQueue.CreationHeight,
-	), b)
+	), key)
 }
 
 // GetBlockHeightToFinalizationQueue returns a blockHeightToFinalizationQueue from its index
 func (k Keeper) GetBlockHeightToFinalizationQueue(
 	ctx sdk.Context,
 	creationHeight uint64,
+	rollappId string,
 ) (val types.BlockHeightToFinalizationQueue, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
 
 	b := store.Get(types.BlockHeightToFinalizationQueueKey(
 		creationHeight,
+		rollappId,
 	))
 	if b == nil {
 		return val, false
@@ -143,10 +219,18 @@ func (k Keeper) GetBlockHeightToFinalizationQueue(
 func (k Keeper) RemoveBlockHeightToFinalizationQueue(
 	ctx sdk.Context,
 	creationHeight uint64,
+	rollappId string,
 ) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
 	store.Delete(types.BlockHeightToFinalizationQueueKey(
 		creationHeight,
+		rollappId,
+	))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueByRollappKeyPrefix))
+	indexStore.Delete(types.BlockHeightToFinalizationQueueByRollappKey(
+		rollappId,
+		creationHeight,
 	))
 }
 
@@ -161,6 +245,22 @@ func (k Keeper) GetAllBlockHeightToFinalizationQueue(ctx sdk.Context) (list []ty
 	return k.getFinalizationQueue(ctx, nil)
 }
 
+// GetRollappBlockHeightToFinalizationQueue returns all the blockHeightToFinalizationQueues of the rollapp
+func (k Keeper) GetRollappBlockHeightToFinalizationQueue(ctx sdk.Context, rollappId string) (list []types.BlockHeightToFinalizationQueue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueByRollappKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(indexStore, types.BlockHeightToFinalizationQueueByRollappPrefix(rollappId))
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BlockHeightToFinalizationQueue
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
 func (k Keeper) getFinalizationQueue(ctx sdk.Context, endHeightNonInclusive *uint64) (list []types.BlockHeightToFinalizationQueue) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
@@ -177,3 +277,29 @@ func (k Keeper) getFinalizationQueue(ctx sdk.Context, endHeightNonInclusive *uin
 
 	return
 }
+
+// MigrateBlockHeightToFinalizationQueueByRollapp splits the legacy finalization queues, keyed by creation height only,
+// into one queue per rollapp, keyed by creation height and rollapp.
+func (k Keeper) MigrateBlockHeightToFinalizationQueueByRollapp(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var legacyQueues []types.BlockHeightToFinalizationQueue
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != len(types.LegacyBlockHeightToFinalizationQueueKey(0)) {
+			continue
+		}
+		var val types.BlockHeightToFinalizationQueue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		legacyQueues = append(legacyQueues, val)
+	}
+	iterator.Close() // nolint: errcheck
+
+	for _, legacyQueue := range legacyQueues {
+		store.Delete(types.LegacyBlockHeightToFinalizationQueueKey(legacyQueue.CreationHeight))
+		// the states keep their relative order within each rollapp queue
+		for _, stateInfoIndex := range legacyQueue.FinalizationQueue {
+			k.AppendToBlockHeightToFinalizationQueue(ctx, legacyQueue.CreationHeight, stateInfoIndex)
+		}
+	}
+}
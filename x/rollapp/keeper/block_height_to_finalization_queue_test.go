@@ -5,15 +5,18 @@ import (
 	"reflect"
 	"slices"
 	"strconv"
+	"strings"
 	"testing"
 	"unsafe"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
 	"github.com/dymensionxyz/dymension/v3/testutil/nullify"
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -50,6 +53,7 @@ func TestBlockHeightToFinalizationQueueGet(t *testing.T) {
 		item := item
 		rst, found := k.GetBlockHeightToFinalizationQueue(ctx,
 			item.CreationHeight,
+			item.RollappId,
 		)
 		require.True(t, found)
 		require.Equal(t,
@@ -65,11 +69,14 @@ func TestBlockHeightToFinalizationQueueRemove(t *testing.T) {
 	for _, item := range items {
 		k.RemoveBlockHeightToFinalizationQueue(ctx,
 			item.CreationHeight,
+			item.RollappId,
 		)
 		_, found := k.GetBlockHeightToFinalizationQueue(ctx,
 			item.CreationHeight,
+			item.RollappId,
 		)
 		require.False(t, found)
+		require.Empty(t, k.GetRollappBlockHeightToFinalizationQueue(ctx, item.RollappId))
 	}
 }
 
@@ -335,7 +342,7 @@ func (suite *RollappTestSuite) TestFinalizeRollapps() {
 				numFinalized := countFinalized(response)
 				suite.Require().Equalf(be.wantNumFinalized, numFinalized, "finalization %d", i+1)
 
-				heightQueue := suite.App.RollappKeeper.GetAllBlockHeightToFinalizationQueue(*ctx)
+				heightQueue := mergeQueuesByHeight(suite.App.RollappKeeper.GetAllBlockHeightToFinalizationQueue(*ctx))
 				suite.Require().Lenf(heightQueue, len(be.wantQueue), "finalization %d", i+1)
 
 				for i, q := range be.wantQueue {
@@ -395,11 +402,49 @@ func createNBlockHeightToFinalizationQueue(keeper *keeper.Keeper, ctx sdk.Contex
 	items := make([]types.BlockHeightToFinalizationQueue, n)
 	for i := range items {
 		items[i].CreationHeight = uint64(i)
+		items[i].RollappId = strconv.Itoa(i)
 		keeper.SetBlockHeightToFinalizationQueue(ctx, items[i])
 	}
 	return items
 }
 
+// splitQueuesByRollapp splits the queues per rollapp, as they are stored in the finalization queue
+func splitQueuesByRollapp(queues []types.BlockHeightToFinalizationQueue) (split []types.BlockHeightToFinalizationQueue) {
+	for _, q := range queues {
+		var rollappQueues []types.BlockHeightToFinalizationQueue
+		for _, index := range q.FinalizationQueue {
+			i := slices.IndexFunc(rollappQueues, func(rq types.BlockHeightToFinalizationQueue) bool {
+				return rq.RollappId == index.RollappId
+			})
+			if i == -1 {
+				rollappQueues = append(rollappQueues, types.BlockHeightToFinalizationQueue{
+					CreationHeight: q.CreationHeight,
+					RollappId:      index.RollappId,
+				})
+				i = len(rollappQueues) - 1
+			}
+			rollappQueues[i].FinalizationQueue = append(rollappQueues[i].FinalizationQueue, index)
+		}
+		slices.SortStableFunc(rollappQueues, func(a, b types.BlockHeightToFinalizationQueue) int {
+			return strings.Compare(a.RollappId, b.RollappId)
+		})
+		split = append(split, rollappQueues...)
+	}
+	return
+}
+
+// mergeQueuesByHeight merges the queues of all rollapps for each height
+func mergeQueuesByHeight(queues []types.BlockHeightToFinalizationQueue) (merged []types.BlockHeightToFinalizationQueue) {
+	for _, q := range queues {
+		if len(merged) == 0 || merged[len(merged)-1].CreationHeight != q.CreationHeight {
+			merged = append(merged, types.BlockHeightToFinalizationQueue{CreationHeight: q.CreationHeight})
+		}
+		last := &merged[len(merged)-1]
+		last.FinalizationQueue = append(last.FinalizationQueue, q.FinalizationQueue...)
+	}
+	return
+}
+
 func countFinalized(response abci.ResponseEndBlock) int {
 	count := 0
 	for _, event := range response.Events {
@@ -609,10 +654,10 @@ func (suite *RollappTestSuite) TestKeeperFinalizePending() {
 
 			k := suite.App.RollappKeeper
 			k.SetFinalizePendingFn(MockFinalizePending(tt.errFinalizeIndices))
-			k.FinalizeAllPending(suite.Ctx, tt.pendingFinalizationQueue)
+			k.FinalizeAllPending(suite.Ctx, splitQueuesByRollapp(tt.pendingFinalizationQueue))
 
-			finalizationQueue := k.GetAllBlockHeightToFinalizationQueue(suite.Ctx)
-			suite.Require().Equal(tt.expectQueueAfter, finalizationQueue)
+			finalizationQueue := mergeQueuesByHeight(k.GetAllBlockHeightToFinalizationQueue(suite.Ctx))
+			suite.Require().Equal(mergeQueuesByHeight(splitQueuesByRollapp(tt.expectQueueAfter)), finalizationQueue)
 		})
 	}
 }
@@ -654,3 +699,60 @@ func (m mockRollappHooks) AfterStateFinalized(_ sdk.Context, _ string, stateInfo
 	}
 	return
 }
+
+func (suite *RollappTestSuite) TestFinalizeRollappStatesRollappDisputePeriod() {
+	suite.SetupTest()
+	k := suite.App.RollappKeeper
+	k.SetParams(suite.Ctx, k.GetParams(suite.Ctx).WithDisputePeriodBounds(1, 10))
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+
+	rollappId, proposer := suite.CreateDefaultRollappAndProposer()
+	owner := k.MustGetRollapp(suite.Ctx, rollappId).Owner
+	setDisputePeriod := func(period uint64) error {
+		_, err := suite.msgServer.UpdateRollappInformation(suite.Ctx, &types.MsgUpdateRollappInformation{
+			Owner:                 owner,
+			RollappId:             rollappId,
+			DisputePeriodInBlocks: period,
+		})
+		return err
+	}
+	finalizeAt := func(height int64) {
+		suite.Ctx = suite.Ctx.WithBlockHeight(height)
+		k.FinalizeRollappStates(suite.Ctx)
+	}
+	status := func(index uint64) common.Status {
+		return k.MustGetStateInfo(suite.Ctx, rollappId, index).Status
+	}
+
+	// the state created before the change uses the param
+	_, err := suite.PostStateUpdate(suite.Ctx, rollappId, proposer, 1, 10)
+	suite.Require().NoError(err)
+
+	// the dispute period must be within the bounds
+	suite.Require().ErrorIs(setDisputePeriod(11), gerrc.ErrOutOfRange)
+	suite.Require().NoError(setDisputePeriod(5))
+	suite.Require().Equal(uint64(5), k.RollappDisputePeriodInBlocks(suite.Ctx, rollappId))
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(11)
+	_, err = suite.PostStateUpdate(suite.Ctx, rollappId, proposer, 11, 10)
+	suite.Require().NoError(err)
+
+	finalizeAt(12)
+	suite.Require().Equal(common.Status_FINALIZED, status(1))
+	suite.Require().Equal(common.Status_PENDING, status(2))
+	suite.Require().Error(suite.App.DelayedAckKeeper.VerifyHeightFinalized(suite.Ctx, rollappId, 11))
+
+	finalizeAt(15)
+	suite.Require().Equal(common.Status_PENDING, status(2))
+	finalizeAt(16)
+	suite.Require().Equal(common.Status_FINALIZED, status(2))
+	suite.Require().NoError(suite.App.DelayedAckKeeper.VerifyHeightFinalized(suite.Ctx, rollappId, 20))
+
+	// a dispute period shorter than the param
+	suite.Require().NoError(setDisputePeriod(1))
+	_, err = suite.PostStateUpdate(suite.Ctx, rollappId, proposer, 21, 10)
+	suite.Require().NoError(err)
+	finalizeAt(17)
+	suite.Require().Equal(common.Status_FINALIZED, status(3))
+	suite.Require().Empty(k.GetAllBlockHeightToFinalizationQueue(suite.Ctx))
+}
@@ -17,6 +17,12 @@ import (
 
 // HandleFraud handles the fraud evidence submitted by the user.
 func (k Keeper) HandleFraud(ctx sdk.Context, rollappID, clientID string, fraudHeight uint64, seqAddr string) error {
+	return k.handleFraud(ctx, rollappID, clientID, fraudHeight, seqAddr, "")
+}
+
+// handleFraud handles the fraud evidence. The reporter of the fraud, if any, is rewarded out of the slashed bond
+// of the sequencer.
+func (k Keeper) handleFraud(ctx sdk.Context, rollappID, clientID string, fraudHeight uint64, seqAddr, reporter string) error {
 	stateInfo, err := k.FindStateInfoByHeight(ctx, rollappID, fraudHeight)
 	if err != nil {
 		return err
@@ -50,7 +56,7 @@ func (k Keeper) HandleFraud(ctx sdk.Context, rollappID, clientID string, fraudHe
 	}
 
 	// slash the sequencer, clean delayed packets
-	err = k.hooks.FraudSubmitted(ctx, rollappID, fraudHeight, seqAddr)
+	err = k.hooks.FraudSubmitted(ctx, rollappID, fraudHeight, seqAddr, reporter)
 	if err != nil {
 		return err
 	}
@@ -88,29 +94,22 @@ func (k Keeper) freezeClientState(ctx sdk.Context, clientId string) error {
 
 // revert all pending states of a rollapp
 func (k Keeper) RevertPendingStates(ctx sdk.Context, rollappID string) {
-	// TODO (#631): Prefix store by rollappID for efficient querying
-	queuePerHeight := k.GetAllBlockHeightToFinalizationQueue(ctx)
-	for _, queue := range queuePerHeight {
-		leftPendingStates := []types.StateInfoIndex{}
-		for _, stateInfoIndex := range queue.FinalizationQueue {
-			// keep pending packets not related to this rollapp in the queue
-			if stateInfoIndex.RollappId != rollappID {
-				leftPendingStates = append(leftPendingStates, stateInfoIndex)
-				continue
-			}
-
-			stateInfo, _ := k.GetStateInfo(ctx, stateInfoIndex.RollappId, stateInfoIndex.Index)
-			stateInfo.Status = common.Status_REVERTED
-			k.SetStateInfo(ctx, stateInfo)
-		}
-
-		if len(leftPendingStates) == 0 {
-			k.RemoveBlockHeightToFinalizationQueue(ctx, queue.CreationHeight)
-		} else {
-			k.SetBlockHeightToFinalizationQueue(ctx, types.BlockHeightToFinalizationQueue{
-				CreationHeight:    queue.CreationHeight,
-				FinalizationQueue: leftPendingStates,
-			})
-		}
+	for _, queue := range k.GetRollappBlockHeightToFinalizationQueue(ctx, rollappID) {
+		k.revertStates(ctx, queue.FinalizationQueue)
+		k.RemoveBlockHeightToFinalizationQueue(ctx, queue.CreationHeight, rollappID)
+	}
+
+	// revert the states waiting for their time-based dispute period
+	for _, queue := range k.GetRollappTimeToFinalizationQueue(ctx, rollappID) {
+		k.revertStates(ctx, queue.FinalizationQueue)
+		k.RemoveTimeToFinalizationQueue(ctx, queue.FinalizationTime, rollappID)
+	}
+}
+
+func (k Keeper) revertStates(ctx sdk.Context, stateInfoIndexes []types.StateInfoIndex) {
+	for _, stateInfoIndex := range stateInfoIndexes {
+		stateInfo, _ := k.GetStateInfo(ctx, stateInfoIndex.RollappId, stateInfoIndex.Index)
+		stateInfo.Status = common.Status_REVERTED
+		k.SetStateInfo(ctx, stateInfo)
 	}
 }
@@ -182,7 +182,7 @@ func (suite *RollappTestSuite) assertBeforeFraud(rollappId string, height uint64
 
 	// check queue
 	expectedHeight := stateInfo.CreationHeight + suite.App.RollappKeeper.DisputePeriodInBlocks(suite.Ctx)
-	queue, found := suite.App.RollappKeeper.GetBlockHeightToFinalizationQueue(suite.Ctx, expectedHeight)
+	queue, found := suite.App.RollappKeeper.GetBlockHeightToFinalizationQueue(suite.Ctx, expectedHeight, rollappId)
 	suite.Require().True(found)
 
 	found = false
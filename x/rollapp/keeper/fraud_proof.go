@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// verifyFraudProof checks that the signed header was signed by the sequencer who submitted the state update
+// for the same height, and that the header app hash disagrees with the state root of the update.
+// Returns the fraudulent state info.
+func (k Keeper) verifyFraudProof(ctx sdk.Context, rollappID string, signedHeader *cmttypes.SignedHeader) (*types.StateInfo, error) {
+	height := uint64(signedHeader.Height)
+	stateInfo, err := k.FindStateInfoByHeight(ctx, rollappID, height)
+	if err != nil {
+		return nil, err
+	}
+
+	bd, found := stateInfo.GetBlockDescriptor(height)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrStateNotExists, "block descriptor for height %d", height)
+	}
+	if bytes.Equal(signedHeader.AppHash, bd.StateRoot) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFraudProof, "header app hash matches the state root for height %d", height)
+	}
+
+	pubKey, err := k.sequencerKeeper.GetSequencerCometPubKey(ctx, stateInfo.Sequencer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sequencer pubkey")
+	}
+	tmPubKey, err := cryptoenc.PubKeyFromProto(pubKey)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sequencer pubkey from proto")
+	}
+
+	// the sequencer is the only validator of the rollapp
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(tmPubKey, 1)})
+	err = valSet.VerifyCommitLight(signedHeader.ChainID, signedHeader.Commit.BlockID, signedHeader.Height, signedHeader.Commit)
+	if err != nil {
+		return nil, errors.Join(types.ErrInvalidFraudProof, errorsmod.Wrap(err, "header not signed by the sequencer"))
+	}
+
+	return stateInfo, nil
+}
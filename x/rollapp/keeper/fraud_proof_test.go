@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (suite *RollappTestSuite) TestSubmitFraudProof() {
+	suite.SetupTest()
+	ctx := &suite.Ctx
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+
+	rollappId := suite.CreateDefaultRollapp()
+	pv := cmttypes.NewMockPV()
+	cmtPubKey, err := pv.GetPubKey()
+	suite.Require().NoError(err)
+	pubKey, err := cryptocodec.FromTmPubKeyInterface(cmtPubKey)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.CreateSequencerByPubkey(*ctx, rollappId, pubKey))
+	proposer := sdk.AccAddress(pubKey.Address()).String()
+
+	_, err = suite.PostStateUpdate(*ctx, rollappId, proposer, 1, 10)
+	suite.Require().NoError(err)
+
+	submitter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	newMsg := func(height int64, appHash []byte, signer cmttypes.PrivValidator) *types.MsgSubmitFraudProof {
+		return &types.MsgSubmitFraudProof{
+			Submitter:    submitter,
+			RollappId:    rollappId,
+			SignedHeader: suite.signedHeader(rollappId, height, appHash, signer),
+		}
+	}
+
+	// the header agrees with the state update
+	_, err = suite.msgServer.SubmitFraudProof(*ctx, newMsg(5, nil, pv))
+	suite.Require().ErrorIs(err, types.ErrInvalidFraudProof)
+	suite.Require().ErrorContains(err, "matches the state root")
+
+	// the header is not signed by the sequencer
+	_, err = suite.msgServer.SubmitFraudProof(*ctx, newMsg(5, []byte("fraud"), cmttypes.NewMockPV()))
+	suite.Require().ErrorIs(err, types.ErrInvalidFraudProof)
+	suite.Require().ErrorContains(err, "not signed by the sequencer")
+
+	// the header is for a height with no state update
+	_, err = suite.msgServer.SubmitFraudProof(*ctx, newMsg(11, []byte("fraud"), pv))
+	suite.Require().ErrorIs(err, types.ErrStateNotExists)
+
+	// the sequencer reports its own fraud
+	selfReport := newMsg(5, []byte("fraud"), pv)
+	selfReport.Submitter = proposer
+	_, err = suite.msgServer.SubmitFraudProof(*ctx, selfReport)
+	suite.Require().ErrorIs(err, sequencertypes.ErrSelfFraudReport)
+
+	suite.Require().False(suite.App.RollappKeeper.MustGetRollapp(*ctx, rollappId).Frozen)
+
+	// valid fraud proof
+	_, err = suite.msgServer.SubmitFraudProof(*ctx, newMsg(5, []byte("fraud"), pv))
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.App.RollappKeeper.MustGetRollapp(*ctx, rollappId).Frozen)
+	suite.Require().Equal(common.Status_REVERTED, suite.App.RollappKeeper.MustGetStateInfo(*ctx, rollappId, 1).Status)
+
+	seq := suite.App.SequencerKeeper.MustGetSequencer(*ctx, proposer)
+	suite.Require().True(seq.Jailed)
+	suite.Require().True(seq.Tokens.IsZero())
+
+	bond := sequencertypes.DefaultParams().MinBond
+	params := suite.App.SequencerKeeper.GetParams(*ctx)
+	reward := params.SlashDistribution.Reporter.Mul(params.FraudSlashFraction).MulInt(bond.Amount).TruncateInt()
+	suite.Require().True(reward.IsPositive())
+	suite.Require().Equal(reward, suite.App.BankKeeper.GetBalance(*ctx, sdk.MustAccAddressFromBech32(submitter), bond.Denom).Amount)
+}
+
+// signedHeader returns a rollapp header for the given height and app hash, signed by the signer
+func (suite *RollappTestSuite) signedHeader(chainID string, height int64, appHash []byte, signer cmttypes.PrivValidator) *cmtproto.SignedHeader {
+	pubKey, err := signer.GetPubKey()
+	suite.Require().NoError(err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	header := cmttypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            chainID,
+		Height:             height,
+		Time:               time.Now().UTC(),
+		AppHash:            appHash,
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		ProposerAddress:    pubKey.Address(),
+	}
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("part"))},
+	}
+	voteSet := cmttypes.NewVoteSet(chainID, height, 0, cmtproto.PrecommitType, valSet)
+	commit, err := cmttypes.MakeCommit(blockID, height, 0, voteSet, []cmttypes.PrivValidator{signer}, time.Now())
+	suite.Require().NoError(err)
+
+	signedHeader := cmttypes.SignedHeader{Header: &header, Commit: commit}
+	return signedHeader.ToProto()
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) StateInfoByTime(c context.Context, req *types.QueryStateInfoByTimeRequest) (*types.QueryStateInfoByTimeResponse, error) {
+	if req == nil || req.Timestamp.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetRollapp(ctx, req.RollappId); !found {
+		return nil, types.ErrRollappNotRegistered
+	}
+
+	var (
+		stateInfo *types.StateInfo
+		bd        types.BlockDescriptor
+		err       error
+	)
+	if req.HubTime {
+		stateInfo, err = k.FindStateInfoByHubTime(ctx, req.RollappId, req.Timestamp)
+		if err == nil {
+			bd = stateInfo.GetLatestBlockDescriptor()
+		}
+	} else {
+		stateInfo, bd, err = k.FindStateInfoByRollappTime(ctx, req.RollappId, req.Timestamp)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStateInfoByTimeResponse{StateInfo: *stateInfo, BlockDescriptor: bd}, nil
+}
+
+// FindStateInfoByHubTime returns the latest state info of the rollapp created on the hub at or before the given time.
+// States removed after the retention period are skipped.
+func (k Keeper) FindStateInfoByHubTime(ctx sdk.Context, rollappId string, hubTime time.Time) (*types.StateInfo, error) {
+	stateInfoIndex, found := k.GetLatestStateInfoIndex(ctx, rollappId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrNotFound,
+			"LatestStateInfoIndex wasn't found for rollappId=%s",
+			rollappId)
+	}
+
+	// search for the latest state created at or before the given time
+	var result *types.StateInfo
+	startInfoIndex := uint64(1)
+	endInfoIndex := stateInfoIndex.Index
+	for startInfoIndex <= endInfoIndex {
+		midIndex := startInfoIndex + (endInfoIndex-startInfoIndex)/2
+		state, ok := k.GetStateInfo(ctx, rollappId, midIndex)
+		// the old states are removed after the retention period, so the searched one is more recent
+		if !ok || !state.CreatedAt.After(hubTime) {
+			if ok {
+				result = &state
+			}
+			startInfoIndex = midIndex + 1
+		} else {
+			endInfoIndex = midIndex - 1
+		}
+	}
+	if result == nil {
+		return nil, errorsmod.Wrapf(types.ErrStateNotExists, "StateInfo wasn't found for rollappId=%s, hub time=%s", rollappId, hubTime)
+	}
+	return result, nil
+}
+
+// FindStateInfoByRollappTime returns the state info and the descriptor of the latest rollapp block
+// with a timestamp at or before the given time. States removed after the retention period are skipped.
+func (k Keeper) FindStateInfoByRollappTime(ctx sdk.Context, rollappId string, rollappTime time.Time) (*types.StateInfo, types.BlockDescriptor, error) {
+	stateInfoIndex, found := k.GetLatestStateInfoIndex(ctx, rollappId)
+	if !found {
+		return nil, types.BlockDescriptor{}, errorsmod.Wrapf(types.ErrNotFound,
+			"LatestStateInfoIndex wasn't found for rollappId=%s",
+			rollappId)
+	}
+
+	// search for the latest state starting at or before the given time
+	var result *types.StateInfo
+	startInfoIndex := uint64(1)
+	endInfoIndex := stateInfoIndex.Index
+	for startInfoIndex <= endInfoIndex {
+		midIndex := startInfoIndex + (endInfoIndex-startInfoIndex)/2
+		state, ok := k.GetStateInfo(ctx, rollappId, midIndex)
+		// the old states are removed after the retention period, so the searched one is more recent
+		if !ok || !state.BDs.BD[0].Timestamp.After(rollappTime) {
+			if ok {
+				result = &state
+			}
+			startInfoIndex = midIndex + 1
+		} else {
+			endInfoIndex = midIndex - 1
+		}
+	}
+	if result == nil {
+		return nil, types.BlockDescriptor{}, errorsmod.Wrapf(types.ErrStateNotExists,
+			"StateInfo wasn't found for rollappId=%s, rollapp time=%s", rollappId, rollappTime)
+	}
+
+	// the block descriptors are ordered by height and timestamp
+	bd := result.BDs.BD[0]
+	for _, next := range result.BDs.BD[1:] {
+		if next.Timestamp.After(rollappTime) {
+			break
+		}
+		bd = next
+	}
+	return result, bd, nil
+}
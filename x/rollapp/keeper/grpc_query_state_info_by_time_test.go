@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// createNTimedStateInfo creates n state infos of 3 blocks each: state i is created on the hub
+// at base+10s*i, its blocks are produced at base+10s*i-3s, base+10s*i-2s and base+10s*i-1s
+func createNTimedStateInfo(k *keeper.Keeper, ctx sdk.Context, n int, rollappId string, base time.Time) []types.StateInfo {
+	k.SetRollapp(ctx, types.Rollapp{RollappId: rollappId})
+	items := make([]types.StateInfo, n)
+	for i := range items {
+		createdAt := base.Add(time.Duration(i+1) * 10 * time.Second)
+		var bds types.BlockDescriptors
+		for j := 0; j < 3; j++ {
+			bds.BD = append(bds.BD, types.BlockDescriptor{
+				Height:    uint64(3*i + j + 1),
+				Timestamp: createdAt.Add(time.Duration(j-3) * time.Second),
+			})
+		}
+		items[i] = types.StateInfo{
+			StateInfoIndex: types.StateInfoIndex{RollappId: rollappId, Index: uint64(i + 1)},
+			StartHeight:    uint64(3*i + 1),
+			NumBlocks:      3,
+			CreatedAt:      createdAt,
+			BDs:            bds,
+		}
+		k.SetStateInfo(ctx, items[i])
+		k.SetLatestStateInfoIndex(ctx, items[i].StateInfoIndex)
+	}
+	return items
+}
+
+func TestStateInfoByTime(t *testing.T) {
+	k, ctx := keepertest.RollappKeeper(t)
+	base := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	items := createNTimedStateInfo(k, ctx, 5, "rollapp_1234-1", base)
+	// an unrelated rollapp with states created at the same times
+	createNTimedStateInfo(k, ctx, 5, "other_5678-1", base.Add(time.Second))
+
+	query := func(timestamp time.Time, hubTime bool) (*types.QueryStateInfoByTimeResponse, error) {
+		return k.StateInfoByTime(ctx, &types.QueryStateInfoByTimeRequest{
+			RollappId: "rollapp_1234-1",
+			Timestamp: timestamp,
+			HubTime:   hubTime,
+		})
+	}
+
+	t.Run("hub time", func(t *testing.T) {
+		_, err := query(base, true)
+		require.ErrorIs(t, err, types.ErrStateNotExists)
+
+		// created exactly at the time
+		res, err := query(items[1].CreatedAt, true)
+		require.NoError(t, err)
+		require.Equal(t, items[1].StateInfoIndex, res.StateInfo.StateInfoIndex)
+		require.Equal(t, items[1].GetLatestBlockDescriptor(), res.BlockDescriptor)
+
+		// created before the time
+		res, err = query(items[1].CreatedAt.Add(5*time.Second), true)
+		require.NoError(t, err)
+		require.Equal(t, items[1].StateInfoIndex, res.StateInfo.StateInfoIndex)
+
+		res, err = query(base.Add(time.Hour), true)
+		require.NoError(t, err)
+		require.Equal(t, items[4].StateInfoIndex, res.StateInfo.StateInfoIndex)
+	})
+
+	t.Run("rollapp time", func(t *testing.T) {
+		_, err := query(base, false)
+		require.ErrorIs(t, err, types.ErrStateNotExists)
+
+		// the second block of the third state
+		res, err := query(items[2].BDs.BD[1].Timestamp.Add(500*time.Millisecond), false)
+		require.NoError(t, err)
+		require.Equal(t, items[2].StateInfoIndex, res.StateInfo.StateInfoIndex)
+		require.Equal(t, items[2].BDs.BD[1], res.BlockDescriptor)
+
+		// between two states, the last block of the previous one
+		res, err = query(items[2].CreatedAt.Add(5*time.Second), false)
+		require.NoError(t, err)
+		require.Equal(t, items[2].StateInfoIndex, res.StateInfo.StateInfoIndex)
+		require.Equal(t, items[2].BDs.BD[2], res.BlockDescriptor)
+	})
+
+	t.Run("old states removed", func(t *testing.T) {
+		k.DeleteStateInfoUntilTimestamp(ctx, items[2].CreatedAt.Add(5*time.Second))
+
+		_, err := query(items[1].CreatedAt, true)
+		require.ErrorIs(t, err, types.ErrStateNotExists)
+
+		res, err := query(items[3].BDs.BD[0].Timestamp, false)
+		require.NoError(t, err)
+		require.Equal(t, items[3].StateInfoIndex, res.StateInfo.StateInfoIndex)
+		require.Equal(t, items[3].BDs.BD[0], res.BlockDescriptor)
+	})
+
+	t.Run("unknown rollapp", func(t *testing.T) {
+		_, err := k.StateInfoByTime(ctx, &types.QueryStateInfoByTimeRequest{
+			RollappId: "unknown_1-1",
+			Timestamp: base,
+		})
+		require.ErrorIs(t, err, types.ErrRollappNotRegistered)
+	})
+}
@@ -19,7 +19,12 @@ func (k Keeper) GetEpochHooks() epochstypes.EpochHooks {
 
 // AfterEpochEnd is the epoch end hook.
 // We want to clean up all the state info records that are older than the sequencer unbonding time.
+// The maintenance windows quota is reset at the end of the maintenance epoch.
 func (e epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) error {
+	if epochIdentifier == e.MaintenanceEpochIdentifier(ctx) {
+		e.ResetMaintenanceWindowsQuota(ctx)
+	}
+
 	if epochIdentifier != e.StateInfoDeletionEpochIdentifier(ctx) {
 		return nil
 	}
@@ -83,7 +83,8 @@ func RollappByEIP155KeyInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// BlockHeightToFinalizationQueueInvariant checks that all unfinalized states are in the finalization queue
+// BlockHeightToFinalizationQueueInvariant checks that all unfinalized states are in the finalization queue,
+// either the block height one or the time one
 func BlockHeightToFinalizationQueueInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var (
@@ -91,6 +92,19 @@ func BlockHeightToFinalizationQueueInvariant(k Keeper) sdk.Invariant {
 			msg    string
 		)
 
+		// the states are not necessarily queued at their creation height, collect all the queued ones
+		queued := make(map[types.StateInfoIndex]struct{})
+		for _, queue := range k.GetAllBlockHeightToFinalizationQueue(ctx) {
+			for _, idx := range queue.FinalizationQueue {
+				queued[idx] = struct{}{}
+			}
+		}
+		for _, queue := range k.GetAllTimeToFinalizationQueue(ctx) {
+			for _, idx := range queue.FinalizationQueue {
+				queued[idx] = struct{}{}
+			}
+		}
+
 		for _, rollapp := range k.GetAllRollapps(ctx) {
 			if !k.IsRollappStarted(ctx, rollapp.RollappId) {
 				continue
@@ -113,23 +127,8 @@ func BlockHeightToFinalizationQueueInvariant(k Keeper) sdk.Invariant {
 					broken = true
 					continue
 				}
-				creationHeight := stateInfo.CreationHeight
-				val, found := k.GetBlockHeightToFinalizationQueue(ctx, creationHeight)
-				if !found {
-					msg += fmt.Sprintf("finalizationQueue (%d) have no block height\n", creationHeight)
-					broken = true
-					continue
-				}
-
 				// check that our state index is in the queue
-				found = false
-				for _, idx := range val.FinalizationQueue {
-					if idx.RollappId == rollapp.RollappId && idx.Index == i {
-						found = true
-						break
-					}
-				}
-				if !found {
+				if _, ok := queued[stateInfo.StateInfoIndex]; !ok {
 					msg += fmt.Sprintf("rollapp (%s) have stateInfo at index %d not in the queue\n", rollapp.RollappId, i)
 					broken = true
 				}
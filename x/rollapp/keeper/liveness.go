@@ -3,6 +3,8 @@ package keeper
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -59,7 +61,13 @@ func (k Keeper) CheckLiveness(ctx sdk.Context) {
 }
 
 // HandleLivenessEvent will slash or jail and then schedule a new event in the future.
+// The events falling within a maintenance window are postponed to its end instead.
 func (k Keeper) HandleLivenessEvent(ctx sdk.Context, e types.LivenessEvent) error {
+	ra := k.MustGetRollapp(ctx, e.RollappId)
+	if k.inMaintenanceWindow(ctx, ra, e.HubHeight) {
+		return k.postponeLivenessEvent(ctx, &ra, e)
+	}
+
 	if e.IsJail {
 		err := k.sequencerKeeper.JailLiveness(ctx, e.RollappId)
 		if err != nil {
@@ -72,11 +80,112 @@ func (k Keeper) HandleLivenessEvent(ctx sdk.Context, e types.LivenessEvent) erro
 		}
 	}
 
-	ra := k.MustGetRollapp(ctx, e.RollappId)
+	ra = k.MustGetRollapp(ctx, e.RollappId)
 	k.RescheduleLivenessEvent(ctx, &ra)
 	return nil
 }
 
+// inMaintenanceWindow returns true if the hub height falls within the maintenance window of the rollapp.
+// The window only applies to the proposer which registered it, so it doesn't outlive a rotation.
+func (k Keeper) inMaintenanceWindow(ctx sdk.Context, ra types.Rollapp, hubHeight int64) bool {
+	w := ra.MaintenanceWindow
+	if w == nil || hubHeight < w.StartHeight || w.EndHeight <= hubHeight {
+		return false
+	}
+	proposer, _, found := k.sequencerKeeper.ProposerBond(ctx, ra.RollappId)
+	return found && proposer == w.Sequencer
+}
+
+// postponeLivenessEvent moves the event to the end of the maintenance window.
+// The use of the window is recorded in an event, so that it stays visible.
+func (k Keeper) postponeLivenessEvent(ctx sdk.Context, ra *types.Rollapp, e types.LivenessEvent) error {
+	w := *ra.MaintenanceWindow
+	k.DelLivenessEvent(ctx, e)
+	k.PutLivenessEvent(ctx, types.LivenessEvent{
+		RollappId: e.RollappId,
+		HubHeight: w.EndHeight,
+		IsJail:    e.IsJail,
+	})
+	ra.LivenessEventHeight = w.EndHeight
+	k.SetRollapp(ctx, *ra)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventLivenessEventPostponed{
+		RollappId:       e.RollappId,
+		Window:          w,
+		IsJail:          e.IsJail,
+		HubHeight:       e.HubHeight,
+		PostponedHeight: w.EndHeight,
+	})
+}
+
+// RegisterMaintenanceWindow registers a maintenance window for the rollapp, during which its liveness events
+// are postponed. Only the proposer can register a window, at most a few times per epoch, and the max length
+// of the window is weighted by the proposer bond. Windows can't be chained: a window starts at least
+// the max window length after the end of the previous one.
+func (k Keeper) RegisterMaintenanceWindow(ctx sdk.Context, rollappID, seqAddr string, startHeight int64, numBlocks uint64) error {
+	ra, found := k.GetRollapp(ctx, rollappID)
+	if !found {
+		return types.ErrUnknownRollappID
+	}
+
+	if ra.Frozen {
+		return types.ErrRollappFrozen
+	}
+
+	proposer, bond, found := k.sequencerKeeper.ProposerBond(ctx, rollappID)
+	if !found || proposer != seqAddr {
+		return errorsmod.Wrap(gerrc.ErrPermissionDenied, "only the proposer can register a maintenance window")
+	}
+
+	if startHeight < ctx.BlockHeight() {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "start height is in the past: %d", startHeight)
+	}
+
+	params := k.GetParams(ctx)
+	if w := ra.MaintenanceWindow; w != nil {
+		if ctx.BlockHeight() < w.EndHeight {
+			return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "maintenance window already registered until height %d", w.EndHeight)
+		}
+		if cooldownEnd := w.EndHeight + int64(params.MaxMaintenanceBlocks); startHeight < cooldownEnd {
+			return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "maintenance window cooldown: start height must be at least %d", cooldownEnd)
+		}
+	}
+
+	if params.MaintenanceWindowsPerEpoch <= ra.MaintenanceWindowsInEpoch {
+		return errorsmod.Wrapf(gerrc.ErrResourceExhausted, "maintenance windows quota reached for the epoch: %d", params.MaintenanceWindowsPerEpoch)
+	}
+
+	if maxBlocks := params.MaxMaintenanceBlocksForBond(bond); maxBlocks < numBlocks {
+		return errorsmod.Wrapf(gerrc.ErrOutOfRange, "maintenance window is too long for the proposer bond: max blocks: %d", maxBlocks)
+	}
+
+	w := types.MaintenanceWindow{
+		Sequencer:   seqAddr,
+		StartHeight: startHeight,
+		EndHeight:   startHeight + int64(numBlocks),
+	}
+	ra.MaintenanceWindow = &w
+	ra.MaintenanceWindowsInEpoch++
+	k.SetRollapp(ctx, ra)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventMaintenanceWindowRegistered{
+		RollappId: rollappID,
+		Window:    w,
+	})
+}
+
+// ResetMaintenanceWindowsQuota resets the number of maintenance windows registered by the rollapps
+// in the epoch. Called at the end of the maintenance epoch.
+func (k Keeper) ResetMaintenanceWindowsQuota(ctx sdk.Context) {
+	used := k.FilterRollapps(ctx, func(ra types.Rollapp) bool {
+		return 0 < ra.MaintenanceWindowsInEpoch
+	})
+	for _, ra := range used {
+		ra.MaintenanceWindowsInEpoch = 0
+		k.SetRollapp(ctx, ra)
+	}
+}
+
 // IndicateLiveness will reschedule pending liveness events to a later block height.
 // Modifies the passed-in rollapp object.
 func (k Keeper) IndicateLiveness(ctx sdk.Context, ra *types.Rollapp) {
@@ -7,12 +7,15 @@ import (
 	"testing"
 	"time"
 
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/urand"
 	"github.com/stretchr/testify/require"
 	"pgregory.net/rapid"
 
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -143,9 +146,84 @@ func (suite *RollappTestSuite) TestLivenessFlow() {
 	})
 }
 
+// Liveness events falling within a maintenance window are postponed to its end.
+func (suite *RollappTestSuite) TestLivenessMaintenanceWindow() {
+	suite.SetupTest()
+
+	raID := urand.RollappID()
+	proposer := sample.AccAddress()
+	p := suite.keeper().GetParams(suite.Ctx)
+	p.LivenessSlashBlocks = 10
+	p.LivenessSlashInterval = 5
+	p.LivenessJailBlocks = 100
+	p.MaintenanceWindowsPerEpoch = 1
+	p.MaxMaintenanceBlocks = 50
+	suite.keeper().SetParams(suite.Ctx, p)
+
+	tracker := newLivenessMockSequencerKeeper()
+	tracker.proposer = proposer
+	tracker.bond = sdk.NewCoins(p.MaintenanceBondPerBlock.AddAmount(p.MaintenanceBondPerBlock.Amount.MulRaw(19))) // 20 blocks
+	suite.keeper().SetSequencerKeeper(tracker)
+	suite.keeper().SetRollapp(suite.Ctx, types.NewRollapp("", raID, "", types.Rollapp_Unspecified, nil, types.GenesisInfo{}, false))
+
+	ra := suite.keeper().MustGetRollapp(suite.Ctx, raID)
+	suite.keeper().IndicateLiveness(suite.Ctx, &ra)
+	start := suite.Ctx.BlockHeight()
+
+	err := suite.keeper().RegisterMaintenanceWindow(suite.Ctx, raID, sample.AccAddress(), start+5, 10)
+	suite.Require().ErrorIs(err, gerrc.ErrPermissionDenied)
+	err = suite.keeper().RegisterMaintenanceWindow(suite.Ctx, raID, proposer, start-1, 10)
+	suite.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+	err = suite.keeper().RegisterMaintenanceWindow(suite.Ctx, raID, proposer, start+5, 21)
+	suite.Require().ErrorIs(err, gerrc.ErrOutOfRange)
+	err = suite.keeper().RegisterMaintenanceWindow(suite.Ctx, raID, proposer, start+5, 20)
+	suite.Require().NoError(err)
+	err = suite.keeper().RegisterMaintenanceWindow(suite.Ctx, raID, proposer, start+30, 1)
+	suite.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+	// the slash due at start+10 is postponed to the end of the window
+	end := start + 25
+	for suite.Ctx.BlockHeight() < end {
+		suite.nextBlock()
+		suite.keeper().CheckLiveness(suite.Ctx)
+		if suite.Ctx.BlockHeight() < end {
+			suite.Require().Zero(tracker.slashes[raID])
+		}
+		msg, notOk := keeper.LivenessEventInvariant(*suite.keeper())(suite.Ctx)
+		suite.Require().False(notOk, msg)
+	}
+	suite.Require().Equal(1, tracker.slashes[raID])
+
+	// the quota is used up until the end of the epoch
+	err = suite.keeper().RegisterMaintenanceWindow(suite.Ctx, raID, proposer, end+50, 1)
+	suite.Require().ErrorIs(err, gerrc.ErrResourceExhausted)
+	suite.keeper().ResetMaintenanceWindowsQuota(suite.Ctx)
+
+	// the next window can't be chained with the previous one
+	err = suite.keeper().RegisterMaintenanceWindow(suite.Ctx, raID, proposer, end+49, 1)
+	suite.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+	err = suite.keeper().RegisterMaintenanceWindow(suite.Ctx, raID, proposer, end+50, 10)
+	suite.Require().NoError(err)
+
+	// the window doesn't outlive a rotation of the proposer: the slash due at end+55 is not postponed
+	suite.Ctx = suite.Ctx.WithBlockHeight(end + 45)
+	ra = suite.keeper().MustGetRollapp(suite.Ctx, raID)
+	suite.keeper().IndicateLiveness(suite.Ctx, &ra)
+	tracker.clear(raID)
+	tracker.proposer = sample.AccAddress()
+	suite.keeper().SetSequencerKeeper(tracker)
+	for suite.Ctx.BlockHeight() < end+55 {
+		suite.nextBlock()
+		suite.keeper().CheckLiveness(suite.Ctx)
+	}
+	suite.Require().Equal(1, tracker.slashes[raID])
+}
+
 type livenessMockSequencerKeeper struct {
-	slashes map[string]int
-	jails   map[string]int
+	slashes  map[string]int
+	jails    map[string]int
+	proposer string
+	bond     sdk.Coins
 }
 
 func (l livenessMockSequencerKeeper) UnbondingTime(sdk.Context) (res time.Duration) {
@@ -154,8 +232,8 @@ func (l livenessMockSequencerKeeper) UnbondingTime(sdk.Context) (res time.Durati
 
 func newLivenessMockSequencerKeeper() livenessMockSequencerKeeper {
 	return livenessMockSequencerKeeper{
-		make(map[string]int),
-		make(map[string]int),
+		slashes: make(map[string]int),
+		jails:   make(map[string]int),
 	}
 }
 
@@ -169,6 +247,14 @@ func (l livenessMockSequencerKeeper) JailLiveness(ctx sdk.Context, rollappID str
 	return nil
 }
 
+func (l livenessMockSequencerKeeper) GetSequencerCometPubKey(sdk.Context, string) (tmprotocrypto.PublicKey, error) {
+	return tmprotocrypto.PublicKey{}, nil
+}
+
+func (l livenessMockSequencerKeeper) ProposerBond(sdk.Context, string) (string, sdk.Coins, bool) {
+	return l.proposer, l.bond, l.proposer != ""
+}
+
 func (l livenessMockSequencerKeeper) clear(rollappID string) {
 	delete(l.slashes, rollappID)
 	delete(l.jails, rollappID)
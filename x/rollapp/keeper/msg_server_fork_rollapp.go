@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) ForkRollapp(goCtx context.Context, msg *types.MsgForkRollapp) (*types.MsgForkRollappResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if rollapp.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	err = k.Keeper.ForkRollapp(ctx, msg.RollappId, msg.ForkHeight, msg.DrsVersion)
+	if err != nil {
+		return nil, fmt.Errorf("fork rollapp: %w", err)
+	}
+
+	return &types.MsgForkRollappResponse{}, nil
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// Happy Flow
+// - rollapp running a vulnerable DRS version is frozen
+// - the owner forks it with a non-vulnerable DRS version
+// - the hub accepts the state updates again from the fork height
+func (suite *RollappTestSuite) TestForkRollapp() {
+	k := suite.App.RollappKeeper
+
+	rollappID, proposer := suite.CreateDefaultRollappAndProposer()
+	clientID := suite.setCanonicalChannel(rollappID)
+
+	// post two states, finalize the first one
+	var lastHeight uint64 = 1
+	for i := int64(0); i < 2; i++ {
+		suite.Ctx = suite.Ctx.WithBlockHeight(10 + i)
+		var err error
+		lastHeight, err = suite.PostStateUpdateWithDRSVersion(suite.Ctx, rollappID, proposer, lastHeight, 10, "drs_1")
+		suite.Require().NoError(err)
+	}
+	k.FinalizeRollappStates(suite.Ctx.WithBlockHeight(12))
+
+	forkMsg := &types.MsgForkRollapp{
+		Owner:      alice,
+		RollappId:  rollappID,
+		ForkHeight: 11,
+		DrsVersion: "drs_2",
+	}
+
+	// the rollapp must be vulnerable
+	_, err := suite.msgServer.ForkRollapp(suite.Ctx, forkMsg)
+	suite.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+	_, err = suite.msgServer.MarkVulnerableRollapps(suite.Ctx, &types.MsgMarkVulnerableRollapps{
+		Authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		DrsVersions: []string{"drs_1"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(k.MustGetRollapp(suite.Ctx, rollappID).IsVulnerable())
+
+	// the vulnerable versions are queryable
+	res, err := suite.queryClient.VulnerableDRSVersions(suite.Ctx, &types.QueryVulnerableDRSVersionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"drs_1"}, res.DrsVersions)
+
+	// only the owner can fork the rollapp
+	forkMsg.Owner = proposer
+	_, err = suite.msgServer.ForkRollapp(suite.Ctx, forkMsg)
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+	forkMsg.Owner = alice
+
+	// the rollapp cannot resume with a vulnerable DRS version
+	forkMsg.DrsVersion = "drs_1"
+	_, err = suite.msgServer.ForkRollapp(suite.Ctx, forkMsg)
+	suite.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+	forkMsg.DrsVersion = "drs_2"
+
+	// the client of the canonical channel, frozen with the rollapp, must be restored first
+	_, err = suite.msgServer.ForkRollapp(suite.Ctx, forkMsg)
+	suite.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+	suite.unfreezeClient(clientID)
+
+	// the finalized states cannot be forked
+	forkMsg.ForkHeight = 10
+	_, err = suite.msgServer.ForkRollapp(suite.Ctx, forkMsg)
+	suite.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	// the fork can't leave a gap after the finalized states
+	forkMsg.ForkHeight = 15
+	_, err = suite.msgServer.ForkRollapp(suite.Ctx, forkMsg)
+	suite.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+	forkMsg.ForkHeight = 11
+
+	_, err = suite.msgServer.ForkRollapp(suite.Ctx, forkMsg)
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(new(types.EventRollappForked)), 1)
+
+	rollapp := k.MustGetRollapp(suite.Ctx, rollappID)
+	suite.Require().False(rollapp.Frozen)
+	suite.Require().False(rollapp.IsVulnerable())
+
+	// the unfinalized state is removed
+	latest, found := k.GetLatestStateInfoIndex(suite.Ctx, rollappID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), latest.Index)
+
+	// the first state update must start from the fork height, with the fork DRS version
+	_, err = suite.PostStateUpdateWithDRSVersion(suite.Ctx, rollappID, proposer, 12, 10, "drs_2")
+	suite.Require().ErrorIs(err, types.ErrWrongBlockHeight)
+	_, err = suite.PostStateUpdateWithDRSVersion(suite.Ctx, rollappID, proposer, 11, 10, "drs_3")
+	suite.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+	lastHeight, err = suite.PostStateUpdateWithDRSVersion(suite.Ctx, rollappID, proposer, 11, 10, "drs_2")
+	suite.Require().NoError(err)
+	suite.Require().Nil(k.MustGetRollapp(suite.Ctx, rollappID).PendingFork)
+
+	// the state updates continue from there
+	_, err = suite.PostStateUpdateWithDRSVersion(suite.Ctx, rollappID, proposer, lastHeight, 10, "drs_2")
+	suite.Require().NoError(err)
+}
+
+// setCanonicalChannel sets a transfer channel, with its connection and tendermint client, as the canonical
+// channel of the rollapp. Returns the client ID.
+func (suite *RollappTestSuite) setCanonicalChannel(rollappID string) string {
+	const (
+		clientID     = "07-tendermint-0"
+		connectionID = "connection-0"
+		channelID    = "channel-0"
+	)
+	suite.App.IBCKeeper.ClientKeeper.SetClientState(suite.Ctx, clientID, &ibctm.ClientState{
+		ChainId:      rollappID,
+		LatestHeight: clienttypes.NewHeight(1, 10),
+	})
+	suite.App.IBCKeeper.ConnectionKeeper.SetConnection(suite.Ctx, connectionID, connectiontypes.ConnectionEnd{
+		ClientId: clientID,
+		State:    connectiontypes.OPEN,
+	})
+	suite.App.IBCKeeper.ChannelKeeper.SetChannel(suite.Ctx, ibctransfertypes.PortID, channelID, channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		ConnectionHops: []string{connectionID},
+	})
+
+	rollapp := suite.App.RollappKeeper.MustGetRollapp(suite.Ctx, rollappID)
+	rollapp.ChannelId = channelID
+	suite.App.RollappKeeper.SetRollapp(suite.Ctx, rollapp)
+	return clientID
+}
+
+// unfreezeClient unfreezes the client, as its substitution through an IBC client update proposal would.
+func (suite *RollappTestSuite) unfreezeClient(clientID string) {
+	clientState, ok := suite.App.IBCKeeper.ClientKeeper.GetClientState(suite.Ctx, clientID)
+	suite.Require().True(ok)
+	tmClientState := clientState.(*ibctm.ClientState)
+	tmClientState.FrozenHeight = clienttypes.ZeroHeight()
+	suite.App.IBCKeeper.ClientKeeper.SetClientState(suite.Ctx, clientID, tmClientState)
+}
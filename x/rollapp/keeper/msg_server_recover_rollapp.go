@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) RecoverRollapp(goCtx context.Context, msg *types.MsgRecoverRollapp) (*types.MsgRecoverRollappResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "only the gov module can recover rollapps")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err = k.Keeper.RecoverRollapp(ctx, msg.RollappId, msg.InitialSequencer)
+	if err != nil {
+		return nil, fmt.Errorf("recover rollapp: %w", err)
+	}
+
+	return &types.MsgRecoverRollappResponse{}, nil
+}
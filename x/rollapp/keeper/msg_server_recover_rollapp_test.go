@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// Happy Flow
+// - rollapp frozen on fraud is recovered by the governance
+// - reverted states are removed, the rollapp resumes from the last finalized state
+// - only the initial sequencer can register and become the proposer
+func (suite *RollappTestSuite) TestRecoverRollapp() {
+	govModule := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k := suite.App.RollappKeeper
+
+	rollappID, proposer := suite.CreateDefaultRollappAndProposer()
+
+	// post three states, finalize the first one
+	var lastHeight uint64 = 1
+	for i := int64(0); i < 3; i++ {
+		suite.Ctx = suite.Ctx.WithBlockHeight(10 + i)
+		var err error
+		lastHeight, err = suite.PostStateUpdate(suite.Ctx, rollappID, proposer, lastHeight, 10)
+		suite.Require().NoError(err)
+	}
+	k.FinalizeRollappStates(suite.Ctx)
+	finalized, found := k.GetLatestFinalizedStateIndex(suite.Ctx, rollappID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), finalized.Index)
+
+	recoverMsg := &types.MsgRecoverRollapp{
+		Authority:        govModule,
+		RollappId:        rollappID,
+		InitialSequencer: "*",
+	}
+
+	// the rollapp must be frozen
+	_, err := suite.msgServer.RecoverRollapp(suite.Ctx, recoverMsg)
+	suite.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+
+	err = k.HandleFraud(suite.Ctx, rollappID, "", 25, proposer)
+	suite.Require().NoError(err)
+
+	// only the gov module can recover the rollapp
+	newSeqPubKey := ed25519.GenPrivKey().PubKey()
+	recoverMsg.InitialSequencer = sdk.AccAddress(newSeqPubKey.Address()).String()
+	recoverMsg.Authority = apptesting.CreateRandomAccounts(1)[0].String()
+	_, err = suite.msgServer.RecoverRollapp(suite.Ctx, recoverMsg)
+	suite.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	recoverMsg.Authority = govModule
+	_, err = suite.msgServer.RecoverRollapp(suite.Ctx, recoverMsg)
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(new(types.EventRollappRecovered)), 1)
+
+	rollapp := k.MustGetRollapp(suite.Ctx, rollappID)
+	suite.Require().False(rollapp.Frozen)
+	suite.Require().True(rollapp.Recovering)
+
+	// the reverted states are removed
+	latest, found := k.GetLatestStateInfoIndex(suite.Ctx, rollappID)
+	suite.Require().True(found)
+	suite.Require().Equal(finalized, latest)
+	for i := uint64(2); i <= 3; i++ {
+		_, found = k.GetStateInfo(suite.Ctx, rollappID, i)
+		suite.Require().False(found)
+	}
+
+	// a sequencer other than the initial one cannot register
+	err = suite.CreateSequencerByPubkey(suite.Ctx, rollappID, ed25519.GenPrivKey().PubKey())
+	suite.Require().ErrorIs(err, sequencertypes.ErrNotInitialSequencer)
+
+	// the initial sequencer registers and becomes the proposer
+	err = suite.CreateSequencerByPubkey(suite.Ctx, rollappID, newSeqPubKey)
+	suite.Require().NoError(err)
+	rollapp = k.MustGetRollapp(suite.Ctx, rollappID)
+	suite.Require().False(rollapp.Recovering)
+
+	// the new proposer resumes right after the last finalized height
+	_, err = suite.PostStateUpdate(suite.Ctx, rollappID, recoverMsg.InitialSequencer, 21, 10)
+	suite.Require().Error(err)
+	_, err = suite.PostStateUpdate(suite.Ctx, rollappID, recoverMsg.InitialSequencer, 11, 10)
+	suite.Require().NoError(err)
+}
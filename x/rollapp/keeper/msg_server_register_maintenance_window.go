@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) RegisterMaintenanceWindow(goCtx context.Context, msg *types.MsgRegisterMaintenanceWindow) (*types.MsgRegisterMaintenanceWindowResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err = k.Keeper.RegisterMaintenanceWindow(ctx, msg.RollappId, msg.Creator, msg.StartHeight, msg.NumBlocks)
+	if err != nil {
+		return nil, fmt.Errorf("register maintenance window: %w", err)
+	}
+
+	return &types.MsgRegisterMaintenanceWindowResponse{}, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) SetRollappDisputePeriodTime(goCtx context.Context, msg *types.MsgSetRollappDisputePeriodTime) (*types.MsgSetRollappDisputePeriodTimeResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "only the gov module can set the rollapp dispute period time")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	rollapp.DisputePeriodTime = msg.DisputePeriodTime
+	k.SetRollapp(ctx, rollapp)
+
+	err = uevent.EmitTypedEvent(ctx, &types.EventRollappDisputePeriodTimeSet{
+		RollappId:         msg.RollappId,
+		DisputePeriodTime: msg.DisputePeriodTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgSetRollappDisputePeriodTimeResponse{}, nil
+}
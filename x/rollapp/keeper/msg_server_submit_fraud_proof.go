@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// SubmitFraudProof handles a fraud proof submitted by anyone: the fraudulent sequencer is punished
+// through the HandleFraud path and the submitter is rewarded out of its slashed bond.
+func (k msgServer) SubmitFraudProof(goCtx context.Context, msg *types.MsgSubmitFraudProof) (*types.MsgSubmitFraudProofResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signedHeader, err := msg.GetCometSignedHeader()
+	if err != nil {
+		return nil, errors.Join(types.ErrInvalidFraudProof, err)
+	}
+
+	stateInfo, err := k.verifyFraudProof(ctx, msg.RollappId, signedHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	// the sequencer can't report its own fraud, otherwise it would get back part of the bond it loses
+	if msg.Submitter == stateInfo.Sequencer {
+		return nil, sequencertypes.ErrSelfFraudReport
+	}
+
+	err = k.handleFraud(ctx, msg.RollappId, msg.IbcClientId, uint64(signedHeader.Height), stateInfo.Sequencer, msg.Submitter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "handle fraud")
+	}
+
+	return &types.MsgSubmitFraudProofResponse{}, nil
+}
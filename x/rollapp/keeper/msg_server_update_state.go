@@ -47,6 +47,20 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		return nil, err
 	}
 
+	// the first state update after the fork must start from the fork point
+	if fork := rollapp.PendingFork; fork != nil {
+		if msg.StartHeight != fork.Height {
+			return nil, errorsmod.Wrapf(types.ErrWrongBlockHeight,
+				"expected fork height (%d), but received (%d)",
+				fork.Height, msg.StartHeight)
+		}
+		if msg.DrsVersion != fork.DrsVersion {
+			return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument,
+				"expected fork DRS version (%s), but received (%s)",
+				fork.DrsVersion, msg.DrsVersion)
+		}
+	}
+
 	// retrieve last updating index
 	var newIndex, lastIndex uint64
 	latestStateInfoIndex, found := k.GetLatestStateInfoIndex(ctx, msg.RollappId)
@@ -73,7 +87,7 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 
 		// check to see if received height is the one we expected
 		expectedStartHeight := stateInfo.StartHeight + stateInfo.NumBlocks
-		if expectedStartHeight != msg.StartHeight {
+		if rollapp.PendingFork == nil && expectedStartHeight != msg.StartHeight {
 			return nil, errorsmod.Wrapf(types.ErrWrongBlockHeight,
 				"expected height (%d), but received (%d)",
 				expectedStartHeight, msg.StartHeight)
@@ -109,6 +123,8 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		blockTime,
 		msg.DrsVersion,
 	)
+	// the rollapp dispute period applies to the states created after it is set
+	stateInfo.DisputePeriodInBlocks = rollapp.DisputePeriodInBlocks
 	// Write new state information to the store indexed by <RollappId,LatestStateInfoIndex>
 	k.SetStateInfo(ctx, *stateInfo)
 
@@ -117,21 +133,12 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		return nil, errorsmod.Wrap(err, "after update state")
 	}
 
-	stateInfoIndex := stateInfo.GetIndex()
-	newFinalizationQueue := []types.StateInfoIndex{stateInfoIndex}
+	queueHeight := k.finalizationQueueHeight(ctx, *stateInfo)
+	k.Logger(ctx).Debug("Adding state to finalization queue at %d", queueHeight)
+	k.AppendToBlockHeightToFinalizationQueue(ctx, queueHeight, stateInfo.GetIndex())
 
-	k.Logger(ctx).Debug("Adding state to finalization queue at %d", creationHeight)
-	// load FinalizationQueue and update
-	blockHeightToFinalizationQueue, found := k.GetBlockHeightToFinalizationQueue(ctx, creationHeight)
-	if found {
-		newFinalizationQueue = append(blockHeightToFinalizationQueue.FinalizationQueue, newFinalizationQueue...)
-	}
-
-	// Write new BlockHeightToFinalizationQueue
-	k.SetBlockHeightToFinalizationQueue(ctx, types.BlockHeightToFinalizationQueue{
-		CreationHeight:    creationHeight,
-		FinalizationQueue: newFinalizationQueue,
-	})
+	// the rollapp resumed from the fork point
+	rollapp.PendingFork = nil
 
 	// TODO: enforce `final_state_update_timeout` if sequencer rotation is in progress
 	// https://github.com/dymensionxyz/dymension/issues/1085
@@ -66,9 +66,10 @@ func (suite *RollappTestSuite) TestUpdateState() {
 		suite.Require().EqualValues(true, found)
 
 		// verify finalization queue
-		expectedFinalizationQueue, _ := suite.App.RollappKeeper.GetBlockHeightToFinalizationQueue(suite.Ctx, expectedStateInfo.CreationHeight)
+		expectedFinalizationQueue, _ := suite.App.RollappKeeper.GetBlockHeightToFinalizationQueue(suite.Ctx, expectedStateInfo.CreationHeight, rollappId)
 		suite.Require().EqualValues(expectedFinalizationQueue, types.BlockHeightToFinalizationQueue{
 			CreationHeight:    expectedStateInfo.CreationHeight,
+			RollappId:         rollappId,
 			FinalizationQueue: []types.StateInfoIndex{latestStateInfoIndex},
 		}, "finalization queue", "i", i)
 
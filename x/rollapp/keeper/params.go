@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -15,6 +17,13 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.LivenessJailBlocks(ctx),
 		k.AppRegistrationFee(ctx),
 		k.StateInfoDeletionEpochIdentifier(ctx),
+		k.DisputePeriodTime(ctx),
+		k.MinDisputePeriodInBlocks(ctx),
+		k.MaxDisputePeriodInBlocks(ctx),
+		k.MaintenanceEpochIdentifier(ctx),
+		k.MaintenanceWindowsPerEpoch(ctx),
+		k.MaintenanceBondPerBlock(ctx),
+		k.MaxMaintenanceBlocks(ctx),
 	)
 }
 
@@ -54,3 +63,39 @@ func (k Keeper) StateInfoDeletionEpochIdentifier(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyStateInfoDeletionEpochIdentifier, &res)
 	return
 }
+
+// DisputePeriodTime returns the DisputePeriodTime param
+func (k Keeper) DisputePeriodTime(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyDisputePeriodTime, &res)
+	return
+}
+
+func (k Keeper) MinDisputePeriodInBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMinDisputePeriodInBlocks, &res)
+	return
+}
+
+func (k Keeper) MaxDisputePeriodInBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxDisputePeriodInBlocks, &res)
+	return
+}
+
+func (k Keeper) MaintenanceEpochIdentifier(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyMaintenanceEpochIdentifier, &res)
+	return
+}
+
+func (k Keeper) MaintenanceWindowsPerEpoch(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaintenanceWindowsPerEpoch, &res)
+	return
+}
+
+func (k Keeper) MaintenanceBondPerBlock(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyMaintenanceBondPerBlock, &res)
+	return
+}
+
+func (k Keeper) MaxMaintenanceBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxMaintenanceBlocks, &res)
+	return
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	cometbfttypes "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -62,6 +64,13 @@ func (k Keeper) CheckAndUpdateRollappFields(ctx sdk.Context, update *types.MsgUp
 		current.Metadata = update.Metadata
 	}
 
+	if update.DisputePeriodInBlocks != 0 {
+		if err := k.GetParams(ctx).CheckDisputePeriodInBlocks(update.DisputePeriodInBlocks); err != nil {
+			return current, err
+		}
+		current.DisputePeriodInBlocks = update.DisputePeriodInBlocks
+	}
+
 	if err := current.ValidateBasic(); err != nil {
 		return current, fmt.Errorf("validate rollapp: %w", err)
 	}
@@ -237,8 +246,17 @@ func (k Keeper) IsRollappStarted(ctx sdk.Context, rollappId string) bool {
 	return found
 }
 
+// MarkRollappAsVulnerable freezes the rollapp running a vulnerable DRS version.
+// The owner can then fork the rollapp with a non-vulnerable DRS version.
 func (k Keeper) MarkRollappAsVulnerable(ctx sdk.Context, rollappId string) error {
-	return k.FreezeRollapp(ctx, rollappId)
+	err := k.FreezeRollapp(ctx, rollappId)
+	if err != nil {
+		return err
+	}
+	rollapp := k.MustGetRollapp(ctx, rollappId)
+	rollapp.Vulnerable = true
+	k.SetRollapp(ctx, rollapp)
+	return nil
 }
 
 // FreezeRollapp marks the rollapp as frozen and reverts all pending states.
@@ -269,6 +287,151 @@ func (k Keeper) FreezeRollapp(ctx sdk.Context, rollappID string) error {
 	return nil
 }
 
+// RecoverRollapp unfreezes a frozen rollapp. The reverted states are removed, so the rollapp resumes
+// from its last finalized state. Until one of the given initial sequencers registers and becomes
+// the proposer, the rollapp stays in the recovering mode.
+func (k Keeper) RecoverRollapp(ctx sdk.Context, rollappID, initialSequencer string) error {
+	rollapp, found := k.GetRollapp(ctx, rollappID)
+	if !found {
+		return gerrc.ErrNotFound
+	}
+
+	if !rollapp.Frozen {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "rollapp is not frozen")
+	}
+
+	if err := k.checkCanonicalClientNotFrozen(ctx, rollapp); err != nil {
+		return err
+	}
+
+	// the new proposer submits the reverted states again
+	trustedHeight := k.resetToLatestFinalizedState(ctx, rollappID)
+
+	rollapp.Frozen = false
+	rollapp.Vulnerable = false
+	rollapp.Recovering = true
+	rollapp.InitialSequencer = initialSequencer
+
+	// give the new proposer a full liveness window
+	k.IndicateLiveness(ctx, &rollapp)
+
+	// reset the light client association, clean the reverted packets
+	err := k.hooks.RollappRecovered(ctx, rollappID)
+	if err != nil {
+		return fmt.Errorf("rollapp recovered hooks: %w", err)
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventRollappRecovered{
+		RollappId:        rollappID,
+		TrustedHeight:    trustedHeight,
+		InitialSequencer: initialSequencer,
+	})
+}
+
+// ForkRollapp hard-forks a rollapp frozen for running a vulnerable DRS version. The reverted states
+// are removed and the hub accepts the state updates again from the fork height, right after the latest
+// finalized state, with the given DRS version.
+func (k Keeper) ForkRollapp(ctx sdk.Context, rollappID string, forkHeight uint64, drsVersion string) error {
+	rollapp, found := k.GetRollapp(ctx, rollappID)
+	if !found {
+		return gerrc.ErrNotFound
+	}
+
+	if !rollapp.IsVulnerable() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "rollapp is not vulnerable")
+	}
+
+	if k.IsDRSVersionVulnerable(ctx, drsVersion) {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "DRS version is vulnerable: %s", drsVersion)
+	}
+
+	if err := k.checkCanonicalClientNotFrozen(ctx, rollapp); err != nil {
+		return err
+	}
+
+	// the rollapp forks right after its latest finalized state: the finalized states cannot be forked,
+	// and the states must stay contiguous
+	if finalizedHeight := k.latestFinalizedHeight(ctx, rollappID); forkHeight != finalizedHeight+1 {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument,
+			"fork height must follow the latest finalized height: fork height: %d: finalized height: %d", forkHeight, finalizedHeight)
+	}
+	k.resetToLatestFinalizedState(ctx, rollappID)
+
+	rollapp.Frozen = false
+	rollapp.Vulnerable = false
+	rollapp.PendingFork = &types.RollappFork{
+		Height:     forkHeight,
+		DrsVersion: drsVersion,
+	}
+
+	// give the proposer a full liveness window
+	k.IndicateLiveness(ctx, &rollapp)
+
+	// reset the light client association, clean the reverted packets
+	err := k.hooks.RollappRecovered(ctx, rollappID)
+	if err != nil {
+		return fmt.Errorf("rollapp recovered hooks: %w", err)
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventRollappForked{
+		RollappId:  rollappID,
+		ForkHeight: forkHeight,
+		DrsVersion: drsVersion,
+	})
+}
+
+// checkCanonicalClientNotFrozen returns an error if the client of the canonical channel of the rollapp is frozen.
+// The canonical channel stays when the rollapp is unfrozen, so the client frozen with the rollapp must be
+// restored first, by substituting it through an IBC client update proposal.
+func (k Keeper) checkCanonicalClientNotFrozen(ctx sdk.Context, rollapp types.Rollapp) error {
+	if rollapp.ChannelId == "" {
+		return nil
+	}
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, "transfer", rollapp.ChannelId)
+	if err != nil {
+		return fmt.Errorf("get channel client state: %w", err)
+	}
+	if tmClientState, ok := clientState.(*cometbfttypes.ClientState); ok && !tmClientState.FrozenHeight.IsZero() {
+		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "client of the canonical channel is frozen: %s", clientID)
+	}
+	return nil
+}
+
+// resetToLatestFinalizedState removes the states above the latest finalized one,
+// so the rollapp resumes from it. Returns the latest finalized height, or zero if none.
+func (k Keeper) resetToLatestFinalizedState(ctx sdk.Context, rollappID string) uint64 {
+	latest, _ := k.GetLatestStateInfoIndex(ctx, rollappID)
+	finalized, found := k.GetLatestFinalizedStateIndex(ctx, rollappID)
+	for i := finalized.Index + 1; i <= latest.Index; i++ {
+		k.RemoveStateInfo(ctx, rollappID, i)
+	}
+
+	if !found {
+		k.RemoveLatestStateInfoIndex(ctx, rollappID)
+		return 0
+	}
+
+	k.SetLatestStateInfoIndex(ctx, finalized)
+	return k.latestFinalizedHeight(ctx, rollappID)
+}
+
+// latestFinalizedHeight returns the latest finalized height of the rollapp, or zero if none.
+func (k Keeper) latestFinalizedHeight(ctx sdk.Context, rollappID string) uint64 {
+	finalized, found := k.GetLatestFinalizedStateIndex(ctx, rollappID)
+	if !found {
+		return 0
+	}
+	stateInfo := k.MustGetStateInfo(ctx, rollappID, finalized.Index)
+	return stateInfo.GetLatestHeight()
+}
+
+// SetRollappAsRecovered marks the recovery of the rollapp as complete. Must be called once
+// the initial sequencer of the recovered rollapp becomes the proposer.
+func (k Keeper) SetRollappAsRecovered(ctx sdk.Context, rollapp *types.Rollapp) {
+	rollapp.Recovering = false
+	k.SetRollapp(ctx, *rollapp)
+}
+
 // verifyClientID verifies that the provided clientID is the same clientID used by the provided rollapp.
 // Possible scenarios:
 //  1. both channelID and clientID are empty -> okay
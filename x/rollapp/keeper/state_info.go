@@ -72,10 +72,15 @@ func (k Keeper) RemoveStateInfo(
 	rollappId string,
 	index uint64,
 ) {
+	stateInfo, found := k.GetStateInfo(ctx, rollappId, index)
+	if !found {
+		return
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StateInfoKeyPrefix))
-	store.Delete(types.StateInfoKey(
-		types.StateInfoIndex{RollappId: rollappId, Index: index},
-	))
+	store.Delete(types.StateInfoKey(stateInfo.StateInfoIndex))
+
+	storeTS := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimestampedStateInfoKeyPrefix))
+	storeTS.Delete(types.StateInfoTimestampKey(stateInfo))
 }
 
 // GetAllStateInfo returns all stateInfo
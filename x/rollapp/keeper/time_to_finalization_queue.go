@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// RollappDisputePeriodTime returns the time-based dispute period of the rollapp:
+// the rollapp override if set, the DisputePeriodTime param otherwise.
+func (k Keeper) RollappDisputePeriodTime(ctx sdk.Context, rollappId string) time.Duration {
+	if rollapp, found := k.GetRollapp(ctx, rollappId); found && rollapp.DisputePeriodTime > 0 {
+		return rollapp.DisputePeriodTime
+	}
+	return k.DisputePeriodTime(ctx)
+}
+
+// FinalizeAllPendingByTime finalizes the states of the time finalization queues.
+func (k Keeper) FinalizeAllPendingByTime(ctx sdk.Context, pendingFinalizationQueue []types.TimeToFinalizationQueue) {
+	// Cache the rollapps that failed to finalize at current EndBlocker execution.
+	failedRollapps := make(map[string]struct{})
+	for _, timeToFinalizationQueue := range pendingFinalizationQueue {
+		k.finalizeQueueForTime(ctx, timeToFinalizationQueue, failedRollapps)
+	}
+}
+
+func (k Keeper) finalizeQueueForTime(ctx sdk.Context, queue types.TimeToFinalizationQueue, failedRollapps map[string]struct{}) {
+	var leftPendingStates []types.StateInfoIndex
+	for _, stateInfoIndex := range queue.FinalizationQueue {
+		// the states of a rollapp are finalized in order: keep the state in the queue if a previous
+		// state of the rollapp failed to finalize or is still waiting for its dispute period
+		_, failed := failedRollapps[stateInfoIndex.RollappId]
+		if failed || k.isPreviousStatePending(ctx, stateInfoIndex) {
+			leftPendingStates = append(leftPendingStates, stateInfoIndex)
+			continue
+		}
+		if err := k.finalizeStateForIndex(ctx, stateInfoIndex); err != nil {
+			failedRollapps[stateInfoIndex.RollappId] = struct{}{}
+			leftPendingStates = append(leftPendingStates, stateInfoIndex)
+		}
+	}
+
+	if len(leftPendingStates) == 0 {
+		k.RemoveTimeToFinalizationQueue(ctx, queue.FinalizationTime, queue.RollappId)
+		return
+	}
+	queue.FinalizationQueue = leftPendingStates
+	k.SetTimeToFinalizationQueue(ctx, queue)
+}
+
+// deferToTimeQueue moves the state to the time finalization queue if its time-based dispute period
+// is not over yet, or if the previous state of the rollapp is still waiting there.
+// Returns true if the state was moved.
+func (k Keeper) deferToTimeQueue(ctx sdk.Context, stateInfoIndex types.StateInfoIndex) bool {
+	stateInfo, found := k.GetStateInfo(ctx, stateInfoIndex.RollappId, stateInfoIndex.Index)
+	if !found {
+		return false
+	}
+	period := k.RollappDisputePeriodTime(ctx, stateInfoIndex.RollappId)
+	finalizationTime := stateInfo.CreatedAt.Add(period)
+	if !(period > 0 && ctx.BlockTime().Before(finalizationTime)) && !k.isPreviousStatePending(ctx, stateInfoIndex) {
+		return false
+	}
+	k.AppendToTimeToFinalizationQueue(ctx, finalizationTime, stateInfoIndex)
+	return true
+}
+
+// isPreviousStatePending returns true if the state preceding the given one is not finalized yet.
+func (k Keeper) isPreviousStatePending(ctx sdk.Context, stateInfoIndex types.StateInfoIndex) bool {
+	if stateInfoIndex.Index <= 1 {
+		return false
+	}
+	prev, found := k.GetStateInfo(ctx, stateInfoIndex.RollappId, stateInfoIndex.Index-1)
+	return found && prev.Status == common.Status_PENDING
+}
+
+// AppendToTimeToFinalizationQueue adds the state to the time finalization queue of the given time
+func (k Keeper) AppendToTimeToFinalizationQueue(ctx sdk.Context, finalizationTime time.Time, stateInfoIndex types.StateInfoIndex) {
+	queue, found := k.GetTimeToFinalizationQueue(ctx, finalizationTime, stateInfoIndex.RollappId)
+	if !found {
+		queue = types.TimeToFinalizationQueue{FinalizationTime: finalizationTime, RollappId: stateInfoIndex.RollappId}
+	}
+	queue.FinalizationQueue = append(queue.FinalizationQueue, stateInfoIndex)
+	k.SetTimeToFinalizationQueue(ctx, queue)
+}
+
+// SetTimeToFinalizationQueue set a specific timeToFinalizationQueue in the store from its index
+func (k Keeper) SetTimeToFinalizationQueue(ctx sdk.Context, timeToFinalizationQueue types.TimeToFinalizationQueue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimeToFinalizationQueueKeyPrefix))
+	b := k.cdc.MustMarshal(&timeToFinalizationQueue)
+	key := types.TimeToFinalizationQueueKey(
+		timeToFinalizationQueue.FinalizationTime,
+		timeToFinalizationQueue.RollappId,
+	)
+	store.Set(key, b)
+
+	// index the queue by rollapp, the value is the key of the queue
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimeToFinalizationQueueByRollappKeyPrefix))
+	indexStore.Set(types.TimeToFinalizationQueueByRollappKey(
+		timeToFinalizationQueue.RollappId,
+		timeToFinalizationQueue.FinalizationTime,
+	), key)
+}
+
+// GetTimeToFinalizationQueue returns a timeToFinalizationQueue from its index
+func (k Keeper) GetTimeToFinalizationQueue(
+	ctx sdk.Context,
+	finalizationTime time.Time,
+	rollappId string,
+) (val types.TimeToFinalizationQueue, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimeToFinalizationQueueKeyPrefix))
+
+	b := store.Get(types.TimeToFinalizationQueueKey(
+		finalizationTime,
+		rollappId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTimeToFinalizationQueue removes a timeToFinalizationQueue from the store
+func (k Keeper) RemoveTimeToFinalizationQueue(
+	ctx sdk.Context,
+	finalizationTime time.Time,
+	rollappId string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimeToFinalizationQueueKeyPrefix))
+	store.Delete(types.TimeToFinalizationQueueKey(
+		finalizationTime,
+		rollappId,
+	))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimeToFinalizationQueueByRollappKeyPrefix))
+	indexStore.Delete(types.TimeToFinalizationQueueByRollappKey(
+		rollappId,
+		finalizationTime,
+	))
+}
+
+// GetRollappTimeToFinalizationQueue returns all the timeToFinalizationQueues of the rollapp
+func (k Keeper) GetRollappTimeToFinalizationQueue(ctx sdk.Context, rollappId string) (list []types.TimeToFinalizationQueue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimeToFinalizationQueueKeyPrefix))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimeToFinalizationQueueByRollappKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(indexStore, types.TimeToFinalizationQueueByRollappPrefix(rollappId))
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TimeToFinalizationQueue
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllFinalizationQueueUntilTimeInclusive returns all the timeToFinalizationQueues with finalization time equal or before the input time
+func (k Keeper) GetAllFinalizationQueueUntilTimeInclusive(ctx sdk.Context, t time.Time) (list []types.TimeToFinalizationQueue) {
+	return k.getTimeFinalizationQueue(ctx, &t)
+}
+
+// GetAllTimeToFinalizationQueue returns all timeToFinalizationQueue
+func (k Keeper) GetAllTimeToFinalizationQueue(ctx sdk.Context) (list []types.TimeToFinalizationQueue) {
+	return k.getTimeFinalizationQueue(ctx, nil)
+}
+
+func (k Keeper) getTimeFinalizationQueue(ctx sdk.Context, endTimeInclusive *time.Time) (list []types.TimeToFinalizationQueue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimeToFinalizationQueueKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TimeToFinalizationQueue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if endTimeInclusive != nil && val.FinalizationTime.After(*endTimeInclusive) {
+			break
+		}
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (suite *RollappTestSuite) TestFinalizeRollappStatesByTime() {
+	suite.SetupTest()
+	k := suite.App.RollappKeeper
+	k.SetParams(suite.Ctx, k.GetParams(suite.Ctx).WithDisputePeriodTime(time.Hour))
+	disputePeriod := int64(k.DisputePeriodInBlocks(suite.Ctx))
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.Ctx = suite.Ctx.WithBlockHeight(10).WithBlockTime(t0)
+	rollappId, proposer := suite.CreateDefaultRollappAndProposer()
+
+	advance := func(blocks int64, d time.Duration) {
+		suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + blocks).WithBlockTime(suite.Ctx.BlockTime().Add(d))
+		k.FinalizeRollappStates(suite.Ctx)
+	}
+	status := func(index uint64) common.Status {
+		return k.MustGetStateInfo(suite.Ctx, rollappId, index).Status
+	}
+
+	_, err := suite.PostStateUpdate(suite.Ctx, rollappId, proposer, 1, 10)
+	suite.Require().NoError(err)
+
+	// the block dispute period is over, but not the time one: the state moves to the time queue
+	advance(disputePeriod, 10*time.Minute)
+	suite.Require().Equal(common.Status_PENDING, status(1))
+	suite.Require().Empty(k.GetAllBlockHeightToFinalizationQueue(suite.Ctx))
+	suite.Require().Len(k.GetAllTimeToFinalizationQueue(suite.Ctx), 1)
+
+	_, err = suite.PostStateUpdate(suite.Ctx, rollappId, proposer, 11, 10)
+	suite.Require().NoError(err)
+	advance(disputePeriod, 20*time.Minute)
+	suite.Require().Equal(common.Status_PENDING, status(2))
+	suite.Require().Len(k.GetAllTimeToFinalizationQueue(suite.Ctx), 2)
+
+	// the packets of the rollapp are not finalized either
+	err = suite.App.DelayedAckKeeper.VerifyHeightFinalized(suite.Ctx, rollappId, 1)
+	suite.Require().ErrorIs(err, gerrc.ErrNotFound)
+
+	// both dispute periods of the first state are over
+	advance(1, 30*time.Minute)
+	suite.Require().Equal(common.Status_FINALIZED, status(1))
+	suite.Require().Equal(common.Status_PENDING, status(2))
+	suite.Require().NoError(suite.App.DelayedAckKeeper.VerifyHeightFinalized(suite.Ctx, rollappId, 10))
+	suite.Require().Error(suite.App.DelayedAckKeeper.VerifyHeightFinalized(suite.Ctx, rollappId, 11))
+
+	advance(1, 10*time.Minute)
+	suite.Require().Equal(common.Status_FINALIZED, status(2))
+	suite.Require().Empty(k.GetAllTimeToFinalizationQueue(suite.Ctx))
+
+	// the rollapp override takes precedence over the param
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = suite.msgServer.SetRollappDisputePeriodTime(suite.Ctx, &types.MsgSetRollappDisputePeriodTime{
+		Authority:         alice,
+		RollappId:         rollappId,
+		DisputePeriodTime: time.Minute,
+	})
+	suite.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+	_, err = suite.msgServer.SetRollappDisputePeriodTime(suite.Ctx, &types.MsgSetRollappDisputePeriodTime{
+		Authority:         gov,
+		RollappId:         rollappId,
+		DisputePeriodTime: 2 * time.Hour,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(2*time.Hour, k.RollappDisputePeriodTime(suite.Ctx, rollappId))
+
+	_, err = suite.PostStateUpdate(suite.Ctx, rollappId, proposer, 21, 10)
+	suite.Require().NoError(err)
+	advance(disputePeriod, time.Hour)
+	suite.Require().Equal(common.Status_PENDING, status(3))
+
+	// reverting the rollapp states empties the time queue
+	k.RevertPendingStates(suite.Ctx, rollappId)
+	suite.Require().Equal(common.Status_REVERTED, status(3))
+	suite.Require().Empty(k.GetAllTimeToFinalizationQueue(suite.Ctx))
+}
@@ -0,0 +1,36 @@
+package rollapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *keeper.Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *keeper.Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3: the finalization queue is keyed by creation height and rollapp,
+// and the rollapps frozen for running a vulnerable DRS version are flagged as vulnerable. The rollapps frozen
+// for a fraud are not, as they can only be recovered by the governance.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.MigrateBlockHeightToFinalizationQueueByRollapp(ctx)
+
+	for _, rollapp := range m.keeper.GetAllRollapps(ctx) {
+		if !rollapp.Frozen {
+			continue
+		}
+		info, found := m.keeper.GetLatestStateInfo(ctx, rollapp.RollappId)
+		if found && m.keeper.IsDRSVersionVulnerable(ctx, info.DrsVersion) {
+			rollapp.Vulnerable = true
+			m.keeper.SetRollapp(ctx, rollapp)
+		}
+	}
+	return nil
+}
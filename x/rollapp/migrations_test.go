@@ -0,0 +1,89 @@
+package rollapp_test
+
+import (
+	"testing"
+	"time"
+
+	cometbftproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	app := apptesting.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, cometbftproto.Header{Height: 1, ChainID: "dymension_100-1", Time: time.Now().UTC()})
+	k := app.RollappKeeper
+
+	// set the legacy queues, keyed by creation height only
+	legacyQueues := []types.BlockHeightToFinalizationQueue{
+		{
+			CreationHeight: 10,
+			FinalizationQueue: []types.StateInfoIndex{
+				{RollappId: "rollappb_2345-1", Index: 1},
+				{RollappId: "rollappa_1234-1", Index: 1},
+				{RollappId: "rollappb_2345-1", Index: 2},
+			},
+		},
+		{
+			CreationHeight: 11,
+			FinalizationQueue: []types.StateInfoIndex{
+				{RollappId: "rollappa_1234-1", Index: 2},
+			},
+		},
+	}
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(types.StoreKey)), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
+	for _, q := range legacyQueues {
+		store.Set(types.LegacyBlockHeightToFinalizationQueueKey(q.CreationHeight), app.AppCodec().MustMarshal(&q))
+	}
+
+	// set a rollapp frozen for running a vulnerable DRS version, and a rollapp frozen for a fraud
+	err := k.SetVulnerableDRSVersion(ctx, "vulnerable")
+	require.NoError(t, err)
+	for rollappId, drsVersion := range map[string]string{"rollappa_1234-1": "vulnerable", "rollappc_3456-1": "safe"} {
+		k.SetRollapp(ctx, types.Rollapp{RollappId: rollappId, Frozen: true})
+		index := types.StateInfoIndex{RollappId: rollappId, Index: 1}
+		k.SetStateInfo(ctx, types.StateInfo{StateInfoIndex: index, DrsVersion: drsVersion})
+		k.SetLatestStateInfoIndex(ctx, index)
+	}
+	k.SetRollapp(ctx, types.Rollapp{RollappId: "rollappb_2345-1"})
+
+	migrator := rollapp.NewMigrator(k)
+	err = migrator.Migrate2to3(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, []types.BlockHeightToFinalizationQueue{
+		{
+			CreationHeight: 10,
+			RollappId:      "rollappa_1234-1",
+			FinalizationQueue: []types.StateInfoIndex{
+				{RollappId: "rollappa_1234-1", Index: 1},
+			},
+		},
+		{
+			CreationHeight: 10,
+			RollappId:      "rollappb_2345-1",
+			FinalizationQueue: []types.StateInfoIndex{
+				{RollappId: "rollappb_2345-1", Index: 1},
+				{RollappId: "rollappb_2345-1", Index: 2},
+			},
+		},
+		{
+			CreationHeight: 11,
+			RollappId:      "rollappa_1234-1",
+			FinalizationQueue: []types.StateInfoIndex{
+				{RollappId: "rollappa_1234-1", Index: 2},
+			},
+		},
+	}, k.GetAllBlockHeightToFinalizationQueue(ctx))
+
+	require.Len(t, k.GetRollappBlockHeightToFinalizationQueue(ctx, "rollappa_1234-1"), 2)
+	require.Len(t, k.GetRollappBlockHeightToFinalizationQueue(ctx, "rollappb_2345-1"), 1)
+
+	require.True(t, k.MustGetRollapp(ctx, "rollappa_1234-1").IsVulnerable())
+	require.False(t, k.MustGetRollapp(ctx, "rollappb_2345-1").IsVulnerable())
+	require.False(t, k.MustGetRollapp(ctx, "rollappc_3456-1").IsVulnerable())
+}
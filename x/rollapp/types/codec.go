@@ -18,6 +18,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddApp{}, "rollapp/AddApp", nil)
 	cdc.RegisterConcrete(&MsgUpdateApp{}, "rollapp/UpdateApp", nil)
 	cdc.RegisterConcrete(&MsgRemoveApp{}, "rollapp/RemoveApp", nil)
+	cdc.RegisterConcrete(&MsgSubmitFraudProof{}, "rollapp/SubmitFraudProof", nil)
+	cdc.RegisterConcrete(&MsgForkRollapp{}, "rollapp/ForkRollapp", nil)
+	cdc.RegisterConcrete(&MsgRegisterMaintenanceWindow{}, "rollapp/RegisterMaintenanceWindow", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -29,6 +32,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgAddApp{},
 		&MsgUpdateApp{},
 		&MsgRemoveApp{},
+		&MsgSubmitFraudProof{},
+		&MsgForkRollapp{},
+		&MsgRegisterMaintenanceWindow{},
 	)
 	registry.RegisterImplementations((*govtypes.Content)(nil), &SubmitFraudProposal{})
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
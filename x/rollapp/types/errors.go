@@ -54,4 +54,5 @@ var (
 	ErrDisputeAlreadyReverted  = errorsmod.Register(ModuleName, 2001, "disputed height already reverted")
 	ErrWrongClientId           = errorsmod.Register(ModuleName, 2002, "client id does not match the rollapp")
 	ErrWrongProposerAddr       = errorsmod.Register(ModuleName, 2003, "wrong proposer address")
+	ErrInvalidFraudProof       = errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid fraud proof")
 )
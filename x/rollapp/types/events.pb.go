@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -208,11 +213,334 @@ func (m *EventMarkVulnerableRollapps) GetDrsVersions() []string {
 	return nil
 }
 
+type EventRollappRecovered struct {
+	// RollappId is the recovered rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// TrustedHeight is the last finalized height of the rollapp, from which it
+	// resumes.
+	TrustedHeight uint64 `protobuf:"varint,2,opt,name=trusted_height,json=trustedHeight,proto3" json:"trusted_height,omitempty"`
+	// InitialSequencer is the initial sequencer set for the rollapp.
+	InitialSequencer string `protobuf:"bytes,3,opt,name=initial_sequencer,json=initialSequencer,proto3" json:"initial_sequencer,omitempty"`
+}
+
+func (m *EventRollappRecovered) Reset()         { *m = EventRollappRecovered{} }
+func (m *EventRollappRecovered) String() string { return proto.CompactTextString(m) }
+func (*EventRollappRecovered) ProtoMessage()    {}
+func (*EventRollappRecovered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{4}
+}
+func (m *EventRollappRecovered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRollappRecovered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRollappRecovered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRollappRecovered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRollappRecovered.Merge(m, src)
+}
+func (m *EventRollappRecovered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRollappRecovered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRollappRecovered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRollappRecovered proto.InternalMessageInfo
+
+func (m *EventRollappRecovered) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRollappRecovered) GetTrustedHeight() uint64 {
+	if m != nil {
+		return m.TrustedHeight
+	}
+	return 0
+}
+
+func (m *EventRollappRecovered) GetInitialSequencer() string {
+	if m != nil {
+		return m.InitialSequencer
+	}
+	return ""
+}
+
+type EventRollappForked struct {
+	// RollappId is the forked rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// ForkHeight is the height the rollapp resumes from.
+	ForkHeight uint64 `protobuf:"varint,2,opt,name=fork_height,json=forkHeight,proto3" json:"fork_height,omitempty"`
+	// DrsVersion is the DRS version the rollapp resumes with.
+	DrsVersion string `protobuf:"bytes,3,opt,name=drs_version,json=drsVersion,proto3" json:"drs_version,omitempty"`
+}
+
+func (m *EventRollappForked) Reset()         { *m = EventRollappForked{} }
+func (m *EventRollappForked) String() string { return proto.CompactTextString(m) }
+func (*EventRollappForked) ProtoMessage()    {}
+func (*EventRollappForked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{5}
+}
+func (m *EventRollappForked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRollappForked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRollappForked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRollappForked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRollappForked.Merge(m, src)
+}
+func (m *EventRollappForked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRollappForked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRollappForked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRollappForked proto.InternalMessageInfo
+
+func (m *EventRollappForked) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRollappForked) GetForkHeight() uint64 {
+	if m != nil {
+		return m.ForkHeight
+	}
+	return 0
+}
+
+func (m *EventRollappForked) GetDrsVersion() string {
+	if m != nil {
+		return m.DrsVersion
+	}
+	return ""
+}
+
+type EventMaintenanceWindowRegistered struct {
+	// RollappId is the rollapp the window is registered for.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// Window is the registered window.
+	Window MaintenanceWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window"`
+}
+
+func (m *EventMaintenanceWindowRegistered) Reset()         { *m = EventMaintenanceWindowRegistered{} }
+func (m *EventMaintenanceWindowRegistered) String() string { return proto.CompactTextString(m) }
+func (*EventMaintenanceWindowRegistered) ProtoMessage()    {}
+func (*EventMaintenanceWindowRegistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{6}
+}
+func (m *EventMaintenanceWindowRegistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMaintenanceWindowRegistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMaintenanceWindowRegistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMaintenanceWindowRegistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMaintenanceWindowRegistered.Merge(m, src)
+}
+func (m *EventMaintenanceWindowRegistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMaintenanceWindowRegistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMaintenanceWindowRegistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMaintenanceWindowRegistered proto.InternalMessageInfo
+
+func (m *EventMaintenanceWindowRegistered) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventMaintenanceWindowRegistered) GetWindow() MaintenanceWindow {
+	if m != nil {
+		return m.Window
+	}
+	return MaintenanceWindow{}
+}
+
+// EventLivenessEventPostponed is emitted when a liveness event falls within a
+// maintenance window.
+type EventLivenessEventPostponed struct {
+	// RollappId is the rollapp of the liveness event.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// Window is the maintenance window used.
+	Window MaintenanceWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window"`
+	// IsJail indicates whether the postponed event is a jail event.
+	IsJail bool `protobuf:"varint,3,opt,name=is_jail,json=isJail,proto3" json:"is_jail,omitempty"`
+	// HubHeight is the height the event was scheduled at.
+	HubHeight int64 `protobuf:"varint,4,opt,name=hub_height,json=hubHeight,proto3" json:"hub_height,omitempty"`
+	// PostponedHeight is the height the event is postponed to.
+	PostponedHeight int64 `protobuf:"varint,5,opt,name=postponed_height,json=postponedHeight,proto3" json:"postponed_height,omitempty"`
+}
+
+func (m *EventLivenessEventPostponed) Reset()         { *m = EventLivenessEventPostponed{} }
+func (m *EventLivenessEventPostponed) String() string { return proto.CompactTextString(m) }
+func (*EventLivenessEventPostponed) ProtoMessage()    {}
+func (*EventLivenessEventPostponed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{7}
+}
+func (m *EventLivenessEventPostponed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLivenessEventPostponed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLivenessEventPostponed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLivenessEventPostponed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLivenessEventPostponed.Merge(m, src)
+}
+func (m *EventLivenessEventPostponed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLivenessEventPostponed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLivenessEventPostponed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLivenessEventPostponed proto.InternalMessageInfo
+
+func (m *EventLivenessEventPostponed) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventLivenessEventPostponed) GetWindow() MaintenanceWindow {
+	if m != nil {
+		return m.Window
+	}
+	return MaintenanceWindow{}
+}
+
+func (m *EventLivenessEventPostponed) GetIsJail() bool {
+	if m != nil {
+		return m.IsJail
+	}
+	return false
+}
+
+func (m *EventLivenessEventPostponed) GetHubHeight() int64 {
+	if m != nil {
+		return m.HubHeight
+	}
+	return 0
+}
+
+func (m *EventLivenessEventPostponed) GetPostponedHeight() int64 {
+	if m != nil {
+		return m.PostponedHeight
+	}
+	return 0
+}
+
+type EventRollappDisputePeriodTimeSet struct {
+	// RollappId is the rollapp the dispute period was set for.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// DisputePeriodTime is the new dispute period of the rollapp.
+	DisputePeriodTime time.Duration `protobuf:"bytes,2,opt,name=dispute_period_time,json=disputePeriodTime,proto3,stdduration" json:"dispute_period_time"`
+}
+
+func (m *EventRollappDisputePeriodTimeSet) Reset()         { *m = EventRollappDisputePeriodTimeSet{} }
+func (m *EventRollappDisputePeriodTimeSet) String() string { return proto.CompactTextString(m) }
+func (*EventRollappDisputePeriodTimeSet) ProtoMessage()    {}
+func (*EventRollappDisputePeriodTimeSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{8}
+}
+func (m *EventRollappDisputePeriodTimeSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRollappDisputePeriodTimeSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRollappDisputePeriodTimeSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRollappDisputePeriodTimeSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRollappDisputePeriodTimeSet.Merge(m, src)
+}
+func (m *EventRollappDisputePeriodTimeSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRollappDisputePeriodTimeSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRollappDisputePeriodTimeSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRollappDisputePeriodTimeSet proto.InternalMessageInfo
+
+func (m *EventRollappDisputePeriodTimeSet) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRollappDisputePeriodTimeSet) GetDisputePeriodTime() time.Duration {
+	if m != nil {
+		return m.DisputePeriodTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventAppAdded)(nil), "dymensionxyz.dymension.rollapp.EventAppAdded")
 	proto.RegisterType((*EventAppUpdated)(nil), "dymensionxyz.dymension.rollapp.EventAppUpdated")
 	proto.RegisterType((*EventAppRemoved)(nil), "dymensionxyz.dymension.rollapp.EventAppRemoved")
 	proto.RegisterType((*EventMarkVulnerableRollapps)(nil), "dymensionxyz.dymension.rollapp.EventMarkVulnerableRollapps")
+	proto.RegisterType((*EventRollappRecovered)(nil), "dymensionxyz.dymension.rollapp.EventRollappRecovered")
+	proto.RegisterType((*EventRollappForked)(nil), "dymensionxyz.dymension.rollapp.EventRollappForked")
+	proto.RegisterType((*EventMaintenanceWindowRegistered)(nil), "dymensionxyz.dymension.rollapp.EventMaintenanceWindowRegistered")
+	proto.RegisterType((*EventLivenessEventPostponed)(nil), "dymensionxyz.dymension.rollapp.EventLivenessEventPostponed")
+	proto.RegisterType((*EventRollappDisputePeriodTimeSet)(nil), "dymensionxyz.dymension.rollapp.EventRollappDisputePeriodTimeSet")
 }
 
 func init() {
@@ -220,25 +548,46 @@ func init() {
 }
 
 var fileDescriptor_e0f74405c12dec3c = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0xf2, 0x73, 0x72,
-	0x12, 0x0b, 0x0a, 0xf4, 0x53, 0xcb, 0x52, 0xf3, 0x4a, 0x8a, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xe4, 0x90, 0x15, 0xeb, 0xc1, 0x39, 0x7a, 0x50, 0xc5, 0x52, 0x1a, 0x04, 0x0c, 0x4b, 0x2c,
-	0x28, 0x80, 0x98, 0xa4, 0xe4, 0xc6, 0xc5, 0xeb, 0x0a, 0x32, 0xd9, 0xb1, 0xa0, 0xc0, 0x31, 0x25,
-	0x25, 0x35, 0x45, 0xc8, 0x94, 0x8b, 0x39, 0xb1, 0xa0, 0x40, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x59, 0x0f, 0xbf, 0x45, 0x7a, 0x8e, 0x05, 0x05, 0x41, 0x20, 0xf5, 0x4a, 0x1e, 0x5c, 0xfc,
-	0x30, 0x73, 0x42, 0x0b, 0x52, 0x12, 0x4b, 0xa8, 0x62, 0x52, 0x50, 0x6a, 0x6e, 0x7e, 0x19, 0xf9,
-	0x26, 0x95, 0x71, 0x49, 0x83, 0x4d, 0xf2, 0x4d, 0x2c, 0xca, 0x0e, 0x2b, 0xcd, 0xc9, 0x4b, 0x2d,
-	0x4a, 0x4c, 0xca, 0x49, 0x0d, 0x82, 0x28, 0x2b, 0x16, 0x32, 0xe1, 0x12, 0x2b, 0x83, 0x8b, 0xc6,
-	0x43, 0x75, 0xc7, 0xe7, 0x95, 0xe6, 0x82, 0x2d, 0x62, 0x09, 0x12, 0x29, 0x43, 0xd7, 0xe3, 0x57,
-	0x9a, 0x2b, 0xa4, 0xc8, 0xc5, 0x93, 0x52, 0x54, 0x1c, 0x5f, 0x96, 0x5a, 0x04, 0xb2, 0xb4, 0x58,
-	0x82, 0x49, 0x81, 0x59, 0x83, 0x33, 0x88, 0x3b, 0xa5, 0xa8, 0x38, 0x0c, 0x2a, 0xe4, 0xe4, 0x77,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x26, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x38, 0xa2, 0xa8, 0xcc, 0x58, 0xbf, 0x02, 0x1e, 0x4f, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xa8, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xbc,
-	0x3d, 0xfa, 0x75, 0x23, 0x02, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xd1, 0x4e, 0xd4, 0x4a,
+	0x18, 0xde, 0xb2, 0x1c, 0x0e, 0x3b, 0x7b, 0x38, 0x40, 0x0f, 0x47, 0x57, 0x8c, 0xdd, 0xb5, 0xc6,
+	0x64, 0x0d, 0xa6, 0x8d, 0x80, 0x0f, 0x00, 0x41, 0x82, 0x46, 0x91, 0x14, 0xc5, 0xc4, 0x9b, 0xa6,
+	0xbb, 0xf3, 0xd3, 0x1d, 0x69, 0x67, 0xc6, 0x99, 0x69, 0x01, 0x1f, 0xc1, 0x2b, 0xe3, 0x85, 0xf1,
+	0x91, 0xb8, 0xe4, 0xd2, 0x2b, 0x35, 0xf0, 0x02, 0x3e, 0x82, 0xe9, 0x74, 0xba, 0x22, 0x46, 0xd7,
+	0x18, 0xe3, 0xd5, 0xee, 0xff, 0xcf, 0xf7, 0xfd, 0xdf, 0x37, 0xdf, 0x34, 0x3f, 0x5a, 0xc0, 0x87,
+	0x29, 0x50, 0x49, 0x18, 0x3d, 0x38, 0x7c, 0xe1, 0x0f, 0x0b, 0x5f, 0xb0, 0x24, 0x89, 0x38, 0xf7,
+	0x21, 0x07, 0xaa, 0xa4, 0xc7, 0x05, 0x53, 0xcc, 0x76, 0xce, 0x82, 0xbd, 0x61, 0xe1, 0x19, 0xf0,
+	0xfc, 0x5c, 0xcc, 0x62, 0xa6, 0xa1, 0x7e, 0xf1, 0xaf, 0x64, 0xcd, 0x3b, 0x31, 0x63, 0x71, 0x02,
+	0xbe, 0xae, 0x7a, 0xd9, 0xae, 0x8f, 0x33, 0x11, 0xa9, 0x82, 0x57, 0x9e, 0x77, 0x47, 0x58, 0x88,
+	0x38, 0x37, 0xc8, 0x9b, 0x23, 0x90, 0xe6, 0xb7, 0x44, 0xbb, 0xeb, 0x68, 0xea, 0x4e, 0xe1, 0x7e,
+	0x85, 0xf3, 0x15, 0x8c, 0x01, 0xdb, 0xb7, 0x51, 0x3d, 0xe2, 0xbc, 0x65, 0x75, 0xac, 0x6e, 0x73,
+	0xf1, 0x9a, 0xf7, 0xe3, 0xcb, 0x78, 0x2b, 0x9c, 0x07, 0x05, 0xde, 0xdd, 0x40, 0xd3, 0xd5, 0x9c,
+	0xc7, 0x1c, 0x47, 0xea, 0xb7, 0x4c, 0x0a, 0x20, 0x65, 0xf9, 0xaf, 0x4f, 0xca, 0xd1, 0x65, 0x3d,
+	0xe9, 0x41, 0x24, 0xf6, 0x76, 0xb2, 0x84, 0x82, 0x88, 0x7a, 0x09, 0x04, 0x25, 0x4c, 0xda, 0xcb,
+	0xe8, 0x42, 0x3e, 0xec, 0x86, 0x86, 0x1d, 0xd2, 0x2c, 0xd5, 0x42, 0xe3, 0xc1, 0x5c, 0x7e, 0x9e,
+	0xb3, 0x99, 0xa5, 0xf6, 0x55, 0xf4, 0x0f, 0x16, 0x32, 0xcc, 0x41, 0x14, 0xa2, 0xb2, 0x35, 0xd6,
+	0xa9, 0x77, 0x1b, 0x41, 0x13, 0x0b, 0xb9, 0x63, 0x5a, 0xee, 0x4b, 0x0b, 0xfd, 0xaf, 0x85, 0x0d,
+	0x2d, 0x80, 0x3e, 0xcb, 0x41, 0x00, 0xb6, 0xaf, 0x20, 0x54, 0xe9, 0x10, 0xac, 0x65, 0x1a, 0x41,
+	0xc3, 0x74, 0xee, 0x62, 0xfb, 0x3a, 0xfa, 0x57, 0x89, 0x4c, 0x2a, 0xc0, 0xe1, 0x00, 0x48, 0x3c,
+	0x50, 0xad, 0x31, 0xed, 0x64, 0xca, 0x74, 0x37, 0x74, 0xd3, 0x5e, 0x40, 0xb3, 0x84, 0x12, 0x45,
+	0xa2, 0x24, 0x94, 0xf0, 0x3c, 0x03, 0xda, 0x07, 0xd1, 0xaa, 0xeb, 0x61, 0x33, 0xe6, 0x60, 0xbb,
+	0xea, 0xbb, 0x19, 0xb2, 0xcf, 0x7a, 0x59, 0x67, 0x62, 0x6f, 0xb4, 0x91, 0x36, 0x6a, 0xee, 0x32,
+	0xb1, 0xf7, 0xb5, 0x0b, 0x54, 0xb4, 0x8c, 0x85, 0x36, 0x6a, 0x9e, 0x49, 0xc1, 0x88, 0xa3, 0x2f,
+	0x21, 0xb8, 0xaf, 0x2d, 0xd4, 0x31, 0xe1, 0x13, 0xaa, 0x80, 0x46, 0xb4, 0x0f, 0x4f, 0x08, 0xc5,
+	0x6c, 0x3f, 0x80, 0x98, 0x48, 0xf5, 0x33, 0x71, 0x3c, 0x44, 0x13, 0xfb, 0x9a, 0xa2, 0x0d, 0x34,
+	0x17, 0x6f, 0x8d, 0x7a, 0xf9, 0x6f, 0xb4, 0x56, 0xc7, 0x8f, 0xde, 0xb7, 0x6b, 0x81, 0x19, 0xe3,
+	0x7e, 0xb2, 0xcc, 0x17, 0x71, 0x9f, 0xe4, 0x40, 0x41, 0x4a, 0x5d, 0x6c, 0x31, 0xa9, 0x38, 0xa3,
+	0x7f, 0xde, 0x8f, 0x7d, 0x11, 0xfd, 0x4d, 0x64, 0xf8, 0x2c, 0x22, 0x89, 0x4e, 0x70, 0x32, 0x98,
+	0x20, 0xf2, 0x5e, 0x44, 0x92, 0xc2, 0xc8, 0x20, 0xeb, 0x55, 0xf1, 0x8f, 0x77, 0xac, 0x6e, 0x3d,
+	0x68, 0x0c, 0xb2, 0x9e, 0x49, 0xff, 0x06, 0x9a, 0xe1, 0x95, 0xe9, 0x0a, 0xf4, 0x97, 0x06, 0x4d,
+	0x0f, 0xfb, 0x25, 0xd4, 0x7d, 0x53, 0xbd, 0x83, 0x79, 0xff, 0x35, 0x22, 0x79, 0xa6, 0x60, 0x0b,
+	0x04, 0x61, 0xf8, 0x11, 0x49, 0x61, 0x1b, 0xd4, 0xa8, 0x7b, 0x6f, 0xa3, 0xff, 0x70, 0x49, 0x0b,
+	0xb9, 0xe6, 0x85, 0x8a, 0xa4, 0x60, 0x42, 0xb8, 0xe4, 0x95, 0x9b, 0xcb, 0xab, 0x36, 0x97, 0xb7,
+	0x66, 0x36, 0xd7, 0xea, 0x64, 0x71, 0xd9, 0xb7, 0x1f, 0xda, 0x56, 0x30, 0x8b, 0xcf, 0xcb, 0xae,
+	0x6e, 0x1e, 0x9d, 0x38, 0xd6, 0xf1, 0x89, 0x63, 0x7d, 0x3c, 0x71, 0xac, 0x57, 0xa7, 0x4e, 0xed,
+	0xf8, 0xd4, 0xa9, 0xbd, 0x3b, 0x75, 0x6a, 0x4f, 0x97, 0x63, 0xa2, 0x06, 0x59, 0xcf, 0xeb, 0xb3,
+	0xd4, 0xff, 0xce, 0x2e, 0xcb, 0x97, 0xfc, 0x83, 0xe1, 0x42, 0x53, 0x87, 0x1c, 0x64, 0x6f, 0x42,
+	0xeb, 0x2f, 0x7d, 0x1e, 0x00, 0x69, 0x12, 0x5f, 0x70, 0xac, 0x05, 0x00, 0x00,
 }
 
 func (m *EventAppAdded) Marshal() (dAtA []byte, err error) {
@@ -383,64 +732,286 @@ func (m *EventMarkVulnerableRollapps) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventRollappRecovered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventAppAdded) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.App != nil {
-		l = m.App.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *EventRollappRecovered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAppUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventRollappRecovered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.App != nil {
-		l = m.App.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.InitialSequencer) > 0 {
+		i -= len(m.InitialSequencer)
+		copy(dAtA[i:], m.InitialSequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InitialSequencer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.TrustedHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TrustedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAppRemoved) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRollappForked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRollappForked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRollappForked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.App != nil {
-		l = m.App.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.DrsVersion) > 0 {
+		i -= len(m.DrsVersion)
+		copy(dAtA[i:], m.DrsVersion)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DrsVersion)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.ForkHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForkHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarkVulnerableRollapps) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMaintenanceWindowRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.VulnerableRollappNum != 0 {
-		n += 1 + sovEvents(uint64(m.VulnerableRollappNum))
+	return dAtA[:n], nil
+}
+
+func (m *EventMaintenanceWindowRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMaintenanceWindowRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLivenessEventPostponed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLivenessEventPostponed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLivenessEventPostponed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PostponedHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PostponedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HubHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HubHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsJail {
+		i--
+		if m.IsJail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRollappDisputePeriodTimeSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRollappDisputePeriodTimeSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRollappDisputePeriodTimeSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DisputePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriodTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintEvents(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventAppAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.App != nil {
+		l = m.App.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAppUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.App != nil {
+		l = m.App.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAppRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.App != nil {
+		l = m.App.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarkVulnerableRollapps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VulnerableRollappNum != 0 {
+		n += 1 + sovEvents(uint64(m.VulnerableRollappNum))
 	}
 	if len(m.DrsVersions) > 0 {
 		for _, s := range m.DrsVersions {
@@ -448,16 +1019,602 @@ func (m *EventMarkVulnerableRollapps) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventRollappRecovered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TrustedHeight != 0 {
+		n += 1 + sovEvents(uint64(m.TrustedHeight))
+	}
+	l = len(m.InitialSequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRollappForked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ForkHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ForkHeight))
+	}
+	l = len(m.DrsVersion)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMaintenanceWindowRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Window.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventLivenessEventPostponed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Window.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.IsJail {
+		n += 2
+	}
+	if m.HubHeight != 0 {
+		n += 1 + sovEvents(uint64(m.HubHeight))
+	}
+	if m.PostponedHeight != 0 {
+		n += 1 + sovEvents(uint64(m.PostponedHeight))
+	}
+	return n
+}
+
+func (m *EventRollappDisputePeriodTimeSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriodTime)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &App{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &App{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &App{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkVulnerableRollapps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkVulnerableRollapps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VulnerableRollappNum", wireType)
+			}
+			m.VulnerableRollappNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VulnerableRollappNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrsVersions = append(m.DrsVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRollappRecovered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRollappRecovered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRollappRecovered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedHeight", wireType)
+			}
+			m.TrustedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrustedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
+func (m *EventRollappForked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -480,17 +1637,17 @@ func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAppAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRollappForked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAppAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRollappForked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -500,27 +1657,74 @@ func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &App{}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkHeight", wireType)
 			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ForkHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForkHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.DrsVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -543,7 +1747,7 @@ func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventMaintenanceWindowRegistered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -566,15 +1770,47 @@ func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAppUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMaintenanceWindowRegistered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAppUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMaintenanceWindowRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -601,10 +1837,7 @@ func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &App{}
-			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -629,7 +1862,7 @@ func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
+func (m *EventLivenessEventPostponed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -652,15 +1885,47 @@ func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAppRemoved: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLivenessEventPostponed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAppRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLivenessEventPostponed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -687,13 +1952,68 @@ func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &App{}
-			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJail = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubHeight", wireType)
+			}
+			m.HubHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HubHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostponedHeight", wireType)
+			}
+			m.PostponedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostponedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -715,7 +2035,7 @@ func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
+func (m *EventRollappDisputePeriodTimeSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -738,17 +2058,17 @@ func (m *EventMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkVulnerableRollapps: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRollappDisputePeriodTimeSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkVulnerableRollapps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRollappDisputePeriodTimeSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VulnerableRollappNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			m.VulnerableRollappNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -758,16 +2078,29 @@ func (m *EventMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VulnerableRollappNum |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -777,23 +2110,24 @@ func (m *EventMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrsVersions = append(m.DrsVersions, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DisputePeriodTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,6 +3,7 @@ package types
 import (
 	"time"
 
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
@@ -20,6 +21,8 @@ type SequencerKeeper interface {
 	SlashLiveness(ctx sdk.Context, rollappID string) error
 	JailLiveness(ctx sdk.Context, rollappID string) error
 	UnbondingTime(ctx sdk.Context) (res time.Duration)
+	GetSequencerCometPubKey(ctx sdk.Context, sequencerAddress string) (tmprotocrypto.PublicKey, error)
+	ProposerBond(ctx sdk.Context, rollappId string) (proposer string, bond sdk.Coins, found bool)
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
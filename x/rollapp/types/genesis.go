@@ -15,6 +15,7 @@ func DefaultGenesis() *GenesisState {
 		LatestStateInfoIndexList:           []StateInfoIndex{},
 		LatestFinalizedStateIndexList:      []StateInfoIndex{},
 		BlockHeightToFinalizationQueueList: []BlockHeightToFinalizationQueue{},
+		TimeToFinalizationQueueList:        []TimeToFinalizationQueue{},
 		AppList:                            []App{},
 		Params:                             DefaultParams(),
 	}
@@ -67,13 +68,24 @@ func (gs GenesisState) Validate() error {
 	blockHeightToFinalizationQueueIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.BlockHeightToFinalizationQueueList {
-		index := string(BlockHeightToFinalizationQueueKey(elem.CreationHeight))
+		index := string(BlockHeightToFinalizationQueueKey(elem.CreationHeight, elem.RollappId))
 		if _, ok := blockHeightToFinalizationQueueIndexMap[index]; ok {
 			return errors.New("duplicated index for blockHeightToFinalizationQueue")
 		}
 		blockHeightToFinalizationQueueIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated index in timeToFinalizationQueue
+	timeToFinalizationQueueIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TimeToFinalizationQueueList {
+		index := string(TimeToFinalizationQueueKey(elem.FinalizationTime, elem.RollappId))
+		if _, ok := timeToFinalizationQueueIndexMap[index]; ok {
+			return errors.New("duplicated index for timeToFinalizationQueue")
+		}
+		timeToFinalizationQueueIndexMap[index] = struct{}{}
+	}
+
 	// Check for duplicated index in app
 	appIndexMap := make(map[string]struct{})
 
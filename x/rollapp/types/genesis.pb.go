@@ -32,8 +32,9 @@ type GenesisState struct {
 	LatestFinalizedStateIndexList      []StateInfoIndex                 `protobuf:"bytes,5,rep,name=latestFinalizedStateIndexList,proto3" json:"latestFinalizedStateIndexList"`
 	BlockHeightToFinalizationQueueList []BlockHeightToFinalizationQueue `protobuf:"bytes,6,rep,name=blockHeightToFinalizationQueueList,proto3" json:"blockHeightToFinalizationQueueList"`
 	// LivenessEvents are scheduled upcoming liveness events
-	LivenessEvents []LivenessEvent `protobuf:"bytes,7,rep,name=livenessEvents,proto3" json:"livenessEvents"`
-	AppList        []App           `protobuf:"bytes,8,rep,name=appList,proto3" json:"appList"`
+	LivenessEvents              []LivenessEvent           `protobuf:"bytes,7,rep,name=livenessEvents,proto3" json:"livenessEvents"`
+	AppList                     []App                     `protobuf:"bytes,8,rep,name=appList,proto3" json:"appList"`
+	TimeToFinalizationQueueList []TimeToFinalizationQueue `protobuf:"bytes,9,rep,name=timeToFinalizationQueueList,proto3" json:"timeToFinalizationQueueList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +126,13 @@ func (m *GenesisState) GetAppList() []App {
 	return nil
 }
 
+func (m *GenesisState) GetTimeToFinalizationQueueList() []TimeToFinalizationQueue {
+	if m != nil {
+		return m.TimeToFinalizationQueueList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.rollapp.GenesisState")
 }
@@ -134,35 +142,37 @@ func init() {
 }
 
 var fileDescriptor_b76890aebc09aa04 = []byte{
-	// 448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xda, 0x30,
-	0x00, 0xc6, 0x93, 0xc1, 0xc2, 0x64, 0xb6, 0x1d, 0xac, 0x1d, 0x22, 0xa4, 0x65, 0x88, 0x49, 0x1b,
-	0xd3, 0x46, 0x22, 0xc1, 0x76, 0x9d, 0x34, 0xf6, 0x17, 0x09, 0x6d, 0x2b, 0xb4, 0x97, 0xf6, 0x80,
-	0x0c, 0x98, 0x60, 0x35, 0xd8, 0x51, 0x6c, 0x10, 0xf0, 0x14, 0x3d, 0xf4, 0x0d, 0xfa, 0x32, 0x1c,
-	0x39, 0xf6, 0x54, 0x55, 0xf0, 0x22, 0x15, 0x8e, 0x13, 0xd1, 0x4a, 0x60, 0xa4, 0x9e, 0x1c, 0xcb,
-	0xdf, 0xf7, 0xfb, 0x3e, 0x5b, 0x76, 0xc0, 0xa7, 0xfe, 0x6c, 0x84, 0x29, 0x27, 0x8c, 0x4e, 0x67,
-	0x73, 0x2f, 0x9d, 0x78, 0x11, 0x0b, 0x02, 0x14, 0x86, 0x9e, 0x8f, 0x29, 0xe6, 0x84, 0xbb, 0x61,
-	0xc4, 0x04, 0x83, 0xce, 0xb6, 0xda, 0x4d, 0x27, 0xae, 0x52, 0x17, 0x5e, 0xf9, 0xcc, 0x67, 0x52,
-	0xea, 0x6d, 0xbe, 0x62, 0x57, 0xe1, 0xa3, 0x26, 0x23, 0x44, 0x11, 0x1a, 0xa9, 0x88, 0x82, 0xae,
-	0x90, 0x1a, 0x95, 0xda, 0xd3, 0xa8, 0xb9, 0x40, 0x02, 0x77, 0x08, 0x1d, 0x24, 0x5d, 0xbe, 0x1c,
-	0xb6, 0xdf, 0x8e, 0x88, 0x10, 0xe5, 0x03, 0x1c, 0x29, 0x5b, 0x45, 0x63, 0x0b, 0xc8, 0x64, 0x63,
-	0x4c, 0x36, 0x51, 0xd6, 0xc8, 0xd3, 0x0d, 0x94, 0xae, 0x2c, 0xf0, 0xfc, 0x77, 0x9c, 0xd9, 0xde,
-	0x74, 0x85, 0x3f, 0x80, 0x15, 0x9f, 0x87, 0x6d, 0x16, 0xcd, 0x72, 0xbe, 0xfa, 0xce, 0xdd, 0x7f,
-	0xe6, 0xee, 0x7f, 0xa9, 0xae, 0x67, 0x17, 0x37, 0x6f, 0x8c, 0x96, 0xf2, 0xc2, 0x7f, 0x20, 0xaf,
-	0xd6, 0x9b, 0x84, 0x0b, 0xfb, 0x49, 0x31, 0x53, 0xce, 0x57, 0xdf, 0xeb, 0x50, 0xad, 0x78, 0x54,
-	0xac, 0x6d, 0x02, 0x3c, 0x01, 0x2f, 0xe4, 0x59, 0x36, 0xe8, 0x80, 0x49, 0x64, 0x46, 0x22, 0x3f,
-	0xe8, 0x90, 0xed, 0xc4, 0xa4, 0xa0, 0xf7, 0x29, 0x30, 0x04, 0x76, 0x80, 0x04, 0xe6, 0x22, 0xd5,
-	0x35, 0x68, 0x1f, 0x4f, 0x65, 0x42, 0x56, 0x26, 0xb8, 0x07, 0x27, 0x48, 0xa7, 0x8a, 0xd9, 0x49,
-	0x85, 0x73, 0xf0, 0x3a, 0x5e, 0xfb, 0x45, 0x28, 0x0a, 0xc8, 0x1c, 0xf7, 0x95, 0x28, 0x89, 0x7d,
-	0xfa, 0x88, 0xd8, 0xfd, 0x68, 0x78, 0x69, 0x82, 0x52, 0x37, 0x60, 0xbd, 0xf3, 0x3f, 0x98, 0xf8,
-	0x43, 0x71, 0xcc, 0x94, 0x10, 0x09, 0xc2, 0xe8, 0xd1, 0x18, 0x8f, 0xb1, 0x6c, 0x60, 0xc9, 0x06,
-	0x5f, 0x75, 0x0d, 0xea, 0x7b, 0x49, 0xaa, 0xd1, 0x01, 0x79, 0xf0, 0x0c, 0xbc, 0x4c, 0xee, 0xef,
-	0xcf, 0x09, 0xa6, 0x82, 0xdb, 0x39, 0xd9, 0xa0, 0xa2, 0x6b, 0xd0, 0xdc, 0x76, 0xa9, 0xc0, 0x07,
-	0x28, 0xf8, 0x1d, 0xe4, 0x92, 0x5b, 0xf8, 0x4c, 0x52, 0xdf, 0xea, 0xa8, 0xdf, 0xd2, 0x1b, 0x98,
-	0x38, 0xeb, 0x7f, 0x17, 0x2b, 0xc7, 0x5c, 0xae, 0x1c, 0xf3, 0x76, 0xe5, 0x98, 0x17, 0x6b, 0xc7,
-	0x58, 0xae, 0x1d, 0xe3, 0x7a, 0xed, 0x18, 0xa7, 0x9f, 0x7d, 0x22, 0x86, 0xe3, 0xae, 0xdb, 0x63,
-	0xa3, 0x5d, 0xff, 0x82, 0x49, 0xcd, 0x9b, 0xa6, 0x2f, 0x4f, 0xcc, 0x42, 0xcc, 0xbb, 0x96, 0x7c,
-	0x7c, 0xb5, 0xbb, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x26, 0x79, 0x86, 0xfe, 0x04, 0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcd, 0xae, 0x12, 0x31,
+	0x1c, 0xc5, 0x67, 0x04, 0x41, 0x8b, 0xba, 0x68, 0x5c, 0x4c, 0x30, 0x8e, 0x04, 0x13, 0xc5, 0x28,
+	0x33, 0x09, 0x68, 0xdc, 0x99, 0x88, 0x9f, 0x24, 0xc4, 0x0f, 0xc0, 0x8d, 0x2e, 0x48, 0x81, 0x32,
+	0x34, 0xce, 0xb4, 0x93, 0x69, 0x21, 0xc0, 0xc6, 0x57, 0x70, 0xe1, 0x23, 0xf8, 0x30, 0x2c, 0x59,
+	0xba, 0x32, 0x06, 0x5e, 0xc4, 0xd0, 0xe9, 0x4c, 0xb8, 0x37, 0x97, 0x29, 0xc9, 0x5d, 0x0d, 0x4d,
+	0xcf, 0xf9, 0x9d, 0xd3, 0x7f, 0x5a, 0xc0, 0x93, 0xf1, 0x32, 0xc0, 0x94, 0x13, 0x46, 0x17, 0xcb,
+	0x95, 0x9b, 0x2e, 0xdc, 0x88, 0xf9, 0x3e, 0x0a, 0x43, 0xd7, 0xc3, 0x14, 0x73, 0xc2, 0x9d, 0x30,
+	0x62, 0x82, 0x41, 0xfb, 0x50, 0xed, 0xa4, 0x0b, 0x47, 0xa9, 0xcb, 0xb7, 0x3d, 0xe6, 0x31, 0x29,
+	0x75, 0xf7, 0xbf, 0x62, 0x57, 0xf9, 0xb1, 0x26, 0x23, 0x44, 0x11, 0x0a, 0x54, 0x44, 0x59, 0x57,
+	0x48, 0x7d, 0x95, 0xda, 0xd5, 0xa8, 0xb9, 0x40, 0x02, 0x0f, 0x08, 0x9d, 0x24, 0x5d, 0x9e, 0x9d,
+	0x76, 0xde, 0x81, 0x88, 0x10, 0xe5, 0x13, 0x1c, 0x29, 0x5b, 0x5d, 0x63, 0xf3, 0xc9, 0x7c, 0x6f,
+	0x4c, 0x0e, 0x51, 0xd3, 0xc8, 0xd3, 0x03, 0x54, 0x7f, 0x17, 0xc1, 0x8d, 0x77, 0x71, 0x66, 0x6f,
+	0xdf, 0x15, 0xbe, 0x06, 0x85, 0x78, 0x1e, 0x96, 0x59, 0x31, 0x6b, 0xa5, 0xc6, 0x03, 0x27, 0x7b,
+	0xe6, 0xce, 0x27, 0xa9, 0x6e, 0xe5, 0xd7, 0x7f, 0xef, 0x19, 0x5d, 0xe5, 0x85, 0x1f, 0x41, 0x49,
+	0xed, 0x77, 0x08, 0x17, 0xd6, 0x95, 0x4a, 0xae, 0x56, 0x6a, 0x3c, 0xd4, 0xa1, 0xba, 0xf1, 0x57,
+	0xb1, 0x0e, 0x09, 0xf0, 0x0b, 0xb8, 0x29, 0x67, 0xd9, 0xa6, 0x13, 0x26, 0x91, 0x39, 0x89, 0x7c,
+	0xa4, 0x43, 0xf6, 0x12, 0x93, 0x82, 0x9e, 0xa5, 0xc0, 0x10, 0x58, 0x3e, 0x12, 0x98, 0x8b, 0x54,
+	0xd7, 0xa6, 0x63, 0xbc, 0x90, 0x09, 0x79, 0x99, 0xe0, 0x9c, 0x9c, 0x20, 0x9d, 0x2a, 0xe6, 0x28,
+	0x15, 0xae, 0xc0, 0xdd, 0x78, 0xef, 0x2d, 0xa1, 0xc8, 0x27, 0x2b, 0x3c, 0x56, 0xa2, 0x24, 0xf6,
+	0xea, 0x25, 0x62, 0xb3, 0xd1, 0xf0, 0x97, 0x09, 0xaa, 0x43, 0x9f, 0x8d, 0xbe, 0xbf, 0xc7, 0xc4,
+	0x9b, 0x8a, 0x3e, 0x53, 0x42, 0x24, 0x08, 0xa3, 0x9f, 0x67, 0x78, 0x86, 0x65, 0x83, 0x82, 0x6c,
+	0xf0, 0x42, 0xd7, 0xa0, 0x95, 0x49, 0x52, 0x8d, 0x4e, 0xc8, 0x83, 0xdf, 0xc0, 0xad, 0xe4, 0xfe,
+	0xbe, 0x99, 0x63, 0x2a, 0xb8, 0x55, 0x94, 0x0d, 0xea, 0xba, 0x06, 0x9d, 0x43, 0x97, 0x0a, 0x3c,
+	0x87, 0x82, 0xaf, 0x40, 0x31, 0xb9, 0x85, 0xd7, 0x24, 0xf5, 0xbe, 0x8e, 0xfa, 0x32, 0xbd, 0x81,
+	0x89, 0x13, 0xfe, 0x00, 0x77, 0x04, 0x09, 0xf0, 0xb1, 0x81, 0x5d, 0x97, 0xe0, 0xe7, 0x3a, 0x70,
+	0xff, 0x62, 0x84, 0x0a, 0xcb, 0x4a, 0x68, 0x7d, 0x58, 0x6f, 0x6d, 0x73, 0xb3, 0xb5, 0xcd, 0x7f,
+	0x5b, 0xdb, 0xfc, 0xb9, 0xb3, 0x8d, 0xcd, 0xce, 0x36, 0xfe, 0xec, 0x6c, 0xe3, 0xeb, 0x53, 0x8f,
+	0x88, 0xe9, 0x6c, 0xe8, 0x8c, 0x58, 0x70, 0xec, 0xcf, 0x68, 0xde, 0x74, 0x17, 0xe9, 0xd3, 0x17,
+	0xcb, 0x10, 0xf3, 0x61, 0x41, 0xbe, 0xfe, 0xe6, 0xff, 0x01, 0x00, 0xce, 0xa2, 0x97, 0xd0, 0x7f,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +195,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TimeToFinalizationQueueList) > 0 {
+		for iNdEx := len(m.TimeToFinalizationQueueList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeToFinalizationQueueList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.AppList) > 0 {
 		for iNdEx := len(m.AppList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -357,6 +381,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TimeToFinalizationQueueList) > 0 {
+		for _, e := range m.TimeToFinalizationQueueList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -666,6 +696,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToFinalizationQueueList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeToFinalizationQueueList = append(m.TimeToFinalizationQueueList, TimeToFinalizationQueue{})
+			if err := m.TimeToFinalizationQueueList[len(m.TimeToFinalizationQueueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -14,7 +14,8 @@ type RollappHooks interface {
 	BeforeUpdateState(ctx sdk.Context, seqAddr, rollappId string, lastStateUpdateBySequencer bool) error // Must be called when a rollapp's state changes
 	AfterUpdateState(ctx sdk.Context, rollappID string, stateInfo *StateInfo) error                      // Must be called when a rollapp's state changes
 	AfterStateFinalized(ctx sdk.Context, rollappID string, stateInfo *StateInfo) error                   // Must be called when a rollapp's state changes
-	FraudSubmitted(ctx sdk.Context, rollappID string, height uint64, seqAddr string) error
+	FraudSubmitted(ctx sdk.Context, rollappID string, height uint64, seqAddr, reporter string) error
+	RollappRecovered(ctx sdk.Context, rollappID string) error // Must be called when a frozen rollapp is recovered
 	RollappCreated(ctx sdk.Context, rollappID, alias string, creator sdk.AccAddress) error
 	AfterTransfersEnabled(ctx sdk.Context, rollappID, rollappIBCDenom string) error
 }
@@ -59,9 +60,19 @@ func (h MultiRollappHooks) AfterStateFinalized(ctx sdk.Context, rollappID string
 	return nil
 }
 
-func (h MultiRollappHooks) FraudSubmitted(ctx sdk.Context, rollappID string, height uint64, seqAddr string) error {
+func (h MultiRollappHooks) FraudSubmitted(ctx sdk.Context, rollappID string, height uint64, seqAddr, reporter string) error {
 	for i := range h {
-		err := h[i].FraudSubmitted(ctx, rollappID, height, seqAddr)
+		err := h[i].FraudSubmitted(ctx, rollappID, height, seqAddr, reporter)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiRollappHooks) RollappRecovered(ctx sdk.Context, rollappID string) error {
+	for i := range h {
+		err := h[i].RollappRecovered(ctx, rollappID)
 		if err != nil {
 			return err
 		}
@@ -102,7 +113,10 @@ func (StubRollappCreatedHooks) BeforeUpdateState(sdk.Context, string, string, bo
 func (StubRollappCreatedHooks) AfterUpdateState(sdk.Context, string, *StateInfo) error {
 	return nil
 }
-func (StubRollappCreatedHooks) FraudSubmitted(sdk.Context, string, uint64, string) error { return nil }
+func (StubRollappCreatedHooks) FraudSubmitted(sdk.Context, string, uint64, string, string) error {
+	return nil
+}
+func (StubRollappCreatedHooks) RollappRecovered(sdk.Context, string) error { return nil }
 func (StubRollappCreatedHooks) AfterStateFinalized(sdk.Context, string, *StateInfo) error {
 	return nil
 }
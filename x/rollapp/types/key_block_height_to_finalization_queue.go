@@ -7,17 +7,54 @@ var _ binary.ByteOrder
 const (
 	// BlockHeightToFinalizationQueueKeyPrefix is the prefix to retrieve all BlockHeightToFinalizationQueue
 	BlockHeightToFinalizationQueueKeyPrefix = "BlockHeightToFinalizationQueue/value/"
+	// BlockHeightToFinalizationQueueByRollappKeyPrefix is the prefix to retrieve the BlockHeightToFinalizationQueue heights of a rollapp
+	BlockHeightToFinalizationQueueByRollappKeyPrefix = "BlockHeightToFinalizationQueueByRollapp/value/"
 )
 
 // BlockHeightToFinalizationQueueKey returns the store key to retrieve a BlockHeightToFinalizationQueue from the index fields
 func BlockHeightToFinalizationQueueKey(
 	creationHeight uint64,
+	rollappId string,
 ) []byte {
 	var key []byte
 
-	creationHeightBytes := make([]byte, 8)
-	binary.BigEndian.PutUint64(creationHeightBytes, creationHeight)
-	key = append(key, creationHeightBytes...)
+	key = append(key, blockHeightKey(creationHeight)...)
+	key = append(key, []byte(rollappId)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// BlockHeightToFinalizationQueueByRollappKey returns the store key of the rollapp index of a BlockHeightToFinalizationQueue
+func BlockHeightToFinalizationQueueByRollappKey(
+	rollappId string,
+	creationHeight uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, BlockHeightToFinalizationQueueByRollappPrefix(rollappId)...)
+	key = append(key, blockHeightKey(creationHeight)...)
+
+	return key
+}
+
+// BlockHeightToFinalizationQueueByRollappPrefix returns the store key prefix to range over the heights of a rollapp
+func BlockHeightToFinalizationQueueByRollappPrefix(rollappId string) []byte {
+	return append([]byte(rollappId), []byte("/")...)
+}
+
+// LegacyBlockHeightToFinalizationQueueKey returns the store key of a BlockHeightToFinalizationQueue before it was
+// split by rollapp
+func LegacyBlockHeightToFinalizationQueueKey(creationHeight uint64) []byte {
+	return blockHeightKey(creationHeight)
+}
+
+func blockHeightKey(height uint64) []byte {
+	var key []byte
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	key = append(key, heightBytes...)
 	key = append(key, []byte("/")...)
 
 	return key
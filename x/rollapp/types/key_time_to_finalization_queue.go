@@ -0,0 +1,47 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TimeToFinalizationQueueKeyPrefix is the prefix to retrieve all TimeToFinalizationQueue
+	TimeToFinalizationQueueKeyPrefix = "TimeToFinalizationQueue/value/"
+	// TimeToFinalizationQueueByRollappKeyPrefix is the prefix to retrieve the TimeToFinalizationQueue times of a rollapp
+	TimeToFinalizationQueueByRollappKeyPrefix = "TimeToFinalizationQueueByRollapp/value/"
+)
+
+// TimeToFinalizationQueueKey returns the store key to retrieve a TimeToFinalizationQueue from the index fields
+func TimeToFinalizationQueueKey(
+	finalizationTime time.Time,
+	rollappId string,
+) []byte {
+	var key []byte
+
+	key = append(key, sdk.FormatTimeBytes(finalizationTime)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(rollappId)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// TimeToFinalizationQueueByRollappKey returns the store key of the rollapp index of a TimeToFinalizationQueue
+func TimeToFinalizationQueueByRollappKey(
+	rollappId string,
+	finalizationTime time.Time,
+) []byte {
+	var key []byte
+
+	key = append(key, TimeToFinalizationQueueByRollappPrefix(rollappId)...)
+	key = append(key, sdk.FormatTimeBytes(finalizationTime)...)
+
+	return key
+}
+
+// TimeToFinalizationQueueByRollappPrefix returns the store key prefix to range over the times of a rollapp
+func TimeToFinalizationQueueByRollappPrefix(rollappId string) []byte {
+	return append([]byte(rollappId), []byte("/")...)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = new(MsgForkRollapp)
+
+func (m MsgForkRollapp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "owner must be a valid bech32 address"))
+	}
+
+	if _, err = NewChainID(m.RollappId); err != nil {
+		return errors.Join(ErrInvalidRollappID, err)
+	}
+
+	if m.ForkHeight == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "fork height must be positive")
+	}
+
+	if m.DrsVersion == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "DRS version is required")
+	}
+
+	return nil
+}
+
+func (m MsgForkRollapp) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
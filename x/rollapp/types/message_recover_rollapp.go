@@ -0,0 +1,38 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = new(MsgRecoverRollapp)
+
+func (m MsgRecoverRollapp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "authority must be a valid bech32 address"))
+	}
+
+	if m.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id is required")
+	}
+
+	// the recovered rollapp must be restarted by a known sequencer
+	if m.InitialSequencer == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "initial sequencer is required")
+	}
+
+	if err = validateInitialSequencer(m.InitialSequencer); err != nil {
+		return errors.Join(ErrInvalidInitialSequencer, err)
+	}
+
+	return nil
+}
+
+func (m MsgRecoverRollapp) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
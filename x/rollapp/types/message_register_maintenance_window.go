@@ -0,0 +1,37 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = new(MsgRegisterMaintenanceWindow)
+
+func (m MsgRegisterMaintenanceWindow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Creator)
+	if err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "creator must be a valid bech32 address"))
+	}
+
+	if _, err = NewChainID(m.RollappId); err != nil {
+		return errors.Join(ErrInvalidRollappID, err)
+	}
+
+	if m.StartHeight <= 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "start height must be positive")
+	}
+
+	if m.NumBlocks == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "number of blocks must be positive")
+	}
+
+	return nil
+}
+
+func (m MsgRegisterMaintenanceWindow) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = new(MsgSetRollappDisputePeriodTime)
+
+func (m MsgSetRollappDisputePeriodTime) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "authority must be a valid bech32 address"))
+	}
+
+	if m.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id cannot be empty")
+	}
+
+	if err = validateDisputePeriodTime(m.DisputePeriodTime); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, err)
+	}
+
+	return nil
+}
+
+func (m MsgSetRollappDisputePeriodTime) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = new(MsgSubmitFraudProof)
+
+func (m MsgSubmitFraudProof) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Submitter)
+	if err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "submitter must be a valid bech32 address"))
+	}
+
+	if _, err = NewChainID(m.RollappId); err != nil {
+		return errors.Join(ErrInvalidRollappID, err)
+	}
+
+	if _, err = m.GetCometSignedHeader(); err != nil {
+		return errors.Join(ErrInvalidFraudProof, err)
+	}
+
+	return nil
+}
+
+func (m MsgSubmitFraudProof) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Submitter)
+	return []sdk.AccAddress{signer}
+}
+
+// GetCometSignedHeader returns the signed header of the proof, checked to belong to the rollapp chain
+func (m MsgSubmitFraudProof) GetCometSignedHeader() (*cmttypes.SignedHeader, error) {
+	if m.SignedHeader == nil {
+		return nil, errors.New("signed header cannot be empty")
+	}
+	signedHeader, err := cmttypes.SignedHeaderFromProto(m.SignedHeader)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "signed header from proto")
+	}
+	// the chain id of a rollapp is its rollapp id
+	if err = signedHeader.ValidateBasic(m.RollappId); err != nil {
+		return nil, errorsmod.Wrap(err, "signed header")
+	}
+	return signedHeader, nil
+}
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uparam"
 	"github.com/osmosis-labs/osmosis/v15/x/epochs/types"
 	"gopkg.in/yaml.v2"
@@ -34,6 +36,20 @@ var (
 
 	// KeyStateInfoDeletionEpochIdentifier defines the key to store the epoch identifier
 	KeyStateInfoDeletionEpochIdentifier = []byte("StateInfoDeletionEpochIdentifier")
+
+	// KeyDisputePeriodTime is store's key for DisputePeriodTime Params
+	KeyDisputePeriodTime = []byte("DisputePeriodTime")
+
+	// KeyMinDisputePeriodInBlocks and KeyMaxDisputePeriodInBlocks are store's keys for the bounds of the rollapp dispute period
+	KeyMinDisputePeriodInBlocks = []byte("MinDisputePeriodInBlocks")
+	KeyMaxDisputePeriodInBlocks = []byte("MaxDisputePeriodInBlocks")
+
+	// KeyMaintenanceEpochIdentifier, KeyMaintenanceWindowsPerEpoch, KeyMaintenanceBondPerBlock and KeyMaxMaintenanceBlocks
+	// are store's keys for the bounds of the liveness maintenance windows
+	KeyMaintenanceEpochIdentifier = []byte("MaintenanceEpochIdentifier")
+	KeyMaintenanceWindowsPerEpoch = []byte("MaintenanceWindowsPerEpoch")
+	KeyMaintenanceBondPerBlock    = []byte("MaintenanceBondPerBlock")
+	KeyMaxMaintenanceBlocks       = []byte("MaxMaintenanceBlocks")
 )
 
 const (
@@ -45,8 +61,22 @@ const (
 	DefaultLivenessSlashInterval = uint64(3600)  // 1 hour at 1 block per 6 seconds
 	DefaultLivenessJailBlocks    = uint64(28800) // 48 hours at 1 block per 6 seconds
 	defaultEpochIdentifier       = "hour"
+
+	// DefaultDisputePeriodTime is zero, i.e. the time-based dispute period is disabled by default
+	DefaultDisputePeriodTime time.Duration = 0
+
+	// DefaultMinDisputePeriodInBlocks and DefaultMaxDisputePeriodInBlocks bound the dispute period set by rollapp owners.
+	// By default, owners can only lengthen the dispute period.
+	DefaultMinDisputePeriodInBlocks = DefaultDisputePeriodInBlocks
+	DefaultMaxDisputePeriodInBlocks = uint64(120960) // 1 week at 1 block per 5 seconds
+
+	DefaultMaintenanceEpochIdentifier = "day"
+	DefaultMaintenanceWindowsPerEpoch = uint64(1)
+	DefaultMaxMaintenanceBlocks       = uint64(1200) // 2 hours at 1 block per 6 seconds
 )
 
+var DefaultMaintenanceBondPerBlock = sdk.NewCoin(params.BaseDenom, DYM.QuoRaw(10))
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -60,6 +90,13 @@ func NewParams(
 	livenessJailBlocks uint64,
 	appRegistrationFee sdk.Coin,
 	epochIdentifier string,
+	disputePeriodTime time.Duration,
+	minDisputePeriodInBlocks uint64,
+	maxDisputePeriodInBlocks uint64,
+	maintenanceEpochIdentifier string,
+	maintenanceWindowsPerEpoch uint64,
+	maintenanceBondPerBlock sdk.Coin,
+	maxMaintenanceBlocks uint64,
 ) Params {
 	return Params{
 		DisputePeriodInBlocks:            disputePeriodInBlocks,
@@ -68,6 +105,13 @@ func NewParams(
 		LivenessJailBlocks:               livenessJailBlocks,
 		AppRegistrationFee:               appRegistrationFee,
 		StateInfoDeletionEpochIdentifier: epochIdentifier,
+		DisputePeriodTime:                disputePeriodTime,
+		MinDisputePeriodInBlocks:         minDisputePeriodInBlocks,
+		MaxDisputePeriodInBlocks:         maxDisputePeriodInBlocks,
+		MaintenanceEpochIdentifier:       maintenanceEpochIdentifier,
+		MaintenanceWindowsPerEpoch:       maintenanceWindowsPerEpoch,
+		MaintenanceBondPerBlock:          maintenanceBondPerBlock,
+		MaxMaintenanceBlocks:             maxMaintenanceBlocks,
 	}
 }
 
@@ -79,6 +123,13 @@ func DefaultParams() Params {
 		DefaultLivenessJailBlocks,
 		DefaultAppRegistrationFee,
 		defaultEpochIdentifier,
+		DefaultDisputePeriodTime,
+		DefaultMinDisputePeriodInBlocks,
+		DefaultMaxDisputePeriodInBlocks,
+		DefaultMaintenanceEpochIdentifier,
+		DefaultMaintenanceWindowsPerEpoch,
+		DefaultMaintenanceBondPerBlock,
+		DefaultMaxMaintenanceBlocks,
 	)
 }
 
@@ -91,6 +142,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyLivenessJailBlocks, &p.LivenessJailBlocks, validateLivenessJailBlocks),
 		paramtypes.NewParamSetPair(KeyAppRegistrationFee, &p.AppRegistrationFee, validateAppRegistrationFee),
 		paramtypes.NewParamSetPair(KeyStateInfoDeletionEpochIdentifier, &p.StateInfoDeletionEpochIdentifier, types.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyDisputePeriodTime, &p.DisputePeriodTime, validateDisputePeriodTime),
+		paramtypes.NewParamSetPair(KeyMinDisputePeriodInBlocks, &p.MinDisputePeriodInBlocks, validateDisputePeriodInBlocks),
+		paramtypes.NewParamSetPair(KeyMaxDisputePeriodInBlocks, &p.MaxDisputePeriodInBlocks, validateDisputePeriodInBlocks),
+		paramtypes.NewParamSetPair(KeyMaintenanceEpochIdentifier, &p.MaintenanceEpochIdentifier, types.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyMaintenanceWindowsPerEpoch, &p.MaintenanceWindowsPerEpoch, uparam.ValidateUint64),
+		paramtypes.NewParamSetPair(KeyMaintenanceBondPerBlock, &p.MaintenanceBondPerBlock, validateMaintenanceBondPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxMaintenanceBlocks, &p.MaxMaintenanceBlocks, uparam.ValidateUint64),
 	}
 }
 
@@ -99,6 +157,43 @@ func (p Params) WithDisputePeriodInBlocks(x uint64) Params {
 	return p
 }
 
+func (p Params) WithDisputePeriodTime(x time.Duration) Params {
+	p.DisputePeriodTime = x
+	return p
+}
+
+func (p Params) WithDisputePeriodBounds(min, max uint64) Params {
+	p.MinDisputePeriodInBlocks = min
+	p.MaxDisputePeriodInBlocks = max
+	return p
+}
+
+// CheckDisputePeriodInBlocks returns an error if the rollapp dispute period is out of the bounds.
+func (p Params) CheckDisputePeriodInBlocks(x uint64) error {
+	if x < p.MinDisputePeriodInBlocks || p.MaxDisputePeriodInBlocks < x {
+		return errorsmod.Wrapf(gerrc.ErrOutOfRange, "dispute period must be within [%d, %d] blocks: got %d",
+			p.MinDisputePeriodInBlocks, p.MaxDisputePeriodInBlocks, x)
+	}
+	return nil
+}
+
+func (p Params) WithMaintenanceWindows(windowsPerEpoch uint64, bondPerBlock sdk.Coin, maxBlocks uint64) Params {
+	p.MaintenanceWindowsPerEpoch = windowsPerEpoch
+	p.MaintenanceBondPerBlock = bondPerBlock
+	p.MaxMaintenanceBlocks = maxBlocks
+	return p
+}
+
+// MaxMaintenanceBlocksForBond returns the maximal length of a maintenance window for the proposer bond:
+// the bond weighted length, capped by the max maintenance blocks.
+func (p Params) MaxMaintenanceBlocksForBond(bond sdk.Coins) uint64 {
+	blocks := bond.AmountOf(p.MaintenanceBondPerBlock.Denom).Quo(p.MaintenanceBondPerBlock.Amount)
+	if !blocks.IsUint64() {
+		return p.MaxMaintenanceBlocks
+	}
+	return min(blocks.Uint64(), p.MaxMaintenanceBlocks)
+}
+
 func (p Params) WithLivenessSlashBlocks(x uint64) Params {
 	p.LivenessSlashBlocks = x
 	return p
@@ -119,6 +214,18 @@ func (p Params) Validate() error {
 	if err := validateDisputePeriodInBlocks(p.DisputePeriodInBlocks); err != nil {
 		return errorsmod.Wrap(err, "dispute period")
 	}
+	if err := validateDisputePeriodTime(p.DisputePeriodTime); err != nil {
+		return errorsmod.Wrap(err, "dispute period time")
+	}
+	if err := validateDisputePeriodInBlocks(p.MinDisputePeriodInBlocks); err != nil {
+		return errorsmod.Wrap(err, "min dispute period")
+	}
+	if err := validateDisputePeriodInBlocks(p.MaxDisputePeriodInBlocks); err != nil {
+		return errorsmod.Wrap(err, "max dispute period")
+	}
+	if p.MaxDisputePeriodInBlocks < p.MinDisputePeriodInBlocks {
+		return errors.New("max dispute period cannot be lower than min dispute period")
+	}
 
 	if err := validateLivenessSlashBlocks(p.LivenessSlashBlocks); err != nil {
 		return errorsmod.Wrap(err, "liveness slash blocks")
@@ -133,6 +240,13 @@ func (p Params) Validate() error {
 	if err := validateAppRegistrationFee(p.AppRegistrationFee); err != nil {
 		return errorsmod.Wrap(err, "app registration fee")
 	}
+
+	if err := types.ValidateEpochIdentifierInterface(p.MaintenanceEpochIdentifier); err != nil {
+		return errorsmod.Wrap(err, "maintenance epoch identifier")
+	}
+	if err := validateMaintenanceBondPerBlock(p.MaintenanceBondPerBlock); err != nil {
+		return errorsmod.Wrap(err, "maintenance bond per block")
+	}
 	return nil
 }
 
@@ -168,6 +282,32 @@ func validateDisputePeriodInBlocks(v interface{}) error {
 	return nil
 }
 
+// validateDisputePeriodTime validates the DisputePeriodTime param
+func validateDisputePeriodTime(v interface{}) error {
+	disputePeriodTime, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if disputePeriodTime < 0 {
+		return errors.New("dispute period time cannot be negative")
+	}
+
+	return nil
+}
+
+func validateMaintenanceBondPerBlock(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() || v.IsZero() {
+		return fmt.Errorf("invalid maintenance bond per block: %s", v)
+	}
+
+	return nil
+}
+
 func validateAppRegistrationFee(i interface{}) error {
 	v, ok := i.(sdk.Coin)
 	if !ok {
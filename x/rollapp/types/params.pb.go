@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,30 @@ type Params struct {
 	AppRegistrationFee types.Coin `protobuf:"bytes,7,opt,name=app_registration_fee,json=appRegistrationFee,proto3" json:"app_registration_fee" yaml:"app_registration_fee"`
 	// state_info_deletion_epoch_identifier is used to control the interval at which the state info records will be deleted.
 	StateInfoDeletionEpochIdentifier string `protobuf:"bytes,8,opt,name=state_info_deletion_epoch_identifier,json=stateInfoDeletionEpochIdentifier,proto3" json:"state_info_deletion_epoch_identifier,omitempty" yaml:"state_info_deletion_epoch_identifier"`
+	// dispute_period_time is the minimal time that must pass since the creation
+	// of a state before it can be finalized, on top of dispute_period_in_blocks.
+	// Zero disables the time-based dispute period.
+	DisputePeriodTime time.Duration `protobuf:"bytes,9,opt,name=dispute_period_time,json=disputePeriodTime,proto3,stdduration" json:"dispute_period_time" yaml:"dispute_period_time"`
+	// min_dispute_period_in_blocks is the minimal dispute period a rollapp owner
+	// can set for the rollapp.
+	MinDisputePeriodInBlocks uint64 `protobuf:"varint,10,opt,name=min_dispute_period_in_blocks,json=minDisputePeriodInBlocks,proto3" json:"min_dispute_period_in_blocks,omitempty" yaml:"min_dispute_period_in_blocks"`
+	// max_dispute_period_in_blocks is the maximal dispute period a rollapp owner
+	// can set for the rollapp.
+	MaxDisputePeriodInBlocks uint64 `protobuf:"varint,11,opt,name=max_dispute_period_in_blocks,json=maxDisputePeriodInBlocks,proto3" json:"max_dispute_period_in_blocks,omitempty" yaml:"max_dispute_period_in_blocks"`
+	// maintenance_epoch_identifier is the epoch over which the quota of
+	// maintenance windows applies.
+	MaintenanceEpochIdentifier string `protobuf:"bytes,12,opt,name=maintenance_epoch_identifier,json=maintenanceEpochIdentifier,proto3" json:"maintenance_epoch_identifier,omitempty" yaml:"maintenance_epoch_identifier"`
+	// maintenance_windows_per_epoch is the number of maintenance windows the
+	// proposer of a rollapp can register per epoch. Zero disables the
+	// maintenance windows.
+	MaintenanceWindowsPerEpoch uint64 `protobuf:"varint,13,opt,name=maintenance_windows_per_epoch,json=maintenanceWindowsPerEpoch,proto3" json:"maintenance_windows_per_epoch,omitempty" yaml:"maintenance_windows_per_epoch"`
+	// maintenance_bond_per_block is the proposer bond required per hub block of
+	// a maintenance window.
+	MaintenanceBondPerBlock types.Coin `protobuf:"bytes,14,opt,name=maintenance_bond_per_block,json=maintenanceBondPerBlock,proto3" json:"maintenance_bond_per_block" yaml:"maintenance_bond_per_block"`
+	// max_maintenance_blocks is the maximal length (num hub blocks) of a
+	// maintenance window, whatever the proposer bond. It is also the min gap
+	// between two windows, so it must stay well below the epoch length.
+	MaxMaintenanceBlocks uint64 `protobuf:"varint,15,opt,name=max_maintenance_blocks,json=maxMaintenanceBlocks,proto3" json:"max_maintenance_blocks,omitempty" yaml:"max_maintenance_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -116,6 +144,55 @@ func (m *Params) GetStateInfoDeletionEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetDisputePeriodTime() time.Duration {
+	if m != nil {
+		return m.DisputePeriodTime
+	}
+	return 0
+}
+
+func (m *Params) GetMinDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MinDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MaxDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenanceEpochIdentifier() string {
+	if m != nil {
+		return m.MaintenanceEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaintenanceWindowsPerEpoch() uint64 {
+	if m != nil {
+		return m.MaintenanceWindowsPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetMaintenanceBondPerBlock() types.Coin {
+	if m != nil {
+		return m.MaintenanceBondPerBlock
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxMaintenanceBlocks() uint64 {
+	if m != nil {
+		return m.MaxMaintenanceBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.rollapp.Params")
 }
@@ -125,38 +202,52 @@ func init() {
 }
 
 var fileDescriptor_75a44aa904ae1ba5 = []byte{
-	// 486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0x6a, 0x42, 0x30, 0x97, 0xca, 0x24, 0x22, 0x14, 0x64, 0x47, 0x2e, 0x87, 0x48,
-	0x95, 0xbc, 0x2a, 0xe5, 0xd4, 0x63, 0xf8, 0x90, 0x92, 0x03, 0x2a, 0x86, 0x53, 0x85, 0x64, 0xad,
-	0xed, 0x49, 0xb2, 0xb0, 0xde, 0x5d, 0x79, 0xb7, 0x51, 0xc3, 0x85, 0x57, 0xe0, 0xc8, 0x91, 0xc7,
-	0xe9, 0xb1, 0x47, 0x4e, 0x16, 0x4a, 0x5e, 0x00, 0xf9, 0x09, 0x50, 0xd6, 0x1f, 0x84, 0x2a, 0x95,
-	0xb8, 0x79, 0xff, 0xf3, 0x9b, 0xdf, 0xac, 0x34, 0x5e, 0xeb, 0x28, 0x59, 0xa6, 0xc0, 0x24, 0xe1,
-	0xec, 0x72, 0xf9, 0x05, 0x35, 0x07, 0x94, 0x71, 0x4a, 0xb1, 0x10, 0x48, 0xe0, 0x0c, 0xa7, 0xd2,
-	0x17, 0x19, 0x57, 0xdc, 0x76, 0xb6, 0x61, 0xbf, 0x39, 0xf8, 0x15, 0x7c, 0xd0, 0x9d, 0xf1, 0x19,
-	0xd7, 0x28, 0xda, 0x7c, 0x95, 0x5d, 0x07, 0x4e, 0xcc, 0x65, 0xca, 0x25, 0x8a, 0xb0, 0x04, 0xb4,
-	0x38, 0x8e, 0x40, 0xe1, 0x63, 0x14, 0x73, 0xc2, 0xca, 0xba, 0xf7, 0xdb, 0xb4, 0xda, 0x67, 0x7a,
-	0x8c, 0xfd, 0xd1, 0xea, 0x27, 0x44, 0x8a, 0x0b, 0x05, 0xa1, 0x80, 0x8c, 0xf0, 0x24, 0x24, 0x2c,
-	0x8c, 0x28, 0x8f, 0x3f, 0xcb, 0xbe, 0x31, 0x30, 0x86, 0xe6, 0xe8, 0xb0, 0xc8, 0x5d, 0x77, 0x89,
-	0x53, 0x7a, 0xea, 0xdd, 0x46, 0x7a, 0x41, 0xaf, 0x2a, 0x9d, 0xe9, 0xca, 0x98, 0x8d, 0x74, 0x6e,
-	0x7f, 0xb0, 0x7a, 0x94, 0x2c, 0x80, 0x81, 0x94, 0xa1, 0xa4, 0x58, 0xce, 0x6b, 0xb5, 0xa9, 0xd5,
-	0x83, 0x22, 0x77, 0x9f, 0x96, 0xea, 0x9d, 0x98, 0x17, 0x3c, 0xac, 0xf3, 0xf7, 0x9b, 0xb8, 0xb2,
-	0x9e, 0x5b, 0x8f, 0x6e, 0xe0, 0x84, 0x29, 0xc8, 0x16, 0x98, 0xf6, 0xef, 0x6a, 0xaf, 0x57, 0xe4,
-	0xae, 0xb3, 0xd3, 0x5b, 0x83, 0x5e, 0xd0, 0xfb, 0xc7, 0x3c, 0xae, 0x72, 0xfb, 0x9d, 0xd5, 0x6d,
-	0x5a, 0x3e, 0x61, 0x42, 0xeb, 0x0b, 0xb7, 0xb5, 0xd8, 0x2d, 0x72, 0xf7, 0xc9, 0x0d, 0xf1, 0x16,
-	0xe5, 0x05, 0x76, 0x1d, 0x4f, 0x30, 0xa1, 0xd5, 0x75, 0x85, 0xd5, 0xc5, 0x42, 0x84, 0x19, 0xcc,
-	0x88, 0x54, 0x19, 0x56, 0x84, 0xb3, 0x70, 0x0a, 0xd0, 0xbf, 0x37, 0x30, 0x86, 0x0f, 0x9e, 0x3f,
-	0xf6, 0xcb, 0x65, 0xf9, 0x9b, 0x65, 0xf9, 0xd5, 0xb2, 0xfc, 0x97, 0x9c, 0xb0, 0xd1, 0xe1, 0x55,
-	0xee, 0xb6, 0xfe, 0x4e, 0xdc, 0x25, 0xf1, 0x02, 0x1b, 0x0b, 0x11, 0x6c, 0xa5, 0x6f, 0x00, 0xec,
-	0xaf, 0xd6, 0x33, 0xa9, 0xb0, 0x82, 0x90, 0xb0, 0x29, 0x0f, 0x13, 0xa0, 0xa0, 0x79, 0x10, 0x3c,
-	0x9e, 0x87, 0x24, 0x01, 0xa6, 0xc8, 0x94, 0x40, 0xd6, 0xef, 0x0c, 0x8c, 0xe1, 0xfd, 0x11, 0x2a,
-	0x72, 0xf7, 0xa8, 0x1c, 0xf1, 0x3f, 0x5d, 0x5e, 0x30, 0xd0, 0xd8, 0x98, 0x4d, 0xf9, 0xab, 0x0a,
-	0x7a, 0xbd, 0x61, 0xc6, 0x0d, 0x72, 0x6a, 0x7e, 0xff, 0xe1, 0xb6, 0x26, 0x66, 0xe7, 0xce, 0xfe,
-	0xde, 0xc4, 0xec, 0xec, 0xed, 0x9b, 0xa3, 0xb7, 0x57, 0x2b, 0xc7, 0xb8, 0x5e, 0x39, 0xc6, 0xaf,
-	0x95, 0x63, 0x7c, 0x5b, 0x3b, 0xad, 0xeb, 0xb5, 0xd3, 0xfa, 0xb9, 0x76, 0x5a, 0xe7, 0x2f, 0x66,
-	0x44, 0xcd, 0x2f, 0x22, 0x3f, 0xe6, 0x29, 0xba, 0xe5, 0x69, 0x2c, 0x4e, 0xd0, 0x65, 0xf3, 0x3e,
-	0xd4, 0x52, 0x80, 0x8c, 0xda, 0xfa, 0x4f, 0x3e, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xd2,
-	0xe3, 0x18, 0x4e, 0x03, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
+	0x14, 0x8c, 0x69, 0x28, 0xed, 0x96, 0x8f, 0xe2, 0xa6, 0xd4, 0x84, 0xd6, 0x4e, 0xb7, 0x15, 0x04,
+	0x55, 0xb2, 0x55, 0xca, 0xa9, 0xc7, 0x50, 0x90, 0x52, 0x09, 0x14, 0x4c, 0xa5, 0x4a, 0x15, 0x92,
+	0xb5, 0x89, 0x37, 0xe9, 0x52, 0x7b, 0xd7, 0x78, 0x9d, 0x34, 0xe1, 0x82, 0xf8, 0x07, 0x1c, 0x7b,
+	0xe4, 0xe7, 0xf4, 0xd8, 0x23, 0x27, 0x83, 0xda, 0x1b, 0x47, 0xff, 0x02, 0xe4, 0xb5, 0x93, 0x3a,
+	0x5f, 0x85, 0x9b, 0xf7, 0xbd, 0x79, 0x33, 0x6f, 0x3d, 0x63, 0x19, 0x6c, 0xd9, 0x3d, 0x17, 0x53,
+	0x4e, 0x18, 0xed, 0xf6, 0xbe, 0x18, 0x83, 0x83, 0xe1, 0x33, 0xc7, 0x41, 0x9e, 0x67, 0x78, 0xc8,
+	0x47, 0x2e, 0xd7, 0x3d, 0x9f, 0x05, 0x4c, 0x56, 0xb3, 0x60, 0x7d, 0x70, 0xd0, 0x53, 0x70, 0xb1,
+	0xd0, 0x62, 0x2d, 0x26, 0xa0, 0x46, 0xfc, 0x94, 0x4c, 0x15, 0xd5, 0x06, 0xe3, 0x2e, 0xe3, 0x46,
+	0x1d, 0x71, 0x6c, 0x74, 0xb6, 0xeb, 0x38, 0x40, 0xdb, 0x46, 0x83, 0x11, 0xda, 0xef, 0xb7, 0x18,
+	0x6b, 0x39, 0xd8, 0x10, 0xa7, 0x7a, 0xbb, 0x69, 0xd8, 0x6d, 0x1f, 0x05, 0x31, 0xaf, 0xa8, 0xc0,
+	0x3f, 0x00, 0xcc, 0xd6, 0xc4, 0x1a, 0xf2, 0x47, 0xa0, 0xd8, 0x84, 0x7b, 0xed, 0x00, 0x5b, 0x1e,
+	0xf6, 0x09, 0xb3, 0x2d, 0x42, 0xad, 0xba, 0xc3, 0x1a, 0x27, 0x5c, 0x91, 0x4a, 0x52, 0x39, 0x5f,
+	0xd9, 0x88, 0x42, 0x4d, 0xeb, 0x21, 0xd7, 0xd9, 0x85, 0xd3, 0x90, 0xd0, 0x5c, 0x4e, 0x5b, 0x35,
+	0xd1, 0xa9, 0xd2, 0x8a, 0xa8, 0xcb, 0x07, 0x60, 0xd9, 0x21, 0x1d, 0x4c, 0x31, 0xe7, 0x16, 0x77,
+	0x10, 0x3f, 0xee, 0x53, 0xe7, 0x05, 0x75, 0x29, 0x0a, 0xb5, 0xd5, 0x84, 0x7a, 0x22, 0x0c, 0x9a,
+	0x4b, 0xfd, 0xfa, 0x87, 0xb8, 0x9c, 0xb2, 0x1e, 0x81, 0x95, 0x11, 0x38, 0xa1, 0x01, 0xf6, 0x3b,
+	0xc8, 0x51, 0x6e, 0x0b, 0x5e, 0x18, 0x85, 0x9a, 0x3a, 0x91, 0xb7, 0x0f, 0x84, 0xe6, 0xf2, 0x10,
+	0x73, 0x35, 0xad, 0xcb, 0xef, 0x41, 0x61, 0x30, 0xf2, 0x09, 0x11, 0xa7, 0xbf, 0xf0, 0xac, 0x20,
+	0xd6, 0xa2, 0x50, 0x7b, 0x32, 0x42, 0x9c, 0x41, 0x41, 0x53, 0xee, 0x97, 0xf7, 0x11, 0x71, 0xd2,
+	0x75, 0x3d, 0x50, 0x40, 0x9e, 0x67, 0xf9, 0xb8, 0x45, 0x78, 0x90, 0xf8, 0x60, 0x35, 0x31, 0x56,
+	0xee, 0x94, 0xa4, 0xf2, 0xc2, 0x8b, 0xc7, 0x7a, 0x62, 0xa6, 0x1e, 0x9b, 0xa9, 0xa7, 0x66, 0xea,
+	0xaf, 0x18, 0xa1, 0x95, 0x8d, 0xf3, 0x50, 0xcb, 0x5d, 0x2b, 0x4e, 0x22, 0x81, 0xa6, 0x8c, 0x3c,
+	0xcf, 0xcc, 0x54, 0xdf, 0x60, 0x2c, 0x7f, 0x05, 0x9b, 0x3c, 0x40, 0x01, 0xb6, 0x08, 0x6d, 0x32,
+	0xcb, 0xc6, 0x0e, 0x16, 0x78, 0xec, 0xb1, 0xc6, 0xb1, 0x45, 0x6c, 0x4c, 0x03, 0xd2, 0x24, 0xd8,
+	0x57, 0xe6, 0x4a, 0x52, 0x79, 0xbe, 0x62, 0x44, 0xa1, 0xb6, 0x95, 0x48, 0xfc, 0xcf, 0x14, 0x34,
+	0x4b, 0x02, 0x56, 0xa5, 0x4d, 0xb6, 0x97, 0x82, 0x5e, 0xc7, 0x98, 0xea, 0x00, 0x22, 0x7f, 0x06,
+	0x4b, 0x23, 0x59, 0x09, 0x88, 0x8b, 0x95, 0xf9, 0xf4, 0xc6, 0x49, 0x3c, 0xf5, 0x7e, 0x3c, 0xf5,
+	0xbd, 0x34, 0x9e, 0x95, 0xa7, 0xe9, 0x8d, 0x8b, 0x13, 0xf3, 0x16, 0x73, 0xc0, 0xb3, 0x5f, 0x9a,
+	0x64, 0x3e, 0x1c, 0x8a, 0xdb, 0x01, 0x71, 0xb1, 0xdc, 0x02, 0xab, 0x2e, 0xa1, 0xd6, 0xd4, 0x30,
+	0x03, 0x61, 0xe0, 0xb3, 0x28, 0xd4, 0x36, 0x12, 0xf2, 0x9b, 0xd0, 0xd0, 0x54, 0x5c, 0x42, 0xf7,
+	0x26, 0x66, 0x3a, 0x16, 0x42, 0xdd, 0xe9, 0x42, 0x0b, 0x63, 0x42, 0xa8, 0x7b, 0xa3, 0x10, 0xea,
+	0x4e, 0x16, 0x22, 0xb1, 0x50, 0x1c, 0x58, 0x8a, 0x68, 0x03, 0x8f, 0xbb, 0x77, 0x57, 0xb8, 0x37,
+	0x24, 0x34, 0x1d, 0x0d, 0xcd, 0x62, 0xa6, 0x3d, 0xea, 0xd7, 0x09, 0x58, 0xcb, 0x0e, 0x9f, 0x12,
+	0x6a, 0xb3, 0x53, 0x1e, 0x6f, 0x9b, 0x10, 0x29, 0xf7, 0xc4, 0xa5, 0xca, 0x51, 0xa8, 0x6d, 0x8e,
+	0x6b, 0x8d, 0xc1, 0x87, 0xc5, 0x0e, 0x93, 0x76, 0x0d, 0xfb, 0x42, 0x56, 0xfe, 0x26, 0x81, 0x6c,
+	0xdb, 0xaa, 0x33, 0x6a, 0x8b, 0x59, 0xf1, 0x4a, 0x94, 0xfb, 0xff, 0xfa, 0x2c, 0x9e, 0xa7, 0x21,
+	0x59, 0x1f, 0xdf, 0x64, 0x98, 0x0a, 0x9a, 0x2b, 0x99, 0x66, 0x85, 0x51, 0xbb, 0x86, 0x7d, 0xf1,
+	0x72, 0xe5, 0x43, 0xf0, 0x28, 0xb6, 0x65, 0x68, 0x36, 0xb1, 0xef, 0x81, 0xb8, 0xe9, 0x7a, 0x14,
+	0x6a, 0x6b, 0xd7, 0xf6, 0x8d, 0xe3, 0xa0, 0x59, 0x70, 0x51, 0xf7, 0x6d, 0x86, 0x5e, 0x94, 0x77,
+	0xf3, 0x67, 0x3f, 0xb4, 0xdc, 0x7e, 0x7e, 0xee, 0xd6, 0xe2, 0xcc, 0x7e, 0x7e, 0x6e, 0x66, 0x31,
+	0x5f, 0x79, 0x77, 0x7e, 0xa9, 0x4a, 0x17, 0x97, 0xaa, 0xf4, 0xfb, 0x52, 0x95, 0xbe, 0x5f, 0xa9,
+	0xb9, 0x8b, 0x2b, 0x35, 0xf7, 0xf3, 0x4a, 0xcd, 0x1d, 0xbd, 0x6c, 0x91, 0xe0, 0xb8, 0x5d, 0xd7,
+	0x1b, 0xcc, 0x35, 0xa6, 0xfc, 0x34, 0x3a, 0x3b, 0x46, 0x77, 0xf0, 0xe7, 0x08, 0x7a, 0x1e, 0xe6,
+	0xf5, 0x59, 0xf1, 0xd9, 0xec, 0xfc, 0x1d, 0x00, 0x22, 0xbe, 0x6b, 0xc1, 0x68, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -179,6 +270,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMaintenanceBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMaintenanceBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.MaintenanceBondPerBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.MaintenanceWindowsPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaintenanceWindowsPerEpoch))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.MaintenanceEpochIdentifier) > 0 {
+		i -= len(m.MaintenanceEpochIdentifier)
+		copy(dAtA[i:], m.MaintenanceEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaintenanceEpochIdentifier)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DisputePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriodTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
 	if len(m.StateInfoDeletionEpochIdentifier) > 0 {
 		i -= len(m.StateInfoDeletionEpochIdentifier)
 		copy(dAtA[i:], m.StateInfoDeletionEpochIdentifier)
@@ -254,6 +390,26 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriodTime)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinDisputePeriodInBlocks))
+	}
+	if m.MaxDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxDisputePeriodInBlocks))
+	}
+	l = len(m.MaintenanceEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaintenanceWindowsPerEpoch != 0 {
+		n += 1 + sovParams(uint64(m.MaintenanceWindowsPerEpoch))
+	}
+	l = m.MaintenanceBondPerBlock.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxMaintenanceBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxMaintenanceBlocks))
+	}
 	return n
 }
 
@@ -433,6 +589,180 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.StateInfoDeletionEpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DisputePeriodTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDisputePeriodInBlocks", wireType)
+			}
+			m.MinDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDisputePeriodInBlocks", wireType)
+			}
+			m.MaxDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowsPerEpoch", wireType)
+			}
+			m.MaintenanceWindowsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceWindowsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceBondPerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceBondPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceBlocks", wireType)
+			}
+			m.MaxMaintenanceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaintenanceBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
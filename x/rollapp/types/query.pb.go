@@ -10,19 +10,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -689,6 +693,201 @@ func (m *QueryGetStateInfoResponse) GetStateInfo() StateInfo {
 	return StateInfo{}
 }
 
+type QueryStateInfoByTimeRequest struct {
+	RollappId string    `protobuf:"bytes,1,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// hub_time indicates that the timestamp is a hub time. Otherwise, it is a
+	// rollapp block timestamp.
+	HubTime bool `protobuf:"varint,3,opt,name=hub_time,json=hubTime,proto3" json:"hub_time,omitempty"`
+}
+
+func (m *QueryStateInfoByTimeRequest) Reset()         { *m = QueryStateInfoByTimeRequest{} }
+func (m *QueryStateInfoByTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStateInfoByTimeRequest) ProtoMessage()    {}
+func (*QueryStateInfoByTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{13}
+}
+func (m *QueryStateInfoByTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateInfoByTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateInfoByTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStateInfoByTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateInfoByTimeRequest.Merge(m, src)
+}
+func (m *QueryStateInfoByTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateInfoByTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateInfoByTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStateInfoByTimeRequest proto.InternalMessageInfo
+
+func (m *QueryStateInfoByTimeRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *QueryStateInfoByTimeRequest) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *QueryStateInfoByTimeRequest) GetHubTime() bool {
+	if m != nil {
+		return m.HubTime
+	}
+	return false
+}
+
+type QueryStateInfoByTimeResponse struct {
+	StateInfo StateInfo `protobuf:"bytes,1,opt,name=stateInfo,proto3" json:"stateInfo"`
+	// block_descriptor is the descriptor of the latest rollapp block at the time.
+	BlockDescriptor BlockDescriptor `protobuf:"bytes,2,opt,name=block_descriptor,json=blockDescriptor,proto3" json:"block_descriptor"`
+}
+
+func (m *QueryStateInfoByTimeResponse) Reset()         { *m = QueryStateInfoByTimeResponse{} }
+func (m *QueryStateInfoByTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStateInfoByTimeResponse) ProtoMessage()    {}
+func (*QueryStateInfoByTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{14}
+}
+func (m *QueryStateInfoByTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStateInfoByTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStateInfoByTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStateInfoByTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStateInfoByTimeResponse.Merge(m, src)
+}
+func (m *QueryStateInfoByTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStateInfoByTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStateInfoByTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStateInfoByTimeResponse proto.InternalMessageInfo
+
+func (m *QueryStateInfoByTimeResponse) GetStateInfo() StateInfo {
+	if m != nil {
+		return m.StateInfo
+	}
+	return StateInfo{}
+}
+
+func (m *QueryStateInfoByTimeResponse) GetBlockDescriptor() BlockDescriptor {
+	if m != nil {
+		return m.BlockDescriptor
+	}
+	return BlockDescriptor{}
+}
+
+type QueryVulnerableDRSVersionsRequest struct {
+}
+
+func (m *QueryVulnerableDRSVersionsRequest) Reset()         { *m = QueryVulnerableDRSVersionsRequest{} }
+func (m *QueryVulnerableDRSVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVulnerableDRSVersionsRequest) ProtoMessage()    {}
+func (*QueryVulnerableDRSVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{15}
+}
+func (m *QueryVulnerableDRSVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVulnerableDRSVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVulnerableDRSVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVulnerableDRSVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVulnerableDRSVersionsRequest.Merge(m, src)
+}
+func (m *QueryVulnerableDRSVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVulnerableDRSVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVulnerableDRSVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVulnerableDRSVersionsRequest proto.InternalMessageInfo
+
+type QueryVulnerableDRSVersionsResponse struct {
+	DrsVersions []string `protobuf:"bytes,1,rep,name=drs_versions,json=drsVersions,proto3" json:"drs_versions,omitempty"`
+}
+
+func (m *QueryVulnerableDRSVersionsResponse) Reset()         { *m = QueryVulnerableDRSVersionsResponse{} }
+func (m *QueryVulnerableDRSVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVulnerableDRSVersionsResponse) ProtoMessage()    {}
+func (*QueryVulnerableDRSVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{16}
+}
+func (m *QueryVulnerableDRSVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVulnerableDRSVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVulnerableDRSVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVulnerableDRSVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVulnerableDRSVersionsResponse.Merge(m, src)
+}
+func (m *QueryVulnerableDRSVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVulnerableDRSVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVulnerableDRSVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVulnerableDRSVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryVulnerableDRSVersionsResponse) GetDrsVersions() []string {
+	if m != nil {
+		return m.DrsVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.rollapp.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.rollapp.QueryParamsResponse")
@@ -703,6 +902,10 @@ func init() {
 	proto.RegisterType((*QueryAllRollappResponse)(nil), "dymensionxyz.dymension.rollapp.QueryAllRollappResponse")
 	proto.RegisterType((*QueryGetStateInfoRequest)(nil), "dymensionxyz.dymension.rollapp.QueryGetStateInfoRequest")
 	proto.RegisterType((*QueryGetStateInfoResponse)(nil), "dymensionxyz.dymension.rollapp.QueryGetStateInfoResponse")
+	proto.RegisterType((*QueryStateInfoByTimeRequest)(nil), "dymensionxyz.dymension.rollapp.QueryStateInfoByTimeRequest")
+	proto.RegisterType((*QueryStateInfoByTimeResponse)(nil), "dymensionxyz.dymension.rollapp.QueryStateInfoByTimeResponse")
+	proto.RegisterType((*QueryVulnerableDRSVersionsRequest)(nil), "dymensionxyz.dymension.rollapp.QueryVulnerableDRSVersionsRequest")
+	proto.RegisterType((*QueryVulnerableDRSVersionsResponse)(nil), "dymensionxyz.dymension.rollapp.QueryVulnerableDRSVersionsResponse")
 }
 
 func init() {
@@ -710,65 +913,80 @@ func init() {
 }
 
 var fileDescriptor_00a0238fb38306fa = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x38, 0xae, 0x13, 0xbf, 0x22, 0x11, 0x0d, 0x51, 0x08, 0x6e, 0xe4, 0x46, 0x8b, 0xd4,
-	0xba, 0x05, 0xed, 0xc8, 0x09, 0x6e, 0xa9, 0x02, 0xa5, 0x8e, 0xa0, 0x21, 0x15, 0x54, 0x61, 0x03,
-	0x42, 0x80, 0x50, 0xb4, 0xa9, 0x27, 0x9b, 0x45, 0xeb, 0x9d, 0xad, 0x67, 0x53, 0xd9, 0x8d, 0x72,
-	0x41, 0x7c, 0x00, 0x24, 0x3e, 0x01, 0x5f, 0x80, 0x2b, 0x67, 0xc4, 0x25, 0x07, 0x0e, 0x91, 0x90,
-	0x10, 0x17, 0x10, 0x4a, 0xf8, 0x0e, 0x5c, 0x91, 0x67, 0xde, 0xae, 0xd7, 0xeb, 0x24, 0xbb, 0xb6,
-	0x7a, 0x72, 0x66, 0xf2, 0xde, 0xef, 0xfd, 0x7e, 0xf3, 0xfe, 0x2d, 0xdc, 0x6e, 0xf5, 0xda, 0xdc,
-	0x97, 0xae, 0xf0, 0xbb, 0xbd, 0xe7, 0x2c, 0x3e, 0xb0, 0x8e, 0xf0, 0x3c, 0x3b, 0x08, 0xd8, 0xd3,
-	0x03, 0xde, 0xe9, 0x99, 0x41, 0x47, 0x84, 0x82, 0x56, 0x93, 0xb6, 0x66, 0x7c, 0x30, 0xd1, 0xb6,
-	0x32, 0xef, 0x08, 0x47, 0x28, 0x53, 0xd6, 0xff, 0x4b, 0x7b, 0x55, 0x96, 0x1c, 0x21, 0x1c, 0x8f,
-	0x33, 0x3b, 0x70, 0x99, 0xed, 0xfb, 0x22, 0xb4, 0x43, 0x57, 0xf8, 0x12, 0xff, 0x7b, 0xfb, 0x89,
-	0x90, 0x6d, 0x21, 0xd9, 0xae, 0x2d, 0xb9, 0x0e, 0xc6, 0x9e, 0xd5, 0x77, 0x79, 0x68, 0xd7, 0x59,
-	0x60, 0x3b, 0xae, 0xaf, 0x8c, 0xd1, 0xf6, 0x8d, 0x0c, 0xae, 0x81, 0xdd, 0xb1, 0xdb, 0x11, 0xf0,
-	0x9b, 0x19, 0xc6, 0xf8, 0x8b, 0xd6, 0x2c, 0xc3, 0x5a, 0x86, 0x76, 0xc8, 0x77, 0x5c, 0x7f, 0x2f,
-	0x52, 0x55, 0xcb, 0x70, 0x88, 0xa1, 0x8d, 0x79, 0xa0, 0x9f, 0xf4, 0x75, 0x6d, 0x29, 0x76, 0x16,
-	0x7f, 0x7a, 0xc0, 0x65, 0x68, 0x7c, 0x05, 0xaf, 0x0c, 0xdd, 0xca, 0x40, 0xf8, 0x92, 0xd3, 0xf7,
-	0xa1, 0xa4, 0x55, 0x2c, 0x92, 0x65, 0x52, 0xbb, 0xba, 0x72, 0xc3, 0xbc, 0xfc, 0xcd, 0x4d, 0xed,
-	0xbf, 0x5e, 0x3c, 0xfe, 0xfb, 0xfa, 0x94, 0x85, 0xbe, 0xc6, 0x36, 0x2c, 0x28, 0xf0, 0x0d, 0x1e,
-	0x5a, 0xda, 0x0e, 0xc3, 0xd2, 0x25, 0x28, 0xa3, 0xe7, 0x66, 0x4b, 0x85, 0x28, 0x5b, 0x83, 0x0b,
-	0x7a, 0x0d, 0xca, 0xa2, 0xed, 0x86, 0x3b, 0x76, 0x10, 0xc8, 0xc5, 0xc2, 0x32, 0xa9, 0xcd, 0x5a,
-	0xb3, 0xfd, 0x8b, 0x66, 0x10, 0x48, 0xe3, 0x33, 0xa8, 0xa6, 0x40, 0xd7, 0x7b, 0x1f, 0x6c, 0x6e,
-	0xd5, 0x1b, 0x8d, 0x08, 0x7c, 0x01, 0x4a, 0xdc, 0x0d, 0xea, 0x8d, 0x86, 0x42, 0x2e, 0x5a, 0x78,
-	0xba, 0x1c, 0xf6, 0x0b, 0xb8, 0x16, 0xc1, 0x7e, 0x64, 0x87, 0x5c, 0x86, 0x1f, 0x72, 0xd7, 0xd9,
-	0x0f, 0xf3, 0x11, 0x5e, 0x82, 0xf2, 0x9e, 0xeb, 0xdb, 0x9e, 0xfb, 0x9c, 0xb7, 0x10, 0x79, 0x70,
-	0x61, 0xdc, 0x81, 0xa5, 0xf3, 0xa1, 0xf1, 0xb1, 0x17, 0xa0, 0xb4, 0xaf, 0x6e, 0x22, 0xbe, 0xfa,
-	0x64, 0x7c, 0x0d, 0xd7, 0x87, 0xfd, 0xb6, 0xfb, 0xd9, 0xdf, 0xf4, 0x5b, 0xbc, 0xfb, 0x22, 0x68,
-	0x75, 0x61, 0xf9, 0x62, 0x78, 0xa4, 0xf6, 0x29, 0x80, 0x8c, 0x6f, 0xb1, 0x16, 0xcc, 0xac, 0x5a,
-	0x40, 0x9c, 0x3d, 0xa1, 0xbc, 0xb0, 0x26, 0x12, 0x38, 0xc6, 0x7f, 0x04, 0x5e, 0x1d, 0x29, 0x0c,
-	0x8c, 0xb8, 0x01, 0x33, 0x88, 0x83, 0xe1, 0x6e, 0x66, 0x85, 0x8b, 0xaa, 0x40, 0xc7, 0x89, 0xbc,
-	0xe9, 0x63, 0x98, 0x91, 0x07, 0xed, 0xb6, 0xdd, 0xe9, 0x2d, 0x96, 0xf2, 0xf1, 0x46, 0xa0, 0x6d,
-	0xed, 0x15, 0xe1, 0x21, 0x08, 0x7d, 0x17, 0x8a, 0xaa, 0x70, 0x66, 0x96, 0xa7, 0x6b, 0x57, 0x57,
-	0x5e, 0xcf, 0x02, 0x6b, 0x22, 0x23, 0x62, 0x29, 0xb7, 0x47, 0xc5, 0xd9, 0xc2, 0x5c, 0xc9, 0x38,
-	0xc2, 0x8e, 0x68, 0x7a, 0x5e, 0xaa, 0x23, 0x1e, 0x02, 0x0c, 0x06, 0x4d, 0xdc, 0x75, 0x7a, 0x2a,
-	0x99, 0xfd, 0xa9, 0x64, 0xea, 0x11, 0x88, 0x53, 0xc9, 0xdc, 0xb2, 0x1d, 0x8e, 0xbe, 0x56, 0xc2,
-	0xf3, 0xf2, 0x22, 0xff, 0x25, 0x7a, 0xf8, 0x64, 0x7c, 0x7c, 0xf8, 0xcf, 0x07, 0x0f, 0x3f, 0xad,
-	0x24, 0xde, 0xcd, 0x92, 0x78, 0x41, 0x0a, 0xd3, 0x89, 0xd8, 0x18, 0x52, 0x56, 0xc0, 0xa4, 0x66,
-	0x29, 0xd3, 0x58, 0x49, 0x69, 0x8f, 0x8a, 0xb3, 0x64, 0xae, 0x60, 0x7c, 0x47, 0x60, 0x31, 0x8a,
-	0x1c, 0x57, 0x5a, 0xbe, 0x7e, 0x98, 0x87, 0x2b, 0xae, 0x2a, 0xe4, 0x82, 0xea, 0x33, 0x7d, 0x48,
-	0xb4, 0xdf, 0x74, 0xb2, 0xfd, 0x86, 0xbb, 0xa7, 0x98, 0xee, 0x9e, 0x6f, 0xe0, 0xb5, 0x73, 0x58,
-	0xe0, 0x5b, 0x7e, 0x0c, 0x65, 0x19, 0x5d, 0x62, 0x2e, 0x6f, 0xe5, 0xee, 0x1a, 0x7c, 0xbf, 0x01,
-	0xc2, 0xca, 0x1f, 0x00, 0x57, 0x54, 0x30, 0xfa, 0x23, 0x81, 0x92, 0x1e, 0xb5, 0x74, 0x25, 0x57,
-	0x7a, 0x86, 0xa6, 0x7d, 0x65, 0x75, 0x2c, 0x1f, 0x2d, 0xc6, 0x30, 0xbf, 0xfd, 0xfd, 0xdf, 0x1f,
-	0x0a, 0x35, 0x7a, 0x83, 0xe5, 0xda, 0x7b, 0xf4, 0x67, 0x02, 0x33, 0x58, 0x12, 0xf4, 0xce, 0xd8,
-	0x35, 0xa4, 0x89, 0x4e, 0x5a, 0x7b, 0xc6, 0x9a, 0x22, 0xdb, 0xa0, 0xab, 0x2c, 0xdf, 0xde, 0x65,
-	0x87, 0x71, 0x79, 0x1c, 0xd1, 0x5f, 0x09, 0xbc, 0x9c, 0xda, 0x29, 0xf4, 0xfe, 0x98, 0x4c, 0x52,
-	0xcb, 0x68, 0x72, 0x25, 0x77, 0x95, 0x92, 0x3a, 0x65, 0x59, 0x4a, 0xf4, 0x76, 0x63, 0x87, 0xfa,
-	0xf7, 0x88, 0xfe, 0x44, 0x00, 0x10, 0xac, 0xe9, 0x79, 0x39, 0x53, 0x30, 0x32, 0x90, 0x72, 0x12,
-	0x1f, 0x1d, 0x24, 0x06, 0x53, 0xc4, 0x6f, 0xd1, 0x9b, 0x39, 0x53, 0x40, 0x7f, 0x23, 0xf0, 0x52,
-	0x72, 0x31, 0xd2, 0xb5, 0xbc, 0x6f, 0x76, 0xce, 0xa6, 0xae, 0xbc, 0x33, 0x99, 0x33, 0x92, 0x6f,
-	0x2a, 0xf2, 0x6b, 0xf4, 0x5e, 0x16, 0x79, 0x4f, 0x79, 0xef, 0xe8, 0x59, 0x31, 0x54, 0x45, 0x7f,
-	0x11, 0x98, 0x4b, 0x2f, 0x54, 0xfa, 0xde, 0x78, 0xac, 0x46, 0x36, 0x7d, 0xe5, 0xc1, 0xe4, 0x00,
-	0x28, 0xed, 0xa1, 0x92, 0xf6, 0x80, 0xde, 0xcf, 0x29, 0x2d, 0xfa, 0xd6, 0x6c, 0xf1, 0xee, 0x90,
-	0xbe, 0x63, 0x02, 0xe5, 0x78, 0x58, 0xd1, 0xb7, 0xf3, 0xf2, 0x4a, 0xcf, 0xea, 0xca, 0xbd, 0x09,
-	0x3c, 0xc7, 0x95, 0x32, 0xf8, 0x5e, 0x4e, 0x4a, 0x60, 0x87, 0x4a, 0xd5, 0xd1, 0xfa, 0xe3, 0xe3,
-	0xd3, 0x2a, 0x39, 0x39, 0xad, 0x92, 0x7f, 0x4e, 0xab, 0xe4, 0xfb, 0xb3, 0xea, 0xd4, 0xc9, 0x59,
-	0x75, 0xea, 0xcf, 0xb3, 0xea, 0xd4, 0x97, 0x6f, 0x39, 0x6e, 0xb8, 0x7f, 0xb0, 0x6b, 0x3e, 0x11,
-	0xed, 0x8b, 0x62, 0x3c, 0x5b, 0x65, 0xdd, 0x38, 0x50, 0xd8, 0x0b, 0xb8, 0xdc, 0x2d, 0xa9, 0x4f,
-	0xed, 0xd5, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x27, 0x2c, 0x7d, 0x1f, 0xce, 0x0c, 0x00, 0x00,
+	// 1164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0xae, 0x63, 0xbf, 0x54, 0x24, 0x1a, 0x42, 0x48, 0xdd, 0xc8, 0x49, 0xb7, 0x52,
+	0xeb, 0x16, 0xb4, 0xab, 0x24, 0xb8, 0x21, 0x4a, 0x69, 0x6b, 0x2b, 0x34, 0xa4, 0x82, 0x2a, 0x6c,
+	0x42, 0x11, 0x20, 0x64, 0x76, 0xe3, 0x89, 0xb3, 0xb0, 0xde, 0xdd, 0xee, 0xac, 0xa3, 0xb8, 0x51,
+	0x2e, 0x88, 0x3b, 0x95, 0xb8, 0x71, 0xe3, 0x1f, 0xe0, 0xca, 0x19, 0x71, 0xc9, 0x01, 0x89, 0x4a,
+	0x70, 0xe0, 0x02, 0x45, 0x09, 0xff, 0x03, 0x57, 0xe4, 0xd9, 0xb7, 0xeb, 0x1f, 0x71, 0xbc, 0x6b,
+	0x43, 0x4f, 0xf6, 0x8c, 0xdf, 0xf7, 0xcd, 0xf7, 0xcd, 0xbc, 0x37, 0x6f, 0x0c, 0x37, 0x2b, 0x8d,
+	0x1a, 0xb3, 0xb8, 0x61, 0x5b, 0x07, 0x8d, 0x27, 0x4a, 0x38, 0x50, 0x5c, 0xdb, 0x34, 0x35, 0xc7,
+	0x51, 0x1e, 0xd7, 0x99, 0xdb, 0x90, 0x1d, 0xd7, 0xf6, 0x6c, 0x9a, 0x6b, 0x8f, 0x95, 0xc3, 0x81,
+	0x8c, 0xb1, 0xd9, 0xa9, 0xaa, 0x5d, 0xb5, 0x45, 0xa8, 0xd2, 0xfc, 0xe6, 0xa3, 0xb2, 0xb3, 0x55,
+	0xdb, 0xae, 0x9a, 0x4c, 0xd1, 0x1c, 0x43, 0xd1, 0x2c, 0xcb, 0xf6, 0x34, 0xcf, 0xb0, 0x2d, 0x8e,
+	0xbf, 0xce, 0xe1, 0xaf, 0x62, 0xa4, 0xd7, 0x77, 0x15, 0xcf, 0xa8, 0x31, 0xee, 0x69, 0x35, 0x07,
+	0x03, 0x6e, 0xee, 0xd8, 0xbc, 0x66, 0x73, 0x45, 0xd7, 0x38, 0xf3, 0xd5, 0x28, 0xfb, 0x0b, 0x3a,
+	0xf3, 0xb4, 0x05, 0xc5, 0xd1, 0xaa, 0x86, 0x25, 0xd8, 0x30, 0xf6, 0xb5, 0x08, 0x33, 0x8e, 0xe6,
+	0x6a, 0xb5, 0x60, 0xe5, 0xd7, 0x23, 0x82, 0xf1, 0x13, 0xa3, 0x95, 0x88, 0x68, 0xee, 0x69, 0x1e,
+	0x2b, 0x1b, 0xd6, 0x6e, 0x60, 0xbb, 0x10, 0x01, 0xd0, 0x4d, 0x7b, 0xe7, 0x8b, 0x72, 0x85, 0xf1,
+	0x1d, 0xd7, 0x70, 0x3c, 0xdb, 0x45, 0x58, 0x3e, 0x02, 0x16, 0x2a, 0x92, 0xa6, 0x80, 0xbe, 0xdf,
+	0xdc, 0x8e, 0x4d, 0x61, 0x4a, 0x65, 0x8f, 0xeb, 0x8c, 0x7b, 0xd2, 0x27, 0xf0, 0x72, 0xc7, 0x2c,
+	0x77, 0x6c, 0x8b, 0x33, 0xba, 0x06, 0x29, 0xdf, 0xfc, 0x0c, 0x99, 0x27, 0xf9, 0xf1, 0xc5, 0x6b,
+	0x72, 0xff, 0xb3, 0x94, 0x7d, 0x7c, 0x29, 0x79, 0xfc, 0xe7, 0xdc, 0x88, 0x8a, 0x58, 0x69, 0x0b,
+	0xa6, 0x05, 0xf9, 0x3a, 0xf3, 0x54, 0x3f, 0x0e, 0x97, 0xa5, 0xb3, 0x90, 0x41, 0xe4, 0x46, 0x45,
+	0x2c, 0x91, 0x51, 0x5b, 0x13, 0xf4, 0x32, 0x64, 0xec, 0x9a, 0xe1, 0x95, 0x35, 0xc7, 0xe1, 0x33,
+	0x89, 0x79, 0x92, 0x4f, 0xab, 0xe9, 0xe6, 0x44, 0xd1, 0x71, 0xb8, 0xf4, 0x01, 0xe4, 0xba, 0x48,
+	0x4b, 0x8d, 0xb7, 0x37, 0x36, 0x17, 0x0a, 0x85, 0x80, 0x7c, 0x1a, 0x52, 0xcc, 0x70, 0x16, 0x0a,
+	0x05, 0xc1, 0x9c, 0x54, 0x71, 0xd4, 0x9f, 0xf6, 0x23, 0xb8, 0x1c, 0xd0, 0xbe, 0xab, 0x79, 0x8c,
+	0x7b, 0xef, 0x30, 0xa3, 0xba, 0xe7, 0xc5, 0x13, 0x3c, 0x0b, 0x99, 0x5d, 0xc3, 0xd2, 0x4c, 0xe3,
+	0x09, 0xab, 0x20, 0x73, 0x6b, 0x42, 0xba, 0x05, 0xb3, 0xbd, 0xa9, 0x71, 0xb3, 0xa7, 0x21, 0xb5,
+	0x27, 0x66, 0x02, 0xbd, 0xfe, 0x48, 0xfa, 0x14, 0xe6, 0x3a, 0x71, 0x5b, 0xcd, 0xa4, 0xd9, 0xb0,
+	0x2a, 0xec, 0xe0, 0xff, 0x90, 0x75, 0x00, 0xf3, 0xe7, 0xd3, 0xa3, 0xb4, 0x6d, 0x00, 0x1e, 0xce,
+	0x62, 0x2e, 0xc8, 0x51, 0xb9, 0x80, 0x3c, 0xbb, 0xb6, 0x40, 0x61, 0x4e, 0xb4, 0xf1, 0x48, 0xff,
+	0x10, 0x78, 0xf5, 0x4c, 0x62, 0xe0, 0x8a, 0xeb, 0x30, 0x86, 0x3c, 0xb8, 0xdc, 0xf5, 0xa8, 0xe5,
+	0x82, 0x2c, 0xf0, 0xd7, 0x09, 0xd0, 0xf4, 0x21, 0x8c, 0xf1, 0x7a, 0xad, 0xa6, 0xb9, 0x8d, 0x99,
+	0x54, 0x3c, 0xdd, 0x48, 0xb4, 0xe5, 0xa3, 0x02, 0x3e, 0x24, 0xa1, 0x6f, 0x41, 0x52, 0x24, 0xce,
+	0xd8, 0xfc, 0x68, 0x7e, 0x7c, 0xf1, 0x6a, 0x14, 0x59, 0x11, 0x15, 0x11, 0x55, 0xc0, 0x1e, 0x24,
+	0xd3, 0x89, 0xc9, 0x94, 0x74, 0x84, 0x15, 0x51, 0x34, 0xcd, 0xae, 0x8a, 0xb8, 0x0f, 0xd0, 0xba,
+	0x9f, 0xc2, 0xaa, 0xf3, 0x2f, 0x33, 0xb9, 0x79, 0x99, 0xc9, 0xfe, 0xd5, 0x8a, 0x97, 0x99, 0xbc,
+	0xa9, 0x55, 0x19, 0x62, 0xd5, 0x36, 0x64, 0xff, 0x24, 0xff, 0x31, 0xd8, 0xf8, 0xf6, 0xf5, 0x71,
+	0xe3, 0x3f, 0x6c, 0x6d, 0xfc, 0xa8, 0xb0, 0xb8, 0x1c, 0x65, 0xf1, 0x9c, 0x23, 0xec, 0x3e, 0x88,
+	0xf5, 0x0e, 0x67, 0x09, 0x3c, 0xd4, 0x28, 0x67, 0x3e, 0x57, 0xbb, 0xb5, 0x07, 0xc9, 0x34, 0x99,
+	0x4c, 0x48, 0x5f, 0x11, 0x98, 0x09, 0x56, 0x0e, 0x33, 0x2d, 0x5e, 0x3d, 0x4c, 0xc1, 0x05, 0x43,
+	0x24, 0x72, 0x42, 0xd4, 0x99, 0x3f, 0x68, 0x2b, 0xbf, 0xd1, 0xf6, 0xf2, 0xeb, 0xac, 0x9e, 0x64,
+	0x77, 0xf5, 0x7c, 0x0e, 0x97, 0x7a, 0xa8, 0xc0, 0xbd, 0x7c, 0x0f, 0x32, 0x3c, 0x98, 0xc4, 0xb3,
+	0xbc, 0x11, 0xbb, 0x6a, 0x70, 0xff, 0x5a, 0x0c, 0xd2, 0xb7, 0x04, 0x2f, 0xa7, 0x56, 0x4c, 0x63,
+	0xdb, 0xa8, 0xb1, 0x78, 0xae, 0x4b, 0x90, 0x09, 0x9b, 0x24, 0x6e, 0x7f, 0x56, 0xf6, 0xdb, 0xa8,
+	0x1c, 0xb4, 0x51, 0x79, 0x3b, 0x88, 0x28, 0xa5, 0x9b, 0xab, 0x3f, 0x7d, 0x3e, 0x47, 0xd4, 0x16,
+	0x8c, 0x5e, 0x82, 0xf4, 0x5e, 0x5d, 0x2f, 0x37, 0x27, 0xc4, 0x2e, 0xa5, 0xd5, 0xb1, 0xbd, 0xba,
+	0xde, 0x04, 0x49, 0xbf, 0x10, 0x98, 0xed, 0x2d, 0xee, 0x85, 0x6c, 0x06, 0xfd, 0x0c, 0x26, 0xbb,
+	0x7b, 0x21, 0xba, 0x52, 0xa2, 0x58, 0x4b, 0x4d, 0xdc, 0x5a, 0x08, 0x43, 0xee, 0x09, 0xbd, 0x73,
+	0x5a, 0xba, 0x0a, 0x57, 0x84, 0xa1, 0x47, 0x75, 0xd3, 0x62, 0xae, 0xa6, 0x9b, 0x6c, 0x4d, 0xdd,
+	0x7a, 0xc4, 0xdc, 0x26, 0x57, 0xd8, 0x38, 0xd7, 0x41, 0xea, 0x17, 0x84, 0xde, 0xaf, 0xc0, 0xc5,
+	0x8a, 0xcb, 0xcb, 0xfb, 0x38, 0x3f, 0x43, 0xe6, 0x47, 0xf3, 0x19, 0x75, 0xbc, 0xe2, 0xf2, 0x20,
+	0x74, 0xf1, 0xeb, 0x97, 0xe0, 0x82, 0x60, 0xa2, 0xdf, 0x11, 0x48, 0xf9, 0x7d, 0x94, 0x2e, 0xc6,
+	0xaa, 0xbd, 0x8e, 0x56, 0x9e, 0x5d, 0x1a, 0x08, 0xe3, 0x0b, 0x94, 0xe4, 0x2f, 0x7f, 0xfd, 0xfb,
+	0x9b, 0x44, 0x9e, 0x5e, 0x53, 0x62, 0xbd, 0x85, 0xe8, 0x0f, 0x04, 0xc6, 0xb0, 0xde, 0xe9, 0xad,
+	0x81, 0x2f, 0x08, 0x5f, 0xe8, 0xb0, 0x17, 0x8b, 0xb4, 0x2a, 0xc4, 0x16, 0xe8, 0x92, 0x12, 0xef,
+	0x2d, 0xa6, 0x1c, 0x86, 0x55, 0x70, 0x44, 0x7f, 0x22, 0x30, 0xd1, 0xf5, 0x60, 0xa0, 0x77, 0x06,
+	0x54, 0xd2, 0xf5, 0xd2, 0x18, 0xde, 0xc9, 0xb2, 0x70, 0xb2, 0x40, 0x95, 0x28, 0x27, 0xfe, 0xd3,
+	0x45, 0x39, 0xf4, 0x3f, 0x8f, 0xe8, 0xf7, 0x04, 0x00, 0xc9, 0x8a, 0xa6, 0x19, 0xf3, 0x08, 0xce,
+	0x74, 0x9b, 0xec, 0xf2, 0xc0, 0x38, 0x14, 0xae, 0x08, 0xe1, 0x37, 0xe8, 0xf5, 0x98, 0x47, 0x40,
+	0x7f, 0x26, 0x70, 0xb1, 0xfd, 0xd5, 0x43, 0x57, 0xe3, 0xee, 0x59, 0x8f, 0x67, 0x58, 0xf6, 0xf6,
+	0x70, 0x60, 0x14, 0x5f, 0x14, 0xe2, 0x57, 0xe9, 0x4a, 0x94, 0x78, 0x53, 0xa0, 0xcb, 0x7e, 0x23,
+	0xe8, 0xc8, 0xa2, 0x3f, 0x08, 0x4c, 0x76, 0xbf, 0x96, 0xe8, 0xdd, 0xc1, 0x54, 0x9d, 0x79, 0xc6,
+	0x65, 0xef, 0x0d, 0x4f, 0x80, 0xd6, 0xee, 0x0b, 0x6b, 0xf7, 0xe8, 0x9d, 0x98, 0xd6, 0x82, 0xff,
+	0x1f, 0x15, 0x76, 0xd0, 0xe1, 0xef, 0x98, 0x40, 0x26, 0xbc, 0x7c, 0xe9, 0x9b, 0x71, 0x75, 0x75,
+	0x37, 0xe2, 0xec, 0xca, 0x10, 0xc8, 0x41, 0xad, 0xb4, 0xfe, 0x43, 0xb5, 0x5b, 0x50, 0x0e, 0x85,
+	0xab, 0x23, 0xfa, 0x1b, 0x81, 0x89, 0xae, 0x9e, 0x14, 0x33, 0xf9, 0x7a, 0xb7, 0xd9, 0xec, 0xed,
+	0xe1, 0xc0, 0xc3, 0xdb, 0x2a, 0xeb, 0x0d, 0xd1, 0x72, 0x3b, 0x4e, 0xe8, 0x39, 0x81, 0x57, 0x7a,
+	0x36, 0x1d, 0x5a, 0x8c, 0xa5, 0xaf, 0x5f, 0x57, 0xcb, 0x96, 0xfe, 0x0b, 0x05, 0x1a, 0xbd, 0x2b,
+	0x8c, 0xae, 0xd0, 0xe5, 0x28, 0xa3, 0xfb, 0x21, 0x4d, 0xb9, 0xbd, 0x49, 0x96, 0x1e, 0x1e, 0x9f,
+	0xe4, 0xc8, 0xb3, 0x93, 0x1c, 0xf9, 0xeb, 0x24, 0x47, 0x9e, 0x9e, 0xe6, 0x46, 0x9e, 0x9d, 0xe6,
+	0x46, 0x7e, 0x3f, 0xcd, 0x8d, 0x7c, 0xfc, 0x46, 0xd5, 0xf0, 0xf6, 0xea, 0xba, 0xbc, 0x63, 0xd7,
+	0xce, 0x23, 0xdf, 0x5f, 0x52, 0x0e, 0xc2, 0x15, 0xbc, 0x86, 0xc3, 0xb8, 0x9e, 0x12, 0xaf, 0x9c,
+	0xa5, 0x7f, 0x07, 0x00, 0xc9, 0x4b, 0x8b, 0x02, 0xbc, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -797,6 +1015,11 @@ type QueryClient interface {
 	LatestStateIndex(ctx context.Context, in *QueryGetLatestStateIndexRequest, opts ...grpc.CallOption) (*QueryGetLatestStateIndexResponse, error)
 	// Queries a StateInfo by index.
 	StateInfo(ctx context.Context, in *QueryGetStateInfoRequest, opts ...grpc.CallOption) (*QueryGetStateInfoResponse, error)
+	// Queries the StateInfo and the block descriptor current at a given time:
+	// either a hub time or a rollapp block timestamp.
+	StateInfoByTime(ctx context.Context, in *QueryStateInfoByTimeRequest, opts ...grpc.CallOption) (*QueryStateInfoByTimeResponse, error)
+	// Queries the DRS versions marked as vulnerable.
+	VulnerableDRSVersions(ctx context.Context, in *QueryVulnerableDRSVersionsRequest, opts ...grpc.CallOption) (*QueryVulnerableDRSVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -870,6 +1093,24 @@ func (c *queryClient) StateInfo(ctx context.Context, in *QueryGetStateInfoReques
 	return out, nil
 }
 
+func (c *queryClient) StateInfoByTime(ctx context.Context, in *QueryStateInfoByTimeRequest, opts ...grpc.CallOption) (*QueryStateInfoByTimeResponse, error) {
+	out := new(QueryStateInfoByTimeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/StateInfoByTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VulnerableDRSVersions(ctx context.Context, in *QueryVulnerableDRSVersionsRequest, opts ...grpc.CallOption) (*QueryVulnerableDRSVersionsResponse, error) {
+	out := new(QueryVulnerableDRSVersionsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/VulnerableDRSVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -886,6 +1127,11 @@ type QueryServer interface {
 	LatestStateIndex(context.Context, *QueryGetLatestStateIndexRequest) (*QueryGetLatestStateIndexResponse, error)
 	// Queries a StateInfo by index.
 	StateInfo(context.Context, *QueryGetStateInfoRequest) (*QueryGetStateInfoResponse, error)
+	// Queries the StateInfo and the block descriptor current at a given time:
+	// either a hub time or a rollapp block timestamp.
+	StateInfoByTime(context.Context, *QueryStateInfoByTimeRequest) (*QueryStateInfoByTimeResponse, error)
+	// Queries the DRS versions marked as vulnerable.
+	VulnerableDRSVersions(context.Context, *QueryVulnerableDRSVersionsRequest) (*QueryVulnerableDRSVersionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -913,6 +1159,12 @@ func (*UnimplementedQueryServer) LatestStateIndex(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) StateInfo(ctx context.Context, req *QueryGetStateInfoRequest) (*QueryGetStateInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StateInfo not implemented")
 }
+func (*UnimplementedQueryServer) StateInfoByTime(ctx context.Context, req *QueryStateInfoByTimeRequest) (*QueryStateInfoByTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StateInfoByTime not implemented")
+}
+func (*UnimplementedQueryServer) VulnerableDRSVersions(ctx context.Context, req *QueryVulnerableDRSVersionsRequest) (*QueryVulnerableDRSVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VulnerableDRSVersions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1044,6 +1296,42 @@ func _Query_StateInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StateInfoByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStateInfoByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StateInfoByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Query/StateInfoByTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StateInfoByTime(ctx, req.(*QueryStateInfoByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VulnerableDRSVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVulnerableDRSVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VulnerableDRSVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Query/VulnerableDRSVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VulnerableDRSVersions(ctx, req.(*QueryVulnerableDRSVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.rollapp.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1076,6 +1364,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StateInfo",
 			Handler:    _Query_StateInfo_Handler,
 		},
+		{
+			MethodName: "StateInfoByTime",
+			Handler:    _Query_StateInfoByTime_Handler,
+		},
+		{
+			MethodName: "VulnerableDRSVersions",
+			Handler:    _Query_VulnerableDRSVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/rollapp/query.proto",
@@ -1590,85 +1886,231 @@ func (m *QueryGetStateInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStateInfoByTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryStateInfoByTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetRollappRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStateInfoByTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.HubTime {
+		i--
+		if m.HubTime {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.OmitApps {
-		n += 2
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err8 != nil {
+		return 0, err8
 	}
-	return n
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRollappByEIP155Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Eip155 != 0 {
-		n += 1 + sovQuery(uint64(m.Eip155))
-	}
-	if m.OmitApps {
-		n += 2
+func (m *QueryStateInfoByTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetLatestHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Finalized {
-		n += 2
-	}
-	return n
+func (m *QueryStateInfoByTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetLatestHeightResponse) Size() (n int) {
+func (m *QueryStateInfoByTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockDescriptor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.StateInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVulnerableDRSVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVulnerableDRSVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVulnerableDRSVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVulnerableDRSVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVulnerableDRSVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVulnerableDRSVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DrsVersions) > 0 {
+		for iNdEx := len(m.DrsVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DrsVersions[iNdEx])
+			copy(dAtA[i:], m.DrsVersions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.DrsVersions[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRollappRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OmitApps {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetRollappByEIP155Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eip155 != 0 {
+		n += 1 + sovQuery(uint64(m.Eip155))
+	}
+	if m.OmitApps {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetLatestHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetLatestHeightResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1794,6 +2236,61 @@ func (m *QueryGetStateInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryStateInfoByTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.HubTime {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStateInfoByTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StateInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockDescriptor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVulnerableDRSVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVulnerableDRSVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DrsVersions) > 0 {
+		for _, s := range m.DrsVersions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3079,6 +3576,389 @@ func (m *QueryGetStateInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryStateInfoByTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateInfoByTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateInfoByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubTime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HubTime = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStateInfoByTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStateInfoByTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStateInfoByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDescriptor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVulnerableDRSVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVulnerableDRSVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVulnerableDRSVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVulnerableDRSVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVulnerableDRSVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVulnerableDRSVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrsVersions = append(m.DrsVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
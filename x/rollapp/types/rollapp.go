@@ -107,7 +107,7 @@ func (r Rollapp) GenesisInfoFieldsAreSet() bool {
 }
 
 func (r Rollapp) IsVulnerable() bool {
-	return r.Frozen
+	return r.Vulnerable
 }
 
 func (r GenesisInfo) Validate() error {
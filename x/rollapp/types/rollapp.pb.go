@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -152,6 +153,28 @@ type Rollapp struct {
 	// The LastStateUpdateHeight HUB height when the last state update was
 	// received
 	LastStateUpdateHeight int64 `protobuf:"varint,18,opt,name=last_state_update_height,json=lastStateUpdateHeight,proto3" json:"last_state_update_height,omitempty"`
+	// dispute_period_time overrides the dispute_period_time param for the
+	// rollapp. Zero means the param is used. Set by the governance.
+	DisputePeriodTime time.Duration `protobuf:"bytes,19,opt,name=dispute_period_time,json=disputePeriodTime,proto3,stdduration" json:"dispute_period_time"`
+	// dispute_period_in_blocks overrides the dispute_period_in_blocks param for
+	// the state updates of the rollapp. Zero means the param is used. Set by the
+	// owner within the governance bounds.
+	DisputePeriodInBlocks uint64 `protobuf:"varint,20,opt,name=dispute_period_in_blocks,json=disputePeriodInBlocks,proto3" json:"dispute_period_in_blocks,omitempty"`
+	// recovering is set when the governance recovers the rollapp after a fraud,
+	// until one of the initial sequencers registers and becomes the proposer.
+	Recovering bool `protobuf:"varint,21,opt,name=recovering,proto3" json:"recovering,omitempty"`
+	// vulnerable is set when the rollapp is frozen for running a vulnerable DRS
+	// version, until the owner forks it.
+	Vulnerable bool `protobuf:"varint,22,opt,name=vulnerable,proto3" json:"vulnerable,omitempty"`
+	// pending_fork is set when the owner forks the vulnerable rollapp, until the
+	// first state update from the fork point is accepted.
+	PendingFork *RollappFork `protobuf:"bytes,23,opt,name=pending_fork,json=pendingFork,proto3" json:"pending_fork,omitempty"`
+	// maintenance_window is the latest maintenance window registered by the
+	// proposer. The liveness events are postponed during the window.
+	MaintenanceWindow *MaintenanceWindow `protobuf:"bytes,24,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	// maintenance_windows_in_epoch is the number of maintenance windows
+	// registered in the current epoch.
+	MaintenanceWindowsInEpoch uint64 `protobuf:"varint,25,opt,name=maintenance_windows_in_epoch,json=maintenanceWindowsInEpoch,proto3" json:"maintenance_windows_in_epoch,omitempty"`
 }
 
 func (m *Rollapp) Reset()         { *m = Rollapp{} }
@@ -285,6 +308,175 @@ func (m *Rollapp) GetLastStateUpdateHeight() int64 {
 	return 0
 }
 
+func (m *Rollapp) GetDisputePeriodTime() time.Duration {
+	if m != nil {
+		return m.DisputePeriodTime
+	}
+	return 0
+}
+
+func (m *Rollapp) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Rollapp) GetRecovering() bool {
+	if m != nil {
+		return m.Recovering
+	}
+	return false
+}
+
+func (m *Rollapp) GetVulnerable() bool {
+	if m != nil {
+		return m.Vulnerable
+	}
+	return false
+}
+
+func (m *Rollapp) GetPendingFork() *RollappFork {
+	if m != nil {
+		return m.PendingFork
+	}
+	return nil
+}
+
+func (m *Rollapp) GetMaintenanceWindow() *MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindow
+	}
+	return nil
+}
+
+func (m *Rollapp) GetMaintenanceWindowsInEpoch() uint64 {
+	if m != nil {
+		return m.MaintenanceWindowsInEpoch
+	}
+	return 0
+}
+
+// MaintenanceWindow is a range of hub heights during which the rollapp is
+// allowed to be down, e.g. for a planned upgrade.
+type MaintenanceWindow struct {
+	// sequencer is the proposer which registered the window.
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// start_height is the first hub height of the window.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the first hub height after the window.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// RollappFork is the point the forked rollapp resumes from.
+type RollappFork struct {
+	// height is the start height of the first state update after the fork.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// drs_version is the non-vulnerable DRS version the rollapp resumes with.
+	DrsVersion string `protobuf:"bytes,2,opt,name=drs_version,json=drsVersion,proto3" json:"drs_version,omitempty"`
+}
+
+func (m *RollappFork) Reset()         { *m = RollappFork{} }
+func (m *RollappFork) String() string { return proto.CompactTextString(m) }
+func (*RollappFork) ProtoMessage()    {}
+func (*RollappFork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{3}
+}
+func (m *RollappFork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappFork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappFork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappFork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappFork.Merge(m, src)
+}
+func (m *RollappFork) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappFork) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappFork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappFork proto.InternalMessageInfo
+
+func (m *RollappFork) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RollappFork) GetDrsVersion() string {
+	if m != nil {
+		return m.DrsVersion
+	}
+	return ""
+}
+
 type GenesisInfo struct {
 	// checksum used to verify integrity of the genesis file
 	GenesisChecksum string `protobuf:"bytes,1,opt,name=genesis_checksum,json=genesisChecksum,proto3" json:"genesis_checksum,omitempty"`
@@ -302,7 +494,7 @@ func (m *GenesisInfo) Reset()         { *m = GenesisInfo{} }
 func (m *GenesisInfo) String() string { return proto.CompactTextString(m) }
 func (*GenesisInfo) ProtoMessage()    {}
 func (*GenesisInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+	return fileDescriptor_d4ef2bec3aea5528, []int{4}
 }
 func (m *GenesisInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +568,7 @@ func (m *RollappSummary) Reset()         { *m = RollappSummary{} }
 func (m *RollappSummary) String() string { return proto.CompactTextString(m) }
 func (*RollappSummary) ProtoMessage()    {}
 func (*RollappSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{3}
+	return fileDescriptor_d4ef2bec3aea5528, []int{5}
 }
 func (m *RollappSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,6 +636,8 @@ func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.rollapp.Rollapp_VMType", Rollapp_VMType_name, Rollapp_VMType_value)
 	proto.RegisterType((*RollappGenesisState)(nil), "dymensionxyz.dymension.rollapp.RollappGenesisState")
 	proto.RegisterType((*Rollapp)(nil), "dymensionxyz.dymension.rollapp.Rollapp")
+	proto.RegisterType((*MaintenanceWindow)(nil), "dymensionxyz.dymension.rollapp.MaintenanceWindow")
+	proto.RegisterType((*RollappFork)(nil), "dymensionxyz.dymension.rollapp.RollappFork")
 	proto.RegisterType((*GenesisInfo)(nil), "dymensionxyz.dymension.rollapp.GenesisInfo")
 	proto.RegisterType((*RollappSummary)(nil), "dymensionxyz.dymension.rollapp.RollappSummary")
 }
@@ -453,62 +647,79 @@ func init() {
 }
 
 var fileDescriptor_d4ef2bec3aea5528 = []byte{
-	// 876 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0x25, 0x59, 0x3f, 0x2b, 0xc9, 0x66, 0x36, 0x71, 0xcb, 0x0a, 0x8d, 0x2c, 0xa8, 0x40,
-	0xa1, 0x36, 0x31, 0x89, 0xda, 0x01, 0x7a, 0xae, 0x5b, 0x37, 0x51, 0x1a, 0x17, 0x05, 0x65, 0xbb,
-	0x40, 0x0e, 0x25, 0x28, 0x72, 0x44, 0x2d, 0x42, 0x2e, 0x59, 0xee, 0x4a, 0xb5, 0xfc, 0x14, 0x79,
-	0x88, 0xf6, 0x5d, 0x72, 0x0c, 0x7a, 0x2a, 0x7a, 0x48, 0x0b, 0xfb, 0x45, 0x8a, 0xfd, 0xa1, 0xa2,
-	0xd6, 0x49, 0x15, 0xe4, 0xb4, 0x9c, 0xf9, 0x66, 0x66, 0xe7, 0xe7, 0x9b, 0x25, 0xba, 0x1f, 0x2e,
-	0x13, 0xa0, 0x8c, 0xa4, 0xf4, 0x62, 0x79, 0xe9, 0xac, 0x04, 0x27, 0x4f, 0xe3, 0xd8, 0xcf, 0xb2,
-	0xe2, 0xb4, 0xb3, 0x3c, 0xe5, 0x29, 0xee, 0xad, 0x5b, 0xdb, 0x2b, 0xc1, 0xd6, 0x56, 0xdd, 0x3b,
-	0x51, 0x1a, 0xa5, 0xd2, 0xd4, 0x11, 0x5f, 0xca, 0xab, 0xbb, 0x17, 0xa5, 0x69, 0x14, 0x83, 0x23,
-	0xa5, 0xc9, 0x7c, 0xea, 0x70, 0x92, 0x00, 0xe3, 0x7e, 0xa2, 0xc3, 0x76, 0x3f, 0x0c, 0x52, 0x96,
-	0xa4, 0xcc, 0x49, 0x58, 0xe4, 0x2c, 0xbe, 0x10, 0x87, 0x06, 0x9c, 0x0d, 0xd9, 0x31, 0xee, 0x73,
-	0xf0, 0x08, 0x9d, 0x16, 0x57, 0xed, 0x6f, 0x70, 0x48, 0x80, 0xfb, 0xa1, 0xcf, 0x7d, 0x65, 0x3e,
-	0x78, 0x84, 0x6e, 0xbb, 0x0a, 0x79, 0x08, 0x14, 0x18, 0x61, 0x63, 0x11, 0x10, 0xdf, 0x43, 0xb7,
-	0x78, 0xee, 0x53, 0x36, 0x85, 0x9c, 0x79, 0x40, 0xfd, 0x49, 0x0c, 0xa1, 0x55, 0xee, 0x1b, 0xc3,
-	0x86, 0x6b, 0xae, 0x80, 0x63, 0xa5, 0x7f, 0x5c, 0x6d, 0x18, 0x66, 0x79, 0xf0, 0x5b, 0x0d, 0xd5,
-	0x75, 0x28, 0x7c, 0x17, 0x21, 0x7d, 0x9f, 0x47, 0x42, 0xcb, 0xe8, 0x1b, 0xc3, 0xa6, 0xdb, 0xd4,
-	0x9a, 0x51, 0x88, 0xef, 0xa0, 0xad, 0xf4, 0x17, 0x0a, 0xb9, 0x8c, 0xd8, 0x74, 0x95, 0x80, 0x7f,
-	0x42, 0x9d, 0x48, 0xe5, 0xe0, 0xc9, 0xaa, 0xac, 0x7a, 0xdf, 0x18, 0xb6, 0x0e, 0x0e, 0xed, 0xff,
-	0x6f, 0xb9, 0xfd, 0x86, 0xfc, 0x8f, 0xaa, 0x2f, 0x5e, 0xed, 0x95, 0xdc, 0x76, 0xb4, 0x5e, 0xd3,
-	0x5d, 0x84, 0x82, 0x99, 0x4f, 0x29, 0xc4, 0x22, 0xa9, 0x86, 0x4a, 0x4a, 0x6b, 0x46, 0x21, 0xfe,
-	0x00, 0xd5, 0xa6, 0x79, 0x7a, 0x09, 0xd4, 0x6a, 0xca, 0x3a, 0xb5, 0x84, 0x3f, 0x47, 0x66, 0x0e,
-	0x11, 0x61, 0x1c, 0x72, 0x08, 0xbf, 0x01, 0x9a, 0x26, 0xcc, 0x42, 0xfd, 0xca, 0xb0, 0xe9, 0xde,
-	0xd0, 0xe3, 0xef, 0x50, 0xa3, 0xe8, 0xaf, 0xd5, 0x92, 0xd9, 0x3b, 0xef, 0x98, 0xfd, 0x89, 0x76,
-	0x73, 0x57, 0x01, 0xf0, 0x29, 0x2a, 0xf2, 0x97, 0xf3, 0xb5, 0xda, 0x32, 0xe0, 0xbd, 0x4d, 0x01,
-	0x75, 0x1f, 0x46, 0x74, 0x9a, 0xea, 0x36, 0xb4, 0xa2, 0xd7, 0x2a, 0x31, 0x59, 0x42, 0x09, 0x27,
-	0x7e, 0xec, 0x31, 0xf8, 0x79, 0x0e, 0x34, 0x80, 0xdc, 0xea, 0xc8, 0x66, 0x98, 0x1a, 0x18, 0x17,
-	0x7a, 0xfc, 0x10, 0xd5, 0x17, 0x89, 0xc7, 0x97, 0x19, 0x58, 0xdb, 0x7d, 0x63, 0xb8, 0x7d, 0x60,
-	0xbf, 0x63, 0x39, 0xf6, 0xf9, 0xc9, 0xe9, 0x32, 0x03, 0xb7, 0xb6, 0x48, 0xc4, 0x89, 0xbb, 0xa8,
-	0x11, 0xfb, 0x73, 0x1a, 0xcc, 0x20, 0xb4, 0x76, 0x64, 0x7b, 0x57, 0x32, 0x7e, 0x82, 0x76, 0xb2,
-	0x1c, 0x3c, 0x25, 0x7b, 0x62, 0x33, 0x2c, 0x53, 0x96, 0xda, 0xb5, 0xd5, 0xda, 0xd8, 0xc5, 0xda,
-	0xd8, 0xa7, 0xc5, 0xda, 0x1c, 0x35, 0x44, 0x65, 0xcf, 0xff, 0xda, 0x33, 0xdc, 0x4e, 0x96, 0xc3,
-	0x13, 0xe9, 0x2b, 0x50, 0x7c, 0x80, 0x76, 0x63, 0xb2, 0x10, 0x05, 0x33, 0x0f, 0x16, 0x40, 0xb9,
-	0x37, 0x03, 0x12, 0xcd, 0xb8, 0x75, 0xab, 0x6f, 0x0c, 0x2b, 0xee, 0xed, 0x02, 0x3c, 0x16, 0xd8,
-	0x23, 0x09, 0xe1, 0x2f, 0x91, 0x15, 0xfb, 0x8c, 0x2b, 0xda, 0x79, 0xf3, 0x2c, 0x14, 0x87, 0x76,
-	0xc3, 0xd2, 0x6d, 0x57, 0xe0, 0x92, 0x46, 0x67, 0x12, 0x55, 0x8e, 0x83, 0xfb, 0xa8, 0xa6, 0x0a,
-	0xc5, 0x3b, 0xa8, 0x75, 0x46, 0x59, 0x06, 0x01, 0x99, 0x12, 0x08, 0xcd, 0x12, 0xae, 0xa3, 0xca,
-	0xf1, 0xf9, 0x89, 0x69, 0xe0, 0x06, 0xaa, 0xfe, 0xf8, 0xd5, 0xf8, 0xc4, 0x2c, 0x3f, 0xae, 0x36,
-	0x2a, 0x66, 0x7d, 0xf0, 0x6b, 0x19, 0xb5, 0xd6, 0x66, 0x84, 0x3f, 0x43, 0x66, 0x31, 0xe6, 0x60,
-	0x06, 0xc1, 0x33, 0x36, 0x4f, 0xf4, 0xc6, 0xec, 0x68, 0xfd, 0xd7, 0x5a, 0x8d, 0x3f, 0x41, 0x9d,
-	0x09, 0x04, 0xb3, 0xc3, 0x03, 0x2f, 0xcb, 0x61, 0x4a, 0x2e, 0xf4, 0xfe, 0xb4, 0x95, 0xf2, 0x07,
-	0xa9, 0xc3, 0xe7, 0xa8, 0x4d, 0x7d, 0x4e, 0x16, 0xe0, 0x85, 0x82, 0x94, 0x56, 0x45, 0xf6, 0x72,
-	0x7f, 0xd3, 0xe0, 0x24, 0x83, 0x0b, 0x16, 0x16, 0xc4, 0x51, 0x81, 0x24, 0x84, 0xcf, 0xd0, 0xf6,
-	0x8a, 0x38, 0xf3, 0x2c, 0x8b, 0x97, 0x56, 0x55, 0xdc, 0x7e, 0x64, 0x0b, 0xd3, 0x3f, 0x5f, 0xed,
-	0x7d, 0x1a, 0x11, 0x3e, 0x9b, 0x4f, 0xec, 0x20, 0x4d, 0x1c, 0xfd, 0x9a, 0xa9, 0x63, 0x9f, 0x85,
-	0xcf, 0x1c, 0xc1, 0x21, 0x66, 0x8f, 0x28, 0x77, 0x3b, 0x05, 0xcb, 0x64, 0x10, 0xb1, 0x76, 0x0c,
-	0x7c, 0xf1, 0xbc, 0x6c, 0xa9, 0xb5, 0x53, 0xd2, 0xe0, 0xf7, 0x32, 0xda, 0xd6, 0x64, 0x1a, 0xcf,
-	0x93, 0xc4, 0xcf, 0x97, 0xf8, 0x63, 0xf4, 0xfa, 0x0d, 0xb9, 0xf9, 0xa8, 0x3c, 0x45, 0x66, 0xec,
-	0x73, 0xd0, 0x63, 0x1a, 0xd1, 0x10, 0x54, 0x7f, 0x5a, 0x9b, 0x49, 0xab, 0x3d, 0xa6, 0xa9, 0xf4,
-	0x72, 0x6f, 0xc4, 0xc1, 0x31, 0xfa, 0x48, 0xe9, 0xbe, 0x25, 0xd4, 0x8f, 0xc9, 0x25, 0x84, 0x6b,
-	0x97, 0x54, 0xde, 0xeb, 0x92, 0xb7, 0x07, 0xc4, 0x03, 0xd4, 0x56, 0xa0, 0x62, 0x99, 0xec, 0x73,
-	0xd5, 0xfd, 0x97, 0x0e, 0x3f, 0x40, 0xbb, 0xff, 0x09, 0xa0, 0x8d, 0xb7, 0xa4, 0xf1, 0x9b, 0xc1,
-	0xa3, 0xef, 0x5f, 0x5c, 0xf5, 0x8c, 0x97, 0x57, 0x3d, 0xe3, 0xef, 0xab, 0x9e, 0xf1, 0xfc, 0xba,
-	0x57, 0x7a, 0x79, 0xdd, 0x2b, 0xfd, 0x71, 0xdd, 0x2b, 0x3d, 0x7d, 0xb0, 0x36, 0xbd, 0xb7, 0xfc,
-	0x41, 0x16, 0x87, 0xce, 0xc5, 0xea, 0x37, 0x22, 0xe7, 0x39, 0xa9, 0xc9, 0xcd, 0x3c, 0xfc, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0x7e, 0xf7, 0xb0, 0x5e, 0x44, 0x07, 0x00, 0x00,
+	// 1140 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdf, 0x6e, 0x13, 0xc7,
+	0x17, 0xce, 0x26, 0x26, 0xb1, 0x8f, 0x9d, 0xc4, 0x99, 0x10, 0xd8, 0x44, 0xe0, 0xf8, 0xe7, 0x9f,
+	0x54, 0xb9, 0x05, 0x76, 0x45, 0x82, 0xc4, 0x65, 0xd5, 0x94, 0x00, 0xa6, 0x04, 0xa1, 0x0d, 0x04,
+	0x89, 0x8b, 0x6e, 0x37, 0xbb, 0xc7, 0xeb, 0x51, 0x76, 0x67, 0xb7, 0x33, 0x6b, 0x43, 0x78, 0x0a,
+	0x2e, 0xfb, 0x00, 0x7d, 0x18, 0x2e, 0x51, 0xaf, 0xaa, 0x5e, 0xd0, 0x0a, 0xa4, 0x3e, 0x47, 0x35,
+	0x7f, 0xd6, 0x31, 0x31, 0xd4, 0xa8, 0x57, 0xe3, 0xf3, 0x7d, 0xe7, 0x7c, 0x73, 0xce, 0x99, 0x99,
+	0xb3, 0x86, 0xeb, 0xd1, 0x69, 0x8a, 0x4c, 0xd0, 0x8c, 0xbd, 0x3c, 0x7d, 0xe5, 0x8e, 0x0d, 0x97,
+	0x67, 0x49, 0x12, 0xe4, 0x79, 0xb9, 0x3a, 0x39, 0xcf, 0x8a, 0x8c, 0xb4, 0x26, 0xbd, 0x9d, 0xb1,
+	0xe1, 0x18, 0xaf, 0xad, 0x8b, 0x71, 0x16, 0x67, 0xca, 0xd5, 0x95, 0xbf, 0x74, 0xd4, 0xd6, 0x76,
+	0x9c, 0x65, 0x71, 0x82, 0xae, 0xb2, 0x8e, 0x87, 0x7d, 0xb7, 0xa0, 0x29, 0x8a, 0x22, 0x48, 0x8d,
+	0xec, 0x56, 0xeb, 0xbc, 0x43, 0x34, 0xe4, 0x41, 0x21, 0x85, 0x35, 0x7f, 0x39, 0xcc, 0x44, 0x9a,
+	0x09, 0x37, 0x15, 0xb1, 0x3b, 0xba, 0x29, 0x17, 0x43, 0xb8, 0x33, 0xb2, 0x17, 0x45, 0x50, 0xa0,
+	0x4f, 0x59, 0xbf, 0x4c, 0xe5, 0xc6, 0x8c, 0x80, 0x14, 0x8b, 0x20, 0x0a, 0x8a, 0x40, 0xbb, 0x77,
+	0xee, 0xc3, 0xba, 0xa7, 0x99, 0x7b, 0xc8, 0x50, 0x50, 0x71, 0x28, 0x05, 0xc9, 0x35, 0x58, 0x2b,
+	0x78, 0xc0, 0x44, 0x1f, 0xb9, 0xf0, 0x91, 0x05, 0xc7, 0x09, 0x46, 0xf6, 0x7c, 0xdb, 0xea, 0x56,
+	0xbd, 0xe6, 0x98, 0xd8, 0xd7, 0xf8, 0x83, 0x4a, 0xd5, 0x6a, 0xce, 0x77, 0xfe, 0xae, 0xc1, 0x92,
+	0x91, 0x22, 0x57, 0x01, 0xcc, 0x7e, 0x3e, 0x8d, 0x6c, 0xab, 0x6d, 0x75, 0x6b, 0x5e, 0xcd, 0x20,
+	0xbd, 0x88, 0x5c, 0x84, 0x0b, 0xd9, 0x0b, 0x86, 0x5c, 0x29, 0xd6, 0x3c, 0x6d, 0x90, 0x1f, 0x61,
+	0x39, 0xd6, 0x39, 0xf8, 0xaa, 0x2a, 0x7b, 0xa9, 0x6d, 0x75, 0xeb, 0x3b, 0xbb, 0xce, 0xbf, 0x1f,
+	0x89, 0xf3, 0x89, 0xfc, 0xf7, 0x2a, 0x6f, 0xde, 0x6d, 0xcf, 0x79, 0x8d, 0x78, 0xb2, 0xa6, 0xab,
+	0x00, 0xe1, 0x20, 0x60, 0x0c, 0x13, 0x99, 0x54, 0x55, 0x27, 0x65, 0x90, 0x5e, 0x44, 0x2e, 0xc1,
+	0x62, 0x9f, 0x67, 0xaf, 0x90, 0xd9, 0x35, 0x55, 0xa7, 0xb1, 0xc8, 0x37, 0xd0, 0xe4, 0x18, 0x53,
+	0x51, 0x20, 0xc7, 0xe8, 0x0e, 0xb2, 0x2c, 0x15, 0x36, 0xb4, 0x17, 0xba, 0x35, 0x6f, 0x0a, 0x27,
+	0x3f, 0x40, 0xb5, 0xec, 0xaf, 0x5d, 0x57, 0xd9, 0xbb, 0x5f, 0x98, 0xfd, 0x81, 0x09, 0xf3, 0xc6,
+	0x02, 0xe4, 0x09, 0x94, 0xf9, 0xab, 0xf3, 0xb5, 0x1b, 0x4a, 0xf0, 0xda, 0x2c, 0x41, 0xd3, 0x87,
+	0x1e, 0xeb, 0x67, 0xa6, 0x0d, 0xf5, 0xf8, 0x0c, 0x92, 0x27, 0x4b, 0x19, 0x2d, 0x68, 0x90, 0xf8,
+	0x02, 0x7f, 0x1e, 0x22, 0x0b, 0x91, 0xdb, 0xcb, 0xaa, 0x19, 0x4d, 0x43, 0x1c, 0x96, 0x38, 0xb9,
+	0x07, 0x4b, 0xa3, 0xd4, 0x2f, 0x4e, 0x73, 0xb4, 0x57, 0xda, 0x56, 0x77, 0x65, 0xc7, 0xf9, 0xc2,
+	0x72, 0x9c, 0xa3, 0x83, 0x27, 0xa7, 0x39, 0x7a, 0x8b, 0xa3, 0x54, 0xae, 0x64, 0x0b, 0xaa, 0x49,
+	0x30, 0x64, 0xe1, 0x00, 0x23, 0x7b, 0x55, 0xb5, 0x77, 0x6c, 0x93, 0x87, 0xb0, 0x9a, 0x73, 0xf4,
+	0xb5, 0xed, 0xcb, 0x97, 0x63, 0x37, 0x55, 0xa9, 0x5b, 0x8e, 0x7e, 0x35, 0x4e, 0xf9, 0x6a, 0x9c,
+	0x27, 0xe5, 0xb3, 0xda, 0xab, 0xca, 0xca, 0x5e, 0xff, 0xb9, 0x6d, 0x79, 0xcb, 0x39, 0xc7, 0x87,
+	0x2a, 0x56, 0xb2, 0x64, 0x07, 0x36, 0x12, 0x3a, 0x92, 0x05, 0x0b, 0x1f, 0x47, 0xc8, 0x0a, 0x7f,
+	0x80, 0x34, 0x1e, 0x14, 0xf6, 0x5a, 0xdb, 0xea, 0x2e, 0x78, 0xeb, 0x25, 0xb9, 0x2f, 0xb9, 0xfb,
+	0x8a, 0x22, 0xb7, 0xc1, 0x4e, 0x02, 0x51, 0xe8, 0x6b, 0xe7, 0x0f, 0xf3, 0x48, 0x2e, 0x26, 0x8c,
+	0xa8, 0xb0, 0x0d, 0xc9, 0xab, 0x6b, 0xf4, 0x54, 0xb1, 0x26, 0xf0, 0x10, 0xd6, 0x23, 0x2a, 0xf2,
+	0x61, 0x81, 0x7e, 0x8e, 0x9c, 0x66, 0x91, 0x4e, 0x7f, 0x5d, 0xa5, 0xbf, 0x39, 0x95, 0xfe, 0x1d,
+	0xf3, 0xe8, 0x75, 0xf6, 0xbf, 0xc8, 0xec, 0xd7, 0x4c, 0xfc, 0x63, 0x15, 0xae, 0x2a, 0xb8, 0x0d,
+	0xf6, 0x39, 0x51, 0xca, 0xfc, 0xe3, 0x24, 0x0b, 0x4f, 0x84, 0x7d, 0xb1, 0x6d, 0x75, 0x2b, 0xde,
+	0xc6, 0x47, 0x41, 0x3d, 0xb6, 0xa7, 0x48, 0xd2, 0x02, 0xe0, 0x18, 0x66, 0x23, 0xe4, 0x94, 0xc5,
+	0xf6, 0x86, 0x6a, 0xf3, 0x04, 0x22, 0xf9, 0xd1, 0x30, 0x61, 0xc8, 0xe5, 0xb3, 0xb5, 0x2f, 0x69,
+	0xfe, 0x0c, 0x21, 0x8f, 0xa0, 0x91, 0x23, 0x8b, 0x28, 0x8b, 0xfd, 0x7e, 0xc6, 0x4f, 0xec, 0xcb,
+	0x5f, 0x76, 0xe1, 0xcc, 0x91, 0xdf, 0xcd, 0xf8, 0x89, 0x57, 0x37, 0x02, 0xd2, 0x20, 0x3f, 0x01,
+	0x49, 0x03, 0xca, 0x0a, 0x64, 0x01, 0x0b, 0xd1, 0x7f, 0x41, 0x59, 0x94, 0xbd, 0xb0, 0x6d, 0xa5,
+	0x7a, 0x73, 0x96, 0xea, 0xc1, 0x59, 0xe4, 0x33, 0x15, 0xe8, 0xad, 0xa5, 0xe7, 0x21, 0xf2, 0x2d,
+	0x5c, 0x99, 0xde, 0x41, 0x3e, 0x17, 0x1f, 0xf3, 0x2c, 0x1c, 0xd8, 0x9b, 0xaa, 0x5d, 0x9b, 0x53,
+	0x81, 0xa2, 0xc7, 0xf6, 0xa5, 0x43, 0xe7, 0x3a, 0x2c, 0xea, 0x9b, 0x4a, 0x56, 0xa1, 0xfe, 0x94,
+	0x89, 0x1c, 0x43, 0xda, 0xa7, 0x18, 0x35, 0xe7, 0xc8, 0x12, 0x2c, 0xec, 0x1f, 0x1d, 0x34, 0x2d,
+	0x52, 0x85, 0xca, 0xb3, 0xef, 0x0e, 0x0f, 0x9a, 0xf3, 0x0f, 0x2a, 0xd5, 0x85, 0xe6, 0x52, 0x47,
+	0xc0, 0xda, 0x54, 0x72, 0xe4, 0x0a, 0xd4, 0xce, 0x9e, 0x93, 0x19, 0x78, 0x63, 0x80, 0xfc, 0x0f,
+	0x1a, 0xa2, 0x08, 0xf8, 0xf8, 0x2e, 0xce, 0xab, 0x4b, 0x55, 0x57, 0x98, 0xb9, 0x4a, 0x57, 0x01,
+	0x90, 0x45, 0xa5, 0xc3, 0x82, 0x72, 0xa8, 0x21, 0x8b, 0x34, 0xdd, 0xb9, 0x0b, 0xf5, 0x89, 0x3e,
+	0xcb, 0x61, 0x65, 0x3c, 0x2d, 0x55, 0xa2, 0xb1, 0xc8, 0x36, 0xd4, 0x23, 0x2e, 0xfc, 0x11, 0x72,
+	0xd9, 0x4c, 0x33, 0x5f, 0x21, 0xe2, 0xe2, 0x48, 0x23, 0x9d, 0x5f, 0xe7, 0xa1, 0x3e, 0x31, 0x21,
+	0xc8, 0xd7, 0xd0, 0x2c, 0x87, 0x4c, 0x38, 0xc0, 0xf0, 0x44, 0x0c, 0x53, 0x93, 0xfe, 0xaa, 0xc1,
+	0xbf, 0x37, 0x30, 0xf9, 0x3f, 0x2c, 0x1f, 0x63, 0x38, 0xd8, 0xdd, 0xf1, 0x73, 0x8e, 0x7d, 0xfa,
+	0xd2, 0xa8, 0x37, 0x34, 0xf8, 0x58, 0x61, 0xe4, 0x08, 0x1a, 0x2c, 0x28, 0xe8, 0x08, 0xfd, 0x48,
+	0x8e, 0x44, 0x55, 0x48, 0x7d, 0xe7, 0xc6, 0xac, 0xd3, 0x56, 0xf3, 0xb3, 0x9c, 0x81, 0xe5, 0xd8,
+	0xd2, 0x42, 0x8a, 0x22, 0x4f, 0x61, 0x65, 0x3c, 0xb6, 0x86, 0x79, 0x9e, 0x9c, 0xda, 0x15, 0xb9,
+	0xfb, 0x9e, 0x23, 0x5d, 0xff, 0x78, 0xb7, 0xfd, 0x55, 0x4c, 0x8b, 0xc1, 0xf0, 0xd8, 0x09, 0xb3,
+	0xd4, 0x35, 0xdf, 0x52, 0xbd, 0xdc, 0x10, 0xd1, 0x89, 0x2b, 0x27, 0x98, 0x70, 0x7a, 0xac, 0xf0,
+	0x96, 0xcb, 0x19, 0xa7, 0x44, 0x64, 0x1f, 0x05, 0x06, 0xf2, 0xe3, 0x76, 0x41, 0x0f, 0x7d, 0x6d,
+	0x75, 0x7e, 0x9b, 0x87, 0x15, 0xd3, 0xef, 0xc3, 0x61, 0x9a, 0x06, 0xfc, 0x54, 0x9e, 0xf0, 0xf8,
+	0x0b, 0x36, 0xfd, 0x49, 0x7b, 0x0e, 0xcd, 0x24, 0x28, 0xd0, 0x0c, 0x89, 0x1e, 0x8b, 0x50, 0xf7,
+	0xa7, 0x3e, 0x7b, 0x64, 0x9a, 0x88, 0x7e, 0xa6, 0xa2, 0xbc, 0x29, 0x1d, 0x92, 0xc0, 0xa6, 0xc6,
+	0xee, 0x52, 0x16, 0x24, 0xf4, 0x15, 0x46, 0x13, 0x9b, 0x2c, 0xfc, 0xa7, 0x4d, 0x3e, 0x2f, 0x48,
+	0x3a, 0xd0, 0xd0, 0xa4, 0xbe, 0x79, 0xaa, 0xcf, 0x15, 0xef, 0x23, 0x8c, 0xdc, 0x82, 0x8d, 0x73,
+	0x02, 0xc6, 0xf9, 0x82, 0x9e, 0x4f, 0x9f, 0x24, 0xf7, 0x1e, 0xbd, 0x79, 0xdf, 0xb2, 0xde, 0xbe,
+	0x6f, 0x59, 0x7f, 0xbd, 0x6f, 0x59, 0xaf, 0x3f, 0xb4, 0xe6, 0xde, 0x7e, 0x68, 0xcd, 0xfd, 0xfe,
+	0xa1, 0x35, 0xf7, 0xfc, 0xd6, 0xc4, 0xe9, 0x7d, 0xe6, 0xff, 0xcb, 0x68, 0xd7, 0x7d, 0x39, 0xfe,
+	0x13, 0xa3, 0xce, 0xf3, 0x78, 0x51, 0x0d, 0xd6, 0xdd, 0x7f, 0x06, 0x00, 0x04, 0x0d, 0x15, 0xfa,
+	0xe2, 0x09, 0x00, 0x00,
 }
 
 func (m *RollappGenesisState) Marshal() (dAtA []byte, err error) {
@@ -564,6 +775,82 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaintenanceWindowsInEpoch != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.MaintenanceWindowsInEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.MaintenanceWindow != nil {
+		{
+			size, err := m.MaintenanceWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollapp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.PendingFork != nil {
+		{
+			size, err := m.PendingFork.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollapp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.Vulnerable {
+		i--
+		if m.Vulnerable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Recovering {
+		i--
+		if m.Recovering {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DisputePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriodTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintRollapp(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
 	if m.LastStateUpdateHeight != 0 {
 		i = encodeVarintRollapp(dAtA, i, uint64(m.LastStateUpdateHeight))
 		i--
@@ -578,12 +865,12 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x88
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
-	if err1 != nil {
-		return 0, err1
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintRollapp(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintRollapp(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1
 	i--
@@ -685,6 +972,81 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollappFork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappFork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappFork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DrsVersion) > 0 {
+		i -= len(m.DrsVersion)
+		copy(dAtA[i:], m.DrsVersion)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.DrsVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -892,6 +1254,63 @@ func (m *Rollapp) Size() (n int) {
 	if m.LastStateUpdateHeight != 0 {
 		n += 2 + sovRollapp(uint64(m.LastStateUpdateHeight))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriodTime)
+	n += 2 + l + sovRollapp(uint64(l))
+	if m.DisputePeriodInBlocks != 0 {
+		n += 2 + sovRollapp(uint64(m.DisputePeriodInBlocks))
+	}
+	if m.Recovering {
+		n += 3
+	}
+	if m.Vulnerable {
+		n += 3
+	}
+	if m.PendingFork != nil {
+		l = m.PendingFork.Size()
+		n += 2 + l + sovRollapp(uint64(l))
+	}
+	if m.MaintenanceWindow != nil {
+		l = m.MaintenanceWindow.Size()
+		n += 2 + l + sovRollapp(uint64(l))
+	}
+	if m.MaintenanceWindowsInEpoch != 0 {
+		n += 2 + sovRollapp(uint64(m.MaintenanceWindowsInEpoch))
+	}
+	return n
+}
+
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovRollapp(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovRollapp(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *RollappFork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRollapp(uint64(m.Height))
+	}
+	l = len(m.DrsVersion)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
 	return n
 }
 
@@ -1443,6 +1862,410 @@ func (m *Rollapp) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DisputePeriodTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
+			}
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovering", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recovering = bool(v != 0)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vulnerable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Vulnerable = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFork", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingFork == nil {
+				m.PendingFork = &RollappFork{}
+			}
+			if err := m.PendingFork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaintenanceWindow == nil {
+				m.MaintenanceWindow = &MaintenanceWindow{}
+			}
+			if err := m.MaintenanceWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowsInEpoch", wireType)
+			}
+			m.MaintenanceWindowsInEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceWindowsInEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollappFork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappFork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappFork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrsVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
@@ -114,6 +114,9 @@ type StateInfo struct {
 	CreatedAt time.Time `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at" yaml:"created_at"`
 	// DrsVersion is a DRS version used by the rollapp.
 	DrsVersion string `protobuf:"bytes,11,opt,name=drs_version,json=drsVersion,proto3" json:"drs_version,omitempty"`
+	// dispute_period_in_blocks is the dispute period of the rollapp at the time
+	// the state was created. Zero means the dispute_period_in_blocks param is used.
+	DisputePeriodInBlocks uint64 `protobuf:"varint,12,opt,name=dispute_period_in_blocks,json=disputePeriodInBlocks,proto3" json:"dispute_period_in_blocks,omitempty"`
 }
 
 func (m *StateInfo) Reset()         { *m = StateInfo{} }
@@ -219,6 +222,13 @@ func (m *StateInfo) GetDrsVersion() string {
 	return ""
 }
 
+func (m *StateInfo) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
+	}
+	return 0
+}
+
 // StateInfoSummary is a compact representation of StateInfo
 type StateInfoSummary struct {
 	// stateInfoIndex defines what rollapp the state belongs to
@@ -284,13 +294,15 @@ func (m *StateInfoSummary) GetCreationHeight() uint64 {
 	return 0
 }
 
-// BlockHeightToFinalizationQueue defines a map from block height to list of states to finalized
+// BlockHeightToFinalizationQueue defines a map from block height and rollapp to list of states to finalized
 type BlockHeightToFinalizationQueue struct {
 	// creationHeight is the block height that the state should be finalized
 	CreationHeight uint64 `protobuf:"varint,1,opt,name=creationHeight,proto3" json:"creationHeight,omitempty"`
 	// finalizationQueue is a list of states that are waiting to be finalized
 	// when the block height becomes creationHeight
 	FinalizationQueue []StateInfoIndex `protobuf:"bytes,2,rep,name=finalizationQueue,proto3" json:"finalizationQueue"`
+	// rollappId is the rollapp the states of the queue belong to
+	RollappId string `protobuf:"bytes,3,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
 }
 
 func (m *BlockHeightToFinalizationQueue) Reset()         { *m = BlockHeightToFinalizationQueue{} }
@@ -340,11 +352,86 @@ func (m *BlockHeightToFinalizationQueue) GetFinalizationQueue() []StateInfoIndex
 	return nil
 }
 
+func (m *BlockHeightToFinalizationQueue) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+// TimeToFinalizationQueue defines a map from time and rollapp to list of states to finalized.
+// States are moved here from BlockHeightToFinalizationQueue when their block
+// dispute period is over but their time dispute period is not.
+type TimeToFinalizationQueue struct {
+	// finalizationTime is the time after which the states can be finalized
+	FinalizationTime time.Time `protobuf:"bytes,1,opt,name=finalizationTime,proto3,stdtime" json:"finalizationTime"`
+	// finalizationQueue is a list of states that are waiting to be finalized
+	// when the block time reaches finalizationTime
+	FinalizationQueue []StateInfoIndex `protobuf:"bytes,2,rep,name=finalizationQueue,proto3" json:"finalizationQueue"`
+	// rollappId is the rollapp the states of the queue belong to
+	RollappId string `protobuf:"bytes,3,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+}
+
+func (m *TimeToFinalizationQueue) Reset()         { *m = TimeToFinalizationQueue{} }
+func (m *TimeToFinalizationQueue) String() string { return proto.CompactTextString(m) }
+func (*TimeToFinalizationQueue) ProtoMessage()    {}
+func (*TimeToFinalizationQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_750f3a9f16533ec4, []int{4}
+}
+func (m *TimeToFinalizationQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeToFinalizationQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeToFinalizationQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeToFinalizationQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeToFinalizationQueue.Merge(m, src)
+}
+func (m *TimeToFinalizationQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeToFinalizationQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeToFinalizationQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeToFinalizationQueue proto.InternalMessageInfo
+
+func (m *TimeToFinalizationQueue) GetFinalizationTime() time.Time {
+	if m != nil {
+		return m.FinalizationTime
+	}
+	return time.Time{}
+}
+
+func (m *TimeToFinalizationQueue) GetFinalizationQueue() []StateInfoIndex {
+	if m != nil {
+		return m.FinalizationQueue
+	}
+	return nil
+}
+
+func (m *TimeToFinalizationQueue) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StateInfoIndex)(nil), "dymensionxyz.dymension.rollapp.StateInfoIndex")
 	proto.RegisterType((*StateInfo)(nil), "dymensionxyz.dymension.rollapp.StateInfo")
 	proto.RegisterType((*StateInfoSummary)(nil), "dymensionxyz.dymension.rollapp.StateInfoSummary")
 	proto.RegisterType((*BlockHeightToFinalizationQueue)(nil), "dymensionxyz.dymension.rollapp.BlockHeightToFinalizationQueue")
+	proto.RegisterType((*TimeToFinalizationQueue)(nil), "dymensionxyz.dymension.rollapp.TimeToFinalizationQueue")
 }
 
 func init() {
@@ -352,43 +439,47 @@ func init() {
 }
 
 var fileDescriptor_750f3a9f16533ec4 = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x6a, 0xdb, 0x40,
-	0x14, 0xf5, 0xd8, 0x8e, 0x13, 0x8d, 0xc1, 0x24, 0x43, 0x28, 0xc2, 0xb4, 0xb2, 0x11, 0xb4, 0x98,
-	0x2e, 0xa4, 0x92, 0xb4, 0x9b, 0x42, 0x17, 0x31, 0xa6, 0xc4, 0x5d, 0x94, 0x56, 0x09, 0xa5, 0x94,
-	0x82, 0x91, 0xad, 0xb1, 0x2c, 0x2a, 0xcd, 0xa8, 0x33, 0xa3, 0x60, 0xe7, 0x14, 0xe9, 0x3d, 0x7a,
-	0x90, 0x2c, 0xb3, 0x6b, 0x57, 0x69, 0xb1, 0x6f, 0xd0, 0x13, 0x94, 0x19, 0x29, 0x52, 0xe2, 0xd8,
-	0x0d, 0x04, 0xba, 0xd3, 0x7f, 0xfa, 0xef, 0xf1, 0xfe, 0xfb, 0x9f, 0x81, 0xb6, 0x37, 0x8b, 0x30,
-	0xe1, 0x01, 0x25, 0xd3, 0xd9, 0x69, 0x51, 0xd8, 0x8c, 0x86, 0xa1, 0x1b, 0xc7, 0x36, 0x17, 0xae,
-	0xc0, 0x83, 0x80, 0x8c, 0xa9, 0x15, 0x33, 0x2a, 0x28, 0x32, 0xae, 0x13, 0xac, 0xbc, 0xb0, 0x32,
-	0x42, 0x73, 0xd7, 0xa7, 0x3e, 0x55, 0xad, 0xb6, 0xfc, 0x4a, 0x59, 0xcd, 0x96, 0x4f, 0xa9, 0x1f,
-	0x62, 0x5b, 0x55, 0xc3, 0x64, 0x6c, 0x8b, 0x20, 0xc2, 0x5c, 0xb8, 0x51, 0x9c, 0x35, 0xbc, 0xb8,
-	0xc3, 0xc7, 0x30, 0xa4, 0xa3, 0x2f, 0x03, 0x0f, 0xf3, 0x11, 0x0b, 0x62, 0x41, 0x59, 0x46, 0x7b,
-	0xba, 0x86, 0x36, 0xa2, 0x51, 0x44, 0x89, 0x72, 0x9f, 0xf0, 0xb4, 0xd7, 0xec, 0xc1, 0xc6, 0x91,
-	0x9c, 0xa6, 0x4f, 0xc6, 0xb4, 0x4f, 0x3c, 0x3c, 0x45, 0x0f, 0xa1, 0x96, 0xe9, 0xf7, 0x3d, 0x1d,
-	0xb4, 0x41, 0x47, 0x73, 0x0a, 0x00, 0xed, 0xc2, 0x8d, 0x40, 0xb6, 0xe9, 0xe5, 0x36, 0xe8, 0x54,
-	0x9d, 0xb4, 0x30, 0xbf, 0x55, 0xa1, 0x96, 0xcb, 0xa0, 0xcf, 0xb0, 0xc1, 0x6f, 0x68, 0x2a, 0x99,
-	0xfa, 0x9e, 0x65, 0xfd, 0x3b, 0x26, 0xeb, 0xa6, 0x93, 0x6e, 0xf5, 0xfc, 0xb2, 0x55, 0x72, 0x96,
-	0xb4, 0xa4, 0x3f, 0x8e, 0xbf, 0x26, 0x98, 0x8c, 0x30, 0x53, 0x2e, 0x34, 0xa7, 0x00, 0x50, 0x1b,
-	0xd6, 0xb9, 0x70, 0x99, 0x38, 0xc4, 0x81, 0x3f, 0x11, 0x7a, 0x45, 0xb9, 0xbc, 0x0e, 0x49, 0x3e,
-	0x49, 0xa2, 0xae, 0x8c, 0x8e, 0xeb, 0x55, 0xf5, 0xbf, 0x00, 0xd0, 0x03, 0x58, 0xeb, 0x1d, 0xbc,
-	0x73, 0xc5, 0x44, 0xdf, 0x50, 0xd2, 0x59, 0x85, 0x9e, 0xc0, 0xc6, 0x88, 0x61, 0x57, 0x04, 0x94,
-	0x64, 0xd2, 0x9b, 0x8a, 0xba, 0x84, 0xa2, 0x57, 0xb0, 0x96, 0xe6, 0xab, 0x6f, 0xb5, 0x41, 0xa7,
-	0xb1, 0xf7, 0x78, 0xdd, 0xcc, 0xe9, 0x32, 0xd4, 0xc8, 0x09, 0x77, 0x32, 0x12, 0x3a, 0x84, 0x95,
-	0x6e, 0x8f, 0xeb, 0x9a, 0xca, 0xeb, 0xd9, 0x5d, 0x79, 0x29, 0xcf, 0xbd, 0x7c, 0xfd, 0x3c, 0x4b,
-	0x4c, 0x4a, 0xa0, 0x8f, 0x10, 0x2a, 0x6b, 0xd8, 0x1b, 0xb8, 0x42, 0x87, 0x4a, 0xb0, 0x69, 0xa5,
-	0x17, 0x67, 0x5d, 0x5d, 0x9c, 0x75, 0x7c, 0x75, 0x71, 0xdd, 0x47, 0x92, 0xfa, 0xe7, 0xb2, 0xb5,
-	0x33, 0x73, 0xa3, 0xf0, 0xa5, 0x59, 0x70, 0xcd, 0xb3, 0x5f, 0x2d, 0xe0, 0x68, 0x19, 0x70, 0x20,
-	0x50, 0x0b, 0xd6, 0x3d, 0xc6, 0x07, 0x27, 0x98, 0x49, 0x33, 0x7a, 0x5d, 0xe5, 0x04, 0x3d, 0xc6,
-	0x3f, 0xa4, 0xc8, 0x9b, 0xea, 0x56, 0x6d, 0x7b, 0xd3, 0xfc, 0x01, 0xe0, 0x76, 0xbe, 0xd0, 0xa3,
-	0x24, 0x8a, 0x5c, 0x36, 0xfb, 0xcf, 0xa7, 0x51, 0x84, 0x5f, 0xbe, 0x4f, 0xf8, 0xb7, 0x77, 0x5c,
-	0x59, 0xb5, 0x63, 0xf3, 0x3b, 0x80, 0x86, 0x8a, 0x3e, 0xad, 0x8f, 0xe9, 0xeb, 0x80, 0xb8, 0x61,
-	0x70, 0xaa, 0x7a, 0xde, 0x27, 0x38, 0xc1, 0x2b, 0xa4, 0xc0, 0xca, 0x73, 0x19, 0xc2, 0x9d, 0xf1,
-	0x32, 0x59, 0x2f, 0xb7, 0x2b, 0xf7, 0x8e, 0xe4, 0xb6, 0x5c, 0xf7, 0xed, 0xf9, 0xdc, 0x00, 0x17,
-	0x73, 0x03, 0xfc, 0x9e, 0x1b, 0xe0, 0x6c, 0x61, 0x94, 0x2e, 0x16, 0x46, 0xe9, 0xe7, 0xc2, 0x28,
-	0x7d, 0x7a, 0xee, 0x07, 0x62, 0x92, 0x0c, 0x65, 0x1c, 0xeb, 0x9e, 0xbc, 0x93, 0x7d, 0x7b, 0x9a,
-	0xbf, 0x37, 0x62, 0x16, 0x63, 0x3e, 0xac, 0xa9, 0xeb, 0xd9, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x8a, 0x35, 0x1b, 0xf6, 0x26, 0x05, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xc1, 0x6b, 0x13, 0x4f,
+	0x18, 0xcd, 0x34, 0x69, 0xda, 0x4c, 0x7e, 0x84, 0x76, 0xe8, 0x4f, 0x87, 0xa2, 0x9b, 0xb0, 0xa0,
+	0x14, 0x0f, 0xbb, 0xd2, 0x2a, 0x82, 0xe0, 0xa1, 0x21, 0x48, 0xe3, 0x41, 0x6a, 0x5a, 0x44, 0x44,
+	0x58, 0x36, 0xd9, 0xc9, 0x76, 0x70, 0x77, 0x66, 0x9d, 0x99, 0x2d, 0x4d, 0xff, 0x8a, 0xfe, 0x59,
+	0xa5, 0xa7, 0xde, 0xf4, 0x54, 0xa5, 0x3d, 0x7b, 0xf1, 0x2f, 0x90, 0x99, 0xdd, 0x66, 0xdb, 0x26,
+	0x35, 0x50, 0x10, 0x6f, 0xfb, 0x7d, 0xf3, 0xbd, 0xc7, 0xfb, 0xde, 0x3c, 0x66, 0xa1, 0x1b, 0x8c,
+	0x62, 0xc2, 0x24, 0xe5, 0xec, 0x60, 0x74, 0x58, 0x14, 0xae, 0xe0, 0x51, 0xe4, 0x27, 0x89, 0x2b,
+	0x95, 0xaf, 0x88, 0x47, 0xd9, 0x90, 0x3b, 0x89, 0xe0, 0x8a, 0x23, 0xeb, 0x2a, 0xc0, 0x19, 0x17,
+	0x4e, 0x0e, 0x58, 0x5d, 0x09, 0x79, 0xc8, 0xcd, 0xa8, 0xab, 0xbf, 0x32, 0xd4, 0x6a, 0x33, 0xe4,
+	0x3c, 0x8c, 0x88, 0x6b, 0xaa, 0x7e, 0x3a, 0x74, 0x15, 0x8d, 0x89, 0x54, 0x7e, 0x9c, 0xe4, 0x03,
+	0xcf, 0x67, 0xe8, 0xe8, 0x47, 0x7c, 0xf0, 0xd9, 0x0b, 0x88, 0x1c, 0x08, 0x9a, 0x28, 0x2e, 0x72,
+	0xd8, 0x93, 0x5b, 0x60, 0x03, 0x1e, 0xc7, 0x9c, 0x19, 0xf5, 0xa9, 0xcc, 0x66, 0xed, 0x0e, 0x6c,
+	0xec, 0xe8, 0x6d, 0xba, 0x6c, 0xc8, 0xbb, 0x2c, 0x20, 0x07, 0xe8, 0x01, 0xac, 0xe5, 0xfc, 0xdd,
+	0x00, 0x83, 0x16, 0x58, 0xab, 0xf5, 0x8a, 0x06, 0x5a, 0x81, 0xf3, 0x54, 0x8f, 0xe1, 0xb9, 0x16,
+	0x58, 0xab, 0xf4, 0xb2, 0xc2, 0x3e, 0xa9, 0xc0, 0xda, 0x98, 0x06, 0x7d, 0x82, 0x0d, 0x79, 0x8d,
+	0xd3, 0xd0, 0xd4, 0xd7, 0x1d, 0xe7, 0xcf, 0x36, 0x39, 0xd7, 0x95, 0xb4, 0x2b, 0xc7, 0x67, 0xcd,
+	0x52, 0xaf, 0x21, 0x27, 0xf4, 0x49, 0xf2, 0x25, 0x25, 0x6c, 0x40, 0x84, 0x51, 0x51, 0xeb, 0x15,
+	0x0d, 0xd4, 0x82, 0x75, 0xa9, 0x7c, 0xa1, 0xb6, 0x08, 0x0d, 0xf7, 0x14, 0x2e, 0x1b, 0x95, 0x57,
+	0x5b, 0x1a, 0xcf, 0xd2, 0xb8, 0xad, 0xad, 0x93, 0xb8, 0x62, 0xce, 0x8b, 0x06, 0xba, 0x07, 0xab,
+	0x9d, 0xcd, 0x6d, 0x5f, 0xed, 0xe1, 0x79, 0x43, 0x9d, 0x57, 0xe8, 0x31, 0x6c, 0x0c, 0x04, 0xf1,
+	0x15, 0xe5, 0x2c, 0xa7, 0x5e, 0x30, 0xd0, 0x1b, 0x5d, 0xf4, 0x0a, 0x56, 0x33, 0x7f, 0xf1, 0x62,
+	0x0b, 0xac, 0x35, 0xd6, 0x1f, 0xdd, 0xb6, 0x73, 0x76, 0x19, 0x66, 0xe5, 0x54, 0xf6, 0x72, 0x10,
+	0xda, 0x82, 0xe5, 0x76, 0x47, 0xe2, 0x9a, 0xf1, 0xeb, 0xe9, 0x2c, 0xbf, 0x8c, 0xe6, 0xce, 0xf8,
+	0xfa, 0x65, 0xee, 0x98, 0xa6, 0x40, 0x1f, 0x20, 0x34, 0xd2, 0x48, 0xe0, 0xf9, 0x0a, 0x43, 0x43,
+	0xb8, 0xea, 0x64, 0x89, 0x73, 0x2e, 0x13, 0xe7, 0xec, 0x5e, 0x26, 0xae, 0xfd, 0x50, 0x43, 0x7f,
+	0x9d, 0x35, 0x97, 0x47, 0x7e, 0x1c, 0xbd, 0xb4, 0x0b, 0xac, 0x7d, 0xf4, 0xbd, 0x09, 0x7a, 0xb5,
+	0xbc, 0xb1, 0xa9, 0x50, 0x13, 0xd6, 0x03, 0x21, 0xbd, 0x7d, 0x22, 0xb4, 0x18, 0x5c, 0x37, 0x3e,
+	0xc1, 0x40, 0xc8, 0xf7, 0x59, 0x07, 0xbd, 0x80, 0x38, 0xa0, 0x32, 0x49, 0x15, 0xf1, 0x12, 0x22,
+	0x28, 0x0f, 0x3c, 0xca, 0xbc, 0x7e, 0x66, 0xf8, 0x7f, 0xc6, 0xb5, 0xff, 0xf3, 0xf3, 0x6d, 0x73,
+	0xdc, 0x65, 0x99, 0xf9, 0x6f, 0x2a, 0x8b, 0xd5, 0xa5, 0x05, 0xfb, 0x2b, 0x80, 0x4b, 0xe3, 0x24,
+	0xec, 0xa4, 0x71, 0xec, 0x8b, 0xd1, 0x5f, 0xce, 0x54, 0x71, 0x6b, 0x73, 0x77, 0xb9, 0xb5, 0xc9,
+	0x70, 0x94, 0xa7, 0x85, 0xc3, 0x3e, 0x01, 0xd0, 0x32, 0xab, 0x66, 0xf5, 0x2e, 0x7f, 0x4d, 0x99,
+	0x1f, 0xd1, 0x43, 0x33, 0xf3, 0x2e, 0x25, 0x29, 0x99, 0x42, 0x05, 0xa6, 0xe6, 0xac, 0x0f, 0x97,
+	0x87, 0x37, 0xc1, 0x78, 0xae, 0x55, 0xbe, 0xb3, 0x25, 0x93, 0x74, 0xd7, 0x5f, 0x82, 0xf2, 0x8d,
+	0x97, 0xc0, 0xfe, 0x09, 0xe0, 0x7d, 0x1d, 0x9f, 0x69, 0x5b, 0x6c, 0xc3, 0xa5, 0xab, 0x74, 0x7a,
+	0x0c, 0x83, 0x99, 0x11, 0x5c, 0xd4, 0x42, 0x4c, 0xda, 0x26, 0xd0, 0xff, 0x7e, 0xdf, 0xf6, 0xdb,
+	0xe3, 0x73, 0x0b, 0x9c, 0x9e, 0x5b, 0xe0, 0xc7, 0xb9, 0x05, 0x8e, 0x2e, 0xac, 0xd2, 0xe9, 0x85,
+	0x55, 0xfa, 0x76, 0x61, 0x95, 0x3e, 0x3e, 0x0b, 0xa9, 0xda, 0x4b, 0xfb, 0x3a, 0x1c, 0xb7, 0xfd,
+	0x39, 0xf6, 0x37, 0xdc, 0x83, 0xf1, 0xb3, 0xad, 0x46, 0x09, 0x91, 0xfd, 0xaa, 0x71, 0x60, 0xe3,
+	0xf7, 0x00, 0xfe, 0x3f, 0x75, 0x84, 0x6d, 0x06, 0x00, 0x00,
 }
 
 func (m *StateInfoIndex) Marshal() (dAtA []byte, err error) {
@@ -446,6 +537,11 @@ func (m *StateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintStateInfo(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.DrsVersion) > 0 {
 		i -= len(m.DrsVersion)
 		copy(dAtA[i:], m.DrsVersion)
@@ -581,6 +677,13 @@ func (m *BlockHeightToFinalizationQueue) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintStateInfo(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.FinalizationQueue) > 0 {
 		for iNdEx := len(m.FinalizationQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -603,6 +706,58 @@ func (m *BlockHeightToFinalizationQueue) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *TimeToFinalizationQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeToFinalizationQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeToFinalizationQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintStateInfo(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FinalizationQueue) > 0 {
+		for iNdEx := len(m.FinalizationQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizationQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FinalizationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalizationTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStateInfo(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStateInfo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStateInfo(v)
 	base := offset
@@ -666,6 +821,9 @@ func (m *StateInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStateInfo(uint64(l))
 	}
+	if m.DisputePeriodInBlocks != 0 {
+		n += 1 + sovStateInfo(uint64(m.DisputePeriodInBlocks))
+	}
 	return n
 }
 
@@ -701,6 +859,31 @@ func (m *BlockHeightToFinalizationQueue) Size() (n int) {
 			n += 1 + l + sovStateInfo(uint64(l))
 		}
 	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovStateInfo(uint64(l))
+	}
+	return n
+}
+
+func (m *TimeToFinalizationQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FinalizationTime)
+	n += 1 + l + sovStateInfo(uint64(l))
+	if len(m.FinalizationQueue) > 0 {
+		for _, e := range m.FinalizationQueue {
+			l = e.Size()
+			n += 1 + l + sovStateInfo(uint64(l))
+		}
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovStateInfo(uint64(l))
+	}
 	return n
 }
 
@@ -1111,6 +1294,25 @@ func (m *StateInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.DrsVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
+			}
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStateInfo(dAtA[iNdEx:])
@@ -1335,6 +1537,187 @@ func (m *BlockHeightToFinalizationQueue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeToFinalizationQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeToFinalizationQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeToFinalizationQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FinalizationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizationQueue = append(m.FinalizationQueue, StateInfoIndex{})
+			if err := m.FinalizationQueue[len(m.FinalizationQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStateInfo(dAtA[iNdEx:])
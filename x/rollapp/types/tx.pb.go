@@ -6,22 +6,27 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -181,6 +186,10 @@ type MsgUpdateRollappInformation struct {
 	Metadata *RollappMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// genesis_info is the genesis information
 	GenesisInfo *GenesisInfo `protobuf:"bytes,6,opt,name=genesis_info,json=genesisInfo,proto3" json:"genesis_info,omitempty"`
+	// dispute_period_in_blocks is the dispute period of the rollapp state updates.
+	// Must be within the governance bounds. Applies only to the state updates
+	// submitted after the change.
+	DisputePeriodInBlocks uint64 `protobuf:"varint,7,opt,name=dispute_period_in_blocks,json=disputePeriodInBlocks,proto3" json:"dispute_period_in_blocks,omitempty"`
 }
 
 func (m *MsgUpdateRollappInformation) Reset()         { *m = MsgUpdateRollappInformation{} }
@@ -251,6 +260,13 @@ func (m *MsgUpdateRollappInformation) GetGenesisInfo() *GenesisInfo {
 	return nil
 }
 
+func (m *MsgUpdateRollappInformation) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
+	}
+	return 0
+}
+
 type MsgUpdateRollappInformationResponse struct {
 }
 
@@ -1008,1092 +1024,1277 @@ func (m *MsgMarkVulnerableRollappsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMarkVulnerableRollappsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollapp")
-	proto.RegisterType((*MsgCreateRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollappResponse")
-	proto.RegisterType((*MsgUpdateRollappInformation)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollappInformation")
-	proto.RegisterType((*MsgUpdateRollappInformationResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollappInformationResponse")
-	proto.RegisterType((*MsgUpdateState)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateState")
-	proto.RegisterType((*MsgUpdateStateResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateStateResponse")
-	proto.RegisterType((*MsgTransferOwnership)(nil), "dymensionxyz.dymension.rollapp.MsgTransferOwnership")
-	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "dymensionxyz.dymension.rollapp.MsgTransferOwnershipResponse")
-	proto.RegisterType((*MsgAddApp)(nil), "dymensionxyz.dymension.rollapp.MsgAddApp")
-	proto.RegisterType((*MsgAddAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgAddAppResponse")
-	proto.RegisterType((*MsgUpdateApp)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateApp")
-	proto.RegisterType((*MsgUpdateAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateAppResponse")
-	proto.RegisterType((*MsgRemoveApp)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveApp")
-	proto.RegisterType((*MsgRemoveAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveAppResponse")
-	proto.RegisterType((*MsgMarkVulnerableRollapps)(nil), "dymensionxyz.dymension.rollapp.MsgMarkVulnerableRollapps")
-	proto.RegisterType((*MsgMarkVulnerableRollappsResponse)(nil), "dymensionxyz.dymension.rollapp.MsgMarkVulnerableRollappsResponse")
+// MsgSetRollappDisputePeriodTime overrides the time-based dispute period of
+// a rollapp. Must be called by the governance.
+type MsgSetRollappDisputePeriodTime struct {
+	// Authority is the authority address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// RollappId is the rollapp to set the dispute period for.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// DisputePeriodTime is the new dispute period of the rollapp.
+	// Zero resets the rollapp to the dispute_period_time param.
+	DisputePeriodTime time.Duration `protobuf:"bytes,3,opt,name=dispute_period_time,json=disputePeriodTime,proto3,stdduration" json:"dispute_period_time"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/rollapp/tx.proto", fileDescriptor_1a86300fb8647ecb)
+func (m *MsgSetRollappDisputePeriodTime) Reset()         { *m = MsgSetRollappDisputePeriodTime{} }
+func (m *MsgSetRollappDisputePeriodTime) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRollappDisputePeriodTime) ProtoMessage()    {}
+func (*MsgSetRollappDisputePeriodTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{16}
 }
-
-var fileDescriptor_1a86300fb8647ecb = []byte{
-	// 1048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x8e, 0x7f, 0x3c, 0x3b, 0xfe, 0x3a, 0xf3, 0x8d, 0xc2, 0xe2, 0x16, 0xd7, 0x75,
-	0x84, 0x08, 0xb4, 0xd8, 0x34, 0x0d, 0x08, 0x02, 0x97, 0xa4, 0x91, 0xda, 0x82, 0x4c, 0x61, 0x1b,
-	0x72, 0xe0, 0x62, 0x6d, 0xbc, 0x93, 0xf5, 0xaa, 0xbb, 0x3b, 0xcb, 0xcc, 0xd8, 0x89, 0xe1, 0x86,
-	0x84, 0x38, 0x20, 0x21, 0x4e, 0x9c, 0xf8, 0x23, 0x90, 0xe0, 0x6f, 0x40, 0x3d, 0xf6, 0x08, 0x17,
-	0x84, 0x92, 0x03, 0xff, 0x06, 0x9a, 0xd9, 0xdd, 0xb1, 0x13, 0xff, 0xda, 0x04, 0x4e, 0x3b, 0xf3,
-	0xe6, 0xfd, 0xf8, 0xbc, 0xcf, 0x9b, 0xf7, 0x46, 0x0b, 0xaf, 0x59, 0x43, 0x0f, 0xfb, 0xcc, 0x21,
-	0xfe, 0xe9, 0xf0, 0xcb, 0x96, 0xda, 0xb4, 0x28, 0x71, 0x5d, 0x33, 0x08, 0x5a, 0xfc, 0xb4, 0x19,
-	0x50, 0xc2, 0x09, 0xaa, 0x8d, 0x2b, 0x36, 0xd5, 0xa6, 0x19, 0x29, 0x56, 0x5f, 0xea, 0x12, 0xe6,
-	0x11, 0xd6, 0xf2, 0x98, 0xdd, 0x1a, 0xdc, 0x13, 0x9f, 0xd0, 0xb0, 0xfa, 0xf6, 0x82, 0x08, 0x47,
-	0x2e, 0xe9, 0x3e, 0xeb, 0x58, 0x98, 0x75, 0xa9, 0x13, 0x70, 0x42, 0x23, 0xb3, 0xbb, 0x0b, 0xcc,
-	0xa2, 0x6f, 0xa4, 0xfd, 0xe6, 0x02, 0x6d, 0x0f, 0x73, 0xd3, 0x32, 0xb9, 0x19, 0xa9, 0xaf, 0xd9,
-	0xc4, 0x26, 0x72, 0xd9, 0x12, 0xab, 0x50, 0xda, 0xf8, 0x3e, 0x0d, 0x95, 0x36, 0xb3, 0x1f, 0x50,
-	0x6c, 0x72, 0x6c, 0x84, 0x96, 0x48, 0x87, 0x5c, 0x57, 0x08, 0x08, 0xd5, 0xb5, 0xba, 0xb6, 0x59,
-	0x30, 0xe2, 0x2d, 0x7a, 0x05, 0x20, 0x72, 0xdf, 0x71, 0x2c, 0x3d, 0x25, 0x0f, 0x0b, 0x91, 0xe4,
-	0xb1, 0x85, 0xee, 0xc0, 0xaa, 0xe3, 0x3b, 0xdc, 0x31, 0xdd, 0x0e, 0xc3, 0x5f, 0xf4, 0xb1, 0xdf,
-	0xc5, 0x54, 0x2f, 0x4a, 0xad, 0x4a, 0x74, 0xf0, 0x34, 0x96, 0xa3, 0x35, 0x58, 0x36, 0x5d, 0xc7,
-	0x64, 0x7a, 0x49, 0x2a, 0x84, 0x1b, 0xf4, 0x11, 0xe4, 0x63, 0xe0, 0xfa, 0x4a, 0x5d, 0xdb, 0x2c,
-	0x6e, 0xb5, 0x9a, 0xf3, 0xcb, 0xd0, 0x8c, 0x60, 0xb7, 0x23, 0x33, 0x43, 0x39, 0x40, 0x07, 0x50,
-	0xb2, 0xb1, 0x8f, 0x99, 0xc3, 0x3a, 0x8e, 0x7f, 0x4c, 0xf4, 0xb2, 0x74, 0x78, 0x67, 0x91, 0xc3,
-	0x87, 0xa1, 0xcd, 0x63, 0xff, 0x98, 0xec, 0x65, 0x9e, 0xff, 0x79, 0x4b, 0x33, 0x8a, 0xf6, 0x48,
-	0x84, 0x1e, 0x42, 0x6e, 0xe0, 0x75, 0xf8, 0x30, 0xc0, 0xfa, 0xff, 0xea, 0xda, 0x66, 0x79, 0xab,
-	0x99, 0x10, 0x61, 0xf3, 0xb0, 0x7d, 0x30, 0x0c, 0xb0, 0x91, 0x1d, 0x78, 0xe2, 0xbb, 0x53, 0xfa,
-	0xfa, 0xef, 0x9f, 0xdf, 0x88, 0xb9, 0xfd, 0x30, 0x93, 0x4f, 0x57, 0x8a, 0x8d, 0x2a, 0xe8, 0x97,
-	0xeb, 0x61, 0x60, 0x16, 0x10, 0x9f, 0xe1, 0xc6, 0xaf, 0x29, 0xb8, 0xd1, 0x66, 0xf6, 0x67, 0x81,
-	0x35, 0x3a, 0x14, 0x88, 0xa8, 0x67, 0x72, 0x87, 0xf8, 0x82, 0x51, 0x72, 0xe2, 0xe3, 0xb8, 0x6a,
-	0xe1, 0xe6, 0x5a, 0x35, 0x4b, 0xcf, 0xa8, 0xd9, 0xa7, 0x63, 0xd5, 0x59, 0xbe, 0x56, 0x75, 0x22,
-	0x42, 0x67, 0xd7, 0x28, 0xfb, 0x5f, 0xd4, 0x68, 0x07, 0x04, 0xb5, 0x21, 0x01, 0x8d, 0x57, 0x61,
-	0x63, 0x0e, 0x6b, 0x8a, 0xdd, 0x5f, 0x52, 0x50, 0x56, 0x7a, 0x4f, 0xb9, 0xc9, 0xf1, 0x9c, 0x46,
-	0xb8, 0x09, 0x23, 0x0a, 0x27, 0x39, 0xad, 0x43, 0x91, 0x71, 0x93, 0xf2, 0x47, 0xd8, 0xb1, 0x7b,
-	0x5c, 0xb2, 0x99, 0x31, 0xc6, 0x45, 0xc2, 0xde, 0xef, 0x7b, 0x7b, 0x62, 0x0e, 0x30, 0x3d, 0x23,
-	0xcf, 0x47, 0x02, 0xb4, 0x0e, 0xd9, 0xfd, 0xdd, 0x4f, 0x4c, 0xde, 0x93, 0x24, 0x17, 0x8c, 0x68,
-	0x87, 0x1e, 0x41, 0x7a, 0x6f, 0x9f, 0xe9, 0x39, 0x49, 0xd1, 0x5b, 0x8b, 0x28, 0x92, 0xce, 0xf6,
-	0xd5, 0x90, 0x61, 0x92, 0xa7, 0x25, 0x43, 0xb8, 0x40, 0x08, 0x32, 0xae, 0xc9, 0xb8, 0x9e, 0xaf,
-	0x6b, 0x9b, 0x79, 0x43, 0xae, 0xd1, 0x2d, 0x28, 0x5a, 0x94, 0x75, 0x06, 0x98, 0x0a, 0x37, 0x7a,
-	0x41, 0x86, 0x06, 0x8b, 0xb2, 0xc3, 0x50, 0x32, 0x71, 0x5f, 0xb3, 0x95, 0x5c, 0x43, 0x87, 0xf5,
-	0x8b, 0xa4, 0x29, 0x3e, 0xbf, 0xd3, 0x60, 0xad, 0xcd, 0xec, 0x03, 0x6a, 0xfa, 0xec, 0x18, 0xd3,
-	0x27, 0xa2, 0x16, 0xac, 0xe7, 0x04, 0x68, 0x03, 0x56, 0xba, 0x7d, 0x4a, 0xb1, 0xcf, 0x3b, 0xe3,
-	0xd7, 0xb5, 0x14, 0x09, 0xa5, 0x22, 0xba, 0x01, 0x05, 0x1f, 0x9f, 0x44, 0x0a, 0x21, 0xc1, 0x79,
-	0x1f, 0x9f, 0x3c, 0x99, 0x72, 0xa5, 0xd3, 0x97, 0xe8, 0xdf, 0x41, 0x02, 0xe7, 0xc5, 0x18, 0x8d,
-	0x1a, 0xdc, 0x9c, 0x06, 0x46, 0xa1, 0xfd, 0x4d, 0x83, 0x42, 0x9b, 0xd9, 0xbb, 0x96, 0xb5, 0x3b,
-	0x77, 0x02, 0x22, 0xc8, 0xf8, 0xa6, 0x87, 0x23, 0x48, 0x72, 0xbd, 0x00, 0x8e, 0xb8, 0x0d, 0xf1,
-	0xa8, 0x17, 0xbc, 0x66, 0xe4, 0xf9, 0xb8, 0x48, 0x34, 0xae, 0xe3, 0x99, 0x36, 0x8e, 0xca, 0x1d,
-	0x6e, 0x50, 0x05, 0xd2, 0x7d, 0xea, 0xca, 0x86, 0x28, 0x18, 0x62, 0x29, 0x1b, 0x9c, 0x5a, 0x98,
-	0xca, 0x1b, 0xb0, 0x6c, 0x84, 0x9b, 0x8b, 0x65, 0x69, 0xfc, 0x1f, 0x56, 0x55, 0x1e, 0x2a, 0xbb,
-	0x3f, 0x34, 0x28, 0xa9, 0x32, 0xcd, 0x4f, 0xb0, 0x0c, 0xa9, 0x68, 0x4c, 0x64, 0x8c, 0x94, 0x63,
-	0xa9, 0x84, 0xd3, 0x33, 0x13, 0xce, 0x2c, 0x48, 0x78, 0x79, 0x4e, 0xc2, 0xd9, 0x29, 0x09, 0xe7,
-	0xa6, 0x24, 0x9c, 0x9f, 0x9d, 0xf0, 0xba, 0xbc, 0x66, 0x2a, 0x35, 0x95, 0x33, 0x96, 0x29, 0x1b,
-	0xd8, 0x23, 0x83, 0x2b, 0xa6, 0xbc, 0xe0, 0x7a, 0x4d, 0x0b, 0xaf, 0xc2, 0xa8, 0xf0, 0x2e, 0xbc,
-	0xdc, 0x66, 0x76, 0xdb, 0xa4, 0xcf, 0x0e, 0xfb, 0xae, 0x8f, 0xa9, 0x79, 0xe4, 0xc6, 0xd3, 0x87,
-	0x89, 0xf6, 0x37, 0xfb, 0xbc, 0x47, 0xa8, 0xc3, 0x87, 0x11, 0x9a, 0x91, 0x00, 0xdd, 0x86, 0xd2,
-	0x58, 0x23, 0x32, 0x3d, 0x55, 0x4f, 0x4b, 0x02, 0x55, 0x27, 0xb2, 0x9d, 0xb2, 0xc0, 0x30, 0x32,
-	0x69, 0x6c, 0xc0, 0xed, 0x99, 0xd1, 0x62, 0x48, 0x5b, 0xdf, 0xe4, 0x21, 0xdd, 0x66, 0x36, 0xfa,
-	0x0a, 0x56, 0x2e, 0x3e, 0xf8, 0x0b, 0x47, 0xc9, 0xe5, 0x27, 0xa9, 0xfa, 0xee, 0x55, 0x2d, 0x62,
-	0x10, 0xe8, 0x27, 0x0d, 0xf4, 0x99, 0x2f, 0xd8, 0xfb, 0x09, 0xdc, 0xce, 0x32, 0xae, 0x3e, 0xf8,
-	0x17, 0xc6, 0x0a, 0x5e, 0x1f, 0x8a, 0xe3, 0x2f, 0x40, 0x33, 0xb1, 0x4f, 0xa9, 0x5f, 0x7d, 0xe7,
-	0x6a, 0xfa, 0x2a, 0xec, 0xb7, 0x1a, 0xac, 0x4e, 0x4e, 0xca, 0xed, 0x04, 0xde, 0x26, 0xac, 0xaa,
-	0x1f, 0x5c, 0xc7, 0x4a, 0x21, 0x39, 0x86, 0x6c, 0x34, 0x04, 0x5f, 0x4f, 0xe0, 0x27, 0x54, 0xad,
-	0xde, 0x4b, 0xac, 0xaa, 0xe2, 0x10, 0x28, 0x8c, 0xc6, 0xd1, 0xdd, 0xc4, 0xb4, 0x89, 0x68, 0xdb,
-	0x57, 0xd1, 0x1e, 0x0f, 0x38, 0x1a, 0x06, 0x49, 0x02, 0x2a, 0xed, 0x44, 0x01, 0x27, 0x26, 0x00,
-	0xfa, 0x51, 0x83, 0xf5, 0x19, 0xfd, 0xff, 0x5e, 0x02, 0x87, 0xd3, 0x4d, 0xab, 0xbb, 0xd7, 0x36,
-	0x8d, 0x81, 0xed, 0x7d, 0xfc, 0xfc, 0xac, 0xa6, 0xbd, 0x38, 0xab, 0x69, 0x7f, 0x9d, 0xd5, 0xb4,
-	0x1f, 0xce, 0x6b, 0x4b, 0x2f, 0xce, 0x6b, 0x4b, 0xbf, 0x9f, 0xd7, 0x96, 0x3e, 0xdf, 0xb6, 0x1d,
-	0xde, 0xeb, 0x1f, 0x35, 0xbb, 0xc4, 0x6b, 0xcd, 0xf8, 0xbd, 0x18, 0xdc, 0x6f, 0x9d, 0x8e, 0x7e,
-	0x95, 0x86, 0x01, 0x66, 0x47, 0x59, 0xf9, 0x2f, 0x71, 0xff, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x6d, 0x1c, 0x48, 0x7b, 0x59, 0x0d, 0x00, 0x00,
+func (m *MsgSetRollappDisputePeriodTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRollappDisputePeriodTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRollappDisputePeriodTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRollappDisputePeriodTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRollappDisputePeriodTime.Merge(m, src)
+}
+func (m *MsgSetRollappDisputePeriodTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRollappDisputePeriodTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRollappDisputePeriodTime.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetRollappDisputePeriodTime proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error)
-	UpdateRollappInformation(ctx context.Context, in *MsgUpdateRollappInformation, opts ...grpc.CallOption) (*MsgUpdateRollappInformationResponse, error)
-	UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error)
-	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
-	AddApp(ctx context.Context, in *MsgAddApp, opts ...grpc.CallOption) (*MsgAddAppResponse, error)
-	UpdateApp(ctx context.Context, in *MsgUpdateApp, opts ...grpc.CallOption) (*MsgUpdateAppResponse, error)
-	RemoveApp(ctx context.Context, in *MsgRemoveApp, opts ...grpc.CallOption) (*MsgRemoveAppResponse, error)
-	MarkVulnerableRollapps(ctx context.Context, in *MsgMarkVulnerableRollapps, opts ...grpc.CallOption) (*MsgMarkVulnerableRollappsResponse, error)
+func (m *MsgSetRollappDisputePeriodTime) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetRollappDisputePeriodTime) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetRollappDisputePeriodTime) GetDisputePeriodTime() time.Duration {
+	if m != nil {
+		return m.DisputePeriodTime
+	}
+	return 0
 }
 
-func (c *msgClient) CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error) {
-	out := new(MsgCreateRollappResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetRollappDisputePeriodTimeResponse struct {
 }
 
-func (c *msgClient) UpdateRollappInformation(ctx context.Context, in *MsgUpdateRollappInformation, opts ...grpc.CallOption) (*MsgUpdateRollappInformationResponse, error) {
-	out := new(MsgUpdateRollappInformationResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateRollappInformation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRollappDisputePeriodTimeResponse) Reset() {
+	*m = MsgSetRollappDisputePeriodTimeResponse{}
+}
+func (m *MsgSetRollappDisputePeriodTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRollappDisputePeriodTimeResponse) ProtoMessage()    {}
+func (*MsgSetRollappDisputePeriodTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{17}
+}
+func (m *MsgSetRollappDisputePeriodTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRollappDisputePeriodTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRollappDisputePeriodTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetRollappDisputePeriodTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRollappDisputePeriodTimeResponse.Merge(m, src)
+}
+func (m *MsgSetRollappDisputePeriodTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRollappDisputePeriodTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRollappDisputePeriodTimeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error) {
-	out := new(MsgUpdateStateResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetRollappDisputePeriodTimeResponse proto.InternalMessageInfo
+
+// MsgSubmitFraudProof submits a rollapp header signed by the sequencer of a
+// pending state update, whose app hash disagrees with the state root the
+// sequencer posted to the hub for the same height.
+type MsgSubmitFraudProof struct {
+	// submitter is the bech32-encoded address of the fraud proof submitter.
+	// It is rewarded out of the bond of the fraudulent sequencer.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// rollapp_id is the unique identifier of the rollapp chain.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// ibc_client_id is the IBC client of the rollapp channel, frozen together
+	// with the rollapp. Empty if the rollapp has no channel yet.
+	IbcClientId string `protobuf:"bytes,3,opt,name=ibc_client_id,json=ibcClientId,proto3" json:"ibc_client_id,omitempty"`
+	// signed_header is the rollapp header and the commit of the sequencer.
+	SignedHeader *types.SignedHeader `protobuf:"bytes,4,opt,name=signed_header,json=signedHeader,proto3" json:"signed_header,omitempty"`
 }
 
-func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
-	out := new(MsgTransferOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/TransferOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) Reset()         { *m = MsgSubmitFraudProof{} }
+func (m *MsgSubmitFraudProof) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraudProof) ProtoMessage()    {}
+func (*MsgSubmitFraudProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{18}
+}
+func (m *MsgSubmitFraudProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFraudProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraudProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitFraudProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraudProof.Merge(m, src)
+}
+func (m *MsgSubmitFraudProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFraudProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraudProof.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddApp(ctx context.Context, in *MsgAddApp, opts ...grpc.CallOption) (*MsgAddAppResponse, error) {
-	out := new(MsgAddAppResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/AddApp", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitFraudProof proto.InternalMessageInfo
+
+func (m *MsgSubmitFraudProof) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateApp(ctx context.Context, in *MsgUpdateApp, opts ...grpc.CallOption) (*MsgUpdateAppResponse, error) {
-	out := new(MsgUpdateAppResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateApp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveApp(ctx context.Context, in *MsgRemoveApp, opts ...grpc.CallOption) (*MsgRemoveAppResponse, error) {
-	out := new(MsgRemoveAppResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/RemoveApp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) GetIbcClientId() string {
+	if m != nil {
+		return m.IbcClientId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MarkVulnerableRollapps(ctx context.Context, in *MsgMarkVulnerableRollapps, opts ...grpc.CallOption) (*MsgMarkVulnerableRollappsResponse, error) {
-	out := new(MsgMarkVulnerableRollappsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/MarkVulnerableRollapps", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraudProof) GetSignedHeader() *types.SignedHeader {
+	if m != nil {
+		return m.SignedHeader
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateRollapp(context.Context, *MsgCreateRollapp) (*MsgCreateRollappResponse, error)
-	UpdateRollappInformation(context.Context, *MsgUpdateRollappInformation) (*MsgUpdateRollappInformationResponse, error)
-	UpdateState(context.Context, *MsgUpdateState) (*MsgUpdateStateResponse, error)
-	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
-	AddApp(context.Context, *MsgAddApp) (*MsgAddAppResponse, error)
-	UpdateApp(context.Context, *MsgUpdateApp) (*MsgUpdateAppResponse, error)
-	RemoveApp(context.Context, *MsgRemoveApp) (*MsgRemoveAppResponse, error)
-	MarkVulnerableRollapps(context.Context, *MsgMarkVulnerableRollapps) (*MsgMarkVulnerableRollappsResponse, error)
+type MsgSubmitFraudProofResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSubmitFraudProofResponse) Reset()         { *m = MsgSubmitFraudProofResponse{} }
+func (m *MsgSubmitFraudProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraudProofResponse) ProtoMessage()    {}
+func (*MsgSubmitFraudProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{19}
 }
-
-func (*UnimplementedMsgServer) CreateRollapp(ctx context.Context, req *MsgCreateRollapp) (*MsgCreateRollappResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRollapp not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateRollappInformation(ctx context.Context, req *MsgUpdateRollappInformation) (*MsgUpdateRollappInformationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRollappInformation not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraudProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateState(ctx context.Context, req *MsgUpdateState) (*MsgUpdateStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateState not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraudProofResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) AddApp(ctx context.Context, req *MsgAddApp) (*MsgAddAppResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddApp not implemented")
+func (m *MsgSubmitFraudProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraudProofResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateApp(ctx context.Context, req *MsgUpdateApp) (*MsgUpdateAppResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
+
+var xxx_messageInfo_MsgSubmitFraudProofResponse proto.InternalMessageInfo
+
+// MsgRecoverRollapp unfreezes a rollapp frozen after a fraud. The rollapp
+// resumes from its last finalized state, which becomes the new trusted height
+// of the rollapp. Must be called by the governance. If the rollapp has a
+// canonical channel, the IBC client frozen on fraud must be restored first
+// through an IBC client update proposal.
+type MsgRecoverRollapp struct {
+	// Authority is the authority address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// RollappId is the rollapp to recover.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// InitialSequencer is one or more coma-separated bech32-encoded addresses of
+	// the sequencers allowed to register first and become the proposer of the
+	// recovered rollapp. "*" allows any sequencer.
+	InitialSequencer string `protobuf:"bytes,3,opt,name=initial_sequencer,json=initialSequencer,proto3" json:"initial_sequencer,omitempty"`
 }
-func (*UnimplementedMsgServer) RemoveApp(ctx context.Context, req *MsgRemoveApp) (*MsgRemoveAppResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveApp not implemented")
+
+func (m *MsgRecoverRollapp) Reset()         { *m = MsgRecoverRollapp{} }
+func (m *MsgRecoverRollapp) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverRollapp) ProtoMessage()    {}
+func (*MsgRecoverRollapp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{20}
 }
-func (*UnimplementedMsgServer) MarkVulnerableRollapps(ctx context.Context, req *MsgMarkVulnerableRollapps) (*MsgMarkVulnerableRollappsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarkVulnerableRollapps not implemented")
+func (m *MsgRecoverRollapp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRecoverRollapp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverRollapp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverRollapp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverRollapp.Merge(m, src)
+}
+func (m *MsgRecoverRollapp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverRollapp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverRollapp.DiscardUnknown(m)
 }
 
-func _Msg_CreateRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRollapp)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRollapp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRollapp(ctx, req.(*MsgCreateRollapp))
+var xxx_messageInfo_MsgRecoverRollapp proto.InternalMessageInfo
+
+func (m *MsgRecoverRollapp) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateRollappInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRollappInformation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRollappInformation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateRollappInformation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRollappInformation(ctx, req.(*MsgUpdateRollappInformation))
+func (m *MsgRecoverRollapp) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateState)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateState(ctx, req.(*MsgUpdateState))
+func (m *MsgRecoverRollapp) GetInitialSequencer() string {
+	if m != nil {
+		return m.InitialSequencer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferOwnership)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/TransferOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRecoverRollappResponse struct {
 }
 
-func _Msg_AddApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddApp)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddApp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/AddApp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddApp(ctx, req.(*MsgAddApp))
+func (m *MsgRecoverRollappResponse) Reset()         { *m = MsgRecoverRollappResponse{} }
+func (m *MsgRecoverRollappResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverRollappResponse) ProtoMessage()    {}
+func (*MsgRecoverRollappResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{21}
+}
+func (m *MsgRecoverRollappResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverRollappResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverRollappResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRecoverRollappResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverRollappResponse.Merge(m, src)
+}
+func (m *MsgRecoverRollappResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverRollappResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverRollappResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateApp)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateApp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateApp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateApp(ctx, req.(*MsgUpdateApp))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgRecoverRollappResponse proto.InternalMessageInfo
+
+// MsgForkRollapp hard-forks a rollapp frozen for running a vulnerable DRS
+// version. The rollapp resumes from the fork height with a non-vulnerable DRS
+// version. Must be called by the rollapp owner.
+type MsgForkRollapp struct {
+	// Owner is the bech32-encoded address of the rollapp owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// RollappId is the rollapp to fork.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// ForkHeight is the new genesis or fork height of the rollapp: the start
+	// height of its next state update. Must follow the last finalized height.
+	ForkHeight uint64 `protobuf:"varint,3,opt,name=fork_height,json=forkHeight,proto3" json:"fork_height,omitempty"`
+	// DrsVersion is the non-vulnerable DRS version the rollapp resumes with.
+	DrsVersion string `protobuf:"bytes,4,opt,name=drs_version,json=drsVersion,proto3" json:"drs_version,omitempty"`
 }
 
-func _Msg_RemoveApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveApp)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveApp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/RemoveApp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveApp(ctx, req.(*MsgRemoveApp))
+func (m *MsgForkRollapp) Reset()         { *m = MsgForkRollapp{} }
+func (m *MsgForkRollapp) String() string { return proto.CompactTextString(m) }
+func (*MsgForkRollapp) ProtoMessage()    {}
+func (*MsgForkRollapp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{22}
+}
+func (m *MsgForkRollapp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForkRollapp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForkRollapp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgForkRollapp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForkRollapp.Merge(m, src)
+}
+func (m *MsgForkRollapp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForkRollapp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForkRollapp.DiscardUnknown(m)
 }
 
-func _Msg_MarkVulnerableRollapps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarkVulnerableRollapps)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgForkRollapp proto.InternalMessageInfo
+
+func (m *MsgForkRollapp) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarkVulnerableRollapps(ctx, in)
+	return ""
+}
+
+func (m *MsgForkRollapp) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/MarkVulnerableRollapps",
+	return ""
+}
+
+func (m *MsgForkRollapp) GetForkHeight() uint64 {
+	if m != nil {
+		return m.ForkHeight
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarkVulnerableRollapps(ctx, req.(*MsgMarkVulnerableRollapps))
+	return 0
+}
+
+func (m *MsgForkRollapp) GetDrsVersion() string {
+	if m != nil {
+		return m.DrsVersion
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.rollapp.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRollapp",
-			Handler:    _Msg_CreateRollapp_Handler,
-		},
-		{
-			MethodName: "UpdateRollappInformation",
-			Handler:    _Msg_UpdateRollappInformation_Handler,
-		},
-		{
-			MethodName: "UpdateState",
-			Handler:    _Msg_UpdateState_Handler,
-		},
-		{
-			MethodName: "TransferOwnership",
-			Handler:    _Msg_TransferOwnership_Handler,
-		},
-		{
-			MethodName: "AddApp",
-			Handler:    _Msg_AddApp_Handler,
-		},
-		{
-			MethodName: "UpdateApp",
-			Handler:    _Msg_UpdateApp_Handler,
-		},
-		{
-			MethodName: "RemoveApp",
-			Handler:    _Msg_RemoveApp_Handler,
-		},
-		{
-			MethodName: "MarkVulnerableRollapps",
-			Handler:    _Msg_MarkVulnerableRollapps_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/rollapp/tx.proto",
+type MsgForkRollappResponse struct {
 }
 
-func (m *MsgCreateRollapp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgForkRollappResponse) Reset()         { *m = MsgForkRollappResponse{} }
+func (m *MsgForkRollappResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForkRollappResponse) ProtoMessage()    {}
+func (*MsgForkRollappResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{23}
+}
+func (m *MsgForkRollappResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForkRollappResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForkRollappResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgForkRollappResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForkRollappResponse.Merge(m, src)
+}
+func (m *MsgForkRollappResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForkRollappResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForkRollappResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRollapp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgForkRollappResponse proto.InternalMessageInfo
+
+// MsgRegisterMaintenanceWindow registers a maintenance window for a rollapp,
+// during which its liveness events are postponed. Must be called by the
+// proposer of the rollapp.
+type MsgRegisterMaintenanceWindow struct {
+	// Creator is the bech32-encoded address of the proposer.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// RollappId is the rollapp to register the window for.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// StartHeight is the first hub height of the window. Must not be in the
+	// past.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// NumBlocks is the length of the window in hub blocks.
+	NumBlocks uint64 `protobuf:"varint,4,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
 }
 
-func (m *MsgCreateRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VmType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VmType))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.GenesisInfo != nil {
-		{
-			size, err := m.GenesisInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRegisterMaintenanceWindow) Reset()         { *m = MsgRegisterMaintenanceWindow{} }
+func (m *MsgRegisterMaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMaintenanceWindow) ProtoMessage()    {}
+func (*MsgRegisterMaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{24}
+}
+func (m *MsgRegisterMaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterMaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterMaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+		return b[:n], nil
 	}
-	if len(m.Alias) > 0 {
-		i -= len(m.Alias)
-		copy(dAtA[i:], m.Alias)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Alias)))
-		i--
-		dAtA[i] = 0x62
+}
+func (m *MsgRegisterMaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMaintenanceWindow.Merge(m, src)
+}
+func (m *MsgRegisterMaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterMaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterMaintenanceWindow proto.InternalMessageInfo
+
+func (m *MsgRegisterMaintenanceWindow) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.InitialSequencer) > 0 {
-		i -= len(m.InitialSequencer)
-		copy(dAtA[i:], m.InitialSequencer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialSequencer)))
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+func (m *MsgRegisterMaintenanceWindow) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgRegisterMaintenanceWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgRegisterMaintenanceWindow) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateRollappResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgRegisterMaintenanceWindowResponse struct {
+}
+
+func (m *MsgRegisterMaintenanceWindowResponse) Reset()         { *m = MsgRegisterMaintenanceWindowResponse{} }
+func (m *MsgRegisterMaintenanceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMaintenanceWindowResponse) ProtoMessage()    {}
+func (*MsgRegisterMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{25}
+}
+func (m *MsgRegisterMaintenanceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterMaintenanceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterMaintenanceWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterMaintenanceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMaintenanceWindowResponse.Merge(m, src)
+}
+func (m *MsgRegisterMaintenanceWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterMaintenanceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMaintenanceWindowResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRollappResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterMaintenanceWindowResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollapp")
+	proto.RegisterType((*MsgCreateRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollappResponse")
+	proto.RegisterType((*MsgUpdateRollappInformation)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollappInformation")
+	proto.RegisterType((*MsgUpdateRollappInformationResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollappInformationResponse")
+	proto.RegisterType((*MsgUpdateState)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateState")
+	proto.RegisterType((*MsgUpdateStateResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateStateResponse")
+	proto.RegisterType((*MsgTransferOwnership)(nil), "dymensionxyz.dymension.rollapp.MsgTransferOwnership")
+	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "dymensionxyz.dymension.rollapp.MsgTransferOwnershipResponse")
+	proto.RegisterType((*MsgAddApp)(nil), "dymensionxyz.dymension.rollapp.MsgAddApp")
+	proto.RegisterType((*MsgAddAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgAddAppResponse")
+	proto.RegisterType((*MsgUpdateApp)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateApp")
+	proto.RegisterType((*MsgUpdateAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateAppResponse")
+	proto.RegisterType((*MsgRemoveApp)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveApp")
+	proto.RegisterType((*MsgRemoveAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveAppResponse")
+	proto.RegisterType((*MsgMarkVulnerableRollapps)(nil), "dymensionxyz.dymension.rollapp.MsgMarkVulnerableRollapps")
+	proto.RegisterType((*MsgMarkVulnerableRollappsResponse)(nil), "dymensionxyz.dymension.rollapp.MsgMarkVulnerableRollappsResponse")
+	proto.RegisterType((*MsgSetRollappDisputePeriodTime)(nil), "dymensionxyz.dymension.rollapp.MsgSetRollappDisputePeriodTime")
+	proto.RegisterType((*MsgSetRollappDisputePeriodTimeResponse)(nil), "dymensionxyz.dymension.rollapp.MsgSetRollappDisputePeriodTimeResponse")
+	proto.RegisterType((*MsgSubmitFraudProof)(nil), "dymensionxyz.dymension.rollapp.MsgSubmitFraudProof")
+	proto.RegisterType((*MsgSubmitFraudProofResponse)(nil), "dymensionxyz.dymension.rollapp.MsgSubmitFraudProofResponse")
+	proto.RegisterType((*MsgRecoverRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgRecoverRollapp")
+	proto.RegisterType((*MsgRecoverRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgRecoverRollappResponse")
+	proto.RegisterType((*MsgForkRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgForkRollapp")
+	proto.RegisterType((*MsgForkRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgForkRollappResponse")
+	proto.RegisterType((*MsgRegisterMaintenanceWindow)(nil), "dymensionxyz.dymension.rollapp.MsgRegisterMaintenanceWindow")
+	proto.RegisterType((*MsgRegisterMaintenanceWindowResponse)(nil), "dymensionxyz.dymension.rollapp.MsgRegisterMaintenanceWindowResponse")
 }
 
-func (m *MsgCreateRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/rollapp/tx.proto", fileDescriptor_1a86300fb8647ecb)
 }
 
-func (m *MsgUpdateRollappInformation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_1a86300fb8647ecb = []byte{
+	// 1496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0xce, 0x87, 0x9f, 0x9d, 0x90, 0x6c, 0x43, 0xd8, 0x6c, 0x5a, 0x27, 0x75, 0xa1,
+	0x04, 0x5a, 0x6c, 0x9a, 0x86, 0x42, 0xd3, 0x0a, 0x29, 0x1f, 0x6a, 0x1b, 0x90, 0x69, 0xd9, 0x84,
+	0x22, 0x71, 0xb1, 0xd6, 0xde, 0xc9, 0x66, 0x14, 0xef, 0x8e, 0x99, 0x19, 0x27, 0x0d, 0x48, 0x1c,
+	0x10, 0x12, 0x07, 0x04, 0x42, 0x42, 0x42, 0x1c, 0x90, 0x38, 0x21, 0xae, 0x48, 0xfc, 0x07, 0x1c,
+	0x50, 0x25, 0x84, 0xd4, 0x23, 0x5c, 0x00, 0xb5, 0x07, 0x4e, 0xfc, 0x0f, 0x68, 0x66, 0xd7, 0xe3,
+	0x8d, 0x1d, 0xdb, 0x6b, 0x87, 0x4b, 0xbd, 0xf3, 0xe6, 0xbd, 0x79, 0xbf, 0xdf, 0x7b, 0xf3, 0xf1,
+	0x6b, 0xe0, 0x79, 0xe7, 0xc8, 0x43, 0x3e, 0xc3, 0xc4, 0x7f, 0x70, 0xf4, 0x41, 0x41, 0x0d, 0x0a,
+	0x94, 0x54, 0xab, 0x76, 0xad, 0x56, 0xe0, 0x0f, 0xf2, 0x35, 0x4a, 0x38, 0xd1, 0xb3, 0x51, 0xc7,
+	0xbc, 0x1a, 0xe4, 0x43, 0x47, 0xf3, 0x99, 0x0a, 0x61, 0x1e, 0x61, 0x05, 0x8f, 0xb9, 0x85, 0x83,
+	0x2b, 0xe2, 0x27, 0x08, 0x34, 0x5f, 0xe9, 0x91, 0xa1, 0x5c, 0x25, 0x95, 0xfd, 0x92, 0x83, 0x58,
+	0x85, 0xe2, 0x1a, 0x27, 0x34, 0x0c, 0xbb, 0xdc, 0x23, 0x2c, 0xfc, 0x0d, 0xbd, 0x5f, 0xea, 0xe1,
+	0xed, 0x21, 0x6e, 0x3b, 0x36, 0xb7, 0x43, 0xf7, 0x19, 0x97, 0xb8, 0x44, 0x7e, 0x16, 0xc4, 0x57,
+	0x68, 0xcd, 0xba, 0x84, 0xb8, 0x55, 0x54, 0x90, 0xa3, 0x72, 0x7d, 0xb7, 0xe0, 0xd4, 0xa9, 0xcd,
+	0x05, 0xc9, 0x60, 0xfe, 0x2c, 0x47, 0xbe, 0x83, 0xa8, 0x87, 0x7d, 0x5e, 0xe0, 0x47, 0x35, 0xc4,
+	0x82, 0x7f, 0x83, 0xd9, 0xdc, 0x17, 0x09, 0x98, 0x2a, 0x32, 0x77, 0x83, 0x22, 0x9b, 0x23, 0x2b,
+	0xc8, 0xab, 0x1b, 0x30, 0x56, 0x11, 0x06, 0x42, 0x0d, 0x6d, 0x51, 0x5b, 0x4a, 0x59, 0x8d, 0xa1,
+	0x7e, 0x0e, 0x20, 0x04, 0x57, 0xc2, 0x8e, 0x31, 0x2c, 0x27, 0x53, 0xa1, 0x65, 0xcb, 0xd1, 0x2f,
+	0xc1, 0x34, 0xf6, 0x31, 0xc7, 0x76, 0xb5, 0xc4, 0xd0, 0xfb, 0x75, 0xe4, 0x57, 0x10, 0x35, 0xd2,
+	0xd2, 0x6b, 0x2a, 0x9c, 0xd8, 0x6e, 0xd8, 0xf5, 0x19, 0x18, 0xb1, 0xab, 0xd8, 0x66, 0x46, 0x46,
+	0x3a, 0x04, 0x03, 0xfd, 0x4d, 0x18, 0x6f, 0xd0, 0x36, 0x26, 0x16, 0xb5, 0xa5, 0xf4, 0x72, 0x21,
+	0xdf, 0xbd, 0x89, 0xf9, 0x10, 0x76, 0x31, 0x0c, 0xb3, 0xd4, 0x02, 0xfa, 0x0e, 0x64, 0x5c, 0xe4,
+	0x23, 0x86, 0x59, 0x09, 0xfb, 0xbb, 0xc4, 0x98, 0x94, 0x0b, 0x5e, 0xea, 0xb5, 0xe0, 0xed, 0x20,
+	0x66, 0xcb, 0xdf, 0x25, 0xeb, 0xc9, 0x87, 0x7f, 0x2e, 0x68, 0x56, 0xda, 0x6d, 0x9a, 0xf4, 0xdb,
+	0x30, 0x76, 0xe0, 0x95, 0x44, 0x15, 0x8d, 0xa7, 0x16, 0xb5, 0xa5, 0xc9, 0xe5, 0x7c, 0x4c, 0x84,
+	0xf9, 0xfb, 0xc5, 0x9d, 0xa3, 0x1a, 0xb2, 0x46, 0x0f, 0x3c, 0xf1, 0xbb, 0x9a, 0xf9, 0xf8, 0x9f,
+	0x1f, 0x5f, 0x6c, 0xd4, 0xf6, 0x8d, 0xe4, 0x78, 0x62, 0x2a, 0x9d, 0x33, 0xc1, 0x68, 0xed, 0x87,
+	0x85, 0x58, 0x8d, 0xf8, 0x0c, 0xe5, 0xfe, 0x1d, 0x86, 0xf9, 0x22, 0x73, 0xdf, 0xa9, 0x39, 0xcd,
+	0x49, 0x81, 0x88, 0x7a, 0xb2, 0xe1, 0xa2, 0xa2, 0xe4, 0xd0, 0x47, 0x8d, 0xae, 0x05, 0x83, 0x81,
+	0x7a, 0x96, 0xe8, 0xd0, 0xb3, 0xb7, 0x23, 0xdd, 0x19, 0x19, 0xa8, 0x3b, 0x61, 0x41, 0x3b, 0xf7,
+	0x68, 0xf4, 0x7f, 0xe9, 0xd1, 0xab, 0x60, 0x38, 0x98, 0xd5, 0xea, 0x1c, 0x95, 0x6a, 0x88, 0x62,
+	0xe2, 0x94, 0xb0, 0x5f, 0x92, 0x87, 0x96, 0x19, 0x63, 0x8b, 0xda, 0x52, 0xd2, 0x7a, 0x3a, 0x9c,
+	0xbf, 0x27, 0xa7, 0xb7, 0xfc, 0x75, 0x39, 0xb9, 0x0a, 0xa2, 0x27, 0x41, 0xe5, 0x72, 0xcf, 0xc1,
+	0x85, 0x2e, 0xe5, 0x56, 0x6d, 0xf9, 0x69, 0x18, 0x26, 0x95, 0xdf, 0x36, 0xb7, 0x39, 0xea, 0x72,
+	0x82, 0xce, 0x42, 0xb3, 0xf6, 0xed, 0xcd, 0x58, 0x84, 0x34, 0xe3, 0x36, 0xe5, 0x77, 0x10, 0x76,
+	0xf7, 0xb8, 0x6c, 0x43, 0xd2, 0x8a, 0x9a, 0x44, 0xbc, 0x5f, 0xf7, 0x02, 0xb0, 0x46, 0x52, 0xce,
+	0x37, 0x0d, 0xfa, 0x2c, 0x8c, 0x6e, 0xae, 0xdd, 0xb3, 0xf9, 0x9e, 0xec, 0x4e, 0xca, 0x0a, 0x47,
+	0xfa, 0x1d, 0x48, 0xac, 0x6f, 0x06, 0xcc, 0xd3, 0xcb, 0x2f, 0xf7, 0xaa, 0xad, 0x5c, 0x6c, 0x53,
+	0xdd, 0x6d, 0x4c, 0x16, 0x78, 0xc8, 0x12, 0x4b, 0xe8, 0x3a, 0x24, 0xab, 0x36, 0xe3, 0xc6, 0xf8,
+	0xa2, 0xb6, 0x34, 0x6e, 0xc9, 0x6f, 0x7d, 0x01, 0xd2, 0x0e, 0x65, 0xa5, 0x03, 0x44, 0xc5, 0x32,
+	0x46, 0x4a, 0xa6, 0x06, 0x87, 0xb2, 0xfb, 0x81, 0xa5, 0x6d, 0xa3, 0x8f, 0x4e, 0x8d, 0xe5, 0x0c,
+	0x98, 0x3d, 0x5e, 0x34, 0x55, 0xcf, 0xcf, 0x34, 0x98, 0x29, 0x32, 0x77, 0x87, 0xda, 0x3e, 0xdb,
+	0x45, 0xf4, 0xae, 0xe8, 0x05, 0xdb, 0xc3, 0x35, 0xfd, 0x02, 0x4c, 0x54, 0xea, 0x94, 0x22, 0x9f,
+	0x97, 0xa2, 0xfb, 0x3c, 0x13, 0x1a, 0xa5, 0xa3, 0x3e, 0x0f, 0x29, 0x1f, 0x1d, 0x86, 0x0e, 0x41,
+	0x81, 0xc7, 0x7d, 0x74, 0x78, 0xf7, 0x84, 0xb3, 0x90, 0x68, 0x29, 0xff, 0xaa, 0x2e, 0x70, 0x1e,
+	0xcf, 0x91, 0xcb, 0xc2, 0xd9, 0x93, 0xc0, 0x28, 0xb4, 0xbf, 0x68, 0x90, 0x2a, 0x32, 0x77, 0xcd,
+	0x71, 0xd6, 0xba, 0x5e, 0x9d, 0x3a, 0x24, 0x7d, 0xdb, 0x43, 0x21, 0x24, 0xf9, 0xdd, 0x03, 0x8e,
+	0xd8, 0x0d, 0x8d, 0x17, 0x46, 0xd4, 0x35, 0x29, 0xe7, 0xa3, 0x26, 0x71, 0xe2, 0xb1, 0x67, 0xbb,
+	0x28, 0x6c, 0x77, 0x30, 0xd0, 0xa7, 0x20, 0x51, 0xa7, 0x55, 0x79, 0x92, 0x52, 0x96, 0xf8, 0x14,
+	0x7e, 0x84, 0x3a, 0x88, 0xca, 0x1d, 0x30, 0x62, 0x05, 0x83, 0xe3, 0x6d, 0xc9, 0x9d, 0x81, 0x69,
+	0xc5, 0x43, 0xb1, 0xfb, 0x43, 0x83, 0x8c, 0x6a, 0x53, 0x77, 0x82, 0x93, 0x30, 0x1c, 0xde, 0x2f,
+	0x49, 0x6b, 0x18, 0x3b, 0x8a, 0x70, 0xa2, 0x23, 0xe1, 0x64, 0x0f, 0xc2, 0x23, 0x5d, 0x08, 0x8f,
+	0x9e, 0x40, 0x78, 0xec, 0x04, 0xc2, 0xe3, 0x9d, 0x09, 0xcf, 0xc2, 0x4c, 0x94, 0x9a, 0xe2, 0x8c,
+	0x24, 0x65, 0x0b, 0x79, 0xe4, 0xa0, 0x4f, 0xca, 0x3d, 0xb6, 0xd7, 0x49, 0xe9, 0x55, 0x1a, 0x95,
+	0xbe, 0x0a, 0x73, 0x45, 0xe6, 0x16, 0x6d, 0xba, 0x7f, 0xbf, 0x5e, 0xf5, 0x11, 0xb5, 0xcb, 0xd5,
+	0xc6, 0xed, 0xc3, 0xc4, 0xf1, 0xb7, 0xeb, 0x7c, 0x8f, 0x50, 0xcc, 0x8f, 0x42, 0x34, 0x4d, 0x83,
+	0x7e, 0x1e, 0x32, 0x91, 0x83, 0xc8, 0x8c, 0xe1, 0xc5, 0x84, 0x2c, 0xa0, 0x3a, 0x89, 0x6c, 0x75,
+	0x52, 0x60, 0x68, 0x86, 0xe4, 0x2e, 0xc0, 0xf9, 0x8e, 0xd9, 0x14, 0xa4, 0x9f, 0x35, 0xc8, 0x16,
+	0x99, 0xbb, 0x8d, 0x78, 0x38, 0xb5, 0x19, 0xbd, 0x3b, 0x77, 0xb0, 0x87, 0x7a, 0x00, 0xeb, 0xf1,
+	0x06, 0x6d, 0xc3, 0x99, 0x96, 0xdb, 0x9a, 0xe3, 0x70, 0xe7, 0xa4, 0x97, 0xe7, 0xf2, 0x81, 0xc2,
+	0xc9, 0x37, 0x14, 0x4e, 0x7e, 0x33, 0x54, 0x38, 0xeb, 0xe3, 0xe2, 0x5e, 0xfa, 0xe6, 0xaf, 0x05,
+	0xcd, 0x9a, 0x76, 0x5a, 0x11, 0xb5, 0x31, 0x5d, 0x82, 0x8b, 0xdd, 0x39, 0x28, 0xba, 0xbf, 0x6a,
+	0x70, 0x46, 0xb8, 0xd6, 0xcb, 0x1e, 0xe6, 0xb7, 0xa8, 0x5d, 0x77, 0xee, 0x51, 0x42, 0x76, 0x05,
+	0x47, 0x26, 0x6d, 0x5c, 0xdd, 0x3d, 0x4d, 0x43, 0x2f, 0x8e, 0x39, 0x98, 0xc0, 0xe5, 0x4a, 0xa9,
+	0x52, 0xc5, 0xe2, 0x6e, 0x51, 0xdb, 0x23, 0x8d, 0xcb, 0x95, 0x0d, 0x69, 0xdb, 0x72, 0xf4, 0x0d,
+	0x98, 0x60, 0xd8, 0xf5, 0x91, 0x53, 0xda, 0x43, 0xb6, 0xd8, 0xbd, 0x49, 0x59, 0x81, 0x6c, 0xbe,
+	0xa9, 0xe1, 0xf2, 0x81, 0x7a, 0xdb, 0x96, 0x6e, 0x77, 0xa4, 0x97, 0x95, 0x61, 0x91, 0x51, 0xc8,
+	0x5b, 0xe1, 0xca, 0x9d, 0x83, 0xf9, 0x13, 0xc8, 0x28, 0xb2, 0x9f, 0x6b, 0xf2, 0xdc, 0x5b, 0xa8,
+	0x42, 0x0e, 0x10, 0x6d, 0x48, 0xc0, 0x53, 0xb5, 0xb3, 0x1f, 0x49, 0xd1, 0xd6, 0xa6, 0x79, 0x98,
+	0x6b, 0x83, 0xa3, 0xc0, 0x7e, 0xa5, 0xc9, 0xa7, 0xf6, 0x16, 0xa1, 0xfb, 0x0d, 0xa4, 0x03, 0x89,
+	0x9e, 0x05, 0x48, 0xef, 0x12, 0xba, 0x5f, 0xda, 0x8b, 0xbe, 0xb3, 0x20, 0x4c, 0xe1, 0x33, 0xdb,
+	0xf2, 0xa4, 0x25, 0xdb, 0x9e, 0xb4, 0xa8, 0x4e, 0x08, 0x9e, 0xb2, 0x08, 0x28, 0x85, 0xf7, 0x07,
+	0x4d, 0xbe, 0x1e, 0x16, 0x72, 0x31, 0xe3, 0x88, 0x16, 0x6d, 0xec, 0x73, 0xe4, 0xdb, 0x7e, 0x05,
+	0xbd, 0x8b, 0x7d, 0x87, 0x1c, 0x0e, 0x2e, 0xb5, 0xcf, 0x43, 0x46, 0xca, 0x82, 0x28, 0x85, 0xc4,
+	0x71, 0xa9, 0x70, 0x0e, 0xc0, 0xaf, 0x7b, 0x0d, 0xd5, 0xd3, 0xaa, 0x15, 0x5a, 0x6e, 0xa3, 0x8b,
+	0xf0, 0x6c, 0x37, 0xa0, 0x0d, 0x46, 0xcb, 0xbf, 0x4d, 0x40, 0xa2, 0xc8, 0x5c, 0xfd, 0x43, 0x98,
+	0x38, 0xfe, 0x9f, 0x86, 0x9e, 0xaa, 0xa2, 0x55, 0xd6, 0x9a, 0xaf, 0xf5, 0x1b, 0xd1, 0x00, 0xa1,
+	0x7f, 0xab, 0x81, 0xd1, 0x51, 0x05, 0xdf, 0x88, 0xb1, 0x6c, 0xa7, 0x60, 0x73, 0xe3, 0x14, 0xc1,
+	0x0a, 0x5e, 0x1d, 0xd2, 0x51, 0x31, 0x98, 0x8f, 0xbd, 0xa6, 0xf4, 0x37, 0xaf, 0xf5, 0xe7, 0xaf,
+	0xd2, 0x7e, 0xaa, 0xc1, 0x74, 0xbb, 0x68, 0x5a, 0x89, 0xb1, 0x5a, 0x5b, 0x94, 0x79, 0x73, 0x90,
+	0x28, 0x85, 0x64, 0x17, 0x46, 0x43, 0x3d, 0xf4, 0x42, 0x8c, 0x75, 0x02, 0x57, 0xf3, 0x4a, 0x6c,
+	0x57, 0x95, 0x87, 0x40, 0xaa, 0xa9, 0x4c, 0x2e, 0xc7, 0x2e, 0x9b, 0xc8, 0xb6, 0xd2, 0x8f, 0x77,
+	0x34, 0x61, 0x53, 0x17, 0xc4, 0x49, 0xa8, 0xbc, 0xcd, 0x95, 0x7e, 0xbc, 0x55, 0xc2, 0xaf, 0x35,
+	0x98, 0xed, 0x20, 0x05, 0xae, 0xc7, 0x58, 0xf0, 0xe4, 0x50, 0x73, 0x6d, 0xe0, 0x50, 0x05, 0xec,
+	0x7b, 0x0d, 0xe6, 0xbb, 0xe9, 0x81, 0xd7, 0x63, 0xa4, 0xe8, 0x12, 0x6f, 0xde, 0x3a, 0x5d, 0xbc,
+	0xc2, 0xf9, 0x89, 0x06, 0x53, 0x6d, 0x0f, 0xf9, 0xd5, 0x38, 0x8b, 0xb7, 0x04, 0x99, 0x37, 0x06,
+	0x08, 0x52, 0x30, 0x3e, 0x82, 0xc9, 0x96, 0x17, 0xf6, 0x4a, 0xac, 0xfd, 0x10, 0x0d, 0x31, 0xaf,
+	0xf7, 0x1d, 0x12, 0xbd, 0x92, 0xa2, 0x8f, 0x66, 0x9c, 0x2b, 0x29, 0xe2, 0x6f, 0x5e, 0xeb, 0xcf,
+	0x5f, 0xa5, 0xfd, 0x4e, 0x83, 0xb9, 0xce, 0x8f, 0xdf, 0xcd, 0x58, 0x7c, 0x3a, 0x44, 0x9b, 0x9b,
+	0xa7, 0x89, 0x6e, 0x20, 0x5c, 0x7f, 0xeb, 0xe1, 0xe3, 0xac, 0xf6, 0xe8, 0x71, 0x56, 0xfb, 0xfb,
+	0x71, 0x56, 0xfb, 0xf2, 0x49, 0x76, 0xe8, 0xd1, 0x93, 0xec, 0xd0, 0xef, 0x4f, 0xb2, 0x43, 0xef,
+	0xad, 0xb8, 0x98, 0xef, 0xd5, 0xcb, 0xf9, 0x0a, 0xf1, 0x0a, 0x1d, 0xfe, 0x50, 0x77, 0x70, 0xb5,
+	0xf0, 0xa0, 0xf9, 0x47, 0xc7, 0xa3, 0x1a, 0x62, 0xe5, 0x51, 0xa9, 0x52, 0xaf, 0xfe, 0x37, 0x00,
+	0x77, 0xfb, 0xd9, 0xba, 0xa3, 0x14, 0x00, 0x00,
 }
 
-func (m *MsgUpdateRollappInformation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error)
+	UpdateRollappInformation(ctx context.Context, in *MsgUpdateRollappInformation, opts ...grpc.CallOption) (*MsgUpdateRollappInformationResponse, error)
+	UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error)
+	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	AddApp(ctx context.Context, in *MsgAddApp, opts ...grpc.CallOption) (*MsgAddAppResponse, error)
+	UpdateApp(ctx context.Context, in *MsgUpdateApp, opts ...grpc.CallOption) (*MsgUpdateAppResponse, error)
+	RemoveApp(ctx context.Context, in *MsgRemoveApp, opts ...grpc.CallOption) (*MsgRemoveAppResponse, error)
+	MarkVulnerableRollapps(ctx context.Context, in *MsgMarkVulnerableRollapps, opts ...grpc.CallOption) (*MsgMarkVulnerableRollappsResponse, error)
+	SetRollappDisputePeriodTime(ctx context.Context, in *MsgSetRollappDisputePeriodTime, opts ...grpc.CallOption) (*MsgSetRollappDisputePeriodTimeResponse, error)
+	SubmitFraudProof(ctx context.Context, in *MsgSubmitFraudProof, opts ...grpc.CallOption) (*MsgSubmitFraudProofResponse, error)
+	RecoverRollapp(ctx context.Context, in *MsgRecoverRollapp, opts ...grpc.CallOption) (*MsgRecoverRollappResponse, error)
+	ForkRollapp(ctx context.Context, in *MsgForkRollapp, opts ...grpc.CallOption) (*MsgForkRollappResponse, error)
+	RegisterMaintenanceWindow(ctx context.Context, in *MsgRegisterMaintenanceWindow, opts ...grpc.CallOption) (*MsgRegisterMaintenanceWindowResponse, error)
 }
 
-func (m *MsgUpdateRollappInformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GenesisInfo != nil {
-		{
-			size, err := m.GenesisInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.InitialSequencer) > 0 {
-		i -= len(m.InitialSequencer)
-		copy(dAtA[i:], m.InitialSequencer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialSequencer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgUpdateRollappInformationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error) {
+	out := new(MsgCreateRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateRollappInformationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateRollappInformation(ctx context.Context, in *MsgUpdateRollappInformation, opts ...grpc.CallOption) (*MsgUpdateRollappInformationResponse, error) {
+	out := new(MsgUpdateRollappInformationResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateRollappInformation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateRollappInformationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error) {
+	out := new(MsgUpdateStateResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
+	out := new(MsgTransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/TransferOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddApp(ctx context.Context, in *MsgAddApp, opts ...grpc.CallOption) (*MsgAddAppResponse, error) {
+	out := new(MsgAddAppResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/AddApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DrsVersion) > 0 {
-		i -= len(m.DrsVersion)
-		copy(dAtA[i:], m.DrsVersion)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DrsVersion)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.Last {
-		i--
-		if m.Last {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size, err := m.BDs.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.DAPath) > 0 {
-		i -= len(m.DAPath)
-		copy(dAtA[i:], m.DAPath)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DAPath)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.NumBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NumBlocks))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.StartHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateApp(ctx context.Context, in *MsgUpdateApp, opts ...grpc.CallOption) (*MsgUpdateAppResponse, error) {
+	out := new(MsgUpdateAppResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateApp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveApp(ctx context.Context, in *MsgRemoveApp, opts ...grpc.CallOption) (*MsgRemoveAppResponse, error) {
+	out := new(MsgRemoveAppResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/RemoveApp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgTransferOwnership) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarkVulnerableRollapps(ctx context.Context, in *MsgMarkVulnerableRollapps, opts ...grpc.CallOption) (*MsgMarkVulnerableRollappsResponse, error) {
+	out := new(MsgMarkVulnerableRollappsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/MarkVulnerableRollapps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CurrentOwner) > 0 {
-		i -= len(m.CurrentOwner)
-		copy(dAtA[i:], m.CurrentOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CurrentOwner)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetRollappDisputePeriodTime(ctx context.Context, in *MsgSetRollappDisputePeriodTime, opts ...grpc.CallOption) (*MsgSetRollappDisputePeriodTimeResponse, error) {
+	out := new(MsgSetRollappDisputePeriodTimeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/SetRollappDisputePeriodTime", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitFraudProof(ctx context.Context, in *MsgSubmitFraudProof, opts ...grpc.CallOption) (*MsgSubmitFraudProofResponse, error) {
+	out := new(MsgSubmitFraudProofResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/SubmitFraudProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddApp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RecoverRollapp(ctx context.Context, in *MsgRecoverRollapp, opts ...grpc.CallOption) (*MsgRecoverRollappResponse, error) {
+	out := new(MsgRecoverRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/RecoverRollapp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddApp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgAddApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Order != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Order))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ForkRollapp(ctx context.Context, in *MsgForkRollapp, opts ...grpc.CallOption) (*MsgForkRollappResponse, error) {
+	out := new(MsgForkRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/ForkRollapp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAddAppResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterMaintenanceWindow(ctx context.Context, in *MsgRegisterMaintenanceWindow, opts ...grpc.CallOption) (*MsgRegisterMaintenanceWindowResponse, error) {
+	out := new(MsgRegisterMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/RegisterMaintenanceWindow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAddAppResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateRollapp(context.Context, *MsgCreateRollapp) (*MsgCreateRollappResponse, error)
+	UpdateRollappInformation(context.Context, *MsgUpdateRollappInformation) (*MsgUpdateRollappInformationResponse, error)
+	UpdateState(context.Context, *MsgUpdateState) (*MsgUpdateStateResponse, error)
+	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	AddApp(context.Context, *MsgAddApp) (*MsgAddAppResponse, error)
+	UpdateApp(context.Context, *MsgUpdateApp) (*MsgUpdateAppResponse, error)
+	RemoveApp(context.Context, *MsgRemoveApp) (*MsgRemoveAppResponse, error)
+	MarkVulnerableRollapps(context.Context, *MsgMarkVulnerableRollapps) (*MsgMarkVulnerableRollappsResponse, error)
+	SetRollappDisputePeriodTime(context.Context, *MsgSetRollappDisputePeriodTime) (*MsgSetRollappDisputePeriodTimeResponse, error)
+	SubmitFraudProof(context.Context, *MsgSubmitFraudProof) (*MsgSubmitFraudProofResponse, error)
+	RecoverRollapp(context.Context, *MsgRecoverRollapp) (*MsgRecoverRollappResponse, error)
+	ForkRollapp(context.Context, *MsgForkRollapp) (*MsgForkRollappResponse, error)
+	RegisterMaintenanceWindow(context.Context, *MsgRegisterMaintenanceWindow) (*MsgRegisterMaintenanceWindowResponse, error)
 }
 
-func (m *MsgAddAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateApp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateRollapp(ctx context.Context, req *MsgCreateRollapp) (*MsgCreateRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRollapp not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRollappInformation(ctx context.Context, req *MsgUpdateRollappInformation) (*MsgUpdateRollappInformationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRollappInformation not implemented")
+}
+func (*UnimplementedMsgServer) UpdateState(ctx context.Context, req *MsgUpdateState) (*MsgUpdateStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateState not implemented")
+}
+func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AddApp(ctx context.Context, req *MsgAddApp) (*MsgAddAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddApp not implemented")
+}
+func (*UnimplementedMsgServer) UpdateApp(ctx context.Context, req *MsgUpdateApp) (*MsgUpdateAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
+}
+func (*UnimplementedMsgServer) RemoveApp(ctx context.Context, req *MsgRemoveApp) (*MsgRemoveAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveApp not implemented")
+}
+func (*UnimplementedMsgServer) MarkVulnerableRollapps(ctx context.Context, req *MsgMarkVulnerableRollapps) (*MsgMarkVulnerableRollappsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkVulnerableRollapps not implemented")
+}
+func (*UnimplementedMsgServer) SetRollappDisputePeriodTime(ctx context.Context, req *MsgSetRollappDisputePeriodTime) (*MsgSetRollappDisputePeriodTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRollappDisputePeriodTime not implemented")
+}
+func (*UnimplementedMsgServer) SubmitFraudProof(ctx context.Context, req *MsgSubmitFraudProof) (*MsgSubmitFraudProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFraudProof not implemented")
+}
+func (*UnimplementedMsgServer) RecoverRollapp(ctx context.Context, req *MsgRecoverRollapp) (*MsgRecoverRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverRollapp not implemented")
+}
+func (*UnimplementedMsgServer) ForkRollapp(ctx context.Context, req *MsgForkRollapp) (*MsgForkRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkRollapp not implemented")
+}
+func (*UnimplementedMsgServer) RegisterMaintenanceWindow(ctx context.Context, req *MsgRegisterMaintenanceWindow) (*MsgRegisterMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMaintenanceWindow not implemented")
 }
 
-func (m *MsgUpdateApp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Order != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Order))
-		i--
-		dAtA[i] = 0x40
+func _Msg_CreateRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRollapp)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRollapp(ctx, in)
 	}
-	if len(m.Image) > 0 {
-		i -= len(m.Image)
-		copy(dAtA[i:], m.Image)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp",
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRollapp(ctx, req.(*MsgCreateRollapp))
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRollappInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRollappInformation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRollappInformation(ctx, in)
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateRollappInformation",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRollappInformation(ctx, req.(*MsgUpdateRollappInformation))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateAppResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateState)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateAppResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateState(ctx, req.(*MsgUpdateState))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveApp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferOwnership)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveApp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRemoveApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).TransferOwnership(ctx, in)
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/TransferOwnership",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveAppResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddApp)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRemoveAppResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).AddApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/AddApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddApp(ctx, req.(*MsgAddApp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRemoveAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateApp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateApp(ctx, req.(*MsgUpdateApp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarkVulnerableRollapps) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveApp)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/RemoveApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveApp(ctx, req.(*MsgRemoveApp))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarkVulnerableRollapps) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MarkVulnerableRollapps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarkVulnerableRollapps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarkVulnerableRollapps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/MarkVulnerableRollapps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarkVulnerableRollapps(ctx, req.(*MsgMarkVulnerableRollapps))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarkVulnerableRollapps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_SetRollappDisputePeriodTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRollappDisputePeriodTime)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRollappDisputePeriodTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/SetRollappDisputePeriodTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRollappDisputePeriodTime(ctx, req.(*MsgSetRollappDisputePeriodTime))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitFraudProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFraudProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFraudProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/SubmitFraudProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFraudProof(ctx, req.(*MsgSubmitFraudProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverRollapp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverRollapp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/RecoverRollapp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverRollapp(ctx, req.(*MsgRecoverRollapp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForkRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForkRollapp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForkRollapp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/ForkRollapp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForkRollapp(ctx, req.(*MsgForkRollapp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMaintenanceWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/RegisterMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterMaintenanceWindow(ctx, req.(*MsgRegisterMaintenanceWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.rollapp.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRollapp",
+			Handler:    _Msg_CreateRollapp_Handler,
+		},
+		{
+			MethodName: "UpdateRollappInformation",
+			Handler:    _Msg_UpdateRollappInformation_Handler,
+		},
+		{
+			MethodName: "UpdateState",
+			Handler:    _Msg_UpdateState_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _Msg_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "AddApp",
+			Handler:    _Msg_AddApp_Handler,
+		},
+		{
+			MethodName: "UpdateApp",
+			Handler:    _Msg_UpdateApp_Handler,
+		},
+		{
+			MethodName: "RemoveApp",
+			Handler:    _Msg_RemoveApp_Handler,
+		},
+		{
+			MethodName: "MarkVulnerableRollapps",
+			Handler:    _Msg_MarkVulnerableRollapps_Handler,
+		},
+		{
+			MethodName: "SetRollappDisputePeriodTime",
+			Handler:    _Msg_SetRollappDisputePeriodTime_Handler,
+		},
+		{
+			MethodName: "SubmitFraudProof",
+			Handler:    _Msg_SubmitFraudProof_Handler,
+		},
+		{
+			MethodName: "RecoverRollapp",
+			Handler:    _Msg_RecoverRollapp_Handler,
+		},
+		{
+			MethodName: "ForkRollapp",
+			Handler:    _Msg_ForkRollapp_Handler,
+		},
+		{
+			MethodName: "RegisterMaintenanceWindow",
+			Handler:    _Msg_RegisterMaintenanceWindow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/rollapp/tx.proto",
+}
+
+func (m *MsgCreateRollapp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRollapp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DrsVersions) > 0 {
-		for iNdEx := len(m.DrsVersions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DrsVersions[iNdEx])
-			copy(dAtA[i:], m.DrsVersions[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DrsVersions[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if m.VmType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VmType))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.GenesisInfo != nil {
+		{
+			size, err := m.GenesisInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x72
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.InitialSequencer) > 0 {
+		i -= len(m.InitialSequencer)
+		copy(dAtA[i:], m.InitialSequencer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialSequencer)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarkVulnerableRollappsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateRollappResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2103,12 +2304,12 @@ func (m *MsgMarkVulnerableRollappsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMarkVulnerableRollappsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateRollappResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMarkVulnerableRollappsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2116,332 +2317,2358 @@ func (m *MsgMarkVulnerableRollappsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateRollappInformation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateRollapp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateRollappInformation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRollappInformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.InitialSequencer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Alias)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GenesisInfo != nil {
+		{
+			size, err := m.GenesisInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.GenesisInfo != nil {
-		l = m.GenesisInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.InitialSequencer) > 0 {
+		i -= len(m.InitialSequencer)
+		copy(dAtA[i:], m.InitialSequencer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialSequencer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.VmType != 0 {
-		n += 1 + sovTx(uint64(m.VmType))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRollappResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateRollappInformationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRollappInformation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InitialSequencer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GenesisInfo != nil {
-		l = m.GenesisInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateRollappInformationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateRollappInformationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateRollappInformationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DrsVersion) > 0 {
+		i -= len(m.DrsVersion)
+		copy(dAtA[i:], m.DrsVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DrsVersion)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Last {
+		i--
+		if m.Last {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovTx(uint64(m.StartHeight))
+	{
+		size, err := m.BDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.DAPath) > 0 {
+		i -= len(m.DAPath)
+		copy(dAtA[i:], m.DAPath)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DAPath)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.NumBlocks != 0 {
-		n += 1 + sovTx(uint64(m.NumBlocks))
+		i = encodeVarintTx(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.DAPath)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.BDs.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Last {
-		n += 2
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.DrsVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTransferOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CurrentOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddApp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Order != 0 {
-		n += 1 + sovTx(uint64(m.Order))
+	if len(m.CurrentOwner) > 0 {
+		i -= len(m.CurrentOwner)
+		copy(dAtA[i:], m.CurrentOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CurrentOwner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAppResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateApp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddApp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddApp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if m.Order != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Order != 0 {
-		n += 1 + sovTx(uint64(m.Order))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAppResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveApp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgAddAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveAppResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarkVulnerableRollapps) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateApp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateApp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Order != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.DrsVersions) > 0 {
-		for _, s := range m.DrsVersions {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveApp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveApp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveApp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAppResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAppResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAppResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarkVulnerableRollapps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarkVulnerableRollapps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarkVulnerableRollapps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DrsVersions) > 0 {
+		for iNdEx := len(m.DrsVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DrsVersions[iNdEx])
+			copy(dAtA[i:], m.DrsVersions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DrsVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarkVulnerableRollappsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarkVulnerableRollappsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarkVulnerableRollappsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRollappDisputePeriodTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRollappDisputePeriodTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRollappDisputePeriodTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DisputePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriodTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRollappDisputePeriodTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRollappDisputePeriodTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRollappDisputePeriodTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraudProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraudProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraudProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignedHeader != nil {
+		{
+			size, err := m.SignedHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IbcClientId) > 0 {
+		i -= len(m.IbcClientId)
+		copy(dAtA[i:], m.IbcClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraudProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraudProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraudProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverRollapp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverRollapp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InitialSequencer) > 0 {
+		i -= len(m.InitialSequencer)
+		copy(dAtA[i:], m.InitialSequencer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitialSequencer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForkRollapp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForkRollapp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForkRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DrsVersion) > 0 {
+		i -= len(m.DrsVersion)
+		copy(dAtA[i:], m.DrsVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DrsVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ForkHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ForkHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForkRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForkRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForkRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMaintenanceWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMaintenanceWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMaintenanceWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitialSequencer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GenesisInfo != nil {
+		l = m.GenesisInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VmType != 0 {
+		n += 1 + sovTx(uint64(m.VmType))
+	}
+	return n
+}
+
+func (m *MsgCreateRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRollappInformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitialSequencer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GenesisInfo != nil {
+		l = m.GenesisInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisputePeriodInBlocks != 0 {
+		n += 1 + sovTx(uint64(m.DisputePeriodInBlocks))
+	}
+	return n
+}
+
+func (m *MsgUpdateRollappInformationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovTx(uint64(m.NumBlocks))
+	}
+	l = len(m.DAPath)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BDs.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Last {
+		n += 2
+	}
+	l = len(m.DrsVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddApp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovTx(uint64(m.Order))
+	}
+	return n
+}
+
+func (m *MsgAddAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateApp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovTx(uint64(m.Order))
+	}
+	return n
+}
+
+func (m *MsgUpdateAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveApp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAppResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarkVulnerableRollapps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DrsVersions) > 0 {
+		for _, s := range m.DrsVersions {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMarkVulnerableRollappsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRollappDisputePeriodTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DisputePeriodTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRollappDisputePeriodTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFraudProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IbcClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignedHeader != nil {
+		l = m.SignedHeader.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitFraudProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitialSequencer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForkRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ForkHeight != 0 {
+		n += 1 + sovTx(uint64(m.ForkHeight))
+	}
+	l = len(m.DrsVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForkRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterMaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovTx(uint64(m.NumBlocks))
+	}
+	return n
+}
+
+func (m *MsgRegisterMaintenanceWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRollapp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &RollappMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisInfo == nil {
+				m.GenesisInfo = &GenesisInfo{}
+			}
+			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmType", wireType)
+			}
+			m.VmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VmType |= Rollapp_VMType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &RollappMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisInfo == nil {
+				m.GenesisInfo = &GenesisInfo{}
+			}
+			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
+			}
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DAPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Last = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrsVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgMarkVulnerableRollappsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2464,15 +4691,15 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRollapp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2500,43 +4727,11 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2564,11 +4759,11 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2596,99 +4791,8 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &RollappMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GenesisInfo == nil {
-				m.GenesisInfo = &GenesisInfo{}
-			}
-			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmType", wireType)
-			}
-			m.VmType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VmType |= Rollapp_VMType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2710,7 +4814,7 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2733,10 +4837,10 @@ func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRollappResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2760,7 +4864,7 @@ func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
+func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2783,15 +4887,15 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddApp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddApp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2819,11 +4923,11 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2851,11 +4955,11 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2883,13 +4987,13 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2899,33 +5003,61 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &RollappMetadata{}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2935,28 +5067,43 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GenesisInfo == nil {
-				m.GenesisInfo = &GenesisInfo{}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2978,7 +5125,7 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3001,10 +5148,10 @@ func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3028,7 +5175,7 @@ func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3051,10 +5198,10 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateApp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateApp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3090,6 +5237,57 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
@@ -3121,11 +5319,11 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.StartHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3135,16 +5333,29 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.NumBlocks = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3154,14 +5365,27 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumBlocks |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3189,13 +5413,132 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DAPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAppResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAppResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveApp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveApp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3205,30 +5548,29 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3238,15 +5580,14 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Last = bool(v != 0)
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3274,7 +5615,7 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrsVersion = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3297,7 +5638,7 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3320,10 +5661,10 @@ func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3347,7 +5688,7 @@ func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3370,15 +5711,15 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarkVulnerableRollapps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarkVulnerableRollapps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3406,43 +5747,11 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3470,7 +5779,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.DrsVersions = append(m.DrsVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3493,7 +5802,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarkVulnerableRollappsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3516,10 +5825,10 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarkVulnerableRollappsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarkVulnerableRollappsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3543,7 +5852,7 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRollappDisputePeriodTime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3566,15 +5875,15 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddApp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRollappDisputePeriodTime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddApp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRollappDisputePeriodTime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3602,11 +5911,11 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3634,13 +5943,13 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3650,27 +5959,128 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DisputePeriodTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRollappDisputePeriodTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRollappDisputePeriodTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRollappDisputePeriodTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFraudProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFraudProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFraudProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3698,11 +6108,11 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3730,11 +6140,11 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3762,13 +6172,13 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.IbcClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedHeader", wireType)
 			}
-			m.Order = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3778,11 +6188,28 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Order |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignedHeader == nil {
+				m.SignedHeader = &types.SignedHeader{}
+			}
+			if err := m.SignedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3804,7 +6231,7 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAppResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitFraudProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3827,10 +6254,10 @@ func (m *MsgAddAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitFraudProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitFraudProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3854,7 +6281,7 @@ func (m *MsgAddAppResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverRollapp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3877,15 +6304,15 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateApp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverRollapp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateApp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3913,60 +6340,9 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
@@ -3998,73 +6374,9 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4092,27 +6404,8 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
-			}
-			m.Order = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Order |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4134,7 +6427,7 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAppResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverRollappResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4157,10 +6450,10 @@ func (m *MsgUpdateAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverRollappResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4184,7 +6477,7 @@ func (m *MsgUpdateAppResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
+func (m *MsgForkRollapp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4207,15 +6500,15 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveApp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForkRollapp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveApp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForkRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4243,13 +6536,13 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4259,14 +6552,46 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkHeight", wireType)
+			}
+			m.ForkHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForkHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4294,7 +6619,7 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.DrsVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4317,7 +6642,7 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAppResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForkRollappResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4340,10 +6665,10 @@ func (m *MsgRemoveAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForkRollappResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForkRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4367,7 +6692,7 @@ func (m *MsgRemoveAppResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterMaintenanceWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4390,15 +6715,15 @@ func (m *MsgMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarkVulnerableRollapps: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterMaintenanceWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarkVulnerableRollapps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterMaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4426,11 +6751,11 @@ func (m *MsgMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4458,8 +6783,46 @@ func (m *MsgMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DrsVersions = append(m.DrsVersions, string(dAtA[iNdEx:postIndex]))
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4481,7 +6844,7 @@ func (m *MsgMarkVulnerableRollapps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarkVulnerableRollappsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterMaintenanceWindowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4504,10 +6867,10 @@ func (m *MsgMarkVulnerableRollappsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarkVulnerableRollappsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterMaintenanceWindowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarkVulnerableRollappsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterMaintenanceWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
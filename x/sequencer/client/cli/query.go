@@ -27,6 +27,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetProposerByRollapp())
 	cmd.AddCommand(CmdGetNextProposerByRollapp())
 	cmd.AddCommand(CmdGetAllProposers())
+	cmd.AddCommand(CmdSequencerDelegations())
+	cmd.AddCommand(CmdDelegatorDelegations())
+	cmd.AddCommand(CmdDelegatorUnbondingDelegations())
+	cmd.AddCommand(CmdCompensationPool())
+	cmd.AddCommand(CmdProposerPolicy())
 
 	return cmd
 }
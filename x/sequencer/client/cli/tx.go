@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -29,6 +30,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUnbond())
 	cmd.AddCommand(CmdIncreaseBond())
 	cmd.AddCommand(CmdDecreaseBond())
+	cmd.AddCommand(CmdDelegate())
+	cmd.AddCommand(CmdUndelegate())
+	cmd.AddCommand(CmdUnjailSequencer())
+	cmd.AddCommand(CmdUpdateProposerPolicy())
 
 	return cmd
 }
@@ -204,3 +209,142 @@ func CmdDecreaseBond() *cobra.Command {
 
 	return cmd
 }
+
+func CmdDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate [sequencer-address] [amount]",
+		Short: "Delegate bond to a sequencer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegate(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				amountCoin,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUndelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate [sequencer-address] [amount]",
+		Short: "Undelegate bond from a sequencer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUndelegate(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				amountCoin,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnjailSequencer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail [bond]",
+		Short: "Unjail the sequencer, topping its bond back up to the min bond",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bondCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnjailSequencer(
+				clientCtx.GetFromAddress().String(),
+				bondCoin,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateProposerPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-proposer-policy [rollapp-id] [policy] [successors]",
+		Short:   "Update the proposer election policy of a rollapp, as the rollapp owner",
+		Long:    "Policy is one of highest-bond, round-robin, successor-list, longest-bonded. Successors is a comma separated list of sequencer addresses, for the successor-list policy.",
+		Example: "dymd tx sequencer update-proposer-policy rollapp_1234-1 successor-list dym1...,dym1...",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policyType, ok := proposerPolicyTypes[args[1]]
+			if !ok {
+				return fmt.Errorf("unknown proposer policy: %s", args[1])
+			}
+
+			policy := types.ProposerPolicy{
+				RollappId: args[0],
+				Type:      policyType,
+			}
+			if len(args) == 3 {
+				policy.Successors = strings.Split(args[2], ",")
+			}
+
+			msg := types.NewMsgUpdateProposerPolicy(
+				clientCtx.GetFromAddress().String(),
+				policy,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+var proposerPolicyTypes = map[string]types.ProposerPolicyType{
+	"highest-bond":   types.ProposerPolicyHighestBond,
+	"round-robin":    types.ProposerPolicyRoundRobin,
+	"successor-list": types.ProposerPolicySuccessorList,
+	"longest-bonded": types.ProposerPolicyLongestBonded,
+}
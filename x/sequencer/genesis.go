@@ -29,6 +29,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, bondReduction := range genState.BondReductions {
 		k.SetDecreasingBondQueue(ctx, bondReduction)
 	}
+
+	for _, delegation := range genState.Delegations {
+		k.SetDelegation(ctx, delegation)
+	}
+
+	for _, ubd := range genState.UnbondingDelegations {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	for _, pool := range genState.CompensationPools {
+		k.SetCompensationPool(ctx, pool)
+	}
+
+	for _, policy := range genState.ProposerPolicies {
+		k.SetProposerPolicy(ctx, policy)
+	}
 }
 
 // ExportGenesis returns the sequencer module's exported genesis.
@@ -37,6 +53,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.SequencerList = k.GetAllSequencers(ctx)
 	genesis.BondReductions = k.GetAllBondReductions(ctx)
+	genesis.Delegations = k.GetAllDelegations(ctx)
+	genesis.UnbondingDelegations = k.GetAllUnbondingDelegations(ctx)
+	genesis.CompensationPools = k.GetAllCompensationPools(ctx)
+	genesis.ProposerPolicies = k.GetAllProposerPolicies(ctx)
 
 	proposers := k.GetAllProposers(ctx)
 	for _, proposer := range proposers {
@@ -43,9 +43,10 @@ func (k Keeper) completeBondReduction(ctx sdk.Context, bondReductionID uint64) e
 			reduction.DecreaseBondAmount.String(),
 		)
 	}
-	newBalance := seq.Tokens.Sub(reduction.DecreaseBondAmount)
 	// in case between unbonding queue and now, the minbond value is increased,
 	// handle it by only returning upto minBond amount and not all
+	// the min bond must be kept by the sequencer own bond, regardless of the delegations
+	newBalance := seq.Tokens.Sub(reduction.DecreaseBondAmount).Sub(k.DelegatedBond(ctx, seq.Address)...)
 	minBond := k.GetParams(ctx).MinBond
 	if !newBalance.IsAllGTE(sdk.NewCoins(minBond)) {
 		diff := minBond.SubAmount(newBalance.AmountOf(minBond.Denom))
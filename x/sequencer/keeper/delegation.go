@@ -0,0 +1,366 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// Delegate adds the delegator's coins to the bond of the sequencer.
+// The delegated bond counts toward the sequencer tokens, and so toward the proposer selection.
+func (k Keeper) Delegate(ctx sdk.Context, delegator, seqAddr string, amount sdk.Coin) error {
+	seq, found := k.GetSequencer(ctx, seqAddr)
+	if !found {
+		return types.ErrUnknownSequencer
+	}
+	if !seq.IsBonded() {
+		return types.ErrInvalidSequencerStatus
+	}
+	if delegator == seqAddr {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "sequencer must increase its bond instead of delegating")
+	}
+	if minBond := k.GetParams(ctx).MinBond; !(minBond.IsNil() || minBond.IsZero()) && amount.Denom != minBond.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidCoinDenom, "got %s, expected %s", amount.Denom, minBond.Denom)
+	}
+
+	delegatorAcc := sdk.MustAccAddressFromBech32(delegator)
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAcc, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	delegation, found := k.GetDelegation(ctx, seqAddr, delegator)
+	if !found {
+		delegation = types.Delegation{
+			DelegatorAddress: delegator,
+			SequencerAddress: seqAddr,
+		}
+	}
+	delegation.Amount = delegation.Amount.Add(amount)
+	k.SetDelegation(ctx, delegation)
+
+	seq.Tokens = seq.Tokens.Add(amount)
+	k.UpdateSequencer(ctx, &seq, seq.Status)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventDelegated{
+		Delegator: delegator,
+		Sequencer: seqAddr,
+		Amount:    amount,
+		Bond:      seq.Tokens,
+	})
+}
+
+// Undelegate removes the coins from the bond of the sequencer right away. The coins are returned to the
+// delegator after the unbonding time.
+func (k Keeper) Undelegate(ctx sdk.Context, delegator, seqAddr string, amount sdk.Coin) (time.Time, error) {
+	seq, found := k.GetSequencer(ctx, seqAddr)
+	if !found {
+		return time.Time{}, types.ErrUnknownSequencer
+	}
+	// the delegations to unbonding sequencers are returned when the sequencer completes unbonding
+	if !seq.IsBonded() {
+		return time.Time{}, types.ErrInvalidSequencerStatus
+	}
+
+	delegation, found := k.GetDelegation(ctx, seqAddr, delegator)
+	if !found {
+		return time.Time{}, errorsmod.Wrap(gerrc.ErrNotFound, "delegation")
+	}
+	if !delegation.Amount.IsAllGTE(sdk.NewCoins(amount)) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInsufficientBond, "delegated %s, undelegating %s", delegation.Amount, amount)
+	}
+
+	delegation.Amount = delegation.Amount.Sub(amount)
+	k.SetDelegation(ctx, delegation)
+
+	seq.Tokens = seq.Tokens.Sub(amount)
+	k.UpdateSequencer(ctx, &seq, seq.Status)
+
+	completionTime := ctx.BlockTime().Add(k.UnbondingTime(ctx))
+	k.SetUnbondingDelegation(ctx, types.UnbondingDelegation{
+		DelegatorAddress: delegator,
+		SequencerAddress: seqAddr,
+		Amount:           sdk.NewCoins(amount),
+		CompletionTime:   completionTime,
+		CreationHeight:   ctx.BlockHeight(),
+	})
+
+	err := uevent.EmitTypedEvent(ctx, &types.EventUndelegated{
+		Delegator:      delegator,
+		Sequencer:      seqAddr,
+		Amount:         amount,
+		CompletionTime: completionTime,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return completionTime, nil
+}
+
+// CompleteMatureUnbondingDelegations returns the coins of the mature unbonding delegations to the delegators.
+func (k Keeper) CompleteMatureUnbondingDelegations(ctx sdk.Context, currTime time.Time) {
+	for _, ubd := range k.GetMatureUnbondingDelegations(ctx, currTime) {
+		wrapFn := func(ctx sdk.Context) error {
+			delegatorAcc := sdk.MustAccAddressFromBech32(ubd.DelegatorAddress)
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAcc, ubd.Amount)
+			if err != nil {
+				return err
+			}
+			k.removeUnbondingDelegation(ctx, ubd)
+			return nil
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, wrapFn)
+		if err != nil {
+			k.Logger(ctx).Error("complete unbonding delegation", "error", err, "delegator", ubd.DelegatorAddress, "sequencer", ubd.SequencerAddress)
+			continue
+		}
+	}
+}
+
+// reduceDelegationsProRata reduces the delegations to the sequencer by their share of the removed amount.
+// Must be called before the sequencer tokens are reduced. The shares are rounded up, within the removed amount,
+// so that the rounding never takes the sequencer own bond below zero.
+func (k Keeper) reduceDelegationsProRata(ctx sdk.Context, seq types.Sequencer, amt sdk.Coins) {
+	// what is left of the removed amount to cut from the delegations
+	left := sdk.NewCoins()
+	for _, c := range amt {
+		left = left.Add(sdk.NewCoin(c.Denom, sdk.MinInt(c.Amount, seq.Tokens.AmountOf(c.Denom))))
+	}
+
+	for _, delegation := range k.GetSequencerDelegations(ctx, seq.Address) {
+		var cut sdk.Coins
+		for _, c := range delegation.Amount {
+			total := seq.Tokens.AmountOf(c.Denom)
+			if total.IsZero() {
+				continue
+			}
+			removed := sdk.MinInt(amt.AmountOf(c.Denom), total)
+			share := c.Amount.Mul(removed).Add(total).SubRaw(1).Quo(total)
+			share = sdk.MinInt(share, left.AmountOf(c.Denom))
+			cut = cut.Add(sdk.NewCoin(c.Denom, share))
+		}
+		left = left.Sub(cut...)
+		delegation.Amount = delegation.Amount.Sub(cut...)
+		k.SetDelegation(ctx, delegation)
+	}
+}
+
+// slashUnbondingDelegations slashes the fraction of the unbonding delegations to the sequencer which started
+// unbonding at or after the infraction height, as the bond was still at stake when the infraction happened.
+// The reporter of the fraud, if any, gets its share of the slashed tokens.
+func (k Keeper) slashUnbondingDelegations(ctx sdk.Context, seq types.Sequencer, infractionHeight int64, fraction sdk.Dec, reporter sdk.AccAddress) error {
+	for _, ubd := range k.GetSequencerUnbondingDelegations(ctx, seq.Address) {
+		if ubd.CreationHeight < infractionHeight {
+			continue
+		}
+		cut := sdk.NewCoins(ucoin.MulDec(fraction, ubd.Amount...)...)
+		if cut.IsZero() {
+			continue
+		}
+		err := k.distributeSlashedCoins(ctx, seq, cut, reporter)
+		if err != nil {
+			return err
+		}
+		k.removeUnbondingDelegation(ctx, ubd)
+		ubd.Amount = ubd.Amount.Sub(cut...)
+		if !ubd.Amount.IsZero() {
+			k.SetUnbondingDelegation(ctx, ubd)
+		}
+	}
+	return nil
+}
+
+// refundDelegations returns the delegated bond of the sequencer to the delegators and removes the delegations.
+// Returns the refunded amount.
+func (k Keeper) refundDelegations(ctx sdk.Context, seq types.Sequencer) (sdk.Coins, error) {
+	refunded := sdk.NewCoins()
+	for _, delegation := range k.GetSequencerDelegations(ctx, seq.Address) {
+		delegatorAcc := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAcc, delegation.Amount)
+		if err != nil {
+			return nil, err
+		}
+		refunded = refunded.Add(delegation.Amount...)
+		delegation.Amount = sdk.NewCoins()
+		k.SetDelegation(ctx, delegation)
+	}
+	return refunded, nil
+}
+
+// DelegatedBond returns the total bond delegated to the sequencer.
+func (k Keeper) DelegatedBond(ctx sdk.Context, seqAddr string) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, delegation := range k.GetSequencerDelegations(ctx, seqAddr) {
+		total = total.Add(delegation.Amount...)
+	}
+	return total
+}
+
+// SetDelegation sets the delegation in the store. Empty delegations are removed.
+func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DelegationKey(delegation.SequencerAddress, delegation.DelegatorAddress)
+	indexKey := types.DelegationByDelegatorKey(delegation.DelegatorAddress, delegation.SequencerAddress)
+	if delegation.Amount.IsZero() {
+		store.Delete(key)
+		store.Delete(indexKey)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&delegation))
+	store.Set(indexKey, []byte{})
+}
+
+// GetDelegation returns the delegation of the delegator to the sequencer.
+func (k Keeper) GetDelegation(ctx sdk.Context, seqAddr, delegator string) (types.Delegation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.DelegationKey(seqAddr, delegator))
+	if b == nil {
+		return types.Delegation{}, false
+	}
+	var delegation types.Delegation
+	k.cdc.MustUnmarshal(b, &delegation)
+	return delegation, true
+}
+
+// GetSequencerDelegations returns the delegations to the sequencer.
+func (k Keeper) GetSequencerDelegations(ctx sdk.Context, seqAddr string) (list []types.Delegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationsBySequencerKey(seqAddr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		list = append(list, delegation)
+	}
+	return
+}
+
+// GetDelegatorDelegations returns the delegations of the delegator.
+func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator string) (list []types.Delegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationsByDelegatorKey(delegator))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegation, found := k.GetDelegation(ctx, string(iterator.Key()), delegator)
+		if found {
+			list = append(list, delegation)
+		}
+	}
+	return
+}
+
+// GetAllDelegations returns all the delegations.
+func (k Keeper) GetAllDelegations(ctx sdk.Context) (list []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKeyPrefix)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		list = append(list, delegation)
+	}
+	return
+}
+
+// SetUnbondingDelegation adds the unbonding delegation to the queue. Unbonding delegations of the same
+// delegator to the same sequencer completing at the same time are merged.
+func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.UnbondingDelegationKey(ubd.DelegatorAddress, ubd.SequencerAddress, ubd.CompletionTime)
+	if b := store.Get(key); b != nil {
+		var existing types.UnbondingDelegation
+		k.cdc.MustUnmarshal(b, &existing)
+		ubd.Amount = ubd.Amount.Add(existing.Amount...)
+	}
+	store.Set(key, k.cdc.MustMarshal(&ubd))
+	store.Set(types.UnbondingDelegationByDelegatorKey(ubd.DelegatorAddress, ubd.SequencerAddress, ubd.CompletionTime), []byte{})
+	store.Set(types.UnbondingDelegationBySequencerKey(ubd.DelegatorAddress, ubd.SequencerAddress, ubd.CompletionTime), []byte{})
+}
+
+func (k Keeper) removeUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UnbondingDelegationKey(ubd.DelegatorAddress, ubd.SequencerAddress, ubd.CompletionTime))
+	store.Delete(types.UnbondingDelegationByDelegatorKey(ubd.DelegatorAddress, ubd.SequencerAddress, ubd.CompletionTime))
+	store.Delete(types.UnbondingDelegationBySequencerKey(ubd.DelegatorAddress, ubd.SequencerAddress, ubd.CompletionTime))
+}
+
+// GetMatureUnbondingDelegations returns the unbonding delegations completed by the given time.
+func (k Keeper) GetMatureUnbondingDelegations(ctx sdk.Context, endTime time.Time) (list []types.UnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.UnbondingDelegationQueueKey, sdk.PrefixEndBytes(types.UnbondingDelegationQueueByTimeKey(endTime)))
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ubd types.UnbondingDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &ubd)
+		list = append(list, ubd)
+	}
+	return
+}
+
+// GetAllUnbondingDelegations returns all the unbonding delegations.
+func (k Keeper) GetAllUnbondingDelegations(ctx sdk.Context) (list []types.UnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnbondingDelegationQueueKey)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ubd types.UnbondingDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &ubd)
+		list = append(list, ubd)
+	}
+	return
+}
+
+// GetDelegatorUnbondingDelegations returns the unbonding delegations of the delegator.
+func (k Keeper) GetDelegatorUnbondingDelegations(ctx sdk.Context, delegator string) (list []types.UnbondingDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingDelegationsByDelegatorKey(delegator))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		ubd, found := k.getUnbondingDelegationByKey(ctx, iterator.Key())
+		if found {
+			list = append(list, ubd)
+		}
+	}
+	return
+}
+
+// GetSequencerUnbondingDelegations returns the unbonding delegations to the sequencer.
+func (k Keeper) GetSequencerUnbondingDelegations(ctx sdk.Context, seqAddr string) (list []types.UnbondingDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingDelegationsBySequencerKey(seqAddr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		ubd, found := k.getUnbondingDelegationByKey(ctx, iterator.Key())
+		if found {
+			list = append(list, ubd)
+		}
+	}
+	return
+}
+
+// getUnbondingDelegationByKey returns the unbonding delegation with the given key in the queue.
+func (k Keeper) getUnbondingDelegationByKey(ctx sdk.Context, key []byte) (types.UnbondingDelegation, bool) {
+	b := ctx.KVStore(k.storeKey).Get(key)
+	if b == nil {
+		return types.UnbondingDelegation{}, false
+	}
+	var ubd types.UnbondingDelegation
+	k.cdc.MustUnmarshal(b, &ubd)
+	return ubd, true
+}
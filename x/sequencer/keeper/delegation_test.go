@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) fundDelegator(amt sdk.Coin) string {
+	delegator := sample.AccAddress()
+	err := bankutil.FundAccount(s.App.BankKeeper, s.Ctx, sdk.MustAccAddressFromBech32(delegator), sdk.NewCoins(amt))
+	s.Require().NoError(err)
+	return delegator
+}
+
+func (s *SequencerTestSuite) TestDelegateUndelegate() {
+	k := s.App.SequencerKeeper
+	rollappId, pk := s.CreateDefaultRollapp()
+	seqAddr := s.CreateSequencer(s.Ctx, rollappId, pk)
+	delegator := s.fundDelegator(bond)
+
+	s.Run("invalid delegations", func() {
+		_, err := s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(delegator, sample.AccAddress(), bond))
+		s.Require().ErrorIs(err, types.ErrUnknownSequencer)
+		_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(seqAddr, seqAddr, bond))
+		s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+		_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(delegator, seqAddr, sdk.NewInt64Coin("foo", 1)))
+		s.Require().ErrorIs(err, types.ErrInvalidCoinDenom)
+	})
+
+	_, err := s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(delegator, seqAddr, bond))
+	s.Require().NoError(err)
+
+	seq, _ := k.GetSequencer(s.Ctx, seqAddr)
+	s.Require().Equal(sdk.NewCoins(bond.Add(bond)), seq.Tokens)
+	s.Require().Equal(sdk.NewCoins(bond), k.DelegatedBond(s.Ctx, seqAddr))
+
+	// the delegated bond cannot be withdrawn by the sequencer
+	_, err = s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{Creator: seqAddr, DecreaseAmount: bond})
+	s.Require().ErrorIs(err, types.ErrInsufficientBond)
+
+	// undelegate more than delegated
+	_, err = s.msgServer.Undelegate(s.Ctx, types.NewMsgUndelegate(delegator, seqAddr, bond.Add(bond)))
+	s.Require().ErrorIs(err, types.ErrInsufficientBond)
+
+	half := sdk.NewCoin(bond.Denom, bond.Amount.QuoRaw(2))
+	res, err := s.msgServer.Undelegate(s.Ctx, types.NewMsgUndelegate(delegator, seqAddr, half))
+	s.Require().NoError(err)
+
+	seq, _ = k.GetSequencer(s.Ctx, seqAddr)
+	s.Require().Equal(sdk.NewCoins(bond.Add(half)), seq.Tokens)
+
+	qRes, err := s.queryClient.DelegatorDelegations(s.Ctx, &types.QueryDelegatorDelegationsRequest{DelegatorAddress: delegator})
+	s.Require().NoError(err)
+	s.Require().Len(qRes.Delegations, 1)
+	s.Require().Equal(sdk.NewCoins(half), qRes.Delegations[0].Amount)
+	ubdRes, err := s.queryClient.DelegatorUnbondingDelegations(s.Ctx, &types.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddress: delegator})
+	s.Require().NoError(err)
+	s.Require().Len(ubdRes.UnbondingDelegations, 1)
+	s.Require().Equal(res.CompletionTime, ubdRes.UnbondingDelegations[0].CompletionTime)
+
+	// the coins are returned only after the unbonding time
+	k.CompleteMatureUnbondingDelegations(s.Ctx, res.CompletionTime.Add(-1))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, sdk.MustAccAddressFromBech32(delegator), bond.Denom).IsZero())
+	k.CompleteMatureUnbondingDelegations(s.Ctx, res.CompletionTime)
+	s.Require().Equal(half, s.App.BankKeeper.GetBalance(s.Ctx, sdk.MustAccAddressFromBech32(delegator), bond.Denom))
+	s.Require().Empty(k.GetAllUnbondingDelegations(s.Ctx))
+	s.Require().Empty(k.GetDelegatorUnbondingDelegations(s.Ctx, delegator))
+}
+
+func (s *SequencerTestSuite) TestDelegationsSlashedProRata() {
+	k := s.App.SequencerKeeper
+	rollappId, pk := s.CreateDefaultRollapp()
+	seqAddr := s.CreateSequencer(s.Ctx, rollappId, pk)
+	delegator := s.fundDelegator(bond)
+
+	_, err := s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(delegator, seqAddr, bond))
+	s.Require().NoError(err)
+
+	// the sequencer and the delegator have the same share, so each loses half of the slashed amount
+	seq, _ := k.GetSequencer(s.Ctx, seqAddr)
+	err = k.Slash(s.Ctx, &seq, sdk.NewCoins(bond))
+	s.Require().NoError(err)
+
+	half := sdk.NewCoin(bond.Denom, bond.Amount.QuoRaw(2))
+	delegation, found := k.GetDelegation(s.Ctx, seqAddr, delegator)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(half), delegation.Amount)
+	seq, _ = k.GetSequencer(s.Ctx, seqAddr)
+	s.Require().Equal(sdk.NewCoins(bond), seq.Tokens)
+}
+
+// The rounding of the delegators' shares never takes the sequencer own bond below zero.
+func (s *SequencerTestSuite) TestDelegationsSlashedProRataRounding() {
+	k := s.App.SequencerKeeper
+	rollappId, pk := s.CreateDefaultRollapp()
+	seqAddr := s.CreateSequencer(s.Ctx, rollappId, pk)
+
+	unit := sdk.NewCoin(bond.Denom, sdk.OneInt())
+	var delegators []string
+	for range 3 {
+		delegator := s.fundDelegator(unit)
+		_, err := s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(delegator, seqAddr, unit))
+		s.Require().NoError(err)
+		delegators = append(delegators, delegator)
+	}
+
+	// slash all but one unit
+	seq, _ := k.GetSequencer(s.Ctx, seqAddr)
+	err := k.Slash(s.Ctx, &seq, seq.Tokens.Sub(unit))
+	s.Require().NoError(err)
+
+	seq, _ = k.GetSequencer(s.Ctx, seqAddr)
+	s.Require().Equal(sdk.NewCoins(unit), seq.Tokens)
+	s.Require().True(seq.Tokens.IsAllGTE(k.DelegatedBond(s.Ctx, seqAddr)))
+	for _, delegator := range delegators {
+		_, found := k.GetDelegation(s.Ctx, seqAddr, delegator)
+		s.Require().False(found)
+	}
+}
+
+func (s *SequencerTestSuite) TestDelegationsRefundedOnUnbond() {
+	k := s.App.SequencerKeeper
+	rollappId, pk := s.CreateDefaultRollapp()
+	seqAddr := s.CreateSequencer(s.Ctx, rollappId, pk)
+	delegator := s.fundDelegator(bond)
+
+	_, err := s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(delegator, seqAddr, bond))
+	s.Require().NoError(err)
+
+	err = k.InstantUnbondAllSequencers(s.Ctx, rollappId)
+	s.Require().NoError(err)
+
+	s.Require().Equal(bond, s.App.BankKeeper.GetBalance(s.Ctx, sdk.MustAccAddressFromBech32(delegator), bond.Denom))
+	s.Require().Equal(bond, s.App.BankKeeper.GetBalance(s.Ctx, sdk.MustAccAddressFromBech32(seqAddr), bond.Denom))
+	s.Require().Empty(k.GetSequencerDelegations(s.Ctx, seqAddr))
+}
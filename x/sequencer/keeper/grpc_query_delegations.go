@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) SequencerDelegations(c context.Context, req *types.QuerySequencerDelegationsRequest) (*types.QuerySequencerDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var delegations []types.Delegation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	delegationStore := prefix.NewStore(store, types.DelegationsBySequencerKey(req.SequencerAddress))
+
+	pageRes, err := query.Paginate(delegationStore, req.Pagination, func(key []byte, value []byte) error {
+		var delegation types.Delegation
+		if err := k.cdc.Unmarshal(value, &delegation); err != nil {
+			return err
+		}
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySequencerDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DelegatorDelegations(c context.Context, req *types.QueryDelegatorDelegationsRequest) (*types.QueryDelegatorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var delegations []types.Delegation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.DelegationsByDelegatorKey(req.DelegatorAddress))
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		delegation, found := k.GetDelegation(ctx, string(key), req.DelegatorAddress)
+		if found {
+			delegations = append(delegations, delegation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DelegatorUnbondingDelegations(c context.Context, req *types.QueryDelegatorUnbondingDelegationsRequest) (*types.QueryDelegatorUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var ubds []types.UnbondingDelegation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.UnbondingDelegationsByDelegatorKey(req.DelegatorAddress))
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		ubd, found := k.getUnbondingDelegationByKey(ctx, key)
+		if found {
+			ubds = append(ubds, ubd)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorUnbondingDelegationsResponse{UnbondingDelegations: ubds, Pagination: pageRes}, nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -50,8 +51,23 @@ func (hook rollappHook) BeforeUpdateState(ctx sdk.Context, seqAddr, rollappId st
 
 // FraudSubmitted implements the RollappHooks interface
 // It slashes the sequencer and unbonds all other bonded sequencers
-func (hook rollappHook) FraudSubmitted(ctx sdk.Context, rollappID string, height uint64, seqAddr string) error {
-	err := hook.k.JailSequencerOnFraud(ctx, seqAddr)
+func (hook rollappHook) FraudSubmitted(ctx sdk.Context, rollappID string, height uint64, seqAddr, reporter string) error {
+	// the infraction happened when the fraudulent state was submitted
+	stateInfo, err := hook.k.rollappKeeper.FindStateInfoByHeight(ctx, rollappID, height)
+	if err != nil {
+		return errorsmod.Wrap(err, "find fraudulent state")
+	}
+	infractionHeight := int64(stateInfo.CreationHeight)
+
+	var reporterAcc sdk.AccAddress
+	if reporter != "" {
+		reporterAcc, err = sdk.AccAddressFromBech32(reporter)
+		if err != nil {
+			return errorsmod.Wrap(err, "reporter address")
+		}
+	}
+
+	err = hook.k.JailSequencerOnFraud(ctx, seqAddr, infractionHeight, reporterAcc)
 	if err != nil {
 		return err
 	}
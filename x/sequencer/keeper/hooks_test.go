@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
@@ -40,7 +41,21 @@ func (suite *SequencerTestSuite) TestFraudSubmittedHook() {
 	bds := keeper.GetMatureDecreasingBondIDs(suite.Ctx, resp.GetCompletionTime())
 	suite.Require().Len(bds, 1)
 
-	err = keeper.RollappHooks().FraudSubmitted(suite.Ctx, rollappId, 0, proposer)
+	// the fraudulent state must be known, to find when the infraction happened
+	err = keeper.RollappHooks().FraudSubmitted(suite.Ctx, rollappId, 1, proposer, "")
+	suite.Require().Error(err)
+
+	stateIndex := rollapptypes.StateInfoIndex{RollappId: rollappId, Index: 1}
+	suite.App.RollappKeeper.SetStateInfo(suite.Ctx, rollapptypes.StateInfo{
+		StateInfoIndex: stateIndex,
+		Sequencer:      proposer,
+		StartHeight:    1,
+		NumBlocks:      1,
+		CreationHeight: uint64(suite.Ctx.BlockHeight()),
+	})
+	suite.App.RollappKeeper.SetLatestStateInfoIndex(suite.Ctx, stateIndex)
+
+	err = keeper.RollappHooks().FraudSubmitted(suite.Ctx, rollappId, 1, proposer, "")
 	suite.Require().NoError(err)
 
 	// check if proposer is slashed
@@ -18,13 +18,16 @@ type Keeper struct {
 	cdc           codec.BinaryCodec
 	storeKey      storetypes.StoreKey
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
 	rollappKeeper types.RollappKeeper
+	hooks         types.SequencerHooks
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 	rollappKeeper types.RollappKeeper,
 	authority string,
 ) *Keeper {
@@ -37,6 +40,7 @@ func NewKeeper(
 		cdc:           cdc,
 		storeKey:      storeKey,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 		rollappKeeper: rollappKeeper,
 		authority:     authority,
 	}
@@ -45,3 +49,23 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+/* -------------------------------------------------------------------------- */
+/*                               Hooks handling                               */
+/* -------------------------------------------------------------------------- */
+
+// SetHooks sets the sequencer hooks.
+// The keeper is copied by value, so the hooks must be set before any copy of the keeper is taken.
+func (k *Keeper) SetHooks(sh types.MultiSequencerHooks) {
+	if k.hooks != nil {
+		panic("cannot set sequencer hooks twice")
+	}
+	k.hooks = sh
+}
+
+func (k *Keeper) GetHooks() types.SequencerHooks {
+	if k.hooks == nil {
+		return types.MultiSequencerHooks{}
+	}
+	return k.hooks
+}
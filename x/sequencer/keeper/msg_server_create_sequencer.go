@@ -10,6 +10,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
@@ -23,8 +24,8 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 		return nil, types.ErrUnknownRollappID
 	}
 
-	if rollapp.Frozen {
-		return nil, types.ErrRollappFrozen
+	if err := k.checkBondAdmission(ctx, rollapp, msg.Creator); err != nil {
+		return nil, err
 	}
 
 	if err := msg.VMSpecificValidate(rollapp.VmType); err != nil {
@@ -36,28 +37,7 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 		return nil, types.ErrSequencerExists
 	}
 
-	// In case InitialSequencer is set to one or more bech32 addresses, only one of them can be the first to register,
-	// and is automatically selected as the first proposer, allowing the Rollapp to be set to 'launched'
-	// (provided that all the immutable fields are set in the Rollapp).
-	// This limitation prevents scenarios such as:
-	// a) any unintended initial sequencer getting registered before the immutable fields are set in the Rollapp.
-	// b) situation when sequencer "X" is registered prior to the initial sequencer,
-	// after which the initial sequencer's address is set to sequencer X's address, effectively preventing:
-	// 	1. the initial sequencer from getting selected as the first proposer,
-	// 	2. the rollapp from getting launched again
-	// In case the InitialSequencer is set to the "*" wildcard, any sequencer can be the first to register.
 	if !rollapp.Launched {
-		isInitialOrAllAllowed := slices.Contains(strings.Split(rollapp.InitialSequencer, ","), msg.Creator) || rollapp.InitialSequencer == "*"
-		if !isInitialOrAllAllowed {
-			return nil, types.ErrNotInitialSequencer
-		}
-
-		// check pre launch time.
-		// skipped if no pre launch time is set
-		if rollapp.PreLaunchTime.After(ctx.BlockTime()) {
-			return nil, types.ErrBeforePreLaunchTime
-		}
-
 		if err := k.rollappKeeper.SetRollappAsLaunched(ctx, &rollapp); err != nil {
 			return nil, err
 		}
@@ -92,24 +72,13 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 		Metadata:     msg.Metadata,
 		Status:       types.Bonded,
 		Tokens:       bond,
+		BondHeight:   ctx.BlockHeight(),
 	}
 
-	// we currently only support setting next proposer (or empty one) before the rotation started. This is in order to
-	// avoid handling the case a potential next proposer bonds in the middle of a rotation.
-	// This will be handled in next iteration.
-	nextProposer, ok := k.GetNextProposer(ctx, msg.RollappId)
-	if ok && nextProposer.IsEmpty() {
-		k.Logger(ctx).Info("rotation in progress. sequencer registration disabled", "rollappId", sequencer.RollappId)
-		return nil, types.ErrRotationInProgress
-	}
+	k.SetSequencer(ctx, sequencer)
 
 	// if no proposer set for he rollapp, set this sequencer as the proposer
-	_, proposerExists := k.GetProposer(ctx, msg.RollappId)
-	if !proposerExists {
-		k.SetProposer(ctx, sequencer.RollappId, sequencer.Address)
-	}
-
-	k.SetSequencer(ctx, sequencer)
+	isProposer := k.setProposerIfNone(ctx, &rollapp, sequencer.Address)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -117,9 +86,60 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 			sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
 			sdk.NewAttribute(types.AttributeKeySequencer, msg.Creator),
 			sdk.NewAttribute(types.AttributeKeyBond, msg.Bond.String()),
-			sdk.NewAttribute(types.AttributeKeyProposer, strconv.FormatBool(!proposerExists)),
+			sdk.NewAttribute(types.AttributeKeyProposer, strconv.FormatBool(isProposer)),
 		),
 	)
 
 	return &types.MsgCreateSequencerResponse{}, nil
 }
+
+// checkBondAdmission checks the sequencer can join the bonded set of the rollapp, either by registering or by
+// being unjailed.
+func (k Keeper) checkBondAdmission(ctx sdk.Context, rollapp rollapptypes.Rollapp, seqAddr string) error {
+	if rollapp.Frozen {
+		return types.ErrRollappFrozen
+	}
+
+	// In case InitialSequencer is set to one or more bech32 addresses, only one of them can be the first to register,
+	// and is automatically selected as the first proposer, allowing the Rollapp to be set to 'launched'
+	// (provided that all the immutable fields are set in the Rollapp).
+	// This limitation prevents scenarios such as:
+	// a) any unintended initial sequencer getting registered before the immutable fields are set in the Rollapp.
+	// b) situation when sequencer "X" is registered prior to the initial sequencer,
+	// after which the initial sequencer's address is set to sequencer X's address, effectively preventing:
+	// 	1. the initial sequencer from getting selected as the first proposer,
+	// 	2. the rollapp from getting launched again
+	// In case the InitialSequencer is set to the "*" wildcard, any sequencer can be the first to register.
+	if !rollapp.Launched {
+		if !isInitialOrAllAllowed(rollapp, seqAddr) {
+			return types.ErrNotInitialSequencer
+		}
+
+		// check pre launch time.
+		// skipped if no pre launch time is set
+		if rollapp.PreLaunchTime.After(ctx.BlockTime()) {
+			return types.ErrBeforePreLaunchTime
+		}
+	}
+
+	// The same applies to a rollapp recovered after a fraud: only the initial sequencers set by the governance
+	// can join, until one of them becomes the proposer, which completes the recovery.
+	if rollapp.Recovering && !isInitialOrAllAllowed(rollapp, seqAddr) {
+		return types.ErrNotInitialSequencer
+	}
+
+	// we currently only support setting next proposer (or empty one) before the rotation started. This is in order to
+	// avoid handling the case a potential next proposer bonds in the middle of a rotation.
+	// This will be handled in next iteration.
+	nextProposer, ok := k.GetNextProposer(ctx, rollapp.RollappId)
+	if ok && nextProposer.IsEmpty() {
+		k.Logger(ctx).Info("rotation in progress. sequencer bonding disabled", "rollappId", rollapp.RollappId)
+		return types.ErrRotationInProgress
+	}
+
+	return nil
+}
+
+func isInitialOrAllAllowed(rollapp rollapptypes.Rollapp, addr string) bool {
+	return slices.Contains(strings.Split(rollapp.InitialSequencer, ","), addr) || rollapp.InitialSequencer == "*"
+}
@@ -19,7 +19,8 @@ func (k msgServer) DecreaseBond(goCtx context.Context, msg *types.MsgDecreaseBon
 		return nil, types.ErrInvalidSequencerStatus
 	}
 
-	effectiveBond := sequencer.Tokens
+	// the sequencer can only decrease its own bond, not the delegated one
+	effectiveBond := sequencer.Tokens.Sub(k.DelegatedBond(ctx, msg.Creator)...)
 	if bds := k.GetBondReductionsBySequencer(ctx, msg.Creator); len(bds) > 0 {
 		for _, bd := range bds {
 			effectiveBond = effectiveBond.Sub(bd.DecreaseBondAmount)
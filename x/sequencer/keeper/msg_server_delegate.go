@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// Delegate implements types.MsgServer.
+func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.Delegate(ctx, msg.Delegator, msg.SequencerAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateResponse{}, nil
+}
+
+// Undelegate implements types.MsgServer.
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	completionTime, err := k.Keeper.Undelegate(ctx, msg.Delegator, msg.SequencerAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
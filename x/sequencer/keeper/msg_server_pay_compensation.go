@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// PayCompensation implements types.MsgServer.
+func (k msgServer) PayCompensation(goCtx context.Context, msg *types.MsgPayCompensation) (*types.MsgPayCompensationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	err := k.Keeper.PayCompensation(ctx, msg.RollappId, sdk.MustAccAddressFromBech32(msg.Recipient), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayCompensationResponse{}, nil
+}
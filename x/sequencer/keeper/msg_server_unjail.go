@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// UnjailSequencer implements types.MsgServer.
+func (k msgServer) UnjailSequencer(goCtx context.Context, msg *types.MsgUnjailSequencer) (*types.MsgUnjailSequencerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.UnjailSequencer(ctx, msg.Creator, msg.Bond)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnjailSequencerResponse{}, nil
+}
+
+// PermitUnjail implements types.MsgServer.
+func (k msgServer) PermitUnjail(goCtx context.Context, msg *types.MsgPermitUnjail) (*types.MsgPermitUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	err := k.Keeper.PermitUnjail(ctx, msg.SequencerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPermitUnjailResponse{}, nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// UpdateProposerPolicy implements types.MsgServer.
+func (k msgServer) UpdateProposerPolicy(goCtx context.Context, msg *types.MsgUpdateProposerPolicy) (*types.MsgUpdateProposerPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.UpdateProposerPolicy(ctx, msg.Owner, msg.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateProposerPolicyResponse{}, nil
+}
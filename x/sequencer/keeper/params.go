@@ -23,7 +23,11 @@ func (k Keeper) ValidateParams(ctx sdk.Context, params types.Params) error {
 	// validate unbonding time > dispute period
 	rollappParams := k.rollappKeeper.GetParams(ctx)
 	// Get the time duration of the dispute period
-	disputeDuration := time.Duration(rollappParams.DisputePeriodInBlocks) * HubExpectedTimePerBlock // dispute period duration
+	// rollapps can set their own dispute period up to the max bound
+	disputePeriodInBlocks := max(rollappParams.DisputePeriodInBlocks, rollappParams.MaxDisputePeriodInBlocks)
+	disputeDuration := time.Duration(disputePeriodInBlocks) * HubExpectedTimePerBlock // dispute period duration
+	// the time-based dispute period applies on top of the blocks one
+	disputeDuration = max(disputeDuration, rollappParams.DisputePeriodTime)
 	if params.UnbondingTime < disputeDuration {
 		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "unbonding time must be greater than dispute period")
 	}
@@ -72,3 +76,11 @@ func (k Keeper) NoticePeriod(ctx sdk.Context) (res time.Duration) {
 func (k Keeper) LivenessSlashMultiplier(ctx sdk.Context) (res sdk.Dec) {
 	return k.GetParams(ctx).LivenessSlashMultiplier
 }
+
+func (k Keeper) FraudSlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	return k.GetParams(ctx).FraudSlashFraction
+}
+
+func (k Keeper) SlashDistribution(ctx sdk.Context) (res types.SlashDistribution) {
+	return k.GetParams(ctx).SlashDistribution
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// UpdateProposerPolicy sets the proposer election policy of the rollapp. Only the rollapp owner can set it.
+// A rotation in progress is not affected: the policy applies from the next election.
+func (k Keeper) UpdateProposerPolicy(ctx sdk.Context, owner string, policy types.ProposerPolicy) error {
+	rollapp, found := k.rollappKeeper.GetRollapp(ctx, policy.RollappId)
+	if !found {
+		return types.ErrUnknownRollappID
+	}
+	if rollapp.Owner != owner {
+		return errorsmod.Wrap(gerrc.ErrPermissionDenied, "only the rollapp owner can update the proposer policy")
+	}
+
+	k.SetProposerPolicy(ctx, policy)
+	return nil
+}
+
+// SetProposerPolicy sets the proposer election policy of the rollapp in the store.
+func (k Keeper) SetProposerPolicy(ctx sdk.Context, policy types.ProposerPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProposerPolicyKey(policy.RollappId), k.cdc.MustMarshal(&policy))
+}
+
+// GetProposerPolicy returns the proposer election policy of the rollapp, or the default one if not set.
+func (k Keeper) GetProposerPolicy(ctx sdk.Context, rollappId string) types.ProposerPolicy {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.ProposerPolicyKey(rollappId))
+	if b == nil {
+		return types.DefaultProposerPolicy(rollappId)
+	}
+	var policy types.ProposerPolicy
+	k.cdc.MustUnmarshal(b, &policy)
+	return policy
+}
+
+// GetAllProposerPolicies returns the proposer election policies set for the rollapps.
+func (k Keeper) GetAllProposerPolicies(ctx sdk.Context) (list []types.ProposerPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposerPolicyKeyPrefix)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.ProposerPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		list = append(list, policy)
+	}
+	return
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestUpdateProposerPolicy() {
+	k := s.App.SequencerKeeper
+	rollappId, pk := s.CreateDefaultRollapp()
+	proposer := s.CreateSequencer(s.Ctx, rollappId, pk)
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+
+	// the default policy elects the highest bond
+	s.Require().Equal(types.DefaultProposerPolicy(rollappId), k.GetProposerPolicy(s.Ctx, rollappId))
+
+	seqs := []string{
+		s.CreateSequencer(s.Ctx, rollappId, ed25519.GenPrivKey().PubKey()),
+		s.CreateSequencer(s.Ctx, rollappId, ed25519.GenPrivKey().PubKey()),
+	}
+	policy := types.ProposerPolicy{
+		RollappId:  rollappId,
+		Type:       types.ProposerPolicySuccessorList,
+		Successors: []string{seqs[1], proposer, seqs[0]},
+	}
+
+	_, err := s.msgServer.UpdateProposerPolicy(s.Ctx, types.NewMsgUpdateProposerPolicy(sample.AccAddress(), policy))
+	s.Require().ErrorIs(err, gerrc.ErrPermissionDenied)
+
+	_, err = s.msgServer.UpdateProposerPolicy(s.Ctx, types.NewMsgUpdateProposerPolicy(rollapp.Owner, policy))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.ProposerPolicy(s.Ctx, &types.QueryProposerPolicyRequest{RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().Equal(policy, res.Policy)
+
+	// the successor following the proposer in the list is elected
+	s.Require().Equal(seqs[0], k.ExpectedNextProposer(s.Ctx, rollappId).Address)
+
+	policy.Successors = []string{seqs[1], seqs[0]}
+	_, err = s.msgServer.UpdateProposerPolicy(s.Ctx, types.NewMsgUpdateProposerPolicy(rollapp.Owner, policy))
+	s.Require().NoError(err)
+	s.Require().Equal(seqs[1], k.ExpectedNextProposer(s.Ctx, rollappId).Address)
+}
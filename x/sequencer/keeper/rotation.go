@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"sort"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -60,7 +59,7 @@ func (k Keeper) isNoticePeriodRequired(ctx sdk.Context, seq types.Sequencer) boo
 }
 
 // ExpectedNextProposer returns the next proposer for a rollapp
-// it selects the next proposer from the bonded sequencers by bond amount
+// it elects the next proposer from the bonded sequencers according to the proposer policy of the rollapp
 // if there are no bonded sequencers, it returns an empty sequencer
 func (k Keeper) ExpectedNextProposer(ctx sdk.Context, rollappId string) types.Sequencer {
 	// if nextProposer is set, were in the middle of rotation. The expected next proposer cannot change
@@ -69,21 +68,16 @@ func (k Keeper) ExpectedNextProposer(ctx sdk.Context, rollappId string) types.Se
 		return seq
 	}
 
-	// take the next bonded sequencer to be the proposer. sorted by bond
+	// elect one of the bonded sequencers that is not the proposer
 	seqs := k.GetSequencersByRollappByStatus(ctx, rollappId, types.Bonded)
-	sort.SliceStable(seqs, func(i, j int) bool {
-		return seqs[i].Tokens.IsAllGT(seqs[j].Tokens)
-	})
-
-	// return the first sequencer that is not the proposer
 	proposer, _ := k.GetProposer(ctx, rollappId)
-	for _, s := range seqs {
-		if s.Address != proposer.Address {
-			return s
-		}
+	election := types.NewProposerElection(k.GetProposerPolicy(ctx, rollappId))
+	next, ok := election.NextProposer(proposer.Address, seqs)
+	if !ok {
+		return types.Sequencer{}
 	}
 
-	return types.Sequencer{}
+	return next
 }
 
 // startRotation sets the nextSequencer for the rollapp.
@@ -137,5 +131,5 @@ func (k Keeper) CompleteRotation(ctx sdk.Context, rollappId string) error {
 		),
 	)
 
-	return nil
+	return k.GetHooks().AfterProposerRotated(ctx, rollappId, nextProposer.Address)
 }
@@ -3,9 +3,11 @@ package keeper
 import (
 	"time"
 
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
@@ -63,6 +65,15 @@ func (k Keeper) MustGetSequencer(ctx sdk.Context, sequencerAddress string) types
 	return seq
 }
 
+// GetSequencerCometPubKey returns the tendermint public key of the sequencer, used to sign the rollapp blocks
+func (k Keeper) GetSequencerCometPubKey(ctx sdk.Context, sequencerAddress string) (tmprotocrypto.PublicKey, error) {
+	seq, found := k.GetSequencer(ctx, sequencerAddress)
+	if !found {
+		return tmprotocrypto.PublicKey{}, types.ErrUnknownSequencer
+	}
+	return seq.GetCometPubKey()
+}
+
 // GetAllSequencers returns all sequencer
 func (k Keeper) GetAllSequencers(ctx sdk.Context) (list []types.Sequencer) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SequencersKeyPrefix)
@@ -208,6 +219,23 @@ func (k Keeper) SetProposer(ctx sdk.Context, rollappId, sequencerAddr string) {
 	store.Set(activeKey, addressBytes)
 }
 
+// setProposerIfNone sets the sequencer as the proposer of the rollapp, if the rollapp has none.
+// While the rollapp is recovering from a fraud, only one of the initial sequencers set by the governance
+// can become the proposer, which completes the recovery. Returns true if the sequencer became the proposer.
+func (k Keeper) setProposerIfNone(ctx sdk.Context, rollapp *rollapptypes.Rollapp, seqAddr string) bool {
+	if _, found := k.GetProposer(ctx, rollapp.RollappId); found {
+		return false
+	}
+	if rollapp.Recovering {
+		if !isInitialOrAllAllowed(*rollapp, seqAddr) {
+			return false
+		}
+		k.rollappKeeper.SetRollappAsRecovered(ctx, rollapp)
+	}
+	k.SetProposer(ctx, rollapp.RollappId, seqAddr)
+	return true
+}
+
 // GetProposer returns the proposer for a rollapp
 func (k Keeper) GetProposer(ctx sdk.Context, rollappId string) (val types.Sequencer, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -219,6 +247,15 @@ func (k Keeper) GetProposer(ctx sdk.Context, rollappId string) (val types.Sequen
 	return k.GetSequencer(ctx, string(b))
 }
 
+// ProposerBond returns the address and the bond of the proposer of the rollapp
+func (k Keeper) ProposerBond(ctx sdk.Context, rollappId string) (string, sdk.Coins, bool) {
+	proposer, found := k.GetProposer(ctx, rollappId)
+	if !found {
+		return "", nil, false
+	}
+	return proposer.Address, proposer.Tokens, true
+}
+
 func (k Keeper) removeProposer(ctx sdk.Context, rollappId string) {
 	k.SetProposer(ctx, rollappId, NO_SEQUENCER_AVAILABLE)
 }
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// distributeSlashedCoins splits the slashed coins of the sequencer, held by the module account, according to
+// the SlashDistribution param: to the reporter of the fault, into the compensation pool of the rollapp, the
+// community pool, and burn. The reporter share is burned if there is no reporter. The burned amount takes the rounding.
+func (k Keeper) distributeSlashedCoins(ctx sdk.Context, seq types.Sequencer, amt sdk.Coins, reporter sdk.AccAddress) error {
+	split := k.SlashDistribution(ctx)
+
+	var reward sdk.Coins
+	if !reporter.Empty() {
+		reward = sdk.NewCoins(ucoin.MulDec(split.Reporter, amt...)...)
+	}
+	compensation := sdk.NewCoins(ucoin.MulDec(split.CompensationPool, amt...)...)
+	community := sdk.NewCoins(ucoin.MulDec(split.CommunityPool, amt...)...)
+	burned := amt.Sub(reward...).Sub(compensation...).Sub(community...)
+
+	if !reward.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, reporter, reward)
+		if err != nil {
+			return errorsmod.Wrap(err, "reward reporter")
+		}
+	}
+
+	if !compensation.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.CompensationPoolName, compensation)
+		if err != nil {
+			return errorsmod.Wrap(err, "fund compensation pool")
+		}
+		pool := k.GetCompensationPool(ctx, seq.RollappId)
+		pool.Amount = pool.Amount.Add(compensation...)
+		k.SetCompensationPool(ctx, pool)
+	}
+
+	if !community.IsZero() {
+		err := k.distrKeeper.FundCommunityPool(ctx, community, authtypes.NewModuleAddress(types.ModuleName))
+		if err != nil {
+			return errorsmod.Wrap(err, "fund community pool")
+		}
+	}
+
+	if !burned.IsZero() {
+		err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned)
+		if err != nil {
+			return errorsmod.Wrap(err, "burn")
+		}
+	}
+
+	var reporterAddr string
+	if !reporter.Empty() {
+		reporterAddr = reporter.String()
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventSlashDistributed{
+		Sequencer:        seq.Address,
+		RollappId:        seq.RollappId,
+		CompensationPool: compensation,
+		CommunityPool:    community,
+		Burned:           burned,
+		Reporter:         reporterAddr,
+		ReporterReward:   reward,
+	})
+}
+
+// PayCompensation pays coins of the compensation pool of the rollapp out to the recipient.
+func (k Keeper) PayCompensation(ctx sdk.Context, rollappId string, recipient sdk.AccAddress, amt sdk.Coins) error {
+	pool := k.GetCompensationPool(ctx, rollappId)
+	if !pool.Amount.IsAllGTE(amt) {
+		return errorsmod.Wrapf(types.ErrInsufficientCompensationPool, "pool: %s: amount: %s", pool.Amount, amt)
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CompensationPoolName, recipient, amt)
+	if err != nil {
+		return errorsmod.Wrap(err, "send coins")
+	}
+	pool.Amount = pool.Amount.Sub(amt...)
+	k.SetCompensationPool(ctx, pool)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventCompensationPaid{
+		RollappId: rollappId,
+		Recipient: recipient.String(),
+		Amount:    amt,
+	})
+}
+
+// SetCompensationPool sets the compensation pool of the rollapp in the store.
+// The coins of the pool are held by the compensation module account.
+func (k Keeper) SetCompensationPool(ctx sdk.Context, pool types.CompensationPool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CompensationPoolKey(pool.RollappId), k.cdc.MustMarshal(&pool))
+}
+
+// GetCompensationPool returns the compensation pool of the rollapp. The pool is empty if nothing was paid into it.
+func (k Keeper) GetCompensationPool(ctx sdk.Context, rollappId string) types.CompensationPool {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.CompensationPoolKey(rollappId))
+	if b == nil {
+		return types.CompensationPool{RollappId: rollappId, Amount: sdk.NewCoins()}
+	}
+	var pool types.CompensationPool
+	k.cdc.MustUnmarshal(b, &pool)
+	return pool
+}
+
+// GetAllCompensationPools returns the compensation pools of all the rollapps.
+func (k Keeper) GetAllCompensationPools(ctx sdk.Context) (list []types.CompensationPool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CompensationPoolKeyPrefix)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.CompensationPool
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+		list = append(list, pool)
+	}
+	return
+}
@@ -7,13 +7,32 @@ import (
 	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
 )
 
-func (k Keeper) JailSequencerOnFraud(ctx sdk.Context, seqAddr string) error {
+// JailSequencerOnFraud slashes the FraudSlashFraction of the bond of a sequencer, bonded or unbonding, found
+// fraudulent, and of the delegated bond which started unbonding at or after the infraction height (hub height).
+// The reporter of the fraud, if any, gets its share of the slashed tokens. The sequencer can't report its own
+// fraud, otherwise it would get back part of the bond it loses.
+// The sequencer is then jailed, and the rest of its bond is refunded.
+func (k Keeper) JailSequencerOnFraud(ctx sdk.Context, seqAddr string, infractionHeight int64, reporter sdk.AccAddress) error {
 	seq, found := k.GetSequencer(ctx, seqAddr)
 	if !found {
 		return types.ErrUnknownSequencer
 	}
+	if reporter.Equals(sdk.MustAccAddressFromBech32(seq.Address)) {
+		return types.ErrSelfFraudReport
+	}
+
+	fraction := k.FraudSlashFraction(ctx)
+	err := k.slash(ctx, &seq, sdk.NewCoins(ucoin.MulDec(fraction, seq.Tokens...)...), reporter)
+	if err != nil {
+		return errorsmod.Wrap(err, "slash")
+	}
+
+	err = k.slashUnbondingDelegations(ctx, seq, infractionHeight, fraction, reporter)
+	if err != nil {
+		return errorsmod.Wrap(err, "slash unbonding delegations")
+	}
 
-	if err := k.Jail(ctx, seq); err != nil {
+	if err := k.jail(ctx, seq, false, types.JailReasonFraud); err != nil {
 		return errorsmod.Wrap(err, "jail")
 	}
 
@@ -37,7 +56,7 @@ func (k Keeper) JailLiveness(ctx sdk.Context, rollappID string) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "liveness liable sequencer")
 	}
-	return k.Jail(ctx, seq)
+	return k.Jail(ctx, seq, types.JailReasonLiveness)
 }
 
 // LivenessLiableSequencer returns the sequencer who is responsible for ensuring liveness
@@ -50,6 +69,11 @@ func (k Keeper) LivenessLiableSequencer(ctx sdk.Context, rollappID string) (type
 }
 
 func (k Keeper) Slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins) error {
+	return k.slash(ctx, seq, amt, nil)
+}
+
+// slash slashes the bond of the sequencer. The reporter of the fault, if any, is rewarded out of the slashed tokens.
+func (k Keeper) slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins, reporter sdk.AccAddress) error {
 	if seq.Status == types.Unbonded {
 		return errorsmod.Wrap(
 			types.ErrInvalidSequencerStatus,
@@ -57,7 +81,7 @@ func (k Keeper) Slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins) erro
 		)
 	}
 
-	err := k.reduceSequencerBond(ctx, seq, amt, true)
+	err := k.burnSequencerBond(ctx, seq, amt, reporter)
 	if err != nil {
 		return errorsmod.Wrap(err, "remove sequencer bond")
 	}
@@ -73,18 +97,32 @@ func (k Keeper) Slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins) erro
 	return nil
 }
 
-// Jail sets the sequencer status to Jailed and unbonds the sequencer
-func (k Keeper) Jail(ctx sdk.Context, seq types.Sequencer) error {
-	err := k.unbondSequencerAndJail(ctx, seq.Address)
+// Jail sets the sequencer status to Jailed and unbonds the sequencer. The bond is slashed.
+func (k Keeper) Jail(ctx sdk.Context, seq types.Sequencer, reason types.JailReason) error {
+	return k.jail(ctx, seq, true, reason)
+}
+
+// jail sets the sequencer status to Jailed and unbonds the sequencer.
+// The bond is slashed if burn is true, refunded otherwise.
+// The reason and the time are recorded, as they define the conditions to unjail.
+func (k Keeper) jail(ctx sdk.Context, seq types.Sequencer, burn bool, reason types.JailReason) error {
+	err := k.unbondSequencerAndJail(ctx, seq.Address, burn)
 	if err != nil {
 		return errorsmod.Wrap(err, "unbond and jail")
 	}
 
+	jailed := k.MustGetSequencer(ctx, seq.Address)
+	jailed.JailReason = reason
+	jailed.JailTime = ctx.BlockTime()
+	jailed.UnjailPermitted = false
+	k.UpdateSequencer(ctx, &jailed)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeJailed,
 			sdk.NewAttribute(types.AttributeKeySequencer, seq.Address),
 			sdk.NewAttribute(types.AttributeKeyBond, seq.Tokens.String()),
+			sdk.NewAttribute(types.AttributeKeyJailReason, reason.String()),
 		),
 	)
 
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
@@ -12,7 +13,7 @@ func (suite *SequencerTestSuite) TestJailUnknownSequencer() {
 	suite.CreateDefaultRollapp()
 	keeper := suite.App.SequencerKeeper
 
-	err := keeper.JailSequencerOnFraud(suite.Ctx, "unknown_sequencer")
+	err := keeper.JailSequencerOnFraud(suite.Ctx, "unknown_sequencer", suite.Ctx.BlockHeight(), nil)
 	suite.ErrorIs(err, types.ErrUnknownSequencer)
 }
 
@@ -36,7 +37,7 @@ func (suite *SequencerTestSuite) TestJailUnbondedSequencer() {
 	suite.Equal(seq.Status, types.Unbonded)
 
 	// jail the unbonded sequencer
-	err = keeper.JailSequencerOnFraud(suite.Ctx, seqAddr)
+	err = keeper.JailSequencerOnFraud(suite.Ctx, seqAddr, suite.Ctx.BlockHeight(), nil)
 	suite.ErrorIs(err, types.ErrInvalidSequencerStatus)
 }
 
@@ -57,7 +58,7 @@ func (suite *SequencerTestSuite) TestJailUnbondingSequencer() {
 	suite.Equal(seq.Status, types.Unbonding)
 
 	// jail the unbonding sequencer
-	err = keeper.JailSequencerOnFraud(suite.Ctx, seqAddr)
+	err = keeper.JailSequencerOnFraud(suite.Ctx, seqAddr, suite.Ctx.BlockHeight(), nil)
 	suite.NoError(err)
 	suite.assertJailed(seqAddr)
 }
@@ -68,7 +69,7 @@ func (suite *SequencerTestSuite) TestJailProposerSequencer() {
 	suite.Ctx = suite.Ctx.WithBlockTime(time.Now())
 
 	rollappId, proposer := suite.CreateDefaultRollappAndProposer()
-	err := keeper.JailSequencerOnFraud(suite.Ctx, proposer)
+	err := keeper.JailSequencerOnFraud(suite.Ctx, proposer, suite.Ctx.BlockHeight(), nil)
 	suite.NoError(err)
 	suite.assertJailed(proposer)
 
@@ -90,10 +91,61 @@ func (suite *SequencerTestSuite) TestJailBondReducingSequencer() {
 	bondReductions := keeper.GetMatureDecreasingBondIDs(suite.Ctx, resp.GetCompletionTime())
 	suite.Require().Len(bondReductions, 1)
 
-	err = keeper.JailSequencerOnFraud(suite.Ctx, seqAddr)
+	err = keeper.JailSequencerOnFraud(suite.Ctx, seqAddr, suite.Ctx.BlockHeight(), nil)
 	suite.NoError(err)
 
 	bondReductions = keeper.GetMatureDecreasingBondIDs(suite.Ctx, resp.GetCompletionTime())
 	suite.Require().Len(bondReductions, 0)
 	suite.assertJailed(seqAddr)
 }
+
+func (suite *SequencerTestSuite) TestJailSequencerOnFraudSlashesFraction() {
+	keeper := suite.App.SequencerKeeper
+	params := keeper.GetParams(suite.Ctx)
+	params.FraudSlashFraction = sdk.MustNewDecFromStr("0.5")
+	keeper.SetParams(suite.Ctx, params)
+
+	rollappId, pk := suite.CreateDefaultRollapp()
+	seqAddr := suite.CreateSequencer(suite.Ctx, rollappId, pk)
+	delegator := suite.fundDelegator(bond)
+	_, err := suite.msgServer.Delegate(suite.Ctx, types.NewMsgDelegate(delegator, seqAddr, bond))
+	suite.Require().NoError(err)
+
+	quarter := sdk.NewCoin(bond.Denom, bond.Amount.QuoRaw(4))
+	// undelegated before the infraction
+	suite.Ctx = suite.Ctx.WithBlockHeight(5)
+	_, err = suite.msgServer.Undelegate(suite.Ctx, types.NewMsgUndelegate(delegator, seqAddr, quarter))
+	suite.Require().NoError(err)
+	// undelegated after the infraction
+	suite.Ctx = suite.Ctx.WithBlockHeight(20).WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	_, err = suite.msgServer.Undelegate(suite.Ctx, types.NewMsgUndelegate(delegator, seqAddr, quarter))
+	suite.Require().NoError(err)
+
+	// undelegated from another sequencer after the infraction
+	otherSeqAddr := suite.CreateSequencer(suite.Ctx, rollappId, ed25519.GenPrivKey().PubKey())
+	otherDelegator := suite.fundDelegator(bond)
+	_, err = suite.msgServer.Delegate(suite.Ctx, types.NewMsgDelegate(otherDelegator, otherSeqAddr, bond))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Undelegate(suite.Ctx, types.NewMsgUndelegate(otherDelegator, otherSeqAddr, quarter))
+	suite.Require().NoError(err)
+
+	err = keeper.JailSequencerOnFraud(suite.Ctx, seqAddr, 10, nil)
+	suite.Require().NoError(err)
+	suite.assertJailed(seqAddr)
+
+	otherUbds := keeper.GetSequencerUnbondingDelegations(suite.Ctx, otherSeqAddr)
+	suite.Require().Len(otherUbds, 1)
+	suite.Require().Equal(sdk.NewCoins(quarter), otherUbds[0].Amount)
+
+	// half of the remaining bond is slashed, pro-rata between the sequencer and the delegator, the rest is refunded
+	seqBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32(seqAddr), bond.Denom)
+	suite.Require().Equal(bond.Amount.QuoRaw(2), seqBalance.Amount)
+	delegatorBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32(delegator), bond.Denom)
+	suite.Require().Equal(quarter, delegatorBalance)
+
+	// only the unbonding delegation created after the infraction is slashed
+	ubds := keeper.GetDelegatorUnbondingDelegations(suite.Ctx, delegator)
+	suite.Require().Len(ubds, 2)
+	suite.Require().Equal(sdk.NewCoins(quarter), ubds[0].Amount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bond.Denom, quarter.Amount.QuoRaw(2))), ubds[1].Amount)
+}
@@ -1,5 +1,15 @@
 package keeper_test
 
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
 func (s *SequencerTestSuite) TestSlashBasic() {
 	s.Run("slash at zero does not error", func() {
 		// There shouldn't be an error if the sequencer has no tokens
@@ -14,3 +24,98 @@ func (s *SequencerTestSuite) TestSlashBasic() {
 		s.Require().NoError(err)
 	})
 }
+
+func (s *SequencerTestSuite) TestSlashDistribution() {
+	k := s.App.SequencerKeeper
+	params := k.GetParams(s.Ctx)
+	params.SlashDistribution = types.SlashDistribution{
+		CompensationPool: sdk.MustNewDecFromStr("0.4"),
+		CommunityPool:    sdk.MustNewDecFromStr("0.3"),
+		Burn:             sdk.MustNewDecFromStr("0.2"),
+		Reporter:         sdk.MustNewDecFromStr("0.1"),
+	}
+	k.SetParams(s.Ctx, params)
+
+	rollappId, pk := s.CreateDefaultRollapp()
+	seqAddr := s.CreateSequencer(s.Ctx, rollappId, pk)
+	seq, found := k.GetSequencer(s.Ctx, seqAddr)
+	s.Require().True(found)
+
+	compensationAcc := authtypes.NewModuleAddress(types.CompensationPoolName)
+	supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, bond.Denom)
+	communityBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(bond.Denom)
+
+	// no reporter: the reporter share is burned
+	amt := sdk.NewCoins(sdk.NewCoin(bond.Denom, sdk.NewInt(1000)))
+	err := k.Slash(s.Ctx, &seq, amt)
+	s.Require().NoError(err)
+
+	pool := k.GetCompensationPool(s.Ctx, rollappId)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(bond.Denom, sdk.NewInt(400))), pool.Amount)
+	s.Require().Equal(pool.Amount, s.App.BankKeeper.GetAllBalances(s.Ctx, compensationAcc))
+
+	communityAfter := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(bond.Denom)
+	s.Require().Equal(sdk.NewDec(300), communityAfter.Sub(communityBefore))
+
+	supplyAfter := s.App.BankKeeper.GetSupply(s.Ctx, bond.Denom)
+	s.Require().Equal(sdk.NewInt(300), supplyBefore.Amount.Sub(supplyAfter.Amount))
+
+	res, err := s.queryClient.CompensationPool(s.Ctx, &types.QueryCompensationPoolRequest{RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().Equal(pool, res.Pool)
+
+	// the reporter of a fraud gets its share
+	reporter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	seq = k.MustGetSequencer(s.Ctx, seqAddr)
+	slashed := seq.Tokens
+	err = k.JailSequencerOnFraud(s.Ctx, seqAddr, s.Ctx.BlockHeight(), reporter)
+	s.Require().NoError(err)
+	reward := sdk.NewCoins(ucoin.MulDec(sdk.MustNewDecFromStr("0.1"), slashed...)...)
+	s.Require().Equal(reward, s.App.BankKeeper.GetAllBalances(s.Ctx, reporter))
+}
+
+func (s *SequencerTestSuite) TestPayCompensation() {
+	k := s.App.SequencerKeeper
+	params := k.GetParams(s.Ctx)
+	params.SlashDistribution = types.SlashDistribution{
+		CompensationPool: sdk.OneDec(),
+		CommunityPool:    sdk.ZeroDec(),
+		Burn:             sdk.ZeroDec(),
+		Reporter:         sdk.ZeroDec(),
+	}
+	k.SetParams(s.Ctx, params)
+
+	rollappId, pk := s.CreateDefaultRollapp()
+	seqAddr := s.CreateSequencer(s.Ctx, rollappId, pk)
+	seq := k.MustGetSequencer(s.Ctx, seqAddr)
+	err := k.Slash(s.Ctx, &seq, sdk.NewCoins(sdk.NewCoin(bond.Denom, sdk.NewInt(1000))))
+	s.Require().NoError(err)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	paid := sdk.NewCoins(sdk.NewCoin(bond.Denom, sdk.NewInt(600)))
+	msg := &types.MsgPayCompensation{
+		Authority: authority,
+		RollappId: rollappId,
+		Recipient: recipient.String(),
+		Amount:    paid,
+	}
+
+	// only the governance can pay out
+	_, err = s.msgServer.PayCompensation(s.Ctx, &types.MsgPayCompensation{
+		Authority: seqAddr,
+		RollappId: rollappId,
+		Recipient: recipient.String(),
+		Amount:    paid,
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.PayCompensation(s.Ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(paid, s.App.BankKeeper.GetAllBalances(s.Ctx, recipient))
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(bond.Denom, sdk.NewInt(400))), k.GetCompensationPool(s.Ctx, rollappId).Amount)
+
+	// can't pay more than the pool
+	_, err = s.msgServer.PayCompensation(s.Ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInsufficientCompensationPool)
+}
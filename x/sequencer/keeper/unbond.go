@@ -66,7 +66,9 @@ func (k Keeper) InstantUnbondAllSequencers(ctx sdk.Context, rollappID string) er
 }
 
 // reduceSequencerBond reduces the bond of a sequencer
-// if burn is true, the tokens are burned, otherwise they are refunded
+// if burn is true, the tokens are slashed: distributed according to the SlashDistribution param, and the
+// delegations are reduced pro-rata,
+// otherwise the whole bond is refunded: the delegators get their delegations back and the rest goes to the sequencer
 // returns an error if the sequencer does not have enough bond
 // method updates the sequencer object. doesn't update the store
 func (k Keeper) reduceSequencerBond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins, burn bool) error {
@@ -74,35 +76,52 @@ func (k Keeper) reduceSequencerBond(ctx sdk.Context, seq *types.Sequencer, amt s
 		return nil
 	}
 	if burn {
-		err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt)
-		if err != nil {
-			return err
-		}
-	} else {
-		// refund
-		seqAcc := sdk.MustAccAddressFromBech32(seq.Address)
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, seqAcc, amt)
-		if err != nil {
-			return err
-		}
+		return k.burnSequencerBond(ctx, seq, amt, nil)
+	}
+
+	// refund
+	refunded, err := k.refundDelegations(ctx, *seq)
+	if err != nil {
+		return errorsmod.Wrap(err, "refund delegations")
+	}
+	seqAcc := sdk.MustAccAddressFromBech32(seq.Address)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, seqAcc, amt.Sub(refunded...))
+	if err != nil {
+		return err
 	}
 
 	seq.Tokens = seq.Tokens.Sub(amt...)
 	return nil
 }
 
-func (k Keeper) unbondSequencerAndJail(ctx sdk.Context, seqAddr string) error {
-	return k.unbond(ctx, seqAddr, true)
+// burnSequencerBond slashes the bond of a sequencer: the tokens are distributed according to the SlashDistribution
+// param, rewarding the reporter of the fault if any, and the delegations are reduced pro-rata.
+// method updates the sequencer object. doesn't update the store
+func (k Keeper) burnSequencerBond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins, reporter sdk.AccAddress) error {
+	if amt.IsZero() {
+		return nil
+	}
+	err := k.distributeSlashedCoins(ctx, *seq, amt, reporter)
+	if err != nil {
+		return errorsmod.Wrap(err, "distribute slashed coins")
+	}
+	k.reduceDelegationsProRata(ctx, *seq, amt)
+	seq.Tokens = seq.Tokens.Sub(amt...)
+	return nil
+}
+
+func (k Keeper) unbondSequencerAndJail(ctx sdk.Context, seqAddr string, burn bool) error {
+	return k.unbond(ctx, seqAddr, true, burn)
 }
 
 func (k Keeper) unbondSequencer(ctx sdk.Context, seqAddr string) error {
-	return k.unbond(ctx, seqAddr, false)
+	return k.unbond(ctx, seqAddr, false, false)
 }
 
 // unbond unbonds a sequencer
 // if jail is true, the sequencer is jailed as well (cannot be bonded again)
-// bonded tokens are refunded by default, unless jail is true
-func (k Keeper) unbond(ctx sdk.Context, seqAddr string, jail bool) error {
+// bonded tokens are refunded, unless burn is true
+func (k Keeper) unbond(ctx sdk.Context, seqAddr string, jail, burn bool) error {
 	seq, found := k.GetSequencer(ctx, seqAddr)
 	if !found {
 		return types.ErrUnknownSequencer
@@ -117,8 +136,8 @@ func (k Keeper) unbond(ctx sdk.Context, seqAddr string, jail bool) error {
 	// keep the old status for updating the sequencer
 	oldStatus := seq.Status
 
-	// handle bond: tokens refunded by default, unless jail is true
-	err := k.reduceSequencerBond(ctx, &seq, seq.Tokens, jail)
+	// handle bond: tokens refunded, unless burn is true
+	err := k.reduceSequencerBond(ctx, &seq, seq.Tokens, burn)
 	if err != nil {
 		return errorsmod.Wrap(err, "remove sequencer bond")
 	}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// unjailPolicy returns the conditions to unjail the sequencer, depending on the reason it was jailed for.
+// Sequencers jailed for a fraud, or for an unknown reason, can only be unjailed if permitted by the governance.
+func (k Keeper) unjailPolicy(ctx sdk.Context, seq types.Sequencer) (types.UnjailPolicy, error) {
+	params := k.GetParams(ctx)
+	if seq.JailReason == types.JailReasonLiveness {
+		return params.LivenessUnjail, nil
+	}
+	if !seq.UnjailPermitted {
+		return types.UnjailPolicy{}, types.ErrUnjailNotPermitted
+	}
+	return params.FraudUnjail, nil
+}
+
+// UnjailSequencer returns a jailed sequencer to the bonded set, once its min jail duration has elapsed.
+// The sequencer pays the unjail fee, which is distributed as slashed tokens, and tops its bond back up
+// to the min bond.
+func (k Keeper) UnjailSequencer(ctx sdk.Context, seqAddr string, bond sdk.Coin) error {
+	seq, found := k.GetSequencer(ctx, seqAddr)
+	if !found {
+		return types.ErrUnknownSequencer
+	}
+	if !seq.Jailed {
+		return types.ErrSequencerNotJailed
+	}
+
+	policy, err := k.unjailPolicy(ctx, seq)
+	if err != nil {
+		return err
+	}
+	if unjailTime := seq.JailTime.Add(policy.MinJailDuration); ctx.BlockTime().Before(unjailTime) {
+		return errorsmod.Wrapf(types.ErrJailDurationNotElapsed, "can unjail at %s", unjailTime)
+	}
+
+	// same as for the registration of a sequencer
+	rollapp := k.rollappKeeper.MustGetRollapp(ctx, seq.RollappId)
+	if err := k.checkBondAdmission(ctx, rollapp, seqAddr); err != nil {
+		return err
+	}
+
+	tokens := seq.Tokens.Add(bond)
+	if minBond := k.GetParams(ctx).MinBond; !(minBond.IsNil() || minBond.IsZero()) {
+		if bond.Denom != minBond.Denom {
+			return errorsmod.Wrapf(types.ErrInvalidCoinDenom, "got %s, expected %s", bond.Denom, minBond.Denom)
+		}
+		if tokens.AmountOf(minBond.Denom).LT(minBond.Amount) {
+			return errorsmod.Wrapf(types.ErrInsufficientBond, "got %s, expected %s", tokens, minBond)
+		}
+	}
+
+	seqAcc := sdk.MustAccAddressFromBech32(seqAddr)
+	fee := sdk.NewCoins(policy.Fee)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, seqAcc, types.ModuleName, fee.Add(bond))
+	if err != nil {
+		return err
+	}
+	if !fee.IsZero() {
+		err = k.distributeSlashedCoins(ctx, seq, fee, nil)
+		if err != nil {
+			return errorsmod.Wrap(err, "distribute unjail fee")
+		}
+	}
+
+	reason := seq.JailReason
+	seq.Jailed = false
+	seq.JailReason = types.JailReasonUnspecified
+	seq.JailTime = time.Time{}
+	seq.UnjailPermitted = false
+	seq.UnbondRequestHeight = 0
+	seq.UnbondTime = time.Time{}
+	seq.NoticePeriodTime = time.Time{}
+	seq.Status = types.Bonded
+	seq.Tokens = tokens
+	seq.BondHeight = ctx.BlockHeight()
+	k.UpdateSequencer(ctx, &seq, types.Unbonded)
+
+	// if no proposer set for the rollapp, set this sequencer as the proposer
+	k.setProposerIfNone(ctx, &rollapp, seq.Address)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventSequencerUnjailed{
+		Sequencer: seq.Address,
+		Reason:    reason,
+		Fee:       policy.Fee,
+		Bond:      seq.Tokens,
+	})
+}
+
+// PermitUnjail lets a sequencer jailed for a fraud unjail itself, under the fraud unjail policy.
+func (k Keeper) PermitUnjail(ctx sdk.Context, seqAddr string) error {
+	seq, found := k.GetSequencer(ctx, seqAddr)
+	if !found {
+		return types.ErrUnknownSequencer
+	}
+	if !seq.Jailed {
+		return types.ErrSequencerNotJailed
+	}
+
+	seq.UnjailPermitted = true
+	k.UpdateSequencer(ctx, &seq)
+	return nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestUnjailLiveness() {
+	k := s.App.SequencerKeeper
+	s.Ctx = s.Ctx.WithBlockTime(time.Now())
+	rollappId, pk := s.CreateDefaultRollapp()
+	seqAddr := s.CreateSequencer(s.Ctx, rollappId, pk)
+
+	err := k.JailLiveness(s.Ctx, rollappId)
+	s.Require().NoError(err)
+	s.assertJailed(seqAddr)
+	seq, _ := k.GetSequencer(s.Ctx, seqAddr)
+	s.Require().Equal(types.JailReasonLiveness, seq.JailReason)
+
+	policy := k.GetParams(s.Ctx).LivenessUnjail
+	err = bankutil.FundAccount(s.App.BankKeeper, s.Ctx, sdk.MustAccAddressFromBech32(seqAddr), sdk.NewCoins(bond.Add(policy.Fee)))
+	s.Require().NoError(err)
+
+	// too early
+	_, err = s.msgServer.UnjailSequencer(s.Ctx, types.NewMsgUnjailSequencer(seqAddr, bond))
+	s.Require().ErrorIs(err, types.ErrJailDurationNotElapsed)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(policy.MinJailDuration))
+
+	// the bond must reach the min bond
+	_, err = s.msgServer.UnjailSequencer(s.Ctx, types.NewMsgUnjailSequencer(seqAddr, bond.SubAmount(sdk.OneInt())))
+	s.Require().ErrorIs(err, types.ErrInsufficientBond)
+
+	supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, bond.Denom)
+	_, err = s.msgServer.UnjailSequencer(s.Ctx, types.NewMsgUnjailSequencer(seqAddr, bond))
+	s.Require().NoError(err)
+
+	// the fee is burned with the default slash distribution
+	supplyAfter := s.App.BankKeeper.GetSupply(s.Ctx, bond.Denom)
+	s.Require().Equal(policy.Fee.Amount, supplyBefore.Amount.Sub(supplyAfter.Amount))
+
+	seq, _ = k.GetSequencer(s.Ctx, seqAddr)
+	s.Require().False(seq.Jailed)
+	s.Require().Equal(types.Bonded, seq.Status)
+	s.Require().Equal(sdk.NewCoins(bond), seq.Tokens)
+	proposer, found := k.GetProposer(s.Ctx, rollappId)
+	s.Require().True(found)
+	s.Require().Equal(seqAddr, proposer.Address)
+
+	_, err = s.msgServer.UnjailSequencer(s.Ctx, types.NewMsgUnjailSequencer(seqAddr, bond))
+	s.Require().ErrorIs(err, types.ErrSequencerNotJailed)
+}
+
+func (s *SequencerTestSuite) TestUnjailFraud() {
+	k := s.App.SequencerKeeper
+	s.Ctx = s.Ctx.WithBlockTime(time.Now())
+	rollappId, pk := s.CreateDefaultRollapp()
+	seqAddr := s.CreateSequencer(s.Ctx, rollappId, pk)
+
+	err := k.JailSequencerOnFraud(s.Ctx, seqAddr, s.Ctx.BlockHeight(), nil)
+	s.Require().NoError(err)
+	seq, _ := k.GetSequencer(s.Ctx, seqAddr)
+	s.Require().Equal(types.JailReasonFraud, seq.JailReason)
+
+	policy := k.GetParams(s.Ctx).FraudUnjail
+	err = bankutil.FundAccount(s.App.BankKeeper, s.Ctx, sdk.MustAccAddressFromBech32(seqAddr), sdk.NewCoins(bond.Add(policy.Fee)))
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(policy.MinJailDuration))
+
+	// fraud jails are permanent unless the governance permits to unjail
+	_, err = s.msgServer.UnjailSequencer(s.Ctx, types.NewMsgUnjailSequencer(seqAddr, bond))
+	s.Require().ErrorIs(err, types.ErrUnjailNotPermitted)
+
+	_, err = s.msgServer.PermitUnjail(s.Ctx, &types.MsgPermitUnjail{Authority: seqAddr, SequencerAddress: seqAddr})
+	s.Require().Error(err)
+	_, err = s.msgServer.PermitUnjail(s.Ctx, &types.MsgPermitUnjail{
+		Authority:        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		SequencerAddress: seqAddr,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.UnjailSequencer(s.Ctx, types.NewMsgUnjailSequencer(seqAddr, bond))
+	s.Require().NoError(err)
+	seq, _ = k.GetSequencer(s.Ctx, seqAddr)
+	s.Require().False(seq.Jailed)
+	s.Require().Equal(types.Bonded, seq.Status)
+}
+
+func (s *SequencerTestSuite) TestUnjailRecoveringRollapp() {
+	k := s.App.SequencerKeeper
+	s.Ctx = s.Ctx.WithBlockTime(time.Now())
+	rollappId, pk := s.CreateDefaultRollapp()
+	seqAddr := s.CreateSequencer(s.Ctx, rollappId, pk)
+
+	err := k.JailLiveness(s.Ctx, rollappId)
+	s.Require().NoError(err)
+
+	// the rollapp is recovered with a new initial sequencer
+	initialPk := ed25519.GenPrivKey().PubKey()
+	err = s.App.RollappKeeper.FreezeRollapp(s.Ctx, rollappId)
+	s.Require().NoError(err)
+	err = s.App.RollappKeeper.RecoverRollapp(s.Ctx, rollappId, sdk.AccAddress(initialPk.Address()).String())
+	s.Require().NoError(err)
+
+	policy := k.GetParams(s.Ctx).LivenessUnjail
+	err = bankutil.FundAccount(s.App.BankKeeper, s.Ctx, sdk.MustAccAddressFromBech32(seqAddr), sdk.NewCoins(bond.Add(policy.Fee)))
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(policy.MinJailDuration))
+
+	// only the initial sequencer can join the recovering rollapp
+	_, err = s.msgServer.UnjailSequencer(s.Ctx, types.NewMsgUnjailSequencer(seqAddr, bond))
+	s.Require().ErrorIs(err, types.ErrNotInitialSequencer)
+	_, found := k.GetProposer(s.Ctx, rollappId)
+	s.Require().False(found)
+	s.Require().True(s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId).Recovering)
+
+	// the initial sequencer becomes the proposer, which completes the recovery
+	initialAddr := s.CreateSequencer(s.Ctx, rollappId, initialPk)
+	proposer, found := k.GetProposer(s.Ctx, rollappId)
+	s.Require().True(found)
+	s.Require().Equal(initialAddr, proposer.Address)
+	s.Require().False(s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId).Recovering)
+
+	// the jailed sequencer can then be unjailed
+	_, err = s.msgServer.UnjailSequencer(s.Ctx, types.NewMsgUnjailSequencer(seqAddr, bond))
+	s.Require().NoError(err)
+	proposer, _ = k.GetProposer(s.Ctx, rollappId)
+	s.Require().Equal(initialAddr, proposer.Address)
+}
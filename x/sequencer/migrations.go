@@ -35,6 +35,11 @@ func NewMigrator(keeper seqkeeper.Keeper, ss Subspace) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	var currParams types.Params
 	m.legacySubspace.GetParamSet(ctx, &currParams)
+	// params added after the migration out of x/params are not in the legacy subspace
+	currParams.SlashDistribution = types.DefaultSlashDistribution
+	currParams.FraudSlashFraction = types.DefaultFraudSlashFraction
+	currParams.LivenessUnjail = types.DefaultParams().LivenessUnjail
+	currParams.FraudUnjail = types.DefaultParams().FraudUnjail
 
 	if err := currParams.ValidateBasic(); err != nil {
 		return err
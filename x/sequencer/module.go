@@ -180,5 +180,8 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	// Handle bond reduction
 	am.keeper.HandleBondReduction(ctx, ctx.BlockTime())
 
+	// Return the mature unbonding delegations
+	am.keeper.CompleteMatureUnbondingDelegations(ctx, ctx.BlockTime())
+
 	return []abci.ValidatorUpdate{}
 }
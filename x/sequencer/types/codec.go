@@ -12,6 +12,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUnbond{}, "sequencer/Unbond", nil)
 	cdc.RegisterConcrete(&MsgIncreaseBond{}, "sequencer/IncreaseBond", nil)
 	cdc.RegisterConcrete(&MsgDecreaseBond{}, "sequencer/DecreaseBond", nil)
+	cdc.RegisterConcrete(&MsgDelegate{}, "sequencer/Delegate", nil)
+	cdc.RegisterConcrete(&MsgUndelegate{}, "sequencer/Undelegate", nil)
+	cdc.RegisterConcrete(&MsgUnjailSequencer{}, "sequencer/UnjailSequencer", nil)
+	cdc.RegisterConcrete(&MsgUpdateProposerPolicy{}, "sequencer/UpdateProposerPolicy", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -20,6 +24,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgDecreaseBond{},
 		&MsgUnbond{},
 		&MsgIncreaseBond{},
+		&MsgDelegate{},
+		&MsgUndelegate{},
+		&MsgUnjailSequencer{},
+		&MsgUpdateProposerPolicy{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
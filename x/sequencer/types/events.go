@@ -39,6 +39,10 @@ const (
 	// EventTypeSlashed is emitted when a sequencer is slashed
 	EventTypeSlashed = "slashed"
 	// EventTypeJailed is emitted when a sequencer is jailed
+	// Attributes:
+	// - AttributeKeySequencer
+	// - AttributeKeyBond
+	// - AttributeKeyJailReason
 	EventTypeJailed = "jailed"
 	// EventTypeBondIncreased is emitted when a sequencer's bond is increased
 	EventTypeBondIncreased = "bond_increased"
@@ -49,4 +53,5 @@ const (
 	AttributeKeyProposer       = "proposer"
 	AttributeKeyNextProposer   = "next_proposer"
 	AttributeKeyCompletionTime = "completion_time"
+	AttributeKeyJailReason     = "jail_reason"
 )
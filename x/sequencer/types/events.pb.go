@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -91,8 +95,396 @@ func (m *EventIncreasedBond) GetBond() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+// EventDelegated is an event emitted when bond is delegated to a sequencer.
+type EventDelegated struct {
+	// delegator is the bech32-encoded address of the delegator
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// sequencer is the bech32-encoded address of the sequencer
+	Sequencer string `protobuf:"bytes,2,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// amount is the amount of coins delegated
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// bond is the new active bond amount of the sequencer
+	Bond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=bond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bond"`
+}
+
+func (m *EventDelegated) Reset()         { *m = EventDelegated{} }
+func (m *EventDelegated) String() string { return proto.CompactTextString(m) }
+func (*EventDelegated) ProtoMessage()    {}
+func (*EventDelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{1}
+}
+func (m *EventDelegated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegated.Merge(m, src)
+}
+func (m *EventDelegated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelegated proto.InternalMessageInfo
+
+func (m *EventDelegated) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *EventDelegated) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *EventDelegated) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventDelegated) GetBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+// EventUndelegated is an event emitted when delegated bond starts unbonding.
+type EventUndelegated struct {
+	// delegator is the bech32-encoded address of the delegator
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// sequencer is the bech32-encoded address of the sequencer
+	Sequencer string `protobuf:"bytes,2,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// amount is the amount of coins undelegated
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// completion_time is the time when the coins are returned to the delegator
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *EventUndelegated) Reset()         { *m = EventUndelegated{} }
+func (m *EventUndelegated) String() string { return proto.CompactTextString(m) }
+func (*EventUndelegated) ProtoMessage()    {}
+func (*EventUndelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{2}
+}
+func (m *EventUndelegated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUndelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUndelegated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUndelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUndelegated.Merge(m, src)
+}
+func (m *EventUndelegated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUndelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUndelegated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUndelegated proto.InternalMessageInfo
+
+func (m *EventUndelegated) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *EventUndelegated) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *EventUndelegated) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventUndelegated) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+// EventSlashDistributed is an event emitted when the slashed tokens of a sequencer are distributed.
+type EventSlashDistributed struct {
+	// sequencer is the bech32-encoded address of the slashed sequencer
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// rollapp_id is the rollapp of the sequencer
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// compensation_pool is the amount paid into the compensation pool of the rollapp
+	CompensationPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=compensation_pool,json=compensationPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"compensation_pool"`
+	// community_pool is the amount paid into the community pool
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	// burned is the amount burned
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// reporter is the bech32-encoded address of the reporter of the fault, empty if there is none
+	Reporter string `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// reporter_reward is the amount paid to the reporter
+	ReporterReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=reporter_reward,json=reporterReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reporter_reward"`
+}
+
+func (m *EventSlashDistributed) Reset()         { *m = EventSlashDistributed{} }
+func (m *EventSlashDistributed) String() string { return proto.CompactTextString(m) }
+func (*EventSlashDistributed) ProtoMessage()    {}
+func (*EventSlashDistributed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{3}
+}
+func (m *EventSlashDistributed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSlashDistributed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSlashDistributed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSlashDistributed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlashDistributed.Merge(m, src)
+}
+func (m *EventSlashDistributed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSlashDistributed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlashDistributed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSlashDistributed proto.InternalMessageInfo
+
+func (m *EventSlashDistributed) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *EventSlashDistributed) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventSlashDistributed) GetCompensationPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CompensationPool
+	}
+	return nil
+}
+
+func (m *EventSlashDistributed) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *EventSlashDistributed) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *EventSlashDistributed) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *EventSlashDistributed) GetReporterReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReporterReward
+	}
+	return nil
+}
+
+// EventCompensationPaid is an event emitted when coins of the compensation pool of a rollapp are paid out.
+type EventCompensationPaid struct {
+	// rollapp_id is the rollapp of the compensation pool
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// recipient is the bech32-encoded address of the recipient
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount paid
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventCompensationPaid) Reset()         { *m = EventCompensationPaid{} }
+func (m *EventCompensationPaid) String() string { return proto.CompactTextString(m) }
+func (*EventCompensationPaid) ProtoMessage()    {}
+func (*EventCompensationPaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{4}
+}
+func (m *EventCompensationPaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCompensationPaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCompensationPaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCompensationPaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCompensationPaid.Merge(m, src)
+}
+func (m *EventCompensationPaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCompensationPaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCompensationPaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCompensationPaid proto.InternalMessageInfo
+
+func (m *EventCompensationPaid) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventCompensationPaid) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventCompensationPaid) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EventSequencerUnjailed is an event emitted when a jailed sequencer rejoins the bonded set.
+type EventSequencerUnjailed struct {
+	// sequencer is the bech32-encoded address of the sequencer
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// reason is the reason the sequencer was jailed for
+	Reason JailReason `protobuf:"varint,2,opt,name=reason,proto3,enum=dymensionxyz.dymension.sequencer.JailReason" json:"reason,omitempty"`
+	// fee is the unjail fee paid
+	Fee types.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+	// bond is the new active bond amount of the sequencer
+	Bond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=bond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bond"`
+}
+
+func (m *EventSequencerUnjailed) Reset()         { *m = EventSequencerUnjailed{} }
+func (m *EventSequencerUnjailed) String() string { return proto.CompactTextString(m) }
+func (*EventSequencerUnjailed) ProtoMessage()    {}
+func (*EventSequencerUnjailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{5}
+}
+func (m *EventSequencerUnjailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSequencerUnjailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSequencerUnjailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSequencerUnjailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSequencerUnjailed.Merge(m, src)
+}
+func (m *EventSequencerUnjailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSequencerUnjailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSequencerUnjailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSequencerUnjailed proto.InternalMessageInfo
+
+func (m *EventSequencerUnjailed) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *EventSequencerUnjailed) GetReason() JailReason {
+	if m != nil {
+		return m.Reason
+	}
+	return JailReasonUnspecified
+}
+
+func (m *EventSequencerUnjailed) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *EventSequencerUnjailed) GetBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventIncreasedBond)(nil), "dymensionxyz.dymension.sequencer.EventIncreasedBond")
+	proto.RegisterType((*EventDelegated)(nil), "dymensionxyz.dymension.sequencer.EventDelegated")
+	proto.RegisterType((*EventUndelegated)(nil), "dymensionxyz.dymension.sequencer.EventUndelegated")
+	proto.RegisterType((*EventSlashDistributed)(nil), "dymensionxyz.dymension.sequencer.EventSlashDistributed")
+	proto.RegisterType((*EventCompensationPaid)(nil), "dymensionxyz.dymension.sequencer.EventCompensationPaid")
+	proto.RegisterType((*EventSequencerUnjailed)(nil), "dymensionxyz.dymension.sequencer.EventSequencerUnjailed")
 }
 
 func init() {
@@ -100,29 +492,51 @@ func init() {
 }
 
 var fileDescriptor_1f8a63d7e7167eb3 = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x13, 0x5a, 0x21, 0x35, 0x65, 0x8a, 0x2a, 0x91, 0x76, 0x70, 0x23, 0xa6, 0x2e, 0xb5,
-	0x29, 0x95, 0xba, 0x37, 0x88, 0x81, 0x0d, 0x95, 0x8d, 0xa5, 0x4a, 0x62, 0x2b, 0x44, 0x28, 0xbe,
-	0x92, 0x73, 0xa3, 0x96, 0xa7, 0xe0, 0x39, 0x98, 0x79, 0x88, 0x8e, 0x15, 0x13, 0x13, 0xa0, 0xf6,
-	0x09, 0x78, 0x03, 0x14, 0xc7, 0x0a, 0x5d, 0x60, 0xb2, 0xef, 0xee, 0xff, 0x7e, 0xfd, 0xba, 0x73,
-	0x86, 0x7c, 0x9d, 0x09, 0x89, 0x29, 0xc8, 0xd5, 0xfa, 0x89, 0xd5, 0x05, 0x43, 0xf1, 0xb8, 0x14,
-	0x32, 0x16, 0x39, 0x13, 0x85, 0x90, 0x0a, 0xe9, 0x22, 0x07, 0x05, 0xae, 0x7f, 0x28, 0xa7, 0x75,
-	0x41, 0x6b, 0x79, 0xaf, 0x1b, 0x03, 0x66, 0x80, 0x73, 0xad, 0x67, 0x55, 0x51, 0xc1, 0xbd, 0x4e,
-	0x02, 0x09, 0x54, 0xfd, 0xf2, 0x67, 0xba, 0xa4, 0xd2, 0xb0, 0x28, 0x44, 0xc1, 0x8a, 0x51, 0x24,
-	0x54, 0x38, 0x62, 0x31, 0xa4, 0xd2, 0xcc, 0x4f, 0xcd, 0x3c, 0xc3, 0x84, 0x15, 0xa3, 0xf2, 0xa9,
-	0x06, 0x67, 0xdf, 0xb6, 0xe3, 0x5e, 0x95, 0xe1, 0xae, 0x65, 0x9c, 0x8b, 0x10, 0x05, 0x0f, 0x40,
-	0x72, 0x77, 0xe2, 0xb4, 0xea, 0x34, 0x9e, 0xed, 0xdb, 0x83, 0x56, 0xe0, 0xbd, 0xbd, 0x0e, 0x3b,
-	0x26, 0xca, 0x94, 0xf3, 0x5c, 0x20, 0xde, 0xaa, 0x3c, 0x95, 0xc9, 0xec, 0x57, 0xea, 0x06, 0xce,
-	0x49, 0xc8, 0xb9, 0xe0, 0xf3, 0x30, 0x83, 0xa5, 0x54, 0xde, 0x91, 0x6f, 0x0f, 0xda, 0x17, 0x5d,
-	0x6a, 0xb8, 0x32, 0x1e, 0x35, 0xf1, 0xe8, 0x25, 0xa4, 0x32, 0x68, 0x6e, 0x3e, 0xfa, 0xd6, 0xac,
-	0xad, 0xa1, 0xa9, 0x66, 0xdc, 0xb9, 0xd3, 0x8c, 0x40, 0x72, 0xaf, 0xe1, 0x37, 0xfe, 0x67, 0xcf,
-	0x4b, 0xf6, 0xe5, 0xb3, 0x3f, 0x48, 0x52, 0x75, 0xbf, 0x8c, 0x68, 0x0c, 0x99, 0xd9, 0x95, 0x79,
-	0x86, 0xc8, 0x1f, 0x98, 0x5a, 0x2f, 0x04, 0x6a, 0x00, 0x67, 0xda, 0x38, 0xb8, 0xd9, 0xec, 0x88,
-	0xbd, 0xdd, 0x11, 0xfb, 0x6b, 0x47, 0xec, 0xe7, 0x3d, 0xb1, 0xb6, 0x7b, 0x62, 0xbd, 0xef, 0x89,
-	0x75, 0x37, 0x39, 0x70, 0xfa, 0xe3, 0xa6, 0xc5, 0x98, 0xad, 0x0e, 0x0e, 0xab, 0xdd, 0xa3, 0x63,
-	0xbd, 0xcc, 0xf1, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xed, 0xb7, 0x3b, 0x80, 0x09, 0x02, 0x00,
-	0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4f, 0x53, 0xd3, 0x4e,
+	0x18, 0x6e, 0xda, 0xfe, 0xfa, 0xa3, 0x8b, 0x16, 0xcc, 0xa0, 0x06, 0x66, 0x4c, 0x3b, 0x3d, 0x71,
+	0x90, 0x84, 0x82, 0x83, 0x67, 0x0a, 0x1e, 0x70, 0xc6, 0x19, 0x26, 0xc8, 0xc5, 0x4b, 0x67, 0x93,
+	0x5d, 0xc2, 0x6a, 0xb2, 0x1b, 0x77, 0x37, 0x95, 0xfa, 0x11, 0x3c, 0x71, 0xf6, 0x23, 0x70, 0xf6,
+	0x43, 0x70, 0x64, 0xf4, 0xe2, 0x49, 0x1c, 0x38, 0x7b, 0xf0, 0x1b, 0x38, 0xd9, 0x6c, 0x43, 0x61,
+	0x46, 0xe8, 0x28, 0x3d, 0x78, 0xca, 0xfe, 0x79, 0x9e, 0xf7, 0xcd, 0xf3, 0xec, 0xfb, 0xee, 0x82,
+	0x25, 0x34, 0x88, 0x31, 0x15, 0x84, 0xd1, 0x83, 0xc1, 0x7b, 0xb7, 0x98, 0xb8, 0x02, 0xbf, 0x4d,
+	0x31, 0x0d, 0x30, 0x77, 0x71, 0x1f, 0x53, 0x29, 0x9c, 0x84, 0x33, 0xc9, 0xcc, 0xd6, 0x28, 0xdc,
+	0x29, 0x26, 0x4e, 0x01, 0x5f, 0x98, 0x0f, 0x98, 0x88, 0x99, 0xe8, 0x29, 0xbc, 0x9b, 0x4f, 0x72,
+	0xf2, 0xc2, 0x5c, 0xc8, 0x42, 0x96, 0xaf, 0x67, 0x23, 0xbd, 0x6a, 0xe7, 0x18, 0xd7, 0x87, 0x02,
+	0xbb, 0xfd, 0x8e, 0x8f, 0x25, 0xec, 0xb8, 0x01, 0x23, 0x54, 0xef, 0x3f, 0xd4, 0xfb, 0xb1, 0x08,
+	0xdd, 0x7e, 0x27, 0xfb, 0xe8, 0x8d, 0x66, 0xc8, 0x58, 0x18, 0x61, 0x57, 0xcd, 0xfc, 0x74, 0xcf,
+	0x95, 0x24, 0xc6, 0x42, 0xc2, 0x38, 0xd1, 0x80, 0xe5, 0x1b, 0xb5, 0x15, 0xa3, 0x9c, 0xd1, 0xfe,
+	0x69, 0x00, 0xf3, 0x59, 0xa6, 0x77, 0x8b, 0x06, 0x1c, 0x43, 0x81, 0x51, 0x97, 0x51, 0x64, 0xae,
+	0x81, 0x7a, 0x81, 0xb4, 0x8c, 0x96, 0xb1, 0x58, 0xef, 0x5a, 0x9f, 0x3f, 0x2d, 0xcd, 0x69, 0x75,
+	0xeb, 0x08, 0x71, 0x2c, 0xc4, 0x8e, 0xe4, 0x84, 0x86, 0xde, 0x05, 0xd4, 0xec, 0x82, 0x3b, 0x10,
+	0x21, 0x8c, 0x7a, 0x30, 0x66, 0x29, 0x95, 0x56, 0xb9, 0x65, 0x2c, 0x4e, 0xaf, 0xcc, 0x3b, 0x9a,
+	0x97, 0x29, 0x76, 0xb4, 0x62, 0x67, 0x83, 0x11, 0xda, 0xad, 0x1e, 0x7f, 0x6b, 0x96, 0xbc, 0x69,
+	0x45, 0x5a, 0x57, 0x1c, 0xb3, 0x07, 0xaa, 0x3e, 0xa3, 0xc8, 0xaa, 0xb4, 0x2a, 0xd7, 0x73, 0x97,
+	0x33, 0xee, 0xd1, 0x69, 0x73, 0x31, 0x24, 0x72, 0x3f, 0xf5, 0x9d, 0x80, 0xc5, 0xda, 0x7e, 0xfd,
+	0x59, 0x12, 0xe8, 0x8d, 0x2b, 0x07, 0x09, 0x16, 0x8a, 0x20, 0x3c, 0x15, 0xb8, 0xfd, 0xb1, 0x0c,
+	0x1a, 0x4a, 0xf3, 0x26, 0x8e, 0x70, 0x08, 0x25, 0x56, 0x7a, 0x51, 0x3e, 0x61, 0x63, 0xe8, 0x2d,
+	0xa0, 0x97, 0x7d, 0x2a, 0x8f, 0xef, 0xd3, 0x53, 0x50, 0xd3, 0x0e, 0x55, 0xc6, 0x73, 0xa8, 0x06,
+	0x2f, 0x9b, 0x53, 0x9d, 0x94, 0x39, 0x1f, 0xca, 0x60, 0x56, 0x99, 0xb3, 0x4b, 0xd1, 0xbf, 0x67,
+	0xcf, 0x0b, 0x30, 0x13, 0xb0, 0x38, 0x89, 0xb0, 0x24, 0x8c, 0xf6, 0xb2, 0xf6, 0xb0, 0xaa, 0x2a,
+	0xc2, 0x82, 0x93, 0xf7, 0x8e, 0x33, 0xec, 0x1d, 0xe7, 0xe5, 0xb0, 0x77, 0xba, 0x53, 0x59, 0x88,
+	0xc3, 0xd3, 0xa6, 0xe1, 0x35, 0x2e, 0xc8, 0xd9, 0x76, 0xfb, 0x47, 0x15, 0xdc, 0x57, 0x66, 0xec,
+	0x44, 0x50, 0xec, 0x6f, 0x12, 0x21, 0x39, 0xf1, 0x53, 0xed, 0xc8, 0x1f, 0x35, 0xc8, 0x23, 0x00,
+	0x38, 0x8b, 0x22, 0x98, 0x24, 0x3d, 0x82, 0x72, 0x4b, 0xbc, 0xba, 0x5e, 0xd9, 0x42, 0xe6, 0x01,
+	0xb8, 0x97, 0xfd, 0x02, 0xa6, 0x02, 0x2a, 0x05, 0x09, 0x63, 0xd1, 0x24, 0x1a, 0x61, 0x76, 0x34,
+	0xcb, 0x36, 0x63, 0x91, 0xc9, 0x41, 0x26, 0x3e, 0x4e, 0x29, 0x91, 0x83, 0x3c, 0xed, 0x04, 0x4a,
+	0xec, 0x6e, 0x91, 0x42, 0xe5, 0x0c, 0x40, 0xcd, 0x4f, 0x39, 0xc5, 0xc8, 0xfa, 0xef, 0xf6, 0x73,
+	0xe9, 0xd0, 0xe6, 0x13, 0x30, 0xc5, 0x71, 0xc2, 0xb8, 0xc4, 0xdc, 0xaa, 0xdd, 0x70, 0x50, 0x05,
+	0xd2, 0x94, 0x60, 0x66, 0x38, 0xee, 0x71, 0xfc, 0x0e, 0x72, 0x64, 0xfd, 0x7f, 0xfb, 0xff, 0xd8,
+	0x18, 0xe6, 0xf0, 0x54, 0x8a, 0xf6, 0x17, 0x43, 0xd7, 0xdb, 0xc6, 0xe8, 0xf1, 0x40, 0x82, 0xae,
+	0xd4, 0x8d, 0x71, 0xb5, 0x6e, 0xd6, 0x40, 0x9d, 0xe3, 0x80, 0x24, 0x04, 0xeb, 0x4b, 0xf7, 0xda,
+	0x72, 0x2c, 0xa0, 0xd9, 0x09, 0x14, 0x8d, 0x76, 0xfb, 0x27, 0x90, 0x87, 0x6e, 0x1f, 0x95, 0xc1,
+	0x83, 0xbc, 0x8b, 0x86, 0x6d, 0xb0, 0x4b, 0x5f, 0x43, 0x12, 0xfd, 0x45, 0x1b, 0x6d, 0x82, 0x5a,
+	0xf6, 0x5a, 0x31, 0xaa, 0xc4, 0x36, 0x56, 0x1e, 0x3b, 0x37, 0x3d, 0xd3, 0xce, 0x73, 0x48, 0x22,
+	0x4f, 0x71, 0x3c, 0xcd, 0x35, 0x3b, 0xa0, 0xb2, 0x87, 0xf1, 0xb8, 0x77, 0x4c, 0x86, 0x9d, 0xf8,
+	0xfd, 0xdb, 0xdd, 0x3e, 0x3e, 0xb3, 0x8d, 0x93, 0x33, 0xdb, 0xf8, 0x7e, 0x66, 0x1b, 0x87, 0xe7,
+	0x76, 0xe9, 0xe4, 0xdc, 0x2e, 0x7d, 0x3d, 0xb7, 0x4b, 0xaf, 0xd6, 0x46, 0x22, 0xfd, 0xe6, 0x9d,
+	0xef, 0xaf, 0xba, 0x07, 0x23, 0x8f, 0xbd, 0x8a, 0xee, 0xd7, 0xd4, 0x95, 0xb7, 0xfa, 0x6b, 0x00,
+	0x23, 0x82, 0xc7, 0x92, 0xf9, 0x08, 0x00, 0x00,
 }
 
 func (m *EventIncreasedBond) Marshal() (dAtA []byte, err error) {
@@ -152,72 +566,1476 @@ func (m *EventIncreasedBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.AddedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUndelegated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUndelegated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUndelegated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvents(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReporterReward) > 0 {
+		for iNdEx := len(m.ReporterReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReporterReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CompensationPool) > 0 {
+		for iNdEx := len(m.CompensationPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompensationPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCompensationPaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCompensationPaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCompensationPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSequencerUnjailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSequencerUnjailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequencerUnjailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Reason != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventIncreasedBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.AddedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventDelegated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventUndelegated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSlashDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.CompensationPool) > 0 {
+		for _, e := range m.CompensationPool {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ReporterReward) > 0 {
+		for _, e := range m.ReporterReward {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventCompensationPaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSequencerUnjailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvents(uint64(m.Reason))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIncreasedBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIncreasedBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDelegated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUndelegated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUndelegated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUndelegated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashDistributed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashDistributed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompensationPool = append(m.CompensationPool, types.Coin{})
+			if err := m.CompensationPool[len(m.CompensationPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size, err := m.AddedAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReporterReward = append(m.ReporterReward, types.Coin{})
+			if err := m.ReporterReward[len(m.ReporterReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sequencer) > 0 {
-		i -= len(m.Sequencer)
-		copy(dAtA[i:], m.Sequencer)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *EventIncreasedBond) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sequencer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.AddedAmount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if len(m.Bond) > 0 {
-		for _, e := range m.Bond {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+func (m *EventCompensationPaid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCompensationPaid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCompensationPaid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
+func (m *EventSequencerUnjailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -240,10 +2058,10 @@ func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventIncreasedBond: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSequencerUnjailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventIncreasedBond: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSequencerUnjailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -279,8 +2097,27 @@ func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
 			m.Sequencer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= JailReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -307,11 +2144,11 @@ func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AddedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
 			}
@@ -13,7 +13,9 @@ type RollappKeeper interface {
 	MustGetRollapp(ctx sdk.Context, rollappId string) rollapptypes.Rollapp
 	GetAllRollapps(ctx sdk.Context) (list []rollapptypes.Rollapp)
 	SetRollappAsLaunched(ctx sdk.Context, rollapp *rollapptypes.Rollapp) error
+	SetRollappAsRecovered(ctx sdk.Context, rollapp *rollapptypes.Rollapp)
 	GetParams(ctx sdk.Context) rollapptypes.Params
+	FindStateInfoByHeight(ctx sdk.Context, rollappId string, height uint64) (*rollapptypes.StateInfo, error)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -26,5 +28,11 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
+
+// DistributionKeeper defines the expected interface needed to fund the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
@@ -41,5 +41,45 @@ func (gs GenesisState) Validate() error {
 		proposerIndexMap[rollappId] = struct{}{}
 	}
 
+	// Check the delegations belong to known sequencers
+	delegationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.Delegations {
+		if _, ok := sequencerIndexMap[string(SequencerKey(elem.SequencerAddress))]; !ok {
+			return fmt.Errorf("delegation to unknown sequencer %s", elem.SequencerAddress)
+		}
+		delegationKey := string(DelegationKey(elem.SequencerAddress, elem.DelegatorAddress))
+		if _, ok := delegationIndexMap[delegationKey]; ok {
+			return fmt.Errorf("duplicated delegation of %s to %s", elem.DelegatorAddress, elem.SequencerAddress)
+		}
+		if !elem.Amount.IsValid() {
+			return fmt.Errorf("invalid delegation amount: %s", elem.Amount)
+		}
+		delegationIndexMap[delegationKey] = struct{}{}
+	}
+
+	// Check for duplicated compensation pools
+	compensationPoolIndexMap := make(map[string]struct{})
+	for _, elem := range gs.CompensationPools {
+		if _, ok := compensationPoolIndexMap[elem.RollappId]; ok {
+			return fmt.Errorf("duplicated compensation pool for %s", elem.RollappId)
+		}
+		if !elem.Amount.IsValid() {
+			return fmt.Errorf("invalid compensation pool amount: %s", elem.Amount)
+		}
+		compensationPoolIndexMap[elem.RollappId] = struct{}{}
+	}
+
+	// Check for duplicated proposer policies
+	proposerPolicyIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ProposerPolicies {
+		if _, ok := proposerPolicyIndexMap[elem.RollappId]; ok {
+			return fmt.Errorf("duplicated proposer policy for %s", elem.RollappId)
+		}
+		if err := elem.ValidateBasic(); err != nil {
+			return fmt.Errorf("proposer policy for %s: %w", elem.RollappId, err)
+		}
+		proposerPolicyIndexMap[elem.RollappId] = struct{}{}
+	}
+
 	return gs.Params.ValidateBasic()
 }
@@ -32,6 +32,14 @@ type GenesisState struct {
 	GenesisProposers []GenesisProposer `protobuf:"bytes,3,rep,name=genesisProposers,proto3" json:"genesisProposers"`
 	// bondReductions is a list of all bond reductions
 	BondReductions []BondReduction `protobuf:"bytes,4,rep,name=bondReductions,proto3" json:"bondReductions"`
+	// delegations is a list of all delegations
+	Delegations []Delegation `protobuf:"bytes,5,rep,name=delegations,proto3" json:"delegations"`
+	// unbondingDelegations is a list of all unbonding delegations
+	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,6,rep,name=unbondingDelegations,proto3" json:"unbondingDelegations"`
+	// compensationPools is a list of the compensation pools of the rollapps
+	CompensationPools []CompensationPool `protobuf:"bytes,7,rep,name=compensationPools,proto3" json:"compensationPools"`
+	// proposerPolicies is a list of the proposer election policies of the rollapps
+	ProposerPolicies []ProposerPolicy `protobuf:"bytes,8,rep,name=proposerPolicies,proto3" json:"proposerPolicies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -95,6 +103,34 @@ func (m *GenesisState) GetBondReductions() []BondReduction {
 	return nil
 }
 
+func (m *GenesisState) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingDelegations() []UnbondingDelegation {
+	if m != nil {
+		return m.UnbondingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCompensationPools() []CompensationPool {
+	if m != nil {
+		return m.CompensationPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposerPolicies() []ProposerPolicy {
+	if m != nil {
+		return m.ProposerPolicies
+	}
+	return nil
+}
+
 type GenesisProposer struct {
 	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
@@ -157,29 +193,35 @@ func init() {
 }
 
 var fileDescriptor_3115db717b16c2af = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0x53, 0x0b, 0x4b,
-	0x53, 0xf3, 0x92, 0x53, 0x8b, 0xf4, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0x90, 0xd5, 0x23, 0x34, 0xeb, 0xc1, 0xd5, 0x4b, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x15, 0xeb, 0x83, 0x58, 0x10, 0x7d, 0x52, 0xba, 0x04, 0xed, 0x29, 0x48, 0x2c,
-	0x4a, 0xcc, 0x85, 0x5a, 0x23, 0x65, 0x40, 0x50, 0x39, 0x9c, 0x05, 0xd1, 0xa1, 0xf4, 0x99, 0x89,
-	0x8b, 0xc7, 0x1d, 0xe2, 0xd4, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x37, 0x2e, 0x36, 0x88, 0x91,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x1a, 0x7a, 0x84, 0x9c, 0xae, 0x17, 0x00, 0x56, 0xef,
-	0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xb7, 0x50, 0x38, 0x17, 0x2f, 0x5c, 0x85, 0x4f,
-	0x66, 0x71, 0x89, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x36, 0x61, 0xe3, 0x82, 0x61, 0x2c,
-	0xa8, 0x89, 0xa8, 0xe6, 0x08, 0x25, 0x73, 0x09, 0x40, 0xc3, 0x36, 0xa0, 0x28, 0xbf, 0x20, 0xbf,
-	0x38, 0xb5, 0xa8, 0x58, 0x82, 0x19, 0x6c, 0xb6, 0x21, 0x61, 0xb3, 0xdd, 0x51, 0x75, 0x42, 0x6d,
-	0xc0, 0x30, 0x50, 0x28, 0x96, 0x8b, 0x2f, 0x29, 0x3f, 0x2f, 0x25, 0x28, 0x35, 0xa5, 0x34, 0xb9,
-	0x24, 0x33, 0x3f, 0xaf, 0x58, 0x82, 0x05, 0x6c, 0x85, 0x3e, 0x61, 0x2b, 0x9c, 0x90, 0xf5, 0x41,
-	0x2d, 0x40, 0x33, 0x4c, 0xc9, 0x93, 0x8b, 0x1f, 0xcd, 0x25, 0x42, 0x12, 0x5c, 0xec, 0x89, 0x29,
-	0x29, 0x45, 0xa9, 0xc5, 0x90, 0x80, 0xe7, 0x0c, 0x82, 0x71, 0x85, 0x64, 0xb8, 0x38, 0x8b, 0xf2,
-	0x73, 0x72, 0x12, 0x0b, 0x0a, 0x3c, 0x53, 0x24, 0x98, 0xc0, 0x72, 0x08, 0x01, 0xa7, 0x80, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xc7, 0x91, 0x2e, 0xca, 0x8c, 0xf5, 0x2b, 0x90, 0x12, 0x47, 0x49,
-	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x65, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0d,
-	0xdb, 0x09, 0xa8, 0xe4, 0x02, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x93, 0x6d, 0x64, 0xcc, 0xe5, 0x65, 0x58, 0x3b, 0x58, 0x15, 0x0a, 0xd5, 0x4e, 0x95,
+	0x80, 0x64, 0x74, 0x82, 0x0f, 0x50, 0x26, 0xa6, 0x49, 0x1c, 0xa2, 0x0e, 0x84, 0x84, 0xc4, 0x21,
+	0x2f, 0x26, 0x58, 0x4a, 0xfc, 0x98, 0x38, 0x41, 0x0b, 0x1f, 0x80, 0x33, 0x1f, 0x6b, 0xc7, 0x1d,
+	0x39, 0x21, 0xd4, 0x7e, 0x11, 0x54, 0xc7, 0x4d, 0xd3, 0x14, 0xe4, 0xdd, 0x1e, 0x3b, 0xcf, 0xff,
+	0xf7, 0xb3, 0x62, 0x3f, 0xc8, 0x4b, 0xea, 0x9c, 0x72, 0xc9, 0x80, 0x5f, 0xd5, 0xdf, 0xfd, 0x76,
+	0xe1, 0x4b, 0xfa, 0xb5, 0xa2, 0x3c, 0xa6, 0x85, 0x9f, 0x52, 0x4e, 0x25, 0x93, 0x9e, 0x28, 0xa0,
+	0x04, 0x3c, 0xea, 0xf6, 0xaf, 0xc3, 0x5e, 0xdb, 0x3f, 0x3c, 0x4a, 0x21, 0x05, 0xd5, 0xec, 0x2f,
+	0xab, 0x26, 0x37, 0x7c, 0x6e, 0xf4, 0x88, 0xb0, 0x08, 0x73, 0xad, 0x19, 0x9e, 0x18, 0xdb, 0xdb,
+	0xaa, 0x49, 0x1c, 0xff, 0x70, 0xd0, 0xbd, 0xf3, 0xe6, 0xa8, 0x97, 0x65, 0x58, 0x52, 0xfc, 0x06,
+	0x39, 0x0d, 0x92, 0xd8, 0x23, 0x7b, 0x3c, 0x98, 0x8c, 0x3d, 0xd3, 0xd1, 0xbd, 0x40, 0xf5, 0x4f,
+	0xf7, 0xae, 0x7f, 0x3f, 0xb1, 0x66, 0x3a, 0x8d, 0x3f, 0xa0, 0xfb, 0x6d, 0xc7, 0x5b, 0x26, 0x4b,
+	0xb2, 0x33, 0xda, 0x1d, 0x0f, 0x26, 0x4f, 0xcd, 0xb8, 0xcb, 0x55, 0xa5, 0x89, 0x9b, 0x1c, 0x1c,
+	0xa3, 0x43, 0xfd, 0x6f, 0x83, 0x02, 0x04, 0x48, 0x5a, 0x48, 0xb2, 0xab, 0xd8, 0x2f, 0xcc, 0xec,
+	0xf3, 0xcd, 0xa4, 0x36, 0x6c, 0x01, 0xf1, 0x27, 0xf4, 0x20, 0x02, 0x9e, 0xcc, 0x68, 0x52, 0xc5,
+	0x25, 0x03, 0x2e, 0xc9, 0x9e, 0x52, 0xf8, 0x66, 0xc5, 0xb4, 0x9b, 0xd3, 0x82, 0x1e, 0x0c, 0xbf,
+	0x43, 0x83, 0x84, 0x66, 0x34, 0x0d, 0x1b, 0xf6, 0x1d, 0xc5, 0x7e, 0x66, 0x66, 0x9f, 0xb5, 0x21,
+	0x0d, 0xee, 0x62, 0x30, 0xa0, 0xa3, 0x8a, 0x2f, 0x4d, 0x8c, 0xa7, 0x67, 0x1d, 0xbc, 0xa3, 0xf0,
+	0x2f, 0xcd, 0xf8, 0xf7, 0xdb, 0x69, 0xed, 0xf9, 0x27, 0x18, 0x7f, 0x46, 0x8f, 0x62, 0xc8, 0x05,
+	0xe5, 0x52, 0x6d, 0x04, 0x00, 0x99, 0x24, 0xfb, 0xca, 0x36, 0x31, 0xdb, 0x5e, 0xf7, 0xa2, 0x5a,
+	0xb5, 0x8d, 0xc4, 0x11, 0x3a, 0x14, 0xfa, 0x6a, 0x02, 0xc8, 0x58, 0xcc, 0xa8, 0x24, 0x77, 0x95,
+	0xe6, 0xe4, 0x16, 0xaf, 0xb3, 0x9b, 0xac, 0x57, 0x37, 0xde, 0xe7, 0x1d, 0x5f, 0xa0, 0x87, 0xbd,
+	0xc7, 0x81, 0x09, 0xda, 0x0f, 0x93, 0xa4, 0xa0, 0xb2, 0x99, 0x85, 0x83, 0xd9, 0x6a, 0x89, 0x1f,
+	0xa3, 0x83, 0x02, 0xb2, 0x2c, 0x14, 0xe2, 0x22, 0x21, 0x3b, 0xea, 0xdb, 0x7a, 0x63, 0x1a, 0x5c,
+	0xcf, 0x5d, 0xfb, 0x66, 0xee, 0xda, 0x7f, 0xe6, 0xae, 0xfd, 0x73, 0xe1, 0x5a, 0x37, 0x0b, 0xd7,
+	0xfa, 0xb5, 0x70, 0xad, 0x8f, 0xaf, 0x52, 0x56, 0x7e, 0xa9, 0x22, 0x2f, 0x86, 0xdc, 0xff, 0xcf,
+	0xa8, 0x7e, 0x3b, 0xf5, 0xaf, 0x3a, 0xf3, 0x5a, 0xd6, 0x82, 0xca, 0xc8, 0x51, 0xc3, 0x7a, 0xfa,
+	0x77, 0x00, 0xdc, 0xcf, 0x25, 0x65, 0x77, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -202,6 +244,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposerPolicies) > 0 {
+		for iNdEx := len(m.ProposerPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CompensationPools) > 0 {
+		for iNdEx := len(m.CompensationPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompensationPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.BondReductions) > 0 {
 		for iNdEx := len(m.BondReductions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -331,6 +429,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompensationPools) > 0 {
+		for _, e := range m.CompensationPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposerPolicies) > 0 {
+		for _, e := range m.ProposerPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -521,6 +643,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompensationPools = append(m.CompensationPools, CompensationPool{})
+			if err := m.CompensationPools[len(m.CompensationPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerPolicies = append(m.ProposerPolicies, ProposerPolicy{})
+			if err := m.ProposerPolicies[len(m.ProposerPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SequencerHooks event hooks for sequencer object (noalias)
+type SequencerHooks interface {
+	// AfterProposerRotated is called when the rotation of the proposer of a rollapp completes.
+	// The new proposer is empty if the rollapp is left with no proposer.
+	AfterProposerRotated(ctx sdk.Context, rollappId, newProposer string) error
+}
+
+type MultiSequencerHooks []SequencerHooks
+
+var _ SequencerHooks = MultiSequencerHooks{}
+
+func NewMultiSequencerHooks(hooks ...SequencerHooks) MultiSequencerHooks {
+	return hooks
+}
+
+func (h MultiSequencerHooks) AfterProposerRotated(ctx sdk.Context, rollappId, newProposer string) error {
+	for i := range h {
+		err := h[i].AfterProposerRotated(ctx, rollappId, newProposer)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type BaseSequencerHook struct{}
+
+var _ SequencerHooks = BaseSequencerHook{}
+
+func (b BaseSequencerHook) AfterProposerRotated(sdk.Context, string, string) error {
+	return nil
+}
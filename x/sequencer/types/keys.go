@@ -24,6 +24,9 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// CompensationPoolName is the module account holding the coins of the compensation pools
+	CompensationPoolName = ModuleName + "_compensation"
 )
 
 var (
@@ -47,6 +50,9 @@ var (
 	// It will be cleared after the rotation is completed
 	NextProposerKeyPrefix = []byte{0x03} // prefix/rollappId
 
+	// ProposerPolicyKeyPrefix is the prefix to retrieve the proposer election policy of a rollapp
+	ProposerPolicyKeyPrefix = []byte{0x04} // prefix/rollappId
+
 	// Prefixes for the different sequencer statuses
 	BondedSequencersKeyPrefix    = []byte{0xa1}
 	UnbondedSequencersKeyPrefix  = []byte{0xa2}
@@ -59,6 +65,15 @@ var (
 	DecreasingBondIndexKey     = []byte{0x44} // prefix for the index count for bond reductions
 	DecreasingBondSequencerKey = []byte{0x45} // prefix for the decreasing bond queue by sequencer
 	DecreasingBondIDKey        = []byte{0x46} // prefix for the decreasing bond count - used to generate ID
+
+	UnbondingDelegationQueueKey             = []byte{0x47} // prefix for the timestamps in unbonding delegation queue
+	UnbondingDelegationByDelegatorKeyPrefix = []byte{0x48} // prefix/delegatorAddr/queueKey
+	UnbondingDelegationBySequencerKeyPrefix = []byte{0x49} // prefix/seqAddr/queueKey
+
+	DelegationKeyPrefix            = []byte{0x50} // prefix/seqAddr/delegatorAddr
+	DelegationByDelegatorKeyPrefix = []byte{0x51} // prefix/delegatorAddr/seqAddr
+
+	CompensationPoolKeyPrefix = []byte{0x52} // prefix/rollappId
 )
 
 /* --------------------- specific sequencer address keys -------------------- */
@@ -162,3 +177,74 @@ func ProposerByRollappKey(rollappId string) []byte {
 func NextProposerByRollappKey(rollappId string) []byte {
 	return []byte(fmt.Sprintf("%s%s%s", NextProposerKeyPrefix, KeySeparator, []byte(rollappId)))
 }
+
+/* -------------------------- delegation keys -------------------------- */
+
+// DelegationsBySequencerKey returns the prefix of the delegations to a sequencer
+func DelegationsBySequencerKey(sequencerAddress string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", DelegationKeyPrefix, KeySeparator, sequencerAddress, KeySeparator))
+}
+
+// DelegationKey returns the store key of a delegation
+func DelegationKey(sequencerAddress, delegatorAddress string) []byte {
+	return append(DelegationsBySequencerKey(sequencerAddress), []byte(delegatorAddress)...)
+}
+
+// DelegationsByDelegatorKey returns the prefix of the index of the delegations of a delegator
+func DelegationsByDelegatorKey(delegatorAddress string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", DelegationByDelegatorKeyPrefix, KeySeparator, delegatorAddress, KeySeparator))
+}
+
+// DelegationByDelegatorKey returns the store key of the index of the delegations of a delegator
+func DelegationByDelegatorKey(delegatorAddress, sequencerAddress string) []byte {
+	return append(DelegationsByDelegatorKey(delegatorAddress), []byte(sequencerAddress)...)
+}
+
+func UnbondingDelegationQueueByTimeKey(endTime time.Time) []byte {
+	return utils.EncodeTimeToKey(UnbondingDelegationQueueKey, endTime)
+}
+
+func UnbondingDelegationKey(delegatorAddress, sequencerAddress string, endTime time.Time) []byte {
+	key := UnbondingDelegationQueueByTimeKey(endTime)
+	key = append(key, KeySeparator...)
+	key = append(key, []byte(delegatorAddress)...)
+	key = append(key, KeySeparator...)
+	key = append(key, []byte(sequencerAddress)...)
+	return key
+}
+
+// UnbondingDelegationsByDelegatorKey returns the prefix of the index of the unbonding delegations of a delegator
+func UnbondingDelegationsByDelegatorKey(delegatorAddress string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", UnbondingDelegationByDelegatorKeyPrefix, KeySeparator, delegatorAddress, KeySeparator))
+}
+
+// UnbondingDelegationByDelegatorKey returns the store key of the index of the unbonding delegations of a delegator.
+// The key ends with the key of the unbonding delegation in the queue.
+func UnbondingDelegationByDelegatorKey(delegatorAddress, sequencerAddress string, endTime time.Time) []byte {
+	return append(UnbondingDelegationsByDelegatorKey(delegatorAddress), UnbondingDelegationKey(delegatorAddress, sequencerAddress, endTime)...)
+}
+
+// UnbondingDelegationsBySequencerKey returns the prefix of the index of the unbonding delegations to a sequencer
+func UnbondingDelegationsBySequencerKey(sequencerAddress string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", UnbondingDelegationBySequencerKeyPrefix, KeySeparator, sequencerAddress, KeySeparator))
+}
+
+// UnbondingDelegationBySequencerKey returns the store key of the index of the unbonding delegations to a sequencer.
+// The key ends with the key of the unbonding delegation in the queue.
+func UnbondingDelegationBySequencerKey(delegatorAddress, sequencerAddress string, endTime time.Time) []byte {
+	return append(UnbondingDelegationsBySequencerKey(sequencerAddress), UnbondingDelegationKey(delegatorAddress, sequencerAddress, endTime)...)
+}
+
+/* ------------------------- compensation pool keys ------------------------- */
+
+// CompensationPoolKey returns the store key of the compensation pool of the rollapp
+func CompensationPoolKey(rollappId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", CompensationPoolKeyPrefix, KeySeparator, []byte(rollappId)))
+}
+
+/* -------------------------- proposer policy keys -------------------------- */
+
+// ProposerPolicyKey returns the store key of the proposer election policy of the rollapp
+func ProposerPolicyKey(rollappId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", ProposerPolicyKeyPrefix, KeySeparator, []byte(rollappId)))
+}
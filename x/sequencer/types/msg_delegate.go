@@ -0,0 +1,71 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+)
+
+/* ------------------------------ MsgDelegate ------------------------------ */
+func NewMsgDelegate(delegator, sequencerAddress string, amount sdk.Coin) *MsgDelegate {
+	return &MsgDelegate{
+		Delegator:        delegator,
+		SequencerAddress: sequencerAddress,
+		Amount:           amount,
+	}
+}
+
+func (msg *MsgDelegate) ValidateBasic() error {
+	return validateDelegation(msg.Delegator, msg.SequencerAddress, msg.Amount)
+}
+
+func (msg *MsgDelegate) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+/* ----------------------------- MsgUndelegate ----------------------------- */
+func NewMsgUndelegate(delegator, sequencerAddress string, amount sdk.Coin) *MsgUndelegate {
+	return &MsgUndelegate{
+		Delegator:        delegator,
+		SequencerAddress: sequencerAddress,
+		Amount:           amount,
+	}
+}
+
+func (msg *MsgUndelegate) ValidateBasic() error {
+	return validateDelegation(msg.Delegator, msg.SequencerAddress, msg.Amount)
+}
+
+func (msg *MsgUndelegate) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func validateDelegation(delegator, sequencerAddress string, amount sdk.Coin) error {
+	_, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(sequencerAddress)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid sequencer address (%s)", err)
+	}
+
+	if !(amount.IsValid() && amount.IsPositive()) {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid delegation amount: %s", amount.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgPayCompensation{}
+
+// GetSigners implements types.Msg.
+func (m *MsgPayCompensation) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic implements types.Msg.
+func (m *MsgPayCompensation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	if m.RollappId == "" {
+		return errorsmod.Wrap(ErrInvalidRequest, "empty rollapp id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid amount: %s", m.Amount)
+	}
+
+	return nil
+}
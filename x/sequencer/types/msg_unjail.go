@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgUnjailSequencer{}
+	_ sdk.Msg = &MsgPermitUnjail{}
+)
+
+/* -------------------------- MsgUnjailSequencer -------------------------- */
+func NewMsgUnjailSequencer(creator string, bond sdk.Coin) *MsgUnjailSequencer {
+	return &MsgUnjailSequencer{
+		Creator: creator,
+		Bond:    bond,
+	}
+}
+
+func (msg *MsgUnjailSequencer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if !msg.Bond.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid bond amount: %s", msg.Bond.String())
+	}
+
+	return nil
+}
+
+func (msg *MsgUnjailSequencer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+/* ---------------------------- MsgPermitUnjail ---------------------------- */
+
+// GetSigners implements types.Msg.
+func (m *MsgPermitUnjail) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic implements types.Msg.
+func (m *MsgPermitUnjail) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.SequencerAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid sequencer address (%s)", err)
+	}
+
+	return nil
+}
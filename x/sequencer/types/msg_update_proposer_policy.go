@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUpdateProposerPolicy{}
+
+func NewMsgUpdateProposerPolicy(owner string, policy ProposerPolicy) *MsgUpdateProposerPolicy {
+	return &MsgUpdateProposerPolicy{
+		Owner:  owner,
+		Policy: policy,
+	}
+}
+
+func (msg *MsgUpdateProposerPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if msg.Policy.RollappId == "" {
+		return errorsmod.Wrap(ErrInvalidRequest, "rollapp id cannot be empty")
+	}
+
+	if err := msg.Policy.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (msg *MsgUpdateProposerPolicy) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
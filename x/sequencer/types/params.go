@@ -18,15 +18,36 @@ var (
 	DefaultNoticePeriod time.Duration = time.Hour * 24 * 7 // 1 week
 	// DefaultLivenessSlashMultiplier gives the amount of tokens to slash if the sequencer is liable for a liveness failure
 	DefaultLivenessSlashMultiplier sdk.Dec = sdk.MustNewDecFromStr("0.01907") // leaves 50% of original funds remaining after 48 slashes
+	// DefaultFraudSlashFraction slashes all the tokens of a fraudulent sequencer
+	DefaultFraudSlashFraction sdk.Dec = sdk.OneDec()
+	// DefaultLivenessMinJailDuration is the minimum time a sequencer jailed for a liveness failure stays jailed
+	DefaultLivenessMinJailDuration time.Duration = time.Hour * 24 // 1 day
+	// DefaultLivenessUnjailFee is the fee to unjail a sequencer jailed for a liveness failure
+	DefaultLivenessUnjailFee uint64 = 100000
+	// DefaultFraudMinJailDuration is the minimum time a sequencer jailed for a fraud stays jailed
+	DefaultFraudMinJailDuration time.Duration = time.Hour * 24 * 30 // 30 days
+	// DefaultFraudUnjailFee is the fee to unjail a sequencer jailed for a fraud
+	DefaultFraudUnjailFee uint64 = 1000000
+	// DefaultSlashDistribution pays a tenth of the slashed tokens to the reporter and burns the rest
+	DefaultSlashDistribution = SlashDistribution{
+		CompensationPool: sdk.ZeroDec(),
+		CommunityPool:    sdk.ZeroDec(),
+		Burn:             sdk.MustNewDecFromStr("0.9"),
+		Reporter:         sdk.MustNewDecFromStr("0.1"),
+	}
 )
 
 // NewParams creates a new Params instance
-func NewParams(minBond sdk.Coin, unbondingPeriod, noticePeriod time.Duration, livenessSlashMul sdk.Dec) Params {
+func NewParams(minBond sdk.Coin, unbondingPeriod, noticePeriod time.Duration, livenessSlashMul sdk.Dec, slashDistribution SlashDistribution, fraudSlashFraction sdk.Dec, livenessUnjail, fraudUnjail UnjailPolicy) Params {
 	return Params{
 		MinBond:                 minBond,
 		UnbondingTime:           unbondingPeriod,
 		NoticePeriod:            noticePeriod,
 		LivenessSlashMultiplier: livenessSlashMul,
+		SlashDistribution:       slashDistribution,
+		FraudSlashFraction:      fraudSlashFraction,
+		LivenessUnjail:          livenessUnjail,
+		FraudUnjail:             fraudUnjail,
 	}
 }
 
@@ -39,6 +60,15 @@ func DefaultParams() Params {
 	minBond := sdk.NewCoin(denom, sdk.NewIntFromUint64(DefaultMinBond))
 	return NewParams(
 		minBond, DefaultUnbondingTime, DefaultNoticePeriod, DefaultLivenessSlashMultiplier,
+		DefaultSlashDistribution, DefaultFraudSlashFraction,
+		UnjailPolicy{
+			MinJailDuration: DefaultLivenessMinJailDuration,
+			Fee:             sdk.NewCoin(denom, sdk.NewIntFromUint64(DefaultLivenessUnjailFee)),
+		},
+		UnjailPolicy{
+			MinJailDuration: DefaultFraudMinJailDuration,
+			Fee:             sdk.NewCoin(denom, sdk.NewIntFromUint64(DefaultFraudUnjailFee)),
+		},
 	)
 }
 
@@ -75,6 +105,46 @@ func validateLivenessSlashMultiplier(i interface{}) error {
 	return uparam.ValidateZeroToOneDec(i)
 }
 
+func validateFraudSlashFraction(i interface{}) error {
+	return uparam.ValidateZeroToOneDec(i)
+}
+
+// ValidateBasic checks every share is between zero and one, and the shares sum to one.
+func (d SlashDistribution) ValidateBasic() error {
+	for _, share := range []sdk.Dec{d.Reporter, d.CompensationPool, d.CommunityPool, d.Burn} {
+		if err := uparam.ValidateZeroToOneDec(share); err != nil {
+			return err
+		}
+	}
+	if !d.Reporter.Add(d.CompensationPool).Add(d.CommunityPool).Add(d.Burn).Equal(sdk.OneDec()) {
+		return fmt.Errorf("slash distribution shares must sum to one")
+	}
+	return nil
+}
+
+// ValidateBasic checks the duration is not negative and the fee is valid.
+func (u UnjailPolicy) ValidateBasic() error {
+	if u.MinJailDuration < 0 {
+		return fmt.Errorf("min jail duration must not be negative: %d", u.MinJailDuration)
+	}
+	if !u.Fee.IsValid() {
+		return fmt.Errorf("invalid fee: %s", u.Fee)
+	}
+	return nil
+}
+
+// IsStricterThan returns true if the policy requires at least the duration and the fee of the other policy.
+// The fees must be in the same denom to be compared.
+func (u UnjailPolicy) IsStricterThan(other UnjailPolicy) bool {
+	if u.MinJailDuration < other.MinJailDuration {
+		return false
+	}
+	if u.Fee.Denom != other.Fee.Denom || u.Fee.Amount.LT(other.Fee.Amount) {
+		return false
+	}
+	return true
+}
+
 // ValidateBasic validates the set of params
 func (p Params) ValidateBasic() error {
 	if err := validateMinBond(p.MinBond); err != nil {
@@ -93,6 +163,26 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := p.SlashDistribution.ValidateBasic(); err != nil {
+		return fmt.Errorf("slash distribution: %w", err)
+	}
+
+	if err := validateFraudSlashFraction(p.FraudSlashFraction); err != nil {
+		return err
+	}
+
+	if err := p.LivenessUnjail.ValidateBasic(); err != nil {
+		return fmt.Errorf("liveness unjail: %w", err)
+	}
+
+	if err := p.FraudUnjail.ValidateBasic(); err != nil {
+		return fmt.Errorf("fraud unjail: %w", err)
+	}
+
+	if !p.FraudUnjail.IsStricterThan(p.LivenessUnjail) {
+		return fmt.Errorf("fraud unjail must be at least as strict as liveness unjail")
+	}
+
 	return nil
 }
 
@@ -40,6 +40,18 @@ type Params struct {
 	NoticePeriod time.Duration `protobuf:"bytes,3,opt,name=notice_period,json=noticePeriod,proto3,stdduration" json:"notice_period"`
 	// LivenessSlashMultiplier multiplies with the tokens of the slashed sequencer to compute the burn amount.
 	LivenessSlashMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=liveness_slash_multiplier,json=livenessSlashMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liveness_slash_multiplier" yaml:"liveness_slash_multiplier"`
+	// SlashDistribution defines how the slashed tokens of a sequencer are split, including the reward of the
+	// reporter of the fault.
+	SlashDistribution SlashDistribution `protobuf:"bytes,6,opt,name=slash_distribution,json=slashDistribution,proto3" json:"slash_distribution" yaml:"slash_distribution"`
+	// FraudSlashFraction is the fraction of the tokens of a sequencer found fraudulent that is slashed before
+	// it is jailed. It applies as well to the delegated bond which started unbonding after the fraud.
+	// The rest of the bond is refunded.
+	FraudSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=fraud_slash_fraction,json=fraudSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraud_slash_fraction" yaml:"fraud_slash_fraction"`
+	// LivenessUnjail defines the conditions to unjail a sequencer jailed for a liveness failure.
+	LivenessUnjail UnjailPolicy `protobuf:"bytes,8,opt,name=liveness_unjail,json=livenessUnjail,proto3" json:"liveness_unjail" yaml:"liveness_unjail"`
+	// FraudUnjail defines the conditions to unjail a sequencer jailed for a fraud. It must be at least as strict
+	// as LivenessUnjail. The unjailing must also be permitted by the governance.
+	FraudUnjail UnjailPolicy `protobuf:"bytes,9,opt,name=fraud_unjail,json=fraudUnjail,proto3" json:"fraud_unjail" yaml:"fraud_unjail"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -95,8 +107,132 @@ func (m *Params) GetNoticePeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetSlashDistribution() SlashDistribution {
+	if m != nil {
+		return m.SlashDistribution
+	}
+	return SlashDistribution{}
+}
+
+func (m *Params) GetLivenessUnjail() UnjailPolicy {
+	if m != nil {
+		return m.LivenessUnjail
+	}
+	return UnjailPolicy{}
+}
+
+func (m *Params) GetFraudUnjail() UnjailPolicy {
+	if m != nil {
+		return m.FraudUnjail
+	}
+	return UnjailPolicy{}
+}
+
+// UnjailPolicy defines the conditions to unjail a sequencer.
+type UnjailPolicy struct {
+	// min_jail_duration is the minimum time the sequencer stays jailed.
+	MinJailDuration time.Duration `protobuf:"bytes,1,opt,name=min_jail_duration,json=minJailDuration,proto3,stdduration" json:"min_jail_duration"`
+	// fee is paid by the sequencer to unjail. It is distributed as slashed tokens.
+	Fee types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *UnjailPolicy) Reset()         { *m = UnjailPolicy{} }
+func (m *UnjailPolicy) String() string { return proto.CompactTextString(m) }
+func (*UnjailPolicy) ProtoMessage()    {}
+func (*UnjailPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599b0eefba99ee26, []int{1}
+}
+func (m *UnjailPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnjailPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnjailPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnjailPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnjailPolicy.Merge(m, src)
+}
+func (m *UnjailPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnjailPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnjailPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnjailPolicy proto.InternalMessageInfo
+
+func (m *UnjailPolicy) GetMinJailDuration() time.Duration {
+	if m != nil {
+		return m.MinJailDuration
+	}
+	return 0
+}
+
+func (m *UnjailPolicy) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+// SlashDistribution defines the shares of the slashed tokens going to each destination. The shares must sum to one.
+type SlashDistribution struct {
+	// compensation_pool is the share paid into the compensation pool of the users of the rollapp.
+	CompensationPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=compensation_pool,json=compensationPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"compensation_pool" yaml:"compensation_pool"`
+	// community_pool is the share paid into the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool" yaml:"community_pool"`
+	// burn is the share burned.
+	Burn github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=burn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn" yaml:"burn"`
+	// reporter is the share paid to the reporter of the fault, the submitter of a fraud proof. Faults with no
+	// reporter, like liveness failures which the hub detects by itself, burn this share.
+	Reporter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reporter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reporter" yaml:"reporter"`
+}
+
+func (m *SlashDistribution) Reset()         { *m = SlashDistribution{} }
+func (m *SlashDistribution) String() string { return proto.CompactTextString(m) }
+func (*SlashDistribution) ProtoMessage()    {}
+func (*SlashDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599b0eefba99ee26, []int{2}
+}
+func (m *SlashDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashDistribution.Merge(m, src)
+}
+func (m *SlashDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashDistribution proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.sequencer.Params")
+	proto.RegisterType((*UnjailPolicy)(nil), "dymensionxyz.dymension.sequencer.UnjailPolicy")
+	proto.RegisterType((*SlashDistribution)(nil), "dymensionxyz.dymension.sequencer.SlashDistribution")
 }
 
 func init() {
@@ -104,34 +240,54 @@ func init() {
 }
 
 var fileDescriptor_599b0eefba99ee26 = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x8b, 0xd4, 0x40,
-	0x18, 0xc6, 0x33, 0x9e, 0x9c, 0x6b, 0xf4, 0x2c, 0x82, 0xe0, 0xde, 0x16, 0x49, 0xd8, 0x42, 0xae,
-	0xf0, 0x66, 0x38, 0x0f, 0x2c, 0xae, 0x8c, 0x57, 0x88, 0x20, 0x84, 0x68, 0x65, 0x13, 0xf2, 0xe7,
-	0x35, 0x37, 0x98, 0x99, 0x37, 0x66, 0x26, 0xcb, 0xc5, 0xaf, 0x20, 0x88, 0xe5, 0x95, 0xf7, 0x71,
-	0xae, 0xbc, 0x52, 0x2c, 0xa2, 0xec, 0x36, 0x62, 0xe9, 0x27, 0x90, 0xfc, 0x65, 0x9b, 0x05, 0xab,
-	0xe4, 0x9d, 0xe7, 0x79, 0x7e, 0x3c, 0xbc, 0xbc, 0xe6, 0x71, 0x5a, 0x0b, 0x90, 0x8a, 0xa3, 0xbc,
-	0xac, 0x3f, 0xb3, 0x69, 0x60, 0x0a, 0x3e, 0x55, 0x20, 0x13, 0x28, 0x59, 0x11, 0x95, 0x91, 0x50,
-	0xb4, 0x28, 0x51, 0xa3, 0xe5, 0x6e, 0xdb, 0xe9, 0x34, 0xd0, 0xc9, 0xbe, 0x78, 0x9c, 0x61, 0x86,
-	0x9d, 0x99, 0xb5, 0x7f, 0x7d, 0x6e, 0x61, 0x27, 0xa8, 0x04, 0x2a, 0x16, 0x47, 0x0a, 0xd8, 0xea,
-	0x24, 0x06, 0x1d, 0x9d, 0xb0, 0x04, 0xb9, 0x1c, 0xf5, 0x0c, 0x31, 0xcb, 0x81, 0x75, 0x53, 0x5c,
-	0x7d, 0x60, 0x69, 0x55, 0x46, 0xba, 0x25, 0x77, 0x2f, 0xcb, 0x2f, 0x7b, 0xe6, 0xbe, 0xdf, 0x15,
-	0xb1, 0x7c, 0x73, 0x26, 0xb8, 0x0c, 0x63, 0x94, 0xe9, 0x9c, 0xb8, 0xe4, 0xe8, 0xc1, 0xf3, 0x43,
-	0xda, 0xd3, 0x69, 0x4b, 0xa7, 0x03, 0x9d, 0xbe, 0x44, 0x2e, 0xbd, 0xc5, 0x4d, 0xe3, 0x18, 0x7f,
-	0x1a, 0xc7, 0x1a, 0x23, 0xcf, 0x50, 0x70, 0x0d, 0xa2, 0xd0, 0x75, 0x70, 0x4f, 0x70, 0xe9, 0xa1,
-	0x4c, 0xad, 0xd7, 0xe6, 0xa3, 0x4a, 0xb6, 0x22, 0x97, 0x59, 0xa8, 0xb9, 0x80, 0xf9, 0x9d, 0x81,
-	0xdb, 0xb7, 0xa2, 0x63, 0x2b, 0x7a, 0x3e, 0xb4, 0xf2, 0x66, 0x2d, 0xf7, 0xea, 0xa7, 0x43, 0x82,
-	0x83, 0x29, 0xfa, 0x8e, 0x0b, 0xb0, 0x5e, 0x99, 0x07, 0x12, 0x35, 0x4f, 0x20, 0x2c, 0xa0, 0xe4,
-	0x98, 0xce, 0xf7, 0xfe, 0x1f, 0xf5, 0xb0, 0x4f, 0xfa, 0x5d, 0xd0, 0xfa, 0x4a, 0xcc, 0xc3, 0x9c,
-	0xaf, 0x40, 0x82, 0x52, 0xa1, 0xca, 0x23, 0x75, 0x11, 0x8a, 0x2a, 0xd7, 0xbc, 0xc8, 0x39, 0x94,
-	0xf3, 0xbb, 0x2e, 0x39, 0xba, 0xef, 0x05, 0x6d, 0xf6, 0x47, 0xe3, 0x3c, 0xcd, 0xb8, 0xbe, 0xa8,
-	0x62, 0x9a, 0xa0, 0x60, 0xc3, 0xa6, 0xfb, 0xcf, 0xb1, 0x4a, 0x3f, 0x32, 0x5d, 0x17, 0xa0, 0xe8,
-	0x39, 0x24, 0x7f, 0x1b, 0xc7, 0xad, 0x23, 0x91, 0x9f, 0x2d, 0x77, 0x82, 0x97, 0xc1, 0x93, 0x51,
-	0x7b, 0xdb, 0x4a, 0x6f, 0x26, 0xe5, 0x6c, 0x76, 0x75, 0xed, 0x18, 0xbf, 0xaf, 0x1d, 0xe2, 0xf9,
-	0x37, 0x6b, 0x9b, 0xdc, 0xae, 0x6d, 0xf2, 0x6b, 0x6d, 0x93, 0x6f, 0x1b, 0xdb, 0xb8, 0xdd, 0xd8,
-	0xc6, 0xf7, 0x8d, 0x6d, 0xbc, 0x7f, 0xb1, 0x55, 0x64, 0xc7, 0x65, 0xad, 0x4e, 0xd9, 0xe5, 0xd6,
-	0x79, 0x75, 0xe5, 0xe2, 0xfd, 0x6e, 0x2f, 0xa7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x40,
-	0xbb, 0x89, 0x8f, 0x02, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4d, 0x4f, 0xdb, 0x48,
+	0x18, 0xc7, 0x63, 0xc8, 0x86, 0x64, 0x78, 0xcd, 0x2c, 0xbb, 0x6b, 0x40, 0xb2, 0xb3, 0x3e, 0xac,
+	0x38, 0x2c, 0xb6, 0x58, 0xa4, 0x3d, 0x20, 0xed, 0x61, 0xbd, 0x68, 0x5b, 0x45, 0x42, 0x4d, 0xdd,
+	0xf6, 0x52, 0xa9, 0x8a, 0xfc, 0x32, 0x09, 0xd3, 0x7a, 0x66, 0x5c, 0xbf, 0x00, 0xe9, 0xa5, 0x87,
+	0xaa, 0xd7, 0xaa, 0x47, 0x8e, 0x7c, 0x88, 0x7e, 0x08, 0x8e, 0x1c, 0xab, 0x1e, 0xd2, 0x0a, 0x2e,
+	0x55, 0x8f, 0x7c, 0x82, 0x6a, 0x66, 0x6c, 0xd7, 0x82, 0x22, 0x48, 0x4f, 0xc9, 0x3c, 0x2f, 0xbf,
+	0xff, 0xdf, 0xcf, 0xcc, 0xd8, 0x60, 0x23, 0x18, 0x11, 0x44, 0x13, 0xcc, 0xe8, 0xe1, 0xe8, 0x85,
+	0x55, 0x2e, 0xac, 0x04, 0x3d, 0xcf, 0x10, 0xf5, 0x51, 0x6c, 0x45, 0x6e, 0xec, 0x92, 0xc4, 0x8c,
+	0x62, 0x96, 0x32, 0xd8, 0xa9, 0x96, 0x9b, 0xe5, 0xc2, 0x2c, 0xcb, 0x57, 0x97, 0x87, 0x6c, 0xc8,
+	0x44, 0xb1, 0xc5, 0xff, 0xc9, 0xbe, 0x55, 0xcd, 0x67, 0x09, 0x61, 0x89, 0xe5, 0xb9, 0x09, 0xb2,
+	0xf6, 0x37, 0x3d, 0x94, 0xba, 0x9b, 0x96, 0xcf, 0x30, 0x2d, 0xf2, 0x43, 0xc6, 0x86, 0x21, 0xb2,
+	0xc4, 0xca, 0xcb, 0x06, 0x56, 0x90, 0xc5, 0x6e, 0xca, 0xc9, 0x22, 0x62, 0xbc, 0x9a, 0x01, 0x8d,
+	0x9e, 0x30, 0x02, 0x7b, 0xa0, 0x49, 0x30, 0xed, 0x7b, 0x8c, 0x06, 0xaa, 0xd2, 0x51, 0xd6, 0x67,
+	0xff, 0x5a, 0x31, 0x25, 0xdd, 0xe4, 0x74, 0x33, 0xa7, 0x9b, 0xff, 0x31, 0x4c, 0xed, 0xd5, 0x93,
+	0xb1, 0x5e, 0xfb, 0x32, 0xd6, 0x61, 0xd1, 0xf2, 0x27, 0x23, 0x38, 0x45, 0x24, 0x4a, 0x47, 0xce,
+	0x0c, 0xc1, 0xd4, 0x66, 0x34, 0x80, 0x5d, 0xb0, 0x90, 0x51, 0x9e, 0xc4, 0x74, 0xd8, 0x4f, 0x31,
+	0x41, 0xea, 0x54, 0xce, 0x95, 0xae, 0xcc, 0xc2, 0x95, 0xb9, 0x93, 0xbb, 0xb2, 0x9b, 0x9c, 0x7b,
+	0xf4, 0x51, 0x57, 0x9c, 0xf9, 0xb2, 0xf5, 0x21, 0x26, 0x08, 0xde, 0x05, 0xf3, 0x94, 0xa5, 0xd8,
+	0x47, 0xfd, 0x08, 0xc5, 0x98, 0x05, 0xea, 0xf4, 0xed, 0x51, 0x73, 0xb2, 0xb3, 0x27, 0x1a, 0xe1,
+	0x1b, 0x05, 0xac, 0x84, 0x78, 0x1f, 0x51, 0x94, 0x24, 0xfd, 0x24, 0x74, 0x93, 0xbd, 0x3e, 0xc9,
+	0xc2, 0x14, 0x47, 0x21, 0x46, 0xb1, 0x5a, 0xef, 0x28, 0xeb, 0x2d, 0xdb, 0xe1, 0xbd, 0x1f, 0xc6,
+	0xfa, 0x1f, 0x43, 0x9c, 0xee, 0x65, 0x9e, 0xe9, 0x33, 0x62, 0xe5, 0x93, 0x96, 0x3f, 0x1b, 0x49,
+	0xf0, 0xcc, 0x4a, 0x47, 0x11, 0x4a, 0xcc, 0x1d, 0xe4, 0x5f, 0x8c, 0xf5, 0xce, 0xc8, 0x25, 0xe1,
+	0xb6, 0x71, 0x2d, 0xd8, 0x70, 0x7e, 0x2b, 0x72, 0x0f, 0x78, 0x6a, 0xb7, 0xcc, 0xc0, 0xd7, 0x0a,
+	0x80, 0xb2, 0x3c, 0xc0, 0x49, 0x1a, 0x63, 0x2f, 0xe3, 0xfe, 0xd5, 0x86, 0x78, 0xc0, 0x2d, 0xf3,
+	0xa6, 0x93, 0x61, 0x0a, 0xde, 0x4e, 0xa5, 0xd5, 0xfe, 0x9d, 0xdb, 0xbf, 0x18, 0xeb, 0x2b, 0xd2,
+	0xd4, 0x55, 0xb8, 0xe1, 0xb4, 0x93, 0xcb, 0x5d, 0xf0, 0x25, 0x58, 0x1e, 0xc4, 0x6e, 0x16, 0xe4,
+	0xde, 0x07, 0xb1, 0xeb, 0x0b, 0x23, 0x33, 0x62, 0x24, 0xbb, 0x13, 0x8f, 0x64, 0x4d, 0xaa, 0x7f,
+	0x8f, 0x69, 0x38, 0x50, 0x84, 0x85, 0xf5, 0xff, 0xf3, 0x20, 0x3c, 0x00, 0x8b, 0xe5, 0xfc, 0x32,
+	0xfa, 0xd4, 0xc5, 0xa1, 0xda, 0x14, 0x43, 0x30, 0x6f, 0x1e, 0xc2, 0x23, 0x51, 0xdf, 0x63, 0x21,
+	0xf6, 0x47, 0xb6, 0x96, 0x3f, 0xff, 0xaf, 0x97, 0x36, 0x45, 0x42, 0x0d, 0x67, 0xa1, 0x88, 0xc8,
+	0x2e, 0x48, 0xc1, 0x9c, 0x74, 0x99, 0xab, 0xb6, 0x7e, 0x48, 0x75, 0x2d, 0x57, 0xfd, 0xb9, 0xfa,
+	0xdc, 0x85, 0xe4, 0xac, 0x58, 0xca, 0xfa, 0xed, 0xe6, 0xd1, 0xb1, 0x5e, 0xfb, 0x7c, 0xac, 0x2b,
+	0xdd, 0x7a, 0xf3, 0xa7, 0xa5, 0x86, 0x71, 0xa4, 0x80, 0xb9, 0x2a, 0x0a, 0xde, 0x03, 0x6d, 0x7e,
+	0xb1, 0x78, 0xa4, 0x5f, 0xdc, 0x58, 0x55, 0xb9, 0xfd, 0x89, 0x5f, 0x24, 0x98, 0x76, 0x5d, 0x1c,
+	0x16, 0x29, 0xb8, 0x09, 0xa6, 0x07, 0xe8, 0xdb, 0xfd, 0xbb, 0xf6, 0x5e, 0xd7, 0x39, 0xc2, 0xe1,
+	0xb5, 0xdb, 0x75, 0x6e, 0xd0, 0x78, 0x37, 0x0d, 0xda, 0x57, 0x0e, 0x18, 0x3c, 0x00, 0x6d, 0x9f,
+	0x91, 0x08, 0xd1, 0x44, 0xe0, 0xfb, 0x11, 0x63, 0xa1, 0xf0, 0xd7, 0xb2, 0xbb, 0x13, 0x9f, 0x13,
+	0x55, 0xce, 0xeb, 0x0a, 0xd0, 0x70, 0x96, 0xaa, 0xb1, 0x1e, 0x63, 0x7c, 0xa7, 0x16, 0x7c, 0x46,
+	0x48, 0x46, 0x71, 0x3a, 0x92, 0xaa, 0x53, 0x42, 0xf5, 0xce, 0xc4, 0xaa, 0xbf, 0x94, 0xaa, 0x15,
+	0x9a, 0xe1, 0xcc, 0x97, 0x01, 0xa1, 0x77, 0x1f, 0xd4, 0xbd, 0x2c, 0xa6, 0xe2, 0x6d, 0xd3, 0xb2,
+	0xff, 0x99, 0x58, 0x65, 0x56, 0xaa, 0x70, 0x86, 0xe1, 0x08, 0x14, 0x7c, 0x02, 0x9a, 0x31, 0x8a,
+	0x58, 0x9c, 0x96, 0x6f, 0x9b, 0x7f, 0x27, 0xc6, 0x2e, 0x4a, 0x6c, 0xc1, 0x31, 0x9c, 0x12, 0x29,
+	0xb7, 0xcd, 0xee, 0x9d, 0x9c, 0x69, 0xca, 0xe9, 0x99, 0xa6, 0x7c, 0x3a, 0xd3, 0x94, 0xb7, 0xe7,
+	0x5a, 0xed, 0xf4, 0x5c, 0xab, 0xbd, 0x3f, 0xd7, 0x6a, 0x8f, 0xff, 0xae, 0x88, 0x5c, 0xf3, 0x8d,
+	0xda, 0xdf, 0xb2, 0x0e, 0x2b, 0x1f, 0x2a, 0x21, 0xec, 0x35, 0xc4, 0x79, 0xdb, 0xfa, 0x3a, 0x00,
+	0xd4, 0x2d, 0x22, 0xed, 0xd9, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -165,6 +321,78 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.LivenessSlashMultiplier.Equal(that1.LivenessSlashMultiplier) {
 		return false
 	}
+	if !this.SlashDistribution.Equal(&that1.SlashDistribution) {
+		return false
+	}
+	if !this.FraudSlashFraction.Equal(that1.FraudSlashFraction) {
+		return false
+	}
+	if !this.LivenessUnjail.Equal(&that1.LivenessUnjail) {
+		return false
+	}
+	if !this.FraudUnjail.Equal(&that1.FraudUnjail) {
+		return false
+	}
+	return true
+}
+func (this *UnjailPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnjailPolicy)
+	if !ok {
+		that2, ok := that.(UnjailPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinJailDuration != that1.MinJailDuration {
+		return false
+	}
+	if !this.Fee.Equal(&that1.Fee) {
+		return false
+	}
+	return true
+}
+func (this *SlashDistribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashDistribution)
+	if !ok {
+		that2, ok := that.(SlashDistribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CompensationPool.Equal(that1.CompensationPool) {
+		return false
+	}
+	if !this.CommunityPool.Equal(that1.CommunityPool) {
+		return false
+	}
+	if !this.Burn.Equal(that1.Burn) {
+		return false
+	}
+	if !this.Reporter.Equal(that1.Reporter) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +415,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FraudUnjail.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.LivenessUnjail.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FraudSlashFraction.Size()
+		i -= size
+		if _, err := m.FraudSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.SlashDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.LivenessSlashMultiplier.Size()
 		i -= size
@@ -197,20 +465,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
-	if err1 != nil {
-		return 0, err1
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -218,49 +486,507 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintParams(dAtA, i, uint64(size))
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UnjailPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnjailPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnjailPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinJailDuration):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Reporter.Size()
+		i -= size
+		if _, err := m.Reporter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CompensationPool.Size()
+		i -= size
+		if _, err := m.CompensationPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LivenessSlashMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashDistribution.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FraudSlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LivenessUnjail.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FraudUnjail.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *UnjailPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinJailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *SlashDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CompensationPool.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Reporter.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoticePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.NoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessSlashMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LivenessSlashMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FraudSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessUnjail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LivenessUnjail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudUnjail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FraudUnjail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.MinBond.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
-	n += 1 + l + sovParams(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod)
-	n += 1 + l + sovParams(uint64(l))
-	l = m.LivenessSlashMultiplier.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *UnjailPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -283,15 +1009,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnjailPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnjailPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinJailDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -318,13 +1044,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinJailDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -351,15 +1077,133 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompensationPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompensationPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoticePeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -369,28 +1213,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.NoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LivenessSlashMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -418,7 +1263,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LivenessSlashMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -48,6 +48,38 @@ func TestValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"slash distribution shares not summing to one",
+			func() Params {
+				p := DefaultParams()
+				p.SlashDistribution.CommunityPool = sdk.MustNewDecFromStr("0.5")
+				return p
+			}(),
+			true,
+		},
+		{
+			"fraud unjail fee in another denom than liveness unjail fee",
+			func() Params {
+				p := DefaultParams()
+				p.FraudUnjail.Fee = sdk.NewCoin("other", p.FraudUnjail.Fee.Amount)
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid slash distribution",
+			func() Params {
+				p := DefaultParams()
+				p.SlashDistribution = SlashDistribution{
+					CompensationPool: sdk.MustNewDecFromStr("0.4"),
+					CommunityPool:    sdk.MustNewDecFromStr("0.25"),
+					Burn:             sdk.MustNewDecFromStr("0.25"),
+					Reporter:         sdk.MustNewDecFromStr("0.1"),
+				}
+				return p
+			}(),
+			false,
+		},
 	}
 
 	for _, tt := range tests {
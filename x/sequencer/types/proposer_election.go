@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProposerElection elects the next proposer of a rollapp among its bonded sequencers.
+// Implementations must be deterministic: ties are broken by the sequencer address.
+type ProposerElection interface {
+	// NextProposer returns the elected sequencer among the candidates, excluding the current proposer.
+	// Returns false if there is no candidate.
+	NextProposer(proposer string, candidates []Sequencer) (Sequencer, bool)
+}
+
+// NewProposerElection returns the election implementing the policy.
+func NewProposerElection(policy ProposerPolicy) ProposerElection {
+	switch policy.Type {
+	case ProposerPolicyRoundRobin:
+		return roundRobinElection{}
+	case ProposerPolicySuccessorList:
+		return successorListElection{successors: policy.Successors}
+	case ProposerPolicyLongestBonded:
+		return longestBondedElection{}
+	default:
+		return highestBondElection{}
+	}
+}
+
+// DefaultProposerPolicy returns the policy of the rollapps whose owner did not set one.
+func DefaultProposerPolicy(rollappId string) ProposerPolicy {
+	return ProposerPolicy{RollappId: rollappId, Type: ProposerPolicyHighestBond}
+}
+
+// ValidateBasic checks the policy is known and the successors are only set for the successor list policy.
+func (p ProposerPolicy) ValidateBasic() error {
+	if _, ok := ProposerPolicyType_name[int32(p.Type)]; !ok {
+		return fmt.Errorf("unknown proposer policy: %d", p.Type)
+	}
+	if p.Type != ProposerPolicySuccessorList {
+		if len(p.Successors) != 0 {
+			return fmt.Errorf("successors can only be set for the successor list policy")
+		}
+		return nil
+	}
+	if len(p.Successors) == 0 {
+		return fmt.Errorf("successor list policy requires successors")
+	}
+	seen := make(map[string]struct{}, len(p.Successors))
+	for _, s := range p.Successors {
+		if _, err := sdk.AccAddressFromBech32(s); err != nil {
+			return fmt.Errorf("invalid successor address: %s: %w", s, err)
+		}
+		if _, ok := seen[s]; ok {
+			return fmt.Errorf("duplicated successor: %s", s)
+		}
+		seen[s] = struct{}{}
+	}
+	return nil
+}
+
+// excludeProposer returns the candidates other than the proposer, sorted by address.
+func excludeProposer(proposer string, candidates []Sequencer) []Sequencer {
+	ret := make([]Sequencer, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Address != proposer {
+			ret = append(ret, c)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Address < ret[j].Address
+	})
+	return ret
+}
+
+// highestBondElection elects the sequencer with the highest bond.
+type highestBondElection struct{}
+
+func (highestBondElection) NextProposer(proposer string, candidates []Sequencer) (Sequencer, bool) {
+	seqs := excludeProposer(proposer, candidates)
+	if len(seqs) == 0 {
+		return Sequencer{}, false
+	}
+	sort.SliceStable(seqs, func(i, j int) bool {
+		return seqs[i].Tokens.IsAllGT(seqs[j].Tokens)
+	})
+	return seqs[0], true
+}
+
+// roundRobinElection elects the sequencer following the proposer, by address order.
+type roundRobinElection struct{}
+
+func (roundRobinElection) NextProposer(proposer string, candidates []Sequencer) (Sequencer, bool) {
+	seqs := excludeProposer(proposer, candidates)
+	if len(seqs) == 0 {
+		return Sequencer{}, false
+	}
+	for _, s := range seqs {
+		if proposer < s.Address {
+			return s, true
+		}
+	}
+	// wrap around
+	return seqs[0], true
+}
+
+// successorListElection elects the first bonded sequencer of the successor list, following the proposer if it
+// is in the list. If none of the successors is bonded, it falls back to the highest bond.
+type successorListElection struct {
+	successors []string
+}
+
+func (e successorListElection) NextProposer(proposer string, candidates []Sequencer) (Sequencer, bool) {
+	seqs := excludeProposer(proposer, candidates)
+	if len(seqs) == 0 {
+		return Sequencer{}, false
+	}
+	byAddr := make(map[string]Sequencer, len(seqs))
+	for _, s := range seqs {
+		byAddr[s.Address] = s
+	}
+
+	start := 0
+	for i, s := range e.successors {
+		if s == proposer {
+			start = i + 1
+			break
+		}
+	}
+	for i := range e.successors {
+		if s, ok := byAddr[e.successors[(start+i)%len(e.successors)]]; ok {
+			return s, true
+		}
+	}
+	return highestBondElection{}.NextProposer(proposer, seqs)
+}
+
+// longestBondedElection elects the sequencer which joined the bonded set first. Ties are broken by address:
+// in particular, the sequencers bonded before the v4 upgrade all have the upgrade height as bond height.
+type longestBondedElection struct{}
+
+func (longestBondedElection) NextProposer(proposer string, candidates []Sequencer) (Sequencer, bool) {
+	seqs := excludeProposer(proposer, candidates)
+	if len(seqs) == 0 {
+		return Sequencer{}, false
+	}
+	sort.SliceStable(seqs, func(i, j int) bool {
+		return seqs[i].BondHeight < seqs[j].BondHeight
+	})
+	return seqs[0], true
+}
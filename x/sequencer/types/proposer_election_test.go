@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+)
+
+func TestProposerElection(t *testing.T) {
+	seq := func(addr string, tokens int64, bondHeight int64) Sequencer {
+		return Sequencer{Address: addr, Tokens: sdk.NewCoins(sdk.NewInt64Coin("adym", tokens)), BondHeight: bondHeight}
+	}
+	// addresses are compared as strings, so the order is a < b < c < d
+	a, b, c, d := "a", "b", "c", "d"
+	candidates := []Sequencer{
+		seq(c, 100, 3),
+		seq(a, 200, 4),
+		seq(d, 200, 1),
+		seq(b, 50, 2),
+	}
+
+	tests := []struct {
+		name     string
+		policy   ProposerPolicy
+		proposer string
+		expected string
+	}{
+		{"highest bond, ties broken by address", ProposerPolicy{Type: ProposerPolicyHighestBond}, c, a},
+		{"highest bond, proposer excluded", ProposerPolicy{Type: ProposerPolicyHighestBond}, a, d},
+		{"round robin", ProposerPolicy{Type: ProposerPolicyRoundRobin}, b, c},
+		{"round robin, wrap around", ProposerPolicy{Type: ProposerPolicyRoundRobin}, d, a},
+		{"round robin, no proposer", ProposerPolicy{Type: ProposerPolicyRoundRobin}, "", a},
+		{"successor list, follows the proposer", ProposerPolicy{Type: ProposerPolicySuccessorList, Successors: []string{b, c, a}}, c, a},
+		{"successor list, wrap around", ProposerPolicy{Type: ProposerPolicySuccessorList, Successors: []string{b, c, a}}, a, b},
+		{"successor list, proposer not listed", ProposerPolicy{Type: ProposerPolicySuccessorList, Successors: []string{"e", c}}, a, c},
+		{"successor list, fallback to highest bond", ProposerPolicy{Type: ProposerPolicySuccessorList, Successors: []string{"e"}}, a, d},
+		{"longest bonded", ProposerPolicy{Type: ProposerPolicyLongestBonded}, c, d},
+		{"longest bonded, proposer excluded", ProposerPolicy{Type: ProposerPolicyLongestBonded}, d, b},
+	}
+	// sequencers bonded at the same height, e.g. before the v4 upgrade
+	tied := []Sequencer{
+		seq(c, 100, 5),
+		seq(b, 100, 5),
+		seq(d, 100, 5),
+		seq(a, 100, 6),
+	}
+	t.Run("longest bonded, ties broken by address", func(t *testing.T) {
+		next, ok := NewProposerElection(ProposerPolicy{Type: ProposerPolicyLongestBonded}).NextProposer(b, tied)
+		require.True(t, ok)
+		require.Equal(t, c, next.Address)
+	})
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next, ok := NewProposerElection(tc.policy).NextProposer(tc.proposer, candidates)
+			require.True(t, ok)
+			require.Equal(t, tc.expected, next.Address)
+		})
+	}
+
+	t.Run("no candidate", func(t *testing.T) {
+		for _, typ := range []ProposerPolicyType{ProposerPolicyHighestBond, ProposerPolicyRoundRobin, ProposerPolicyLongestBonded} {
+			_, ok := NewProposerElection(ProposerPolicy{Type: typ}).NextProposer(a, []Sequencer{seq(a, 1, 1)})
+			require.False(t, ok)
+		}
+	})
+}
+
+func TestProposerPolicyValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+
+	tests := []struct {
+		name    string
+		policy  ProposerPolicy
+		wantErr bool
+	}{
+		{"default", DefaultProposerPolicy("rollapp_1234-1"), false},
+		{"successor list", ProposerPolicy{Type: ProposerPolicySuccessorList, Successors: []string{addr}}, false},
+		{"unknown type", ProposerPolicy{Type: ProposerPolicyType(10)}, true},
+		{"successors without successor list", ProposerPolicy{Type: ProposerPolicyRoundRobin, Successors: []string{addr}}, true},
+		{"successor list without successors", ProposerPolicy{Type: ProposerPolicySuccessorList}, true},
+		{"invalid successor", ProposerPolicy{Type: ProposerPolicySuccessorList, Successors: []string{"invalid"}}, true},
+		{"duplicated successor", ProposerPolicy{Type: ProposerPolicySuccessorList, Successors: []string{addr, addr}}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
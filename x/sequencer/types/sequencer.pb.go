@@ -32,6 +32,75 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// JailReason defines the reason a sequencer was jailed for
+type JailReason int32
+
+const (
+	// JAIL_REASON_UNSPECIFIED is set for sequencers not jailed, or jailed before the reasons were recorded
+	JailReasonUnspecified JailReason = 0
+	// JAIL_REASON_LIVENESS defines a sequencer jailed for a liveness failure
+	JailReasonLiveness JailReason = 1
+	// JAIL_REASON_FRAUD defines a sequencer jailed for a fraud
+	JailReasonFraud JailReason = 2
+)
+
+var JailReason_name = map[int32]string{
+	0: "JAIL_REASON_UNSPECIFIED",
+	1: "JAIL_REASON_LIVENESS",
+	2: "JAIL_REASON_FRAUD",
+}
+
+var JailReason_value = map[string]int32{
+	"JAIL_REASON_UNSPECIFIED": 0,
+	"JAIL_REASON_LIVENESS":    1,
+	"JAIL_REASON_FRAUD":       2,
+}
+
+func (x JailReason) String() string {
+	return proto.EnumName(JailReason_name, int32(x))
+}
+
+func (JailReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_997b8663a5fc0f58, []int{0}
+}
+
+// ProposerPolicyType defines how the next proposer of a rollapp is elected among its bonded sequencers.
+// Ties are broken by the sequencer address.
+type ProposerPolicyType int32
+
+const (
+	// PROPOSER_POLICY_HIGHEST_BOND elects the sequencer with the highest bond
+	ProposerPolicyHighestBond ProposerPolicyType = 0
+	// PROPOSER_POLICY_ROUND_ROBIN elects the sequencers in turn, by address order
+	ProposerPolicyRoundRobin ProposerPolicyType = 1
+	// PROPOSER_POLICY_SUCCESSOR_LIST elects the sequencers in the order designated by the rollapp owner
+	ProposerPolicySuccessorList ProposerPolicyType = 2
+	// PROPOSER_POLICY_LONGEST_BONDED elects the sequencer bonded for the longest time
+	ProposerPolicyLongestBonded ProposerPolicyType = 3
+)
+
+var ProposerPolicyType_name = map[int32]string{
+	0: "PROPOSER_POLICY_HIGHEST_BOND",
+	1: "PROPOSER_POLICY_ROUND_ROBIN",
+	2: "PROPOSER_POLICY_SUCCESSOR_LIST",
+	3: "PROPOSER_POLICY_LONGEST_BONDED",
+}
+
+var ProposerPolicyType_value = map[string]int32{
+	"PROPOSER_POLICY_HIGHEST_BOND":   0,
+	"PROPOSER_POLICY_ROUND_ROBIN":    1,
+	"PROPOSER_POLICY_SUCCESSOR_LIST": 2,
+	"PROPOSER_POLICY_LONGEST_BONDED": 3,
+}
+
+func (x ProposerPolicyType) String() string {
+	return proto.EnumName(ProposerPolicyType_name, int32(x))
+}
+
+func (ProposerPolicyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_997b8663a5fc0f58, []int{1}
+}
+
 // Sequencer defines a sequencer identified by its' address (sequencerAddress).
 // The sequencer could be attached to only one rollapp (rollappId).
 type Sequencer struct {
@@ -57,6 +126,14 @@ type Sequencer struct {
 	UnbondTime time.Time `protobuf:"bytes,10,opt,name=unbond_time,json=unbondTime,proto3,stdtime" json:"unbond_time"`
 	// notice_period_time defines the time when the sequencer will finish it's notice period if started
 	NoticePeriodTime time.Time `protobuf:"bytes,11,opt,name=notice_period_time,json=noticePeriodTime,proto3,stdtime" json:"notice_period_time"`
+	// jail_reason is the reason the sequencer was jailed for, if jailed.
+	JailReason JailReason `protobuf:"varint,12,opt,name=jail_reason,json=jailReason,proto3,enum=dymensionxyz.dymension.sequencer.JailReason" json:"jail_reason,omitempty"`
+	// jail_time is the time when the sequencer was jailed, if jailed.
+	JailTime time.Time `protobuf:"bytes,13,opt,name=jail_time,json=jailTime,proto3,stdtime" json:"jail_time"`
+	// unjail_permitted is set by the governance to let a sequencer jailed for fraud unjail itself.
+	UnjailPermitted bool `protobuf:"varint,14,opt,name=unjail_permitted,json=unjailPermitted,proto3" json:"unjail_permitted,omitempty"`
+	// bond_height is the height at which the sequencer joined the bonded set.
+	BondHeight int64 `protobuf:"varint,15,opt,name=bond_height,json=bondHeight,proto3" json:"bond_height,omitempty"`
 }
 
 func (m *Sequencer) Reset()         { *m = Sequencer{} }
@@ -170,6 +247,34 @@ func (m *Sequencer) GetNoticePeriodTime() time.Time {
 	return time.Time{}
 }
 
+func (m *Sequencer) GetJailReason() JailReason {
+	if m != nil {
+		return m.JailReason
+	}
+	return JailReasonUnspecified
+}
+
+func (m *Sequencer) GetJailTime() time.Time {
+	if m != nil {
+		return m.JailTime
+	}
+	return time.Time{}
+}
+
+func (m *Sequencer) GetUnjailPermitted() bool {
+	if m != nil {
+		return m.UnjailPermitted
+	}
+	return false
+}
+
+func (m *Sequencer) GetBondHeight() int64 {
+	if m != nil {
+		return m.BondHeight
+	}
+	return 0
+}
+
 // BondReduction defines an object which holds the information about the sequencer and its queued unbonding amount
 type BondReduction struct {
 	// sequencer_address is the bech32-encoded address of the sequencer account which is the account that the message was sent from.
@@ -234,9 +339,284 @@ func (m *BondReduction) GetDecreaseBondTime() time.Time {
 	return time.Time{}
 }
 
+// Delegation defines the bond delegated by an account to a sequencer. The delegated bond is
+// part of the sequencer tokens and is slashed with them pro-rata.
+type Delegation struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// sequencer_address is the bech32-encoded address of the sequencer.
+	SequencerAddress string `protobuf:"bytes,2,opt,name=sequencer_address,json=sequencerAddress,proto3" json:"sequencer_address,omitempty"`
+	// amount is the delegated bond.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_997b8663a5fc0f58, []int{2}
+}
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *Delegation) GetSequencerAddress() string {
+	if m != nil {
+		return m.SequencerAddress
+	}
+	return ""
+}
+
+func (m *Delegation) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// UnbondingDelegation defines delegated bond being withdrawn, returned to the delegator at completion time.
+type UnbondingDelegation struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// sequencer_address is the bech32-encoded address of the sequencer.
+	SequencerAddress string `protobuf:"bytes,2,opt,name=sequencer_address,json=sequencerAddress,proto3" json:"sequencer_address,omitempty"`
+	// amount is the withdrawn bond.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// completion_time defines the time when the bond is returned to the delegator.
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// creation_height is the hub height at which the unbonding started. The bond is still slashable
+	// for a fraud which happened before.
+	CreationHeight int64 `protobuf:"varint,5,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+}
+
+func (m *UnbondingDelegation) Reset()         { *m = UnbondingDelegation{} }
+func (m *UnbondingDelegation) String() string { return proto.CompactTextString(m) }
+func (*UnbondingDelegation) ProtoMessage()    {}
+func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_997b8663a5fc0f58, []int{3}
+}
+func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingDelegation.Merge(m, src)
+}
+func (m *UnbondingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingDelegation proto.InternalMessageInfo
+
+func (m *UnbondingDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *UnbondingDelegation) GetSequencerAddress() string {
+	if m != nil {
+		return m.SequencerAddress
+	}
+	return ""
+}
+
+func (m *UnbondingDelegation) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *UnbondingDelegation) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func (m *UnbondingDelegation) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+// CompensationPool holds the slashed tokens set aside for the users of a rollapp. The coins are held by the
+// compensation module account and paid out by the governance.
+type CompensationPool struct {
+	// rollapp_id is the rollapp the pool belongs to.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// amount is the balance of the pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *CompensationPool) Reset()         { *m = CompensationPool{} }
+func (m *CompensationPool) String() string { return proto.CompactTextString(m) }
+func (*CompensationPool) ProtoMessage()    {}
+func (*CompensationPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_997b8663a5fc0f58, []int{4}
+}
+func (m *CompensationPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompensationPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompensationPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompensationPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompensationPool.Merge(m, src)
+}
+func (m *CompensationPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompensationPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompensationPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompensationPool proto.InternalMessageInfo
+
+func (m *CompensationPool) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *CompensationPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ProposerPolicy defines the proposer election policy of a rollapp, set by the rollapp owner.
+type ProposerPolicy struct {
+	// rollapp_id is the rollapp the policy applies to.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// type is the election policy.
+	Type ProposerPolicyType `protobuf:"varint,2,opt,name=type,proto3,enum=dymensionxyz.dymension.sequencer.ProposerPolicyType" json:"type,omitempty"`
+	// successors is the ordered list of the designated proposers, for the successor list policy.
+	// If none of them is bonded, the sequencer with the highest bond is elected.
+	Successors []string `protobuf:"bytes,3,rep,name=successors,proto3" json:"successors,omitempty"`
+}
+
+func (m *ProposerPolicy) Reset()         { *m = ProposerPolicy{} }
+func (m *ProposerPolicy) String() string { return proto.CompactTextString(m) }
+func (*ProposerPolicy) ProtoMessage()    {}
+func (*ProposerPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_997b8663a5fc0f58, []int{5}
+}
+func (m *ProposerPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerPolicy.Merge(m, src)
+}
+func (m *ProposerPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerPolicy proto.InternalMessageInfo
+
+func (m *ProposerPolicy) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *ProposerPolicy) GetType() ProposerPolicyType {
+	if m != nil {
+		return m.Type
+	}
+	return ProposerPolicyHighestBond
+}
+
+func (m *ProposerPolicy) GetSuccessors() []string {
+	if m != nil {
+		return m.Successors
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.sequencer.JailReason", JailReason_name, JailReason_value)
+	proto.RegisterEnum("dymensionxyz.dymension.sequencer.ProposerPolicyType", ProposerPolicyType_name, ProposerPolicyType_value)
 	proto.RegisterType((*Sequencer)(nil), "dymensionxyz.dymension.sequencer.Sequencer")
 	proto.RegisterType((*BondReduction)(nil), "dymensionxyz.dymension.sequencer.BondReduction")
+	proto.RegisterType((*Delegation)(nil), "dymensionxyz.dymension.sequencer.Delegation")
+	proto.RegisterType((*UnbondingDelegation)(nil), "dymensionxyz.dymension.sequencer.UnbondingDelegation")
+	proto.RegisterType((*CompensationPool)(nil), "dymensionxyz.dymension.sequencer.CompensationPool")
+	proto.RegisterType((*ProposerPolicy)(nil), "dymensionxyz.dymension.sequencer.ProposerPolicy")
 }
 
 func init() {
@@ -244,48 +624,80 @@ func init() {
 }
 
 var fileDescriptor_997b8663a5fc0f58 = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x6d, 0xd6, 0xad, 0x6b, 0x5d, 0x40, 0xc3, 0x14, 0xc8, 0x26, 0x94, 0x56, 0x3b, 0x45, 0x42,
-	0x73, 0xd6, 0x4e, 0x82, 0xf3, 0x82, 0x90, 0x98, 0x10, 0x62, 0x64, 0x70, 0xe1, 0x12, 0x39, 0x89,
-	0xc9, 0xc2, 0x1a, 0x3b, 0xc4, 0xce, 0xb4, 0xf0, 0x2b, 0xf6, 0x27, 0xb8, 0x70, 0xe6, 0x47, 0x4c,
-	0x9c, 0x76, 0xe4, 0xc4, 0xd0, 0xfa, 0x47, 0x90, 0x1d, 0x27, 0xeb, 0x40, 0x50, 0xed, 0x94, 0x7c,
-	0x7e, 0xdf, 0x7b, 0xf9, 0xbe, 0xe7, 0x17, 0xb0, 0x1d, 0x95, 0x29, 0xa1, 0x3c, 0x61, 0xf4, 0xa4,
-	0xfc, 0xec, 0x34, 0x85, 0xc3, 0xc9, 0xa7, 0x82, 0xd0, 0x90, 0xe4, 0x57, 0x6f, 0x28, 0xcb, 0x99,
-	0x60, 0x70, 0x34, 0xcf, 0x40, 0x4d, 0x81, 0x9a, 0xbe, 0x8d, 0xf5, 0x90, 0xf1, 0x94, 0x71, 0x5f,
-	0xf5, 0x3b, 0x55, 0x51, 0x91, 0x37, 0xd6, 0x63, 0xc6, 0xe2, 0x29, 0x71, 0x54, 0x15, 0x14, 0x1f,
-	0x1c, 0x4c, 0x4b, 0x0d, 0x0d, 0x62, 0x16, 0xb3, 0x8a, 0x22, 0xdf, 0xf4, 0xe9, 0xf0, 0x4f, 0x82,
-	0x48, 0x52, 0xc2, 0x05, 0x4e, 0x33, 0xdd, 0x60, 0x55, 0xfa, 0x4e, 0x80, 0x39, 0x71, 0x8e, 0xc7,
-	0x01, 0x11, 0x78, 0xec, 0x84, 0x2c, 0xa1, 0x1a, 0x7f, 0xa8, 0xf1, 0x94, 0xc7, 0xce, 0xf1, 0x58,
-	0x3e, 0x34, 0xe0, 0x2c, 0xdc, 0x3c, 0x25, 0x02, 0x47, 0x58, 0x60, 0x4d, 0x78, 0xba, 0x90, 0xc0,
-	0x32, 0x92, 0x63, 0x91, 0xd0, 0xd8, 0xe7, 0x02, 0x8b, 0x42, 0x2f, 0xbd, 0xf9, 0x65, 0x05, 0xf4,
-	0x0e, 0xea, 0x26, 0x68, 0x82, 0x55, 0x1c, 0x45, 0x39, 0xe1, 0xdc, 0x34, 0x46, 0x86, 0xdd, 0xf3,
-	0xea, 0x12, 0x7a, 0xe0, 0x56, 0x54, 0xa6, 0x09, 0x15, 0xfb, 0x45, 0xf0, 0x92, 0x94, 0xe6, 0xd2,
-	0xc8, 0xb0, 0xfb, 0x93, 0x01, 0xaa, 0x2c, 0x40, 0xb5, 0x05, 0x68, 0x97, 0x96, 0xae, 0xf9, 0xfd,
-	0xdb, 0xd6, 0x40, 0x5b, 0x1b, 0xe6, 0x65, 0x26, 0x18, 0xaa, 0x58, 0xde, 0x35, 0x0d, 0xf8, 0x08,
-	0xf4, 0x72, 0x36, 0x9d, 0xe2, 0x2c, 0xdb, 0x8b, 0xcc, 0xb6, 0xfa, 0xde, 0xd5, 0x01, 0x7c, 0x07,
-	0xba, 0xf5, 0x92, 0xe6, 0xb2, 0xfa, 0xda, 0x0e, 0x5a, 0x74, 0xbd, 0xa8, 0x59, 0xe5, 0x95, 0xa6,
-	0xba, 0xcb, 0x67, 0x3f, 0x87, 0x2d, 0xaf, 0x91, 0x82, 0x0f, 0x40, 0xe7, 0x23, 0x4e, 0xa6, 0x24,
-	0x32, 0x57, 0x46, 0x86, 0xdd, 0xf5, 0x74, 0x05, 0x2d, 0xd0, 0xcd, 0x72, 0x96, 0x31, 0x4e, 0x72,
-	0xb3, 0x23, 0x11, 0x77, 0xc9, 0x34, 0xbc, 0xe6, 0x0c, 0xee, 0x81, 0x4e, 0x65, 0x9c, 0xb9, 0x3a,
-	0x32, 0xec, 0x3b, 0x93, 0xf1, 0xe2, 0x61, 0x5e, 0xd7, 0x96, 0x1f, 0x28, 0xa2, 0xa7, 0x05, 0x60,
-	0x08, 0x3a, 0x82, 0x1d, 0x11, 0xca, 0xcd, 0xee, 0xa8, 0x6d, 0xf7, 0x27, 0xeb, 0x48, 0x9b, 0x25,
-	0x73, 0x82, 0x74, 0x4e, 0xd0, 0x33, 0x96, 0x50, 0x77, 0x5b, 0x4e, 0xff, 0xf5, 0x62, 0x68, 0xc7,
-	0x89, 0x38, 0x2c, 0x02, 0x14, 0xb2, 0x54, 0x87, 0x56, 0x3f, 0xb6, 0x78, 0x74, 0xe4, 0x88, 0x32,
-	0x23, 0x5c, 0x11, 0xb8, 0xa7, 0xa5, 0xe1, 0x04, 0xdc, 0x2f, 0x68, 0xc0, 0x68, 0xe4, 0xe7, 0x72,
-	0x20, 0x2e, 0xfc, 0x43, 0x92, 0xc4, 0x87, 0xc2, 0xec, 0x8d, 0x0c, 0xbb, 0xed, 0xdd, 0xab, 0x40,
-	0xaf, 0xc2, 0x5e, 0x28, 0x08, 0x3e, 0x07, 0x7d, 0xcd, 0x91, 0x49, 0x36, 0x81, 0x72, 0x7d, 0xe3,
-	0xaf, 0x3b, 0x7e, 0x5b, 0xc7, 0xdc, 0xed, 0xca, 0xf1, 0x4e, 0x2f, 0x86, 0x86, 0x07, 0x2a, 0xa2,
-	0x84, 0xa0, 0x07, 0x20, 0x65, 0x22, 0x09, 0x89, 0x9f, 0x91, 0x3c, 0x61, 0x5a, 0xad, 0x7f, 0x03,
-	0xb5, 0xb5, 0x8a, 0xbf, 0xaf, 0xe8, 0xb2, 0x61, 0x73, 0x66, 0x80, 0xdb, 0xae, 0x1a, 0x38, 0x2a,
-	0x42, 0x91, 0x30, 0x0a, 0x1f, 0x83, 0xbb, 0x8d, 0xd5, 0xfe, 0xf5, 0xd4, 0xae, 0x35, 0xc0, 0xae,
-	0x8e, 0xef, 0x1b, 0x30, 0x88, 0x48, 0x98, 0x13, 0xcc, 0x89, 0xaf, 0x16, 0xc4, 0x29, 0x2b, 0xa8,
-	0xd0, 0x31, 0xfe, 0xcf, 0x05, 0x54, 0xf1, 0x81, 0x35, 0x59, 0x8e, 0xb0, 0xab, 0xa8, 0x72, 0xcb,
-	0xeb, 0x92, 0x6a, 0xcb, 0xf6, 0x4d, 0xb6, 0x9c, 0x57, 0x95, 0x0d, 0xee, 0xfe, 0xd9, 0xa5, 0x65,
-	0x9c, 0x5f, 0x5a, 0xc6, 0xaf, 0x4b, 0xcb, 0x38, 0x9d, 0x59, 0xad, 0xf3, 0x99, 0xd5, 0xfa, 0x31,
-	0xb3, 0x5a, 0xef, 0x9f, 0xcc, 0x05, 0xe0, 0x1f, 0xff, 0xfa, 0xf1, 0x8e, 0x73, 0x32, 0xf7, 0xc3,
-	0xab, 0x50, 0x04, 0x1d, 0x35, 0xc1, 0xce, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x84, 0x3a,
-	0x6a, 0x4c, 0x05, 0x00, 0x00,
+	// 1160 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x3f, 0x93, 0xd3, 0xc6,
+	0x1b, 0x3e, 0xf9, 0x0e, 0x63, 0xaf, 0xc1, 0x67, 0x96, 0x03, 0x74, 0x06, 0x6c, 0xcd, 0x35, 0x3f,
+	0xff, 0x48, 0x90, 0xb8, 0x23, 0x43, 0xaa, 0x4c, 0xc6, 0xf6, 0x19, 0xce, 0xc4, 0xd8, 0xce, 0xea,
+	0x9c, 0x99, 0xa4, 0xd1, 0xc8, 0xd2, 0xa2, 0x13, 0x58, 0xbb, 0x8a, 0x56, 0xba, 0xc1, 0x69, 0xd3,
+	0x64, 0x5c, 0x51, 0x65, 0x26, 0x85, 0xab, 0xa4, 0xc8, 0xa4, 0xce, 0x87, 0x60, 0x92, 0x14, 0x94,
+	0xa9, 0x42, 0x86, 0xfb, 0x22, 0x19, 0xad, 0x56, 0xfe, 0x73, 0x40, 0x3c, 0x57, 0xd0, 0xa4, 0x92,
+	0xf6, 0x7d, 0xf7, 0x79, 0xf4, 0xbe, 0xcf, 0xf3, 0xae, 0x24, 0x70, 0xc7, 0x1e, 0x7b, 0x98, 0x30,
+	0x97, 0x92, 0x67, 0xe3, 0x6f, 0xb4, 0xd9, 0x42, 0x63, 0xf8, 0xeb, 0x08, 0x13, 0x0b, 0x07, 0xf3,
+	0x3b, 0xd5, 0x0f, 0x68, 0x48, 0xa1, 0xb2, 0x88, 0x50, 0x67, 0x0b, 0x75, 0xb6, 0xaf, 0xbc, 0x6d,
+	0x51, 0xe6, 0x51, 0x66, 0xf0, 0xfd, 0x5a, 0xb2, 0x48, 0xc0, 0xe5, 0x6d, 0x87, 0x52, 0x67, 0x84,
+	0x35, 0xbe, 0x1a, 0x46, 0x8f, 0x35, 0x93, 0x8c, 0x45, 0x6a, 0xcb, 0xa1, 0x0e, 0x4d, 0x20, 0xf1,
+	0x9d, 0x88, 0x56, 0x4f, 0x03, 0x42, 0xd7, 0xc3, 0x2c, 0x34, 0x3d, 0x5f, 0x6c, 0xa8, 0x24, 0xfc,
+	0xda, 0xd0, 0x64, 0x58, 0x3b, 0xde, 0x1d, 0xe2, 0xd0, 0xdc, 0xd5, 0x2c, 0xea, 0x12, 0x91, 0xbf,
+	0x26, 0xf2, 0x1e, 0x73, 0xb4, 0xe3, 0xdd, 0xf8, 0x22, 0x12, 0xda, 0xca, 0xce, 0x3d, 0x1c, 0x9a,
+	0xb6, 0x19, 0x9a, 0x02, 0xf0, 0xf1, 0x4a, 0x00, 0xf5, 0x71, 0x60, 0x86, 0x2e, 0x71, 0x0c, 0x16,
+	0x9a, 0x61, 0x24, 0x9a, 0xde, 0xf9, 0xf6, 0x3c, 0xc8, 0xeb, 0xe9, 0x26, 0x28, 0x83, 0xf3, 0xa6,
+	0x6d, 0x07, 0x98, 0x31, 0x59, 0x52, 0xa4, 0x5a, 0x1e, 0xa5, 0x4b, 0x88, 0xc0, 0x05, 0x7b, 0xec,
+	0xb9, 0x24, 0xec, 0x47, 0xc3, 0xcf, 0xf0, 0x58, 0xce, 0x28, 0x52, 0xad, 0xb0, 0xb7, 0xa5, 0x26,
+	0x12, 0xa8, 0xa9, 0x04, 0x6a, 0x9d, 0x8c, 0x1b, 0xf2, 0x6f, 0xbf, 0xde, 0xde, 0x12, 0xd2, 0x5a,
+	0xc1, 0xd8, 0x0f, 0xa9, 0x9a, 0xa0, 0xd0, 0x12, 0x07, 0xbc, 0x01, 0xf2, 0x01, 0x1d, 0x8d, 0x4c,
+	0xdf, 0x6f, 0xdb, 0xf2, 0x3a, 0x7f, 0xde, 0x3c, 0x00, 0x07, 0x20, 0x97, 0x36, 0x29, 0x6f, 0xf0,
+	0xa7, 0xdd, 0x55, 0x57, 0xd9, 0xab, 0xce, 0x5a, 0x79, 0x24, 0xa0, 0x8d, 0x8d, 0x17, 0x7f, 0x55,
+	0xd7, 0xd0, 0x8c, 0x0a, 0x5e, 0x05, 0xd9, 0x27, 0xa6, 0x3b, 0xc2, 0xb6, 0x7c, 0x4e, 0x91, 0x6a,
+	0x39, 0x24, 0x56, 0xb0, 0x02, 0x72, 0x7e, 0x40, 0x7d, 0xca, 0x70, 0x20, 0x67, 0xe3, 0x4c, 0x23,
+	0x23, 0x4b, 0x68, 0x16, 0x83, 0x6d, 0x90, 0x4d, 0x84, 0x93, 0xcf, 0x2b, 0x52, 0xad, 0xb8, 0xb7,
+	0xbb, 0xba, 0x98, 0x5e, 0x2a, 0xb9, 0xce, 0x81, 0x48, 0x10, 0x40, 0x0b, 0x64, 0x43, 0xfa, 0x14,
+	0x13, 0x26, 0xe7, 0x94, 0xf5, 0x5a, 0x61, 0x6f, 0x5b, 0x15, 0x62, 0xc5, 0x73, 0xa2, 0x8a, 0x39,
+	0x51, 0x9b, 0xd4, 0x25, 0x8d, 0x3b, 0x71, 0xf5, 0xbf, 0xbc, 0xaa, 0xd6, 0x1c, 0x37, 0x3c, 0x8a,
+	0x86, 0xaa, 0x45, 0x3d, 0x31, 0xb4, 0xe2, 0x72, 0x9b, 0xd9, 0x4f, 0xb5, 0x70, 0xec, 0x63, 0xc6,
+	0x01, 0x0c, 0x09, 0x6a, 0xb8, 0x07, 0xae, 0x44, 0x64, 0x48, 0x89, 0x6d, 0x04, 0x71, 0x41, 0x2c,
+	0x34, 0x8e, 0xb0, 0xeb, 0x1c, 0x85, 0x72, 0x5e, 0x91, 0x6a, 0xeb, 0xe8, 0x72, 0x92, 0x44, 0x49,
+	0xee, 0x80, 0xa7, 0x60, 0x0b, 0x14, 0x04, 0x26, 0x9e, 0x64, 0x19, 0x70, 0xd5, 0xcb, 0x6f, 0x78,
+	0x7c, 0x98, 0x8e, 0x79, 0x23, 0x17, 0x97, 0xf7, 0xfc, 0x55, 0x55, 0x42, 0x20, 0x01, 0xc6, 0x29,
+	0x88, 0x00, 0x24, 0x34, 0x74, 0x2d, 0x6c, 0xf8, 0x38, 0x70, 0xa9, 0x60, 0x2b, 0x9c, 0x81, 0xad,
+	0x94, 0xe0, 0xfb, 0x1c, 0xce, 0x39, 0x1f, 0x81, 0x42, 0x6c, 0x94, 0x11, 0x60, 0x93, 0x51, 0x22,
+	0x5f, 0xe0, 0x1e, 0x7c, 0xb8, 0xda, 0x83, 0x87, 0xa6, 0x3b, 0x42, 0x1c, 0x83, 0xc0, 0x93, 0xd9,
+	0x3d, 0xac, 0x83, 0x3c, 0xa7, 0xe3, 0x95, 0x5d, 0x3c, 0x43, 0x65, 0xb9, 0x18, 0xc6, 0x2b, 0xfa,
+	0x3f, 0x28, 0x45, 0x84, 0x93, 0xf8, 0x38, 0xf0, 0xdc, 0x30, 0xc4, 0xb6, 0x5c, 0xe4, 0x23, 0xb5,
+	0x99, 0xc4, 0xfb, 0x69, 0x18, 0x56, 0x41, 0x81, 0xab, 0x2a, 0x1c, 0xd8, 0xe4, 0x0e, 0x80, 0x38,
+	0x94, 0x08, 0xbf, 0x73, 0x22, 0x81, 0x8b, 0x0d, 0x6e, 0x87, 0x1d, 0x59, 0xa1, 0x4b, 0x09, 0xfc,
+	0x00, 0x5c, 0x9a, 0x35, 0x61, 0x2c, 0x9f, 0xc9, 0xd2, 0x2c, 0x51, 0x17, 0x87, 0xf3, 0x73, 0xb0,
+	0x65, 0x63, 0x2b, 0x96, 0x06, 0x1b, 0xfc, 0x41, 0xa6, 0x47, 0x23, 0x12, 0x8a, 0x43, 0xfa, 0x2f,
+	0xe3, 0x95, 0x1c, 0x0e, 0x98, 0x82, 0xe3, 0x12, 0xea, 0x1c, 0x1a, 0x7b, 0xb8, 0x4c, 0xc9, 0x95,
+	0x5a, 0x3f, 0x8b, 0x87, 0x8b, 0xac, 0xf1, 0x86, 0x9d, 0xdf, 0x25, 0x00, 0xf6, 0xf1, 0x08, 0x3b,
+	0x66, 0xda, 0xa2, 0x9d, 0xac, 0xe8, 0x1b, 0x2d, 0xce, 0x12, 0x69, 0x8b, 0x6f, 0xd5, 0x23, 0xf3,
+	0x0e, 0x3d, 0x2c, 0x90, 0x15, 0x0a, 0xac, 0xbf, 0x87, 0x03, 0x96, 0x50, 0xef, 0xfc, 0x91, 0x01,
+	0x97, 0x07, 0x7c, 0xe8, 0x5d, 0xe2, 0xfc, 0x67, 0xda, 0x82, 0x8f, 0xc0, 0xa6, 0x45, 0x3d, 0x7f,
+	0x84, 0xe3, 0x66, 0x12, 0xd7, 0x37, 0xce, 0xe0, 0x7a, 0x71, 0x0e, 0xe6, 0xa7, 0xe4, 0x7f, 0x60,
+	0x33, 0x9e, 0x02, 0x4e, 0x26, 0xc6, 0xff, 0x1c, 0x1f, 0xff, 0x62, 0x1a, 0x16, 0x47, 0xe0, 0x7b,
+	0x09, 0x94, 0x9a, 0xd4, 0xf3, 0x31, 0x61, 0x3c, 0xdc, 0xa7, 0x74, 0x04, 0x6f, 0x02, 0x20, 0x3e,
+	0x08, 0x86, 0x6b, 0xcb, 0xd2, 0xe9, 0x4f, 0xc4, 0x5c, 0x90, 0xcc, 0xfb, 0xf3, 0xf9, 0x07, 0x09,
+	0x14, 0xfb, 0xe2, 0x2b, 0xd0, 0xa7, 0x23, 0xd7, 0x1a, 0xaf, 0x2a, 0xeb, 0x00, 0x6c, 0xc4, 0x44,
+	0xdc, 0xc7, 0xe2, 0xde, 0x47, 0xab, 0x5f, 0x52, 0xcb, 0xf4, 0x87, 0x63, 0x1f, 0x23, 0xce, 0x00,
+	0x2b, 0x00, 0xb0, 0xc8, 0xb2, 0x30, 0x63, 0x34, 0x60, 0xdc, 0xf5, 0x3c, 0x5a, 0x88, 0xdc, 0xfa,
+	0x59, 0x02, 0x60, 0xfe, 0x86, 0x83, 0xf7, 0xc0, 0xb5, 0x87, 0xf5, 0x76, 0xc7, 0x40, 0xad, 0xba,
+	0xde, 0xeb, 0x1a, 0x83, 0xae, 0xde, 0x6f, 0x35, 0xdb, 0xf7, 0xdb, 0xad, 0xfd, 0xd2, 0x5a, 0x79,
+	0x7b, 0x32, 0x55, 0xae, 0xcc, 0x37, 0x0f, 0x08, 0xf3, 0xb1, 0xe5, 0x3e, 0x76, 0xb1, 0x0d, 0xef,
+	0x80, 0xad, 0x45, 0x5c, 0xa7, 0xfd, 0x45, 0xab, 0xdb, 0xd2, 0xf5, 0x92, 0x54, 0xbe, 0x3a, 0x99,
+	0x2a, 0x70, 0x0e, 0xea, 0xb8, 0xc7, 0x98, 0xc4, 0xa3, 0x78, 0x0b, 0x5c, 0x5a, 0x44, 0xdc, 0x47,
+	0xf5, 0xc1, 0x7e, 0x29, 0x53, 0xbe, 0x3c, 0x99, 0x2a, 0x9b, 0xf3, 0xed, 0xf7, 0x03, 0x33, 0xb2,
+	0xcb, 0x1b, 0xdf, 0xfd, 0x58, 0x59, 0xbb, 0xf5, 0x53, 0x06, 0xc0, 0x37, 0xfb, 0x84, 0x9f, 0x82,
+	0x1b, 0x7d, 0xd4, 0xeb, 0xf7, 0xf4, 0x16, 0x32, 0xfa, 0xbd, 0x4e, 0xbb, 0xf9, 0xa5, 0x71, 0xd0,
+	0x7e, 0x70, 0xd0, 0xd2, 0x0f, 0x8d, 0x46, 0xaf, 0x1b, 0xd7, 0x7d, 0x73, 0x32, 0x55, 0xb6, 0x97,
+	0x91, 0x07, 0xae, 0x73, 0x84, 0x59, 0x18, 0xbf, 0x58, 0xe0, 0x27, 0xe0, 0xfa, 0x69, 0x02, 0xd4,
+	0x1b, 0x74, 0xf7, 0x0d, 0xd4, 0x6b, 0xb4, 0xbb, 0x25, 0xa9, 0x7c, 0x63, 0x32, 0x55, 0xe4, 0x65,
+	0x3c, 0xa2, 0x11, 0xb1, 0x11, 0x1d, 0xba, 0x04, 0x36, 0x41, 0xe5, 0x34, 0x5c, 0x1f, 0x34, 0x9b,
+	0x2d, 0x5d, 0xef, 0x21, 0xa3, 0xd3, 0xd6, 0x0f, 0x4b, 0x99, 0x72, 0x75, 0x32, 0x55, 0xae, 0x2f,
+	0x33, 0xe8, 0xa9, 0x07, 0x1d, 0x97, 0x85, 0x6f, 0x23, 0xe9, 0xf4, 0xba, 0x0f, 0xd2, 0x26, 0x5a,
+	0xfb, 0xa5, 0xf5, 0xb7, 0x91, 0x74, 0x28, 0x71, 0x44, 0x1b, 0x58, 0xc8, 0xd4, 0xe8, 0xbf, 0x78,
+	0x5d, 0x91, 0x5e, 0xbe, 0xae, 0x48, 0x7f, 0xbf, 0xae, 0x48, 0xcf, 0x4f, 0x2a, 0x6b, 0x2f, 0x4f,
+	0x2a, 0x6b, 0x7f, 0x9e, 0x54, 0xd6, 0xbe, 0xba, 0xb7, 0x30, 0xb9, 0xef, 0xf8, 0xdb, 0x3b, 0xbe,
+	0xab, 0x3d, 0x5b, 0xf8, 0xe5, 0xe3, 0xd3, 0x3c, 0xcc, 0xf2, 0xf3, 0x7a, 0xf7, 0x9f, 0x01, 0x00,
+	0xb1, 0x88, 0xe9, 0x82, 0x4e, 0x0b, 0x00, 0x00,
 }
 
 func (m *Sequencer) Marshal() (dAtA []byte, err error) {
@@ -308,21 +720,49 @@ func (m *Sequencer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NoticePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NoticePeriodTime):])
+	if m.BondHeight != 0 {
+		i = encodeVarintSequencer(dAtA, i, uint64(m.BondHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.UnjailPermitted {
+		i--
+		if m.UnjailPermitted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintSequencer(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x5a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondTime):])
+	dAtA[i] = 0x6a
+	if m.JailReason != 0 {
+		i = encodeVarintSequencer(dAtA, i, uint64(m.JailReason))
+		i--
+		dAtA[i] = 0x60
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NoticePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NoticePeriodTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintSequencer(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x5a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSequencer(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x52
 	if m.UnbondRequestHeight != 0 {
 		i = encodeVarintSequencer(dAtA, i, uint64(m.UnbondRequestHeight))
@@ -427,12 +867,12 @@ func (m *BondReduction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DecreaseBondTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DecreaseBondTime):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DecreaseBondTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DecreaseBondTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintSequencer(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintSequencer(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -455,34 +895,237 @@ func (m *BondReduction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSequencer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSequencer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Sequencer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSequencer(uint64(l))
-	}
-	if m.DymintPubKey != nil {
-		l = m.DymintPubKey.Size()
-		n += 1 + l + sovSequencer(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovSequencer(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSequencer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SequencerAddress) > 0 {
+		i -= len(m.SequencerAddress)
+		copy(dAtA[i:], m.SequencerAddress)
+		i = encodeVarintSequencer(dAtA, i, uint64(len(m.SequencerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSequencer(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintSequencer(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintSequencer(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSequencer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SequencerAddress) > 0 {
+		i -= len(m.SequencerAddress)
+		copy(dAtA[i:], m.SequencerAddress)
+		i = encodeVarintSequencer(dAtA, i, uint64(len(m.SequencerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSequencer(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompensationPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompensationPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompensationPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSequencer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintSequencer(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Successors) > 0 {
+		for iNdEx := len(m.Successors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Successors[iNdEx])
+			copy(dAtA[i:], m.Successors[iNdEx])
+			i = encodeVarintSequencer(dAtA, i, uint64(len(m.Successors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintSequencer(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintSequencer(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSequencer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSequencer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Sequencer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSequencer(uint64(l))
+	}
+	if m.DymintPubKey != nil {
+		l = m.DymintPubKey.Size()
+		n += 1 + l + sovSequencer(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovSequencer(uint64(l))
 	}
 	l = m.Metadata.Size()
 	n += 1 + l + sovSequencer(uint64(l))
@@ -508,6 +1151,17 @@ func (m *Sequencer) Size() (n int) {
 	n += 1 + l + sovSequencer(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NoticePeriodTime)
 	n += 1 + l + sovSequencer(uint64(l))
+	if m.JailReason != 0 {
+		n += 1 + sovSequencer(uint64(m.JailReason))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTime)
+	n += 1 + l + sovSequencer(uint64(l))
+	if m.UnjailPermitted {
+		n += 2
+	}
+	if m.BondHeight != 0 {
+		n += 1 + sovSequencer(uint64(m.BondHeight))
+	}
 	return n
 }
 
@@ -528,6 +1182,98 @@ func (m *BondReduction) Size() (n int) {
 	return n
 }
 
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSequencer(uint64(l))
+	}
+	l = len(m.SequencerAddress)
+	if l > 0 {
+		n += 1 + l + sovSequencer(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSequencer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSequencer(uint64(l))
+	}
+	l = len(m.SequencerAddress)
+	if l > 0 {
+		n += 1 + l + sovSequencer(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSequencer(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovSequencer(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovSequencer(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *CompensationPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovSequencer(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSequencer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposerPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovSequencer(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovSequencer(uint64(m.Type))
+	}
+	if len(m.Successors) > 0 {
+		for _, s := range m.Successors {
+			l = len(s)
+			n += 1 + l + sovSequencer(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSequencer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -874,17 +1620,108 @@ func (m *Sequencer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSequencer(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailReason", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSequencer
+			m.JailReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailReason |= JailReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailPermitted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnjailPermitted = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondHeight", wireType)
+			}
+			m.BondHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSequencer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1043,6 +1880,603 @@ func (m *BondReduction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSequencer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequencerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequencerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSequencer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSequencer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequencerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequencerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSequencer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompensationPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSequencer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompensationPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompensationPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSequencer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSequencer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ProposerPolicyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Successors = append(m.Successors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSequencer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSequencer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -437,7 +437,7 @@ func (s *KeeperTestSuite) TestMsgVoteRollAppGaugeNonBondedSequencer() {
 	proposer := s.CreateDefaultSequencer(s.Ctx, raName)
 
 	// jail the sequencer
-	err := s.App.SequencerKeeper.JailSequencerOnFraud(s.Ctx, proposer)
+	err := s.App.SequencerKeeper.JailSequencerOnFraud(s.Ctx, proposer, s.Ctx.BlockHeight(), nil)
 	s.Require().NoError(err)
 
 	// create a validator and a delegator